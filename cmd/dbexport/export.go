@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/database/export"
+	"github.com/urfave/cli/v2"
+)
+
+var exportCandleCommand = &cli.Command{
+	Name:  "candle",
+	Usage: "export candle data",
+	Flags: append(exportFlags(),
+		&cli.Int64Flag{
+			Name:  "interval",
+			Usage: "interval of candle data in seconds",
+			Value: 86400,
+		},
+	),
+	Action: exportCandles,
+}
+
+var exportTradeCommand = &cli.Command{
+	Name:   "trade",
+	Usage:  "export trade data",
+	Flags:  exportFlags(),
+	Action: exportTrades,
+}
+
+var exportFillCommand = &cli.Command{
+	Name:   "fill",
+	Usage:  "export our own order fills for tax reporting",
+	Flags:  exportFlags(),
+	Action: exportFills,
+}
+
+func exportFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:     "exchange",
+			Usage:    "exchange name of the saved data",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "base",
+			Usage:    "base currency of the saved data",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "quote",
+			Usage:    "quote currency of the saved data",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "asset type of the saved data (spot/margin/futures for example)",
+			Value: "spot",
+		},
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "start date of the export in UTC",
+			Value: time.Now().AddDate(0, -1, 0).UTC().Format(common.SimpleTimeFormat),
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "end date of the export in UTC",
+			Value: time.Now().UTC().Format(common.SimpleTimeFormat),
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "output format <csv/jsonl/parquet>",
+			Value: string(export.CSV),
+		},
+		&cli.StringFlag{
+			Name:      "filename",
+			Usage:     "file to write the exported data to",
+			TakesFile: true,
+			Required:  true,
+		},
+		&cli.IntFlag{
+			Name:  "batchsize",
+			Usage: "number of rows to load from the database at a time",
+			Value: export.DefaultBatchSize,
+		},
+	}
+}
+
+func exportCandles(c *cli.Context) error {
+	return exportData(c, export.Candles)
+}
+
+func exportTrades(c *cli.Context) error {
+	return exportData(c, export.Trades)
+}
+
+func exportFills(c *cli.Context) error {
+	return exportData(c, export.Fills)
+}
+
+func exportData(c *cli.Context, dataType export.DataType) error {
+	format, err := export.ParseFormat(c.String("format"))
+	if err != nil {
+		return err
+	}
+
+	start, err := time.Parse(common.SimpleTimeFormat, c.String("start"))
+	if err != nil {
+		return err
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, c.String("end"))
+	if err != nil {
+		return err
+	}
+
+	params := &export.Params{
+		DataType:  dataType,
+		Format:    format,
+		Exchange:  c.String("exchange"),
+		Base:      c.String("base"),
+		Quote:     c.String("quote"),
+		Asset:     c.String("asset"),
+		Interval:  c.Int64("interval"),
+		Start:     start,
+		End:       end,
+		BatchSize: c.Int("batchsize"),
+	}
+	err = params.Validate()
+	if err != nil {
+		return err
+	}
+
+	fileName := c.String("filename")
+	if fileName == "" {
+		return errors.New("filename must be set")
+	}
+	if !filepath.IsAbs(fileName) {
+		fileName = filepath.Join(workingDir, fileName)
+	}
+
+	err = load(c)
+	if err != nil {
+		return err
+	}
+
+	totalExported, err := export.ToFile(params, fileName)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Exported: %v records to %v", totalExported, fileName)
+	return nil
+}
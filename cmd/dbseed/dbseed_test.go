@@ -30,6 +30,7 @@ var (
 		Commands: []*cli.Command{
 			seedExchangeCommand,
 			seedCandleCommand,
+			seedTradeCommand,
 		},
 	}
 )
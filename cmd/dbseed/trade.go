@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/urfave/cli/v2"
+)
+
+var seedTradeCommand = &cli.Command{
+	Name:  "trade",
+	Usage: "seed trade data",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "file",
+			Usage: "seed trade data from an exchange data dump",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "exchange name of supplied trade data",
+				},
+				&cli.StringFlag{
+					Name:  "base",
+					Usage: "base currency of supplied trade data",
+				},
+				&cli.StringFlag{
+					Name:  "quote",
+					Usage: "quote currency of supplied trade data",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "asset type of supplied data (spot/margin/futures for example)",
+				},
+				&cli.StringFlag{
+					Name:  "format",
+					Usage: "layout of the supplied file <gct/binance/bitmex> (see readme for formatting details)",
+					Value: string(trade.GCTFormat),
+				},
+				&cli.StringFlag{
+					Name:      "filename",
+					Usage:     "csv or gzip compressed csv file to load trade data from",
+					TakesFile: true,
+					FilePath:  workingDir,
+				},
+				&cli.IntFlag{
+					Name:  "batchsize",
+					Usage: "number of trades to insert per transaction",
+					Value: trade.DefaultImportBatchSize,
+				},
+			},
+			Action: seedTradeFromFile,
+		},
+	},
+}
+
+func seedTradeFromFile(c *cli.Context) error {
+	if c.NumFlags() == 0 && c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else if c.Args().Get(0) != "" {
+		exchangeName = c.Args().Get(0)
+	}
+
+	var base string
+	if c.IsSet("base") {
+		base = c.String("base")
+	} else if c.Args().Get(1) != "" {
+		base = c.Args().Get(1)
+	}
+
+	var quote string
+	if c.IsSet("quote") {
+		quote = c.String("quote")
+	} else if c.Args().Get(2) != "" {
+		quote = c.Args().Get(2)
+	}
+
+	var asset string
+	if c.IsSet("asset") {
+		asset = c.String("asset")
+	} else if c.Args().Get(3) != "" {
+		asset = c.Args().Get(3)
+	}
+
+	format := c.String("format")
+	if !c.IsSet("format") && c.Args().Get(4) != "" {
+		format = c.Args().Get(4)
+	}
+	fileFormat, err := trade.ParseFileFormat(format)
+	if err != nil {
+		return err
+	}
+
+	var fileName string
+	if c.IsSet("filename") {
+		fileName = c.String("filename")
+	} else if c.Args().Get(5) != "" {
+		fileName = c.Args().Get(5)
+	}
+
+	batchSize := c.Int("batchsize")
+	if !c.IsSet("batchsize") && c.Args().Get(6) != "" {
+		batchSize, err = strconv.Atoi(c.Args().Get(6))
+		if err != nil {
+			return errors.New("failed to convert batch size")
+		}
+	}
+
+	_, err = os.Stat(fileName)
+	if err != nil {
+		return err
+	}
+
+	err = load(c)
+	if err != nil {
+		return err
+	}
+
+	totalRead, err := trade.InsertFromFile(exchangeName,
+		base, quote, asset, fileFormat,
+		fileName, batchSize)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Processed: %v records, existing trade IDs were skipped", totalRead)
+	return nil
+}
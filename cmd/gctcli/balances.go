@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var getBalanceSnapshotsCommand = cli.Command{
+	Name:      "getbalancesnapshots",
+	Usage:     "gets saved account balance snapshots between a set of dates valued in the reporting currency",
+	ArgsUsage: "<start> <end> <exchange> <account> <asset> <currency>",
+	Action:    getBalanceSnapshots,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().AddDate(0, 0, -7).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "optional exchange to filter snapshots by",
+		},
+		cli.StringFlag{
+			Name:  "account",
+			Usage: "optional sub-account to filter snapshots by",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "optional asset type to filter snapshots by",
+		},
+		cli.StringFlag{
+			Name:  "currency, c",
+			Usage: "optional currency to filter snapshots by",
+		},
+	},
+}
+
+func getBalanceSnapshots(c *cli.Context) error {
+	if !c.IsSet("start") {
+		if c.Args().Get(0) != "" {
+			startTime = c.Args().Get(0)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(1) != "" {
+			endTime = c.Args().Get(1)
+		}
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(2)
+	}
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var accountID string
+	if c.IsSet("account") {
+		accountID = c.String("account")
+	} else {
+		accountID = c.Args().Get(3)
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(4)
+	}
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var currencyCode string
+	if c.IsSet("currency") {
+		currencyCode = c.String("currency")
+	} else {
+		currencyCode = c.Args().Get(5)
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetBalanceSnapshots(context.Background(),
+		&gctrpc.GetBalanceSnapshotsRequest{
+			Exchange:  exchangeName,
+			AccountId: accountID,
+			AssetType: assetType,
+			Currency:  currencyCode,
+			Start:     negateLocalOffset(s),
+			End:       negateLocalOffset(e),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -490,6 +490,1634 @@ func getExchangeInfo(c *cli.Context) error {
 	return nil
 }
 
+var getRateLimitBudgetCommand = cli.Command{
+	Name:      "getratelimitbudget",
+	Usage:     "gets an exchange's rate limit budget as reported by its responses",
+	ArgsUsage: "<exchange>",
+	Action:    getRateLimitBudget,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the rate limit budget for",
+		},
+	},
+}
+
+func getRateLimitBudget(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getratelimitbudget")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetRateLimitBudget(context.Background(),
+		&gctrpc.GenericExchangeNameRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getLatencyStatsCommand = cli.Command{
+	Name:      "getlatencystats",
+	Usage:     "gets REST round trip, websocket delay and server clock offset percentiles for exchanges",
+	ArgsUsage: "<exchange>",
+	Action:    getLatencyStats,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get latency statistics for, all exchanges if unset",
+		},
+	},
+}
+
+func getLatencyStats(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLatencyStats(context.Background(),
+		&gctrpc.GenericExchangeNameRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getPositionsCommand = cli.Command{
+	Name:      "getpositions",
+	Usage:     "gets the open positions for an exchange's derivatives asset type",
+	ArgsUsage: "<exchange> <asset>",
+	Action:    getPositions,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get positions for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the derivatives asset type e.g. futures, perpetualswap",
+		},
+	},
+}
+
+func getPositions(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getpositions")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPositions(context.Background(),
+		&gctrpc.GetPositionsRequest{
+			Exchange: exchangeName,
+			Asset:    assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var closePositionCommand = cli.Command{
+	Name:      "closeposition",
+	Usage:     "closes all or part of an open derivatives position",
+	ArgsUsage: "<exchange> <pair> <asset> <amount> <price> <client_order_id> <side>",
+	Action:    closePosition,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to close the position on",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair of the position",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the derivatives asset type e.g. futures, perpetualswap",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount to close, the whole position if unset",
+		},
+		cli.Float64Flag{
+			Name:  "price",
+			Usage: "the limit price to close at, a market order if unset",
+		},
+		cli.StringFlag{
+			Name:  "client_order_id",
+			Usage: "the optional client order ID",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the leg of a hedged position to close, long or short",
+		},
+	},
+}
+
+func closePosition(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "closeposition")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var price float64
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var clientOrderID string
+	if c.IsSet("client_order_id") {
+		clientOrderID = c.String("client_order_id")
+	} else {
+		clientOrderID = c.Args().Get(5)
+	}
+
+	var side string
+	if c.IsSet("side") {
+		side = c.String("side")
+	} else {
+		side = c.Args().Get(6)
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ClosePosition(context.Background(),
+		&gctrpc.ClosePositionRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Asset:         assetType,
+			Amount:        amount,
+			Price:         price,
+			ClientOrderId: clientOrderID,
+			Side:          side,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getLatestFundingRateCommand = cli.Command{
+	Name:      "getlatestfundingrate",
+	Usage:     "gets the current funding rate of a perpetual contract",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getLatestFundingRate,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the funding rate from",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair of the perpetual contract",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type e.g. perpetualswap, futures",
+		},
+	},
+}
+
+func getLatestFundingRate(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getlatestfundingrate")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLatestFundingRate(context.Background(),
+		&gctrpc.GetLatestFundingRateRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Asset: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getFundingRateHistoryCommand = cli.Command{
+	Name:      "getfundingratehistory",
+	Usage:     "gets the funding rates applied to a perpetual contract over a date range",
+	ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+	Action:    getFundingRateHistory,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the funding rates from",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair of the perpetual contract",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type e.g. perpetualswap, futures",
+		},
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.BoolFlag{
+			Name:  "sync",
+			Usage: "saves the funding rates to the database <true/false>",
+		},
+		cli.BoolFlag{
+			Name:  "db",
+			Usage: "source data from database <true/false>",
+		},
+	},
+}
+
+func getFundingRateHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getfundingratehistory")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	var sync bool
+	if c.IsSet("sync") {
+		sync = c.Bool("sync")
+	}
+
+	var useDB bool
+	if c.IsSet("db") {
+		useDB = c.Bool("db")
+	}
+
+	if sync && useDB {
+		return errors.New("cannot sync funding rates sourced from the database")
+	}
+
+	var s, e time.Time
+	s, err = time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err = time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFundingRateHistory(context.Background(),
+		&gctrpc.GetFundingRateHistoryRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Asset: assetType,
+			Start: negateLocalOffset(s),
+			End:   negateLocalOffset(e),
+			Sync:  sync,
+			UseDb: useDB,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getLeverageCommand = cli.Command{
+	Name:      "getleverage",
+	Usage:     "gets the leverage and margin type applied to orders in a derivatives contract",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getLeverage,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the leverage from",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair of the contract",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type e.g. perpetualswap, futures",
+		},
+	},
+}
+
+func getLeverage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getleverage")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLeverage(context.Background(),
+		&gctrpc.GetLeverageRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Asset: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var setLeverageCommand = cli.Command{
+	Name:      "setleverage",
+	Usage:     "sets the leverage applied to orders in a derivatives contract",
+	ArgsUsage: "<exchange> <pair> <asset> <leverage>",
+	Action:    setLeverage,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to set the leverage on",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair of the contract",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type e.g. perpetualswap, futures",
+		},
+		cli.Float64Flag{
+			Name:  "leverage, l",
+			Usage: "the leverage to apply",
+		},
+	},
+}
+
+func setLeverage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "setleverage")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var leverage float64
+	if c.IsSet("leverage") {
+		leverage = c.Float64("leverage")
+	} else {
+		leverage, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetLeverage(context.Background(),
+		&gctrpc.SetLeverageRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Asset:    assetType,
+			Leverage: leverage,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var setMarginTypeCommand = cli.Command{
+	Name:      "setmargintype",
+	Usage:     "sets whether a derivatives contract uses isolated or cross margin",
+	ArgsUsage: "<exchange> <pair> <asset> <margin_type>",
+	Action:    setMarginType,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to set the margin type on",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair of the contract",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type e.g. perpetualswap, futures",
+		},
+		cli.StringFlag{
+			Name:  "margin_type, m",
+			Usage: "the margin type to use, isolated or cross",
+		},
+	},
+}
+
+func setMarginType(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "setmargintype")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var marginType string
+	if c.IsSet("margin_type") {
+		marginType = c.String("margin_type")
+	} else {
+		marginType = c.Args().Get(3)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetMarginType(context.Background(),
+		&gctrpc.SetMarginTypeRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Asset:      assetType,
+			MarginType: marginType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getLoansCommand = cli.Command{
+	Name:      "getloans",
+	Usage:     "gets outstanding borrowed and lent funds, the loans tracked by the portfolio manager are returned when no exchange is specified",
+	ArgsUsage: "<exchange>",
+	Action:    getLoans,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to fetch loans from",
+		},
+	},
+}
+
+func getLoans(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLoans(context.Background(),
+		&gctrpc.GetLoansRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var borrowCommand = cli.Command{
+	Name:      "borrow",
+	Usage:     "borrows funds against an exchange margin account",
+	ArgsUsage: "<exchange> <currency> <amount>",
+	Action:    borrow,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to borrow funds from",
+		},
+		cli.StringFlag{
+			Name:  "currency, c",
+			Usage: "the currency to borrow",
+		},
+		cli.Float64Flag{
+			Name:  "amount, a",
+			Usage: "the amount to borrow",
+		},
+	},
+}
+
+func borrow(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "borrow")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().Get(1)
+	}
+
+	var amount float64
+	var err error
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else {
+		amount, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.Borrow(context.Background(),
+		&gctrpc.BorrowRequest{
+			Exchange: exchangeName,
+			Currency: curr,
+			Amount:   amount,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var repayCommand = cli.Command{
+	Name:      "repay",
+	Usage:     "repays borrowed funds, omitting the amount repays the whole loan including interest",
+	ArgsUsage: "<exchange> <currency> <amount> <loan_id>",
+	Action:    repay,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to repay funds to",
+		},
+		cli.StringFlag{
+			Name:  "currency, c",
+			Usage: "the currency to repay",
+		},
+		cli.Float64Flag{
+			Name:  "amount, a",
+			Usage: "the amount to repay",
+		},
+		cli.StringFlag{
+			Name:  "loan_id, l",
+			Usage: "the loan to repay, required by exchanges which track borrowings per loan",
+		},
+	},
+}
+
+func repay(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "repay")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().Get(1)
+	}
+
+	var amount float64
+	var err error
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(2) != "" {
+		amount, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var loanID string
+	if c.IsSet("loan_id") {
+		loanID = c.String("loan_id")
+	} else {
+		loanID = c.Args().Get(3)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.Repay(context.Background(),
+		&gctrpc.RepayRequest{
+			Exchange: exchangeName,
+			Currency: curr,
+			Amount:   amount,
+			LoanId:   loanID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var offerFundsCommand = cli.Command{
+	Name:      "offerfunds",
+	Usage:     "offers funds to be lent to margin traders",
+	ArgsUsage: "<exchange> <currency> <amount> <rate> <period>",
+	Action:    offerFunds,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to offer funds on",
+		},
+		cli.StringFlag{
+			Name:  "currency, c",
+			Usage: "the currency to lend",
+		},
+		cli.Float64Flag{
+			Name:  "amount, a",
+			Usage: "the amount to lend",
+		},
+		cli.Float64Flag{
+			Name:  "rate, r",
+			Usage: "the daily interest rate as a fraction e.g. 0.0002",
+		},
+		cli.Int64Flag{
+			Name:  "period, p",
+			Usage: "the loan term in days",
+		},
+	},
+}
+
+func offerFunds(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "offerfunds")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().Get(1)
+	}
+
+	var amount float64
+	var err error
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else {
+		amount, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var rate float64
+	if c.IsSet("rate") {
+		rate = c.Float64("rate")
+	} else {
+		rate, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var period int64
+	if c.IsSet("period") {
+		period = c.Int64("period")
+	} else {
+		period, err = strconv.ParseInt(c.Args().Get(4), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.OfferFunds(context.Background(),
+		&gctrpc.OfferFundsRequest{
+			Exchange: exchangeName,
+			Currency: curr,
+			Amount:   amount,
+			Rate:     rate,
+			Period:   period,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var cancelFundingOfferCommand = cli.Command{
+	Name:      "cancelfundingoffer",
+	Usage:     "cancels an open lending offer",
+	ArgsUsage: "<exchange> <offer_id>",
+	Action:    cancelFundingOffer,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange the offer was placed on",
+		},
+		cli.StringFlag{
+			Name:  "offer_id, o",
+			Usage: "the offer to cancel",
+		},
+	},
+}
+
+func cancelFundingOffer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "cancelfundingoffer")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var offerID string
+	if c.IsSet("offer_id") {
+		offerID = c.String("offer_id")
+	} else {
+		offerID = c.Args().Get(1)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelFundingOffer(context.Background(),
+		&gctrpc.CancelFundingOfferRequest{
+			Exchange: exchangeName,
+			Id:       offerID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDerivativePriceCommand = cli.Command{
+	Name:      "getderivativeprice",
+	Usage:     "gets the mark price, index price, open interest and estimated settlement price of a derivatives contract",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getDerivativePrice,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the derivative price from",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair of the contract",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type e.g. perpetualswap, futures",
+		},
+	},
+}
+
+func getDerivativePrice(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getderivativeprice")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDerivativePrice(context.Background(),
+		&gctrpc.GetDerivativePriceRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDerivativePriceStreamCommand = cli.Command{
+	Name:      "getderivativepricestream",
+	Usage:     "streams derivative price updates for a specific contract and exchange",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getDerivativePriceStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the derivative price from",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair of the contract",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type e.g. perpetualswap, futures",
+		},
+	},
+}
+
+func getDerivativePriceStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getderivativepricestream")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDerivativePriceStream(context.Background(),
+		&gctrpc.GetDerivativePriceStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Derivative price stream for %s %s %s:\n",
+			exchangeName,
+			resp.Pair.String(),
+			resp.Asset)
+		fmt.Println()
+
+		fmt.Printf("MARK: %f\n INDEX: %f\n OPENINTEREST: %f\n ESTIMATEDSETTLEMENT: %f\n LASTUPDATED: %d\n",
+			resp.MarkPrice,
+			resp.IndexPrice,
+			resp.OpenInterest,
+			resp.EstimatedSettlementPrice,
+			resp.LastUpdated)
+	}
+}
+
+var getExchangeDerivativePriceStreamCommand = cli.Command{
+	Name:      "getexchangederivativepricestream",
+	Usage:     "streams all derivative price updates associated with an exchange",
+	ArgsUsage: "<exchange>",
+	Action:    getExchangeDerivativePriceStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the derivative prices from",
+		},
+	},
+}
+
+func getExchangeDerivativePriceStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getexchangederivativepricestream")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeDerivativePriceStream(context.Background(),
+		&gctrpc.GetExchangeDerivativePriceStreamRequest{
+			Exchange: exchangeName,
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Derivative price stream for %s %s %s:\n",
+			exchangeName,
+			resp.Pair.String(),
+			resp.Asset)
+
+		fmt.Printf("MARK: %f INDEX: %f OPENINTEREST: %f ESTIMATEDSETTLEMENT: %f LASTUPDATED: %d\n",
+			resp.MarkPrice,
+			resp.IndexPrice,
+			resp.OpenInterest,
+			resp.EstimatedSettlementPrice,
+			resp.LastUpdated)
+	}
+}
+
+var getOptionChainCommand = cli.Command{
+	Name:      "getoptionchain",
+	Usage:     "gets the option contracts of an underlying currency",
+	ArgsUsage: "<exchange> <underlying> <expiry>",
+	Action:    getOptionChain,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the option chain from",
+		},
+		cli.StringFlag{
+			Name:  "underlying, u",
+			Usage: "the underlying currency of the options e.g. BTC",
+		},
+		cli.StringFlag{
+			Name:  "expiry",
+			Usage: "<expiry> limits the chain to contracts expiring at this time, e.g. 2021-06-25 08:00:00",
+		},
+	},
+}
+
+func getOptionChain(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getoptionchain")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var underlying string
+	if c.IsSet("underlying") {
+		underlying = c.String("underlying")
+	} else {
+		underlying = c.Args().Get(1)
+	}
+	if underlying == "" {
+		return errors.New("underlying currency must be set")
+	}
+
+	var expiryTime string
+	if c.IsSet("expiry") {
+		expiryTime = c.String("expiry")
+	} else {
+		expiryTime = c.Args().Get(2)
+	}
+
+	var expiry int64
+	if expiryTime != "" {
+		e, err := time.Parse(common.SimpleTimeFormat, expiryTime)
+		if err != nil {
+			return fmt.Errorf("invalid time format for expiry: %v", err)
+		}
+		expiry = e.Unix()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOptionChain(context.Background(),
+		&gctrpc.GetOptionChainRequest{
+			Exchange:   exchangeName,
+			Underlying: underlying,
+			Expiry:     expiry,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getContractsCommand = cli.Command{
+	Name:      "getcontracts",
+	Usage:     "gets the type, expiry and settlement currency of an exchange's derivatives contracts",
+	ArgsUsage: "<exchange> <asset>",
+	Action:    getContracts,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to get the contracts from",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the derivatives asset type e.g. futures",
+		},
+	},
+}
+
+func getContracts(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getcontracts")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetContracts(context.Background(),
+		&gctrpc.GetContractsRequest{
+			Exchange:  exchangeName,
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getTickerCommand = cli.Command{
 	Name:      "getticker",
 	Usage:     "gets the ticker for a specific currency pair and exchange",
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var exportSavedDataCommand = cli.Command{
+	Name:      "exportsaveddata",
+	Usage:     "exports saved candle, trade or fill data from the database to a local csv, jsonl or parquet file",
+	ArgsUsage: "<exchange> <pair> <asset> <datatype> <format> <output> <start> <end>",
+	Action:    exportSavedData,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange the data was saved for",
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "the currency pair to export",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the asset type of the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "datatype, d",
+			Usage: "the data to export <candle/trade/fill>",
+			Value: "candle",
+		},
+		cli.StringFlag{
+			Name:  "format, f",
+			Usage: "the output format <csv/jsonl/parquet>",
+			Value: "csv",
+		},
+		cli.StringFlag{
+			Name:  "output, o",
+			Usage: "the local file to write the exported data to",
+		},
+		cli.Int64Flag{
+			Name:        "interval, i",
+			Usage:       fmt.Sprintf(klineMessage, "candle interval"),
+			Value:       86400,
+			Destination: &candleGranularity,
+		},
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.Int64Flag{
+			Name:  "batchsize, b",
+			Usage: "the number of rows the server loads from the database at a time",
+			Value: 10000,
+		},
+	},
+}
+
+func exportSavedData(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "exportsaveddata")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	dataType := c.String("datatype")
+	if !c.IsSet("datatype") && c.Args().Get(3) != "" {
+		dataType = c.Args().Get(3)
+	}
+
+	format := c.String("format")
+	if !c.IsSet("format") && c.Args().Get(4) != "" {
+		format = c.Args().Get(4)
+	}
+
+	var output string
+	if c.IsSet("output") {
+		output = c.String("output")
+	} else {
+		output = c.Args().Get(5)
+	}
+	if output == "" {
+		return errors.New("output file must be set")
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(6) != "" {
+			startTime = c.Args().Get(6)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(7) != "" {
+			endTime = c.Args().Get(7)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ExportSavedData(context.Background(),
+		&gctrpc.ExportSavedDataRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			DataType:  dataType,
+			Format:    format,
+			Interval:  candleGranularity,
+			Start:     negateLocalOffset(s),
+			End:       negateLocalOffset(e),
+			BatchSize: c.Int64("batchsize"),
+		})
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for {
+		resp, err := result.Recv()
+		if err == io.EOF {
+			return errors.New("export stream closed before completion")
+		}
+		if err != nil {
+			return err
+		}
+		if len(resp.Data) > 0 {
+			_, err = f.Write(resp.Data)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		fmt.Printf("Exported %v %s records to %s\n", resp.TotalRecords, dataType, output)
+		return nil
+	}
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var fillsCommand = cli.Command{
+	Name:      "fills",
+	Usage:     "execute fills ledger and profit and loss commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "import",
+			Usage:     "imports an exchange's order history into the fills ledger",
+			ArgsUsage: "<exchange> <asset> <start> <end>",
+			Action:    importFills,
+			Flags: append(fillFilterFlags(),
+				cli.StringFlag{
+					Name:  "strategy, s",
+					Usage: "the strategy tag to apply to the imported fills",
+				},
+			),
+		},
+		{
+			Name:      "get",
+			Usage:     "gets fills from the fills ledger",
+			ArgsUsage: "<exchange> <asset> <start> <end>",
+			Action:    getFills,
+			Flags: append(fillFilterFlags(),
+				cli.StringFlag{
+					Name:  "strategy, s",
+					Usage: "optional strategy tag to filter fills by",
+				},
+			),
+		},
+		{
+			Name:      "pnl",
+			Usage:     "calculates realised and unrealised profit and loss from the fills ledger",
+			ArgsUsage: "<exchange> <asset> <start> <end>",
+			Action:    getPNL,
+			Flags: append(fillFilterFlags(),
+				cli.StringFlag{
+					Name:  "strategy, s",
+					Usage: "optional strategy tag to filter fills by",
+				},
+				cli.StringFlag{
+					Name:  "method, m",
+					Usage: "the lot matching method <fifo/lifo/average>",
+					Value: "fifo",
+				},
+				cli.BoolFlag{
+					Name:  "disposals, d",
+					Usage: "includes each closed lot in the output for tax reporting",
+				},
+			),
+		},
+	},
+}
+
+func fillFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange the fills belong to",
+		},
+		cli.StringFlag{
+			Name:  "asset, a",
+			Usage: "the asset type of the fills",
+		},
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start>",
+			Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end>",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		cli.StringFlag{
+			Name:  "pair, p",
+			Usage: "optional currency pair to filter fills by",
+		},
+	}
+}
+
+type fillFilter struct {
+	exchange  string
+	assetType string
+	pair      *gctrpc.CurrencyPair
+	start     string
+	end       string
+}
+
+func parseFillFilter(c *cli.Context, exchangeRequired bool) (*fillFilter, error) {
+	var f fillFilter
+	if c.IsSet("exchange") {
+		f.exchange = c.String("exchange")
+	} else {
+		f.exchange = c.Args().First()
+	}
+	if (exchangeRequired || f.exchange != "") && !validExchange(f.exchange) {
+		return nil, errInvalidExchange
+	}
+
+	if c.IsSet("asset") {
+		f.assetType = c.String("asset")
+	} else {
+		f.assetType = c.Args().Get(1)
+	}
+	if (exchangeRequired || f.assetType != "") && !validAsset(f.assetType) {
+		return nil, errInvalidAsset
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(2) != "" {
+			startTime = c.Args().Get(2)
+		}
+	}
+	if !c.IsSet("end") {
+		if c.Args().Get(3) != "" {
+			endTime = c.Args().Get(3)
+		}
+	}
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return nil, errors.New("start cannot be after end")
+	}
+	f.start = negateLocalOffset(s)
+	f.end = negateLocalOffset(e)
+
+	if c.IsSet("pair") {
+		if !validPair(c.String("pair")) {
+			return nil, errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(c.String("pair"), pairDelimiter)
+		if err != nil {
+			return nil, err
+		}
+		f.pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+	return &f, nil
+}
+
+func importFills(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "import")
+	}
+
+	f, err := parseFillFilter(c, true)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ImportFills(context.Background(),
+		&gctrpc.ImportFillsRequest{
+			Exchange:  f.exchange,
+			Pair:      f.pair,
+			AssetType: f.assetType,
+			Start:     f.start,
+			End:       f.end,
+			Strategy:  c.String("strategy"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getFills(c *cli.Context) error {
+	f, err := parseFillFilter(c, false)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFills(context.Background(),
+		&gctrpc.GetFillsRequest{
+			Exchange:  f.exchange,
+			Pair:      f.pair,
+			AssetType: f.assetType,
+			Strategy:  c.String("strategy"),
+			Start:     f.start,
+			End:       f.end,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getPNL(c *cli.Context) error {
+	f, err := parseFillFilter(c, false)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPNL(context.Background(),
+		&gctrpc.GetPNLRequest{
+			Exchange:         f.exchange,
+			Pair:             f.pair,
+			AssetType:        f.assetType,
+			Strategy:         c.String("strategy"),
+			Start:            f.start,
+			End:              f.end,
+			Method:           c.String("method"),
+			IncludeDisposals: c.Bool("disposals"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
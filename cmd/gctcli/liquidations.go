@@ -0,0 +1,394 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var liquidationCommand = cli.Command{
+	Name:      "liquidation",
+	Usage:     "execute liquidation related commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "setexchangeliquidationprocessing",
+			Usage:     "sets whether an exchange can save liquidations to the database",
+			ArgsUsage: "<exchange> <status>",
+			Action:    setExchangeLiquidationProcessing,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to change the status of",
+				},
+				cli.BoolFlag{
+					Name:  "status",
+					Usage: "<true>/<false>",
+				},
+			},
+		},
+		{
+			Name:      "get",
+			Usage:     "gets liquidations between two periods from the exchange",
+			ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+			Action:    getLiquidations,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to get the liquidations from",
+				},
+				cli.StringFlag{
+					Name:  "pair, p",
+					Usage: "the currency pair to get the liquidations for",
+				},
+				cli.StringFlag{
+					Name:  "asset, a",
+					Usage: "the asset type of the currency pair",
+				},
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().Add(-time.Hour * 6).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+			},
+		},
+		{
+			Name:      "getsaved",
+			Usage:     "gets liquidations from the database",
+			ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+			Action:    getSavedLiquidations,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to get the liquidations from",
+				},
+				cli.StringFlag{
+					Name:  "pair, p",
+					Usage: "the currency pair to get the liquidations for",
+				},
+				cli.StringFlag{
+					Name:  "asset, a",
+					Usage: "the asset type of the currency pair",
+				},
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+			},
+		},
+		{
+			Name:      "stream",
+			Usage:     "streams liquidations for an exchange, optionally filtered by pair and asset",
+			ArgsUsage: "<exchange> <pair> <asset>",
+			Action:    getLiquidationStream,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to stream liquidations from",
+				},
+				cli.StringFlag{
+					Name:  "pair, p",
+					Usage: "the optional currency pair to filter by",
+				},
+				cli.StringFlag{
+					Name:  "asset, a",
+					Usage: "the optional asset type to filter by",
+				},
+			},
+		},
+	},
+}
+
+func setExchangeLiquidationProcessing(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "setexchangeliquidationprocessing")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var status bool
+	if c.IsSet("status") {
+		status = c.Bool("status")
+	} else {
+		statusStr := c.Args().Get(1)
+		var err error
+		status, err = strconv.ParseBool(statusStr)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetExchangeLiquidationProcessing(context.Background(),
+		&gctrpc.SetExchangeLiquidationProcessingRequest{
+			Exchange: exchangeName,
+			Status:   status,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getLiquidations(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "get")
+	}
+
+	req, err := parseLiquidationsRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLiquidations(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getSavedLiquidations(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getsaved")
+	}
+
+	req, err := parseLiquidationsRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetSavedLiquidations(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func parseLiquidationsRequest(c *cli.Context) (*gctrpc.GetLiquidationsRequest, error) {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return nil, errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return nil, errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return nil, errInvalidAsset
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	return &gctrpc.GetLiquidationsRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType: assetType,
+		Start:     negateLocalOffset(s),
+		End:       negateLocalOffset(e),
+	}, nil
+}
+
+func getLiquidationStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "stream")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	var pair *gctrpc.CurrencyPair
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLiquidationStream(context.Background(),
+		&gctrpc.GetLiquidationStreamRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		for i := range resp.Liquidations {
+			fmt.Printf("%s %s %s LIQUIDATION: %s %f @ %f %s\n",
+				resp.ExchangeName,
+				resp.Pair.String(),
+				resp.Asset,
+				resp.Liquidations[i].Side,
+				resp.Liquidations[i].Amount,
+				resp.Liquidations[i].Price,
+				resp.Liquidations[i].Timestamp)
+		}
+	}
+}
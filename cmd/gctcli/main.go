@@ -103,6 +103,25 @@ func main() {
 		getExchangeOTPCommand,
 		getExchangeOTPsCommand,
 		getExchangeInfoCommand,
+		getRateLimitBudgetCommand,
+		getLatencyStatsCommand,
+		getPositionsCommand,
+		closePositionCommand,
+		getLatestFundingRateCommand,
+		getFundingRateHistoryCommand,
+		getLeverageCommand,
+		setLeverageCommand,
+		setMarginTypeCommand,
+		getLoansCommand,
+		borrowCommand,
+		repayCommand,
+		offerFundsCommand,
+		cancelFundingOfferCommand,
+		getDerivativePriceCommand,
+		getDerivativePriceStreamCommand,
+		getExchangeDerivativePriceStreamCommand,
+		getOptionChainCommand,
+		getContractsCommand,
 		getTickerCommand,
 		getTickersCommand,
 		getOrderbookCommand,
@@ -147,6 +166,11 @@ func main() {
 		gctScriptCommand,
 		websocketManagerCommand,
 		tradeCommand,
+		exportSavedDataCommand,
+		getBalanceSnapshotsCommand,
+		fillsCommand,
+		liquidationCommand,
+		transferCommand,
 	}
 
 	err := app.Run(os.Args)
@@ -0,0 +1,443 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli"
+)
+
+var transferCommand = cli.Command{
+	Name:      "transfer",
+	Usage:     "execute sub-account and inter-account transfer related commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "getsubaccounts",
+			Usage:     "gets the sub-accounts of an exchange account",
+			ArgsUsage: "<exchange>",
+			Action:    getSubAccounts,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to get the sub-accounts from",
+				},
+			},
+		},
+		{
+			Name:      "funds",
+			Usage:     "transfers funds between wallets or sub-accounts on an exchange",
+			ArgsUsage: "<exchange> <currency> <amount> <from_account> <to_account> <from_asset> <to_asset> <description>",
+			Action:    transferFunds,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to transfer funds on",
+				},
+				cli.StringFlag{
+					Name:  "currency, c",
+					Usage: "the currency to transfer",
+				},
+				cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount to transfer",
+				},
+				cli.StringFlag{
+					Name:  "from_account",
+					Usage: "the source sub-account, leave blank for the main account",
+				},
+				cli.StringFlag{
+					Name:  "to_account",
+					Usage: "the destination sub-account, leave blank for the main account",
+				},
+				cli.StringFlag{
+					Name:  "from_asset",
+					Usage: "the source wallet asset type e.g. spot",
+					Value: "spot",
+				},
+				cli.StringFlag{
+					Name:  "to_asset",
+					Usage: "the destination wallet asset type e.g. futures",
+					Value: "spot",
+				},
+				cli.StringFlag{
+					Name:  "description",
+					Usage: "an optional description recorded against the transfer",
+				},
+			},
+		},
+		{
+			Name:      "byid",
+			Usage:     "gets a recorded transfer by its id",
+			ArgsUsage: "<id>",
+			Action:    transferEventByID,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "id",
+					Usage: "<id>",
+				},
+			},
+		},
+		{
+			Name:      "byexchange",
+			Usage:     "gets recorded transfers for an exchange",
+			ArgsUsage: "<exchange> <limit>",
+			Action:    transferEventsByExchange,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to get the transfers for",
+				},
+				cli.Int64Flag{
+					Name:  "limit",
+					Usage: "<limit>",
+				},
+			},
+		},
+		{
+			Name:      "bydate",
+			Usage:     "gets recorded transfers for an exchange between two dates",
+			ArgsUsage: "<exchange> <start> <end> <limit>",
+			Action:    transferEventsByDate,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to get the transfers for",
+				},
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+				cli.Int64Flag{
+					Name:  "limit",
+					Usage: "<limit>",
+				},
+			},
+		},
+	},
+}
+
+func getSubAccounts(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getsubaccounts")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetSubAccounts(context.Background(),
+		&gctrpc.GetSubAccountsRequest{
+			Exchange: exchangeName,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func transferFunds(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "funds")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().Get(1)
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(2) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var fromAccount string
+	if c.IsSet("from_account") {
+		fromAccount = c.String("from_account")
+	} else {
+		fromAccount = c.Args().Get(3)
+	}
+
+	var toAccount string
+	if c.IsSet("to_account") {
+		toAccount = c.String("to_account")
+	} else {
+		toAccount = c.Args().Get(4)
+	}
+
+	fromAsset := c.String("from_asset")
+	if !c.IsSet("from_asset") && c.Args().Get(5) != "" {
+		fromAsset = c.Args().Get(5)
+	}
+	fromAsset = strings.ToLower(fromAsset)
+	if !validAsset(fromAsset) {
+		return errInvalidAsset
+	}
+
+	toAsset := c.String("to_asset")
+	if !c.IsSet("to_asset") && c.Args().Get(6) != "" {
+		toAsset = c.Args().Get(6)
+	}
+	toAsset = strings.ToLower(toAsset)
+	if !validAsset(toAsset) {
+		return errInvalidAsset
+	}
+
+	var description string
+	if c.IsSet("description") {
+		description = c.String("description")
+	} else {
+		description = c.Args().Get(7)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.TransferFunds(context.Background(),
+		&gctrpc.TransferFundsRequest{
+			Exchange:    exchangeName,
+			Currency:    curr,
+			Amount:      amount,
+			Description: description,
+			FromAccount: fromAccount,
+			ToAccount:   toAccount,
+			FromAsset:   fromAsset,
+			ToAsset:     toAsset,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func transferEventByID(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "byid")
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errors.New("an ID must be specified")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.TransferEventByID(context.Background(),
+		&gctrpc.TransferEventByIDRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func parseTransferLimit(c *cli.Context, argIndex int) (int32, error) {
+	if c.IsSet("limit") {
+		limit := c.Int64("limit")
+		if limit > math.MaxInt32 {
+			return 0, fmt.Errorf("limit greater than max size: %v", math.MaxInt32)
+		}
+		return int32(limit), nil
+	}
+	if c.Args().Get(argIndex) == "" {
+		return 0, nil
+	}
+	limit, err := strconv.ParseInt(c.Args().Get(argIndex), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return int32(limit), nil
+}
+
+func transferEventsByExchange(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "byexchange")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	limit, err := parseTransferLimit(c, 1)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.TransferEventsByExchange(context.Background(),
+		&gctrpc.TransferEventsByExchangeRequest{
+			Exchange: exchangeName,
+			Limit:    limit,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func transferEventsByDate(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "bydate")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if !c.IsSet("start") && c.Args().Get(1) != "" {
+		startTime = c.Args().Get(1)
+	}
+	if !c.IsSet("end") && c.Args().Get(2) != "" {
+		endTime = c.Args().Get(2)
+	}
+
+	limit, err := parseTransferLimit(c, 3)
+	if err != nil {
+		return err
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+		if err != nil {
+			fmt.Print(err)
+		}
+	}()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.TransferEventsByDate(context.Background(),
+		&gctrpc.TransferEventsByDateRequest{
+			Exchange: exchangeName,
+			Start:    negateLocalOffset(s),
+			End:      negateLocalOffset(e),
+			Limit:    limit,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
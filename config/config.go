@@ -1021,6 +1021,29 @@ func (c *Config) CheckExchangeConfigValues() error {
 					defaultWebsocketOrderbookBufferLimit)
 				c.Exchanges[i].OrderbookConfig.WebsocketBufferLimit = defaultWebsocketOrderbookBufferLimit
 			}
+			if s := c.Exchanges[i].SharedRateLimit; s != nil && s.Enabled {
+				if s.Interval <= 0 || s.Requests <= 0 {
+					log.Warnf(log.ConfigMgr,
+						"Exchange %s shared rate limit interval and requests must be set, disabling shared rate limit.",
+						c.Exchanges[i].Name)
+					s.Enabled = false
+				} else if s.Path == "" {
+					s.Path = c.GetDataPath("ratelimit",
+						strings.ToLower(c.Exchanges[i].Name)+".json")
+				}
+			}
+			if w := c.Exchanges[i].WebsocketCapture; w != nil && w.Enabled {
+				if w.Directory == "" {
+					w.Directory = c.GetDataPath("websocket_capture",
+						strings.ToLower(c.Exchanges[i].Name))
+				}
+				if w.MaxFileSize <= 0 {
+					w.MaxFileSize = defaultWebsocketCaptureMaxFileSize
+				}
+				if w.MaxFiles <= 0 {
+					w.MaxFiles = defaultWebsocketCaptureMaxFiles
+				}
+			}
 			err := c.CheckPairConsistency(c.Exchanges[i].Name)
 			if err != nil {
 				log.Errorf(log.ConfigMgr,
@@ -1343,6 +1366,10 @@ func (c *Config) checkDatabaseConfig() error {
 		database.DB.DataPath = databaseDir
 	}
 
+	if c.Database.Retention != nil && c.Database.Retention.Interval <= 0 {
+		c.Database.Retention.Interval = database.DefaultRetentionInterval
+	}
+
 	database.DB.Config = &c.Database
 
 	return nil
@@ -1426,6 +1453,28 @@ func (c *Config) CheckConnectionMonitorConfig() {
 	}
 }
 
+// CheckLatencyMonitorConfig checks and if zero value assigns default values
+func (c *Config) CheckLatencyMonitorConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.LatencyMonitor.CheckInterval <= 0 {
+		c.LatencyMonitor.CheckInterval = defaultLatencyCheckInterval
+	}
+
+	if c.LatencyMonitor.MaxRoundTrip <= 0 {
+		c.LatencyMonitor.MaxRoundTrip = defaultLatencyMaxRoundTrip
+	}
+
+	if c.LatencyMonitor.MaxWebsocketDelay <= 0 {
+		c.LatencyMonitor.MaxWebsocketDelay = defaultLatencyMaxWebsocketDelay
+	}
+
+	if c.LatencyMonitor.MaxServerOffset <= 0 {
+		c.LatencyMonitor.MaxServerOffset = defaultLatencyMaxServerOffset
+	}
+}
+
 // DefaultFilePath returns the default config file path
 // MacOS/Linux: $HOME/.gocryptotrader/config.json or config.dat
 // Windows: %APPDATA%\GoCryptoTrader\config.json or config.dat
@@ -1751,6 +1800,7 @@ func (c *Config) CheckConfig() error {
 	}
 
 	c.CheckConnectionMonitorConfig()
+	c.CheckLatencyMonitorConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
@@ -24,7 +25,7 @@ import (
 const (
 	// Default number of enabled exchanges. Modify this whenever an exchange is
 	// added or removed
-	defaultEnabledExchanges = 28
+	defaultEnabledExchanges = 29
 	testFakeExchangeName    = "Stampbit"
 	testPair                = "BTC-USD"
 	testString              = "test"
@@ -1260,6 +1261,59 @@ func TestUpdateExchangeConfig(t *testing.T) {
 }
 
 // TestCheckExchangeConfigValues logic test
+func TestCheckSharedRateLimitConfig(t *testing.T) {
+	var cfg Config
+	err := cfg.LoadConfig(TestFile, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.DataDirectory = "data"
+	cfg.Exchanges[0].Enabled = true
+	cfg.Exchanges[0].SharedRateLimit = &SharedRateLimitConfig{Enabled: true}
+	err = cfg.CheckExchangeConfigValues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Exchanges[0].SharedRateLimit.Enabled {
+		t.Error("shared rate limit without interval and requests should be disabled")
+	}
+
+	cfg.Exchanges[0].SharedRateLimit = &SharedRateLimitConfig{
+		Enabled:  true,
+		Interval: time.Second,
+		Requests: 10,
+	}
+	err = cfg.CheckExchangeConfigValues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := filepath.Join("data", "ratelimit", strings.ToLower(cfg.Exchanges[0].Name)+".json")
+	if s := cfg.Exchanges[0].SharedRateLimit; !s.Enabled || s.Path != expected {
+		t.Errorf("unexpected shared rate limit config %+v", s)
+	}
+}
+
+func TestCheckWebsocketCaptureConfig(t *testing.T) {
+	var cfg Config
+	err := cfg.LoadConfig(TestFile, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.DataDirectory = "data"
+	cfg.Exchanges[0].Enabled = true
+	cfg.Exchanges[0].WebsocketCapture = &WebsocketCaptureConfig{Enabled: true}
+	err = cfg.CheckExchangeConfigValues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := filepath.Join("data", "websocket_capture", strings.ToLower(cfg.Exchanges[0].Name))
+	if w := cfg.Exchanges[0].WebsocketCapture; w.Directory != expected ||
+		w.MaxFileSize != defaultWebsocketCaptureMaxFileSize ||
+		w.MaxFiles != defaultWebsocketCaptureMaxFiles {
+		t.Errorf("unexpected websocket capture config %+v", w)
+	}
+}
+
 func TestCheckExchangeConfigValues(t *testing.T) {
 	var cfg Config
 	if err := cfg.CheckExchangeConfigValues(); err == nil {
@@ -1975,6 +2029,23 @@ func TestCheckNTPConfig(t *testing.T) {
 	}
 }
 
+func TestCheckLatencyMonitorConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.LatencyMonitor.MaxRoundTrip = time.Second
+	c.CheckLatencyMonitorConfig()
+	if c.LatencyMonitor.CheckInterval != defaultLatencyCheckInterval {
+		t.Errorf("expected %v, received %v", defaultLatencyCheckInterval, c.LatencyMonitor.CheckInterval)
+	}
+	if c.LatencyMonitor.MaxRoundTrip != time.Second {
+		t.Errorf("expected configured value to be kept, received %v", c.LatencyMonitor.MaxRoundTrip)
+	}
+	if c.LatencyMonitor.MaxWebsocketDelay != defaultLatencyMaxWebsocketDelay ||
+		c.LatencyMonitor.MaxServerOffset != defaultLatencyMaxServerOffset {
+		t.Error("expected default thresholds")
+	}
+}
+
 func TestCheckCurrencyConfigValues(t *testing.T) {
 	c := GetConfig()
 	c.Currency.ForexProviders = nil
@@ -28,9 +28,15 @@ const (
 	defaultWebsocketResponseMaxLimit     = time.Second * 7
 	defaultWebsocketOrderbookBufferLimit = 5
 	defaultWebsocketTrafficTimeout       = time.Second * 30
+	defaultWebsocketCaptureMaxFileSize   = 64 << 20
+	defaultWebsocketCaptureMaxFiles      = 10
 	maxAuthFailures                      = 3
 	defaultNTPAllowedDifference          = 50000000
 	defaultNTPAllowedNegativeDifference  = 50000000
+	defaultLatencyCheckInterval          = time.Minute
+	defaultLatencyMaxRoundTrip           = time.Second * 2
+	defaultLatencyMaxWebsocketDelay      = time.Second * 5
+	defaultLatencyMaxServerOffset        = time.Second * 5
 	DefaultAPIKey                        = "Key"
 	DefaultAPISecret                     = "Secret"
 	DefaultAPIClientID                   = "ClientID"
@@ -83,7 +89,9 @@ type Config struct {
 	Logging           log.Config              `json:"logging"`
 	ConnectionMonitor ConnectionMonitorConfig `json:"connectionMonitor"`
 	Profiler          Profiler                `json:"profiler"`
+	Metrics           MetricsConfig           `json:"metrics"`
 	NTPClient         NTPClientConfig         `json:"ntpclient"`
+	LatencyMonitor    LatencyMonitorConfig    `json:"latencyMonitor"`
 	GCTScript         gctscript.Config        `json:"gctscript"`
 	Currency          CurrencyConfig          `json:"currencyConfig"`
 	Communications    CommunicationsConfig    `json:"communications"`
@@ -130,6 +138,8 @@ type ExchangeConfig struct {
 	Features                      *FeaturesConfig        `json:"features"`
 	BankAccounts                  []banking.Account      `json:"bankAccounts,omitempty"`
 	OrderbookConfig               `json:"orderbook"`
+	SharedRateLimit               *SharedRateLimitConfig  `json:"sharedRateLimit,omitempty"`
+	WebsocketCapture              *WebsocketCaptureConfig `json:"websocketCapture,omitempty"`
 
 	// Deprecated settings which will be removed in a future update
 	AvailablePairs                   *currency.Pairs      `json:"availablePairs,omitempty"`
@@ -158,6 +168,25 @@ type Profiler struct {
 	MutexProfileFraction int  `json:"mutex_profile_fraction"`
 }
 
+// LatencyMonitorConfig defines the thresholds at which exchange REST round
+// trips, websocket message delays and server clock offsets are alerted on.
+// Round trips and delays are compared against their 90th percentile and
+// offsets against their median
+type LatencyMonitorConfig struct {
+	Enabled           bool          `json:"enabled"`
+	CheckInterval     time.Duration `json:"checkInterval"`
+	MaxRoundTrip      time.Duration `json:"maxRoundTrip"`
+	MaxWebsocketDelay time.Duration `json:"maxWebsocketDelay"`
+	MaxServerOffset   time.Duration `json:"maxServerOffset"`
+}
+
+// MetricsConfig defines whether metrics are recorded and served in the
+// Prometheus text format on /metrics of the deprecated RPC server and gRPC
+// proxy
+type MetricsConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
 // NTPClientConfig defines a network time protocol configuration to allow for
 // positive and negative differences
 type NTPClientConfig struct {
@@ -331,9 +360,10 @@ type FeaturesSupportedConfig struct {
 
 // FeaturesEnabledConfig stores the exchanges enabled features
 type FeaturesEnabledConfig struct {
-	AutoPairUpdates bool `json:"autoPairUpdates"`
-	Websocket       bool `json:"websocketAPI"`
-	SaveTradeData   bool `json:"saveTradeData"`
+	AutoPairUpdates     bool `json:"autoPairUpdates"`
+	Websocket           bool `json:"websocketAPI"`
+	SaveTradeData       bool `json:"saveTradeData"`
+	SaveLiquidationData bool `json:"saveLiquidationData"`
 }
 
 // FeaturesConfig stores the exchanges supported and enabled features
@@ -381,6 +411,30 @@ type APIConfig struct {
 	Endpoints            map[string]string              `json:"urlEndpoints"`
 }
 
+// SharedRateLimitConfig coordinates an exchange's REST rate limit across
+// several instances on the same host which share an API key or IP
+type SharedRateLimitConfig struct {
+	Enabled bool `json:"enabled"`
+	// Path is the state file shared by each instance, defaulting to
+	// ratelimit/<exchange>.json in the data directory
+	Path     string        `json:"path,omitempty"`
+	Interval time.Duration `json:"interval"`
+	Requests int           `json:"requests"`
+}
+
+// WebsocketCaptureConfig captures an exchange's raw inbound websocket frames
+// to rotating compressed files so production sessions can be replayed
+type WebsocketCaptureConfig struct {
+	Enabled bool `json:"enabled"`
+	// Directory holds the capture files, defaulting to
+	// websocket_capture/<exchange> in the data directory
+	Directory string `json:"directory,omitempty"`
+	// MaxFileSize is the uncompressed size in bytes at which a file is
+	// rotated
+	MaxFileSize int64 `json:"maxFileSize"`
+	MaxFiles    int   `json:"maxFiles"`
+}
+
 // OrderbookConfig stores the orderbook configuration variables
 type OrderbookConfig struct {
 	VerificationBypass     bool `json:"verificationBypass"`
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 )
@@ -24,6 +25,31 @@ type Config struct {
 	Verbose                   bool   `json:"verbose"`
 	Driver                    string `json:"driver"`
 	drivers.ConnectionDetails `json:"connectionDetails"`
+	Retention                 *RetentionConfig `json:"retention,omitempty"`
+}
+
+// RetentionConfig holds the scheduled cleanup rules applied to saved data
+type RetentionConfig struct {
+	Enabled bool `json:"enabled"`
+	// Interval is how often the rules are applied
+	Interval time.Duration   `json:"interval"`
+	Rules    []RetentionRule `json:"rules"`
+}
+
+// RetentionRule removes rows older than MaxAge from a table. If
+// DownsampleInterval is set the removed rows are first aggregated into
+// candles of that interval in seconds so older history is kept at a lower
+// resolution
+type RetentionRule struct {
+	// Table is the table the rule applies to, trade or candle
+	Table string `json:"table"`
+	// Exchange limits the rule to a single exchange, empty matches all
+	Exchange string `json:"exchange,omitempty"`
+	// Interval limits a candle rule to candles of this interval in seconds,
+	// zero matches all intervals
+	Interval           int64         `json:"interval,omitempty"`
+	MaxAge             time.Duration `json:"maxAge"`
+	DownsampleInterval int64         `json:"downsampleInterval,omitempty"`
 }
 
 var (
@@ -55,4 +81,12 @@ const (
 	DBPostgreSQL = "postgres"
 	// DBInvalidDriver const string for invalid driver
 	DBInvalidDriver = "invalid driver"
+
+	// RetentionTableTrade applies a retention rule to the trade table
+	RetentionTableTrade = "trade"
+	// RetentionTableCandle applies a retention rule to the candle table
+	RetentionTableCandle = "candle"
+	// DefaultRetentionInterval is how often retention rules are applied when
+	// no interval is configured
+	DefaultRetentionInterval = time.Hour
 )
@@ -0,0 +1,380 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// ParseFormat returns a supported Format from a string
+func ParseFormat(f string) (Format, error) {
+	f = strings.ToLower(f)
+	for i := range SupportedFormats {
+		if string(SupportedFormats[i]) == f {
+			return SupportedFormats[i], nil
+		}
+	}
+	return "", fmt.Errorf("%w %s", errUnsupportedFormat, f)
+}
+
+// ParseDataType returns a supported DataType from a string
+func ParseDataType(d string) (DataType, error) {
+	d = strings.ToLower(d)
+	for i := range SupportedDataTypes {
+		if string(SupportedDataTypes[i]) == d {
+			return SupportedDataTypes[i], nil
+		}
+	}
+	return "", fmt.Errorf("%w %s", errUnsupportedDataType, d)
+}
+
+// Validate checks the export params are complete for the requested data type
+func (p *Params) Validate() error {
+	if p == nil {
+		return errNilParams
+	}
+	if _, err := ParseFormat(string(p.Format)); err != nil {
+		return err
+	}
+	if _, err := ParseDataType(string(p.DataType)); err != nil {
+		return err
+	}
+	if p.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if p.Base == "" || p.Quote == "" {
+		return errCurrencyPairUnset
+	}
+	if p.Asset == "" {
+		return errAssetTypeUnset
+	}
+	if p.DataType == Candles && p.Interval <= 0 {
+		return errIntervalUnset
+	}
+	if !p.Start.Before(p.End) {
+		return errInvalidTimeRange
+	}
+	return nil
+}
+
+// ToFile exports matching database records to a file, creating any missing
+// directories along the way
+func ToFile(p *Params, filename string) (uint64, error) {
+	f, err := file.Writer(filename)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if errClose := f.Close(); errClose != nil {
+			log.Errorln(log.DatabaseMgr, errClose)
+		}
+	}()
+
+	buf := bufio.NewWriter(f)
+	total, err := Write(p, buf)
+	if err != nil {
+		return total, err
+	}
+	return total, buf.Flush()
+}
+
+// Write streams matching database records to w in the requested format,
+// loading rows in batches so large date ranges are not buffered in memory. It
+// returns the number of records written
+func Write(p *Params, w io.Writer) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	if w == nil {
+		return 0, errNilWriter
+	}
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+
+	enc, err := newEncoder(p.Format, p.DataType, w)
+	if err != nil {
+		return 0, err
+	}
+
+	batchSize := p.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	var total uint64
+	switch p.DataType {
+	case Candles:
+		err = candle.StreamSeries(p.Exchange,
+			p.Base,
+			p.Quote,
+			p.Interval,
+			p.Asset,
+			p.Start,
+			p.End,
+			batchSize,
+			func(i *candle.Item) error {
+				for x := range i.Candles {
+					errEnc := enc.encode(&CandleRecord{
+						Exchange:  strings.ToLower(i.ExchangeID),
+						Base:      strings.ToUpper(i.Base),
+						Quote:     strings.ToUpper(i.Quote),
+						Asset:     strings.ToLower(i.Asset),
+						Interval:  i.Interval,
+						Timestamp: i.Candles[x].Timestamp.UTC(),
+						Open:      i.Candles[x].Open,
+						High:      i.Candles[x].High,
+						Low:       i.Candles[x].Low,
+						Close:     i.Candles[x].Close,
+						Volume:    i.Candles[x].Volume,
+					})
+					if errEnc != nil {
+						return errEnc
+					}
+					total++
+				}
+				return nil
+			})
+	case Trades:
+		err = trade.StreamInRange(p.Exchange,
+			p.Asset,
+			p.Base,
+			p.Quote,
+			p.Start,
+			p.End,
+			batchSize,
+			func(d []trade.Data) error {
+				for x := range d {
+					errEnc := enc.encode(&TradeRecord{
+						Exchange:  d[x].Exchange,
+						Base:      d[x].Base,
+						Quote:     d[x].Quote,
+						Asset:     d[x].AssetType,
+						TID:       d[x].TID,
+						Timestamp: d[x].Timestamp.UTC(),
+						Price:     d[x].Price,
+						Amount:    d[x].Amount,
+						Side:      d[x].Side,
+					})
+					if errEnc != nil {
+						return errEnc
+					}
+					total++
+				}
+				return nil
+			})
+	case Fills:
+		err = fill.StreamInRange(&fill.Filter{
+			Exchange:  p.Exchange,
+			Base:      p.Base,
+			Quote:     p.Quote,
+			AssetType: p.Asset,
+			Start:     p.Start,
+			End:       p.End,
+		},
+			batchSize,
+			func(d []fill.Data) error {
+				for x := range d {
+					errEnc := enc.encode(&FillRecord{
+						Exchange:  d[x].Exchange,
+						Base:      d[x].Base,
+						Quote:     d[x].Quote,
+						Asset:     d[x].AssetType,
+						OrderID:   d[x].OrderID,
+						TID:       d[x].TID,
+						Timestamp: d[x].Timestamp.UTC(),
+						Side:      d[x].Side,
+						Price:     d[x].Price,
+						Amount:    d[x].Amount,
+						Fee:       d[x].Fee,
+						FeeAsset:  d[x].FeeAsset,
+						Strategy:  d[x].Strategy,
+					})
+					if errEnc != nil {
+						return errEnc
+					}
+					total++
+				}
+				return nil
+			})
+	}
+	if err != nil {
+		return total, err
+	}
+	return total, enc.close()
+}
+
+func newEncoder(f Format, d DataType, w io.Writer) (encoder, error) {
+	switch f {
+	case CSV:
+		c := &csvEncoder{w: csv.NewWriter(w)}
+		switch d {
+		case Candles:
+			return c, c.w.Write(candleHeader)
+		case Fills:
+			return c, c.w.Write(fillHeader)
+		}
+		return c, c.w.Write(tradeHeader)
+	case JSONLines:
+		return &jsonEncoder{enc: json.NewEncoder(w)}, nil
+	case Parquet:
+		var schema interface{} = new(parquetTrade)
+		switch d {
+		case Candles:
+			schema = new(parquetCandle)
+		case Fills:
+			schema = new(parquetFill)
+		}
+		pw, err := writer.NewParquetWriterFromWriter(w, schema, 1)
+		if err != nil {
+			return nil, err
+		}
+		pw.RowGroupSize = parquetRowGroupSize
+		return &parquetEncoder{pw: pw}, nil
+	}
+	return nil, fmt.Errorf("%w %s", errUnsupportedFormat, f)
+}
+
+var (
+	candleHeader = []string{"exchange", "base", "quote", "asset", "interval", "timestamp", "open", "high", "low", "close", "volume"}
+	tradeHeader  = []string{"exchange", "base", "quote", "asset", "tid", "timestamp", "price", "amount", "side"}
+	fillHeader   = []string{"exchange", "base", "quote", "asset", "order_id", "tid", "timestamp", "side", "price", "amount", "fee", "fee_asset", "strategy"}
+)
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (c *csvEncoder) encode(rec interface{}) error {
+	switch r := rec.(type) {
+	case *CandleRecord:
+		return c.w.Write([]string{
+			r.Exchange,
+			r.Base,
+			r.Quote,
+			r.Asset,
+			strconv.FormatInt(r.Interval, 10),
+			r.Timestamp.Format(time.RFC3339),
+			strconv.FormatFloat(r.Open, 'f', -1, 64),
+			strconv.FormatFloat(r.High, 'f', -1, 64),
+			strconv.FormatFloat(r.Low, 'f', -1, 64),
+			strconv.FormatFloat(r.Close, 'f', -1, 64),
+			strconv.FormatFloat(r.Volume, 'f', -1, 64),
+		})
+	case *TradeRecord:
+		return c.w.Write([]string{
+			r.Exchange,
+			r.Base,
+			r.Quote,
+			r.Asset,
+			r.TID,
+			r.Timestamp.Format(time.RFC3339),
+			strconv.FormatFloat(r.Price, 'f', -1, 64),
+			strconv.FormatFloat(r.Amount, 'f', -1, 64),
+			r.Side,
+		})
+	case *FillRecord:
+		return c.w.Write([]string{
+			r.Exchange,
+			r.Base,
+			r.Quote,
+			r.Asset,
+			r.OrderID,
+			r.TID,
+			r.Timestamp.Format(time.RFC3339),
+			r.Side,
+			strconv.FormatFloat(r.Price, 'f', -1, 64),
+			strconv.FormatFloat(r.Amount, 'f', -1, 64),
+			strconv.FormatFloat(r.Fee, 'f', -1, 64),
+			r.FeeAsset,
+			r.Strategy,
+		})
+	}
+	return errUnsupportedRecord
+}
+
+func (c *csvEncoder) close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonEncoder struct {
+	enc *json.Encoder
+}
+
+func (j *jsonEncoder) encode(rec interface{}) error {
+	return j.enc.Encode(rec)
+}
+
+func (j *jsonEncoder) close() error {
+	return nil
+}
+
+type parquetEncoder struct {
+	pw *writer.ParquetWriter
+}
+
+func (p *parquetEncoder) encode(rec interface{}) error {
+	switch r := rec.(type) {
+	case *CandleRecord:
+		return p.pw.Write(parquetCandle{
+			Exchange:  r.Exchange,
+			Base:      r.Base,
+			Quote:     r.Quote,
+			Asset:     r.Asset,
+			Interval:  r.Interval,
+			Timestamp: r.Timestamp.UnixNano() / int64(time.Millisecond),
+			Open:      r.Open,
+			High:      r.High,
+			Low:       r.Low,
+			Close:     r.Close,
+			Volume:    r.Volume,
+		})
+	case *TradeRecord:
+		return p.pw.Write(parquetTrade{
+			Exchange:  r.Exchange,
+			Base:      r.Base,
+			Quote:     r.Quote,
+			Asset:     r.Asset,
+			TID:       r.TID,
+			Timestamp: r.Timestamp.UnixNano() / int64(time.Millisecond),
+			Price:     r.Price,
+			Amount:    r.Amount,
+			Side:      r.Side,
+		})
+	case *FillRecord:
+		return p.pw.Write(parquetFill{
+			Exchange:  r.Exchange,
+			Base:      r.Base,
+			Quote:     r.Quote,
+			Asset:     r.Asset,
+			OrderID:   r.OrderID,
+			TID:       r.TID,
+			Timestamp: r.Timestamp.UnixNano() / int64(time.Millisecond),
+			Side:      r.Side,
+			Price:     r.Price,
+			Amount:    r.Amount,
+			Fee:       r.Fee,
+			FeeAsset:  r.FeeAsset,
+			Strategy:  r.Strategy,
+		})
+	}
+	return errUnsupportedRecord
+}
+
+func (p *parquetEncoder) close() error {
+	return p.pw.WriteStop()
+}
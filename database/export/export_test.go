@@ -0,0 +1,323 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+const testExchange = "one"
+
+var (
+	verbose   = false
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	testEnd   = testStart.AddDate(0, 0, 30)
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MigrationDir = filepath.Join("..", "migrations")
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+	f, err := ParseFormat("PARQUET")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != Parquet {
+		t.Errorf("expected %v, received %v", Parquet, f)
+	}
+	_, err = ParseFormat("xlsx")
+	if !errors.Is(err, errUnsupportedFormat) {
+		t.Errorf("expected %v, received %v", errUnsupportedFormat, err)
+	}
+}
+
+func TestParseDataType(t *testing.T) {
+	t.Parallel()
+	d, err := ParseDataType("Trade")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d != Trades {
+		t.Errorf("expected %v, received %v", Trades, d)
+	}
+	_, err = ParseDataType("orderbook")
+	if !errors.Is(err, errUnsupportedDataType) {
+		t.Errorf("expected %v, received %v", errUnsupportedDataType, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	var p *Params
+	if err := p.Validate(); !errors.Is(err, errNilParams) {
+		t.Errorf("expected %v, received %v", errNilParams, err)
+	}
+	p = &Params{
+		DataType: Candles,
+		Format:   CSV,
+		Exchange: testExchange,
+		Base:     "BTC",
+		Quote:    "USDT",
+		Asset:    "spot",
+		Start:    testStart,
+		End:      testEnd,
+	}
+	if err := p.Validate(); !errors.Is(err, errIntervalUnset) {
+		t.Errorf("expected %v, received %v", errIntervalUnset, err)
+	}
+	p.Interval = 86400
+	p.End = p.Start
+	if err := p.Validate(); !errors.Is(err, errInvalidTimeRange) {
+		t.Errorf("expected %v, received %v", errInvalidTimeRange, err)
+	}
+	p.End = testEnd
+	if err := p.Validate(); err != nil {
+		t.Error(err)
+	}
+	p.DataType = Trades
+	p.Interval = 0
+	p.Quote = ""
+	if err := p.Validate(); !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("expected %v, received %v", errCurrencyPairUnset, err)
+	}
+}
+
+func TestExport(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = seedDB()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, d := range SupportedDataTypes {
+				for _, f := range SupportedFormats {
+					p := &Params{
+						DataType:  d,
+						Format:    f,
+						Exchange:  testExchange,
+						Base:      "BTC",
+						Quote:     "USDT",
+						Asset:     "spot",
+						Interval:  86400,
+						Start:     testStart,
+						End:       testEnd,
+						BatchSize: 7,
+					}
+					var buf bytes.Buffer
+					total, err := Write(p, &buf)
+					if err != nil {
+						t.Fatalf("%v %v: %v", d, f, err)
+					}
+					if total != 30 {
+						t.Errorf("%v %v: expected 30 records, received %v", d, f, total)
+					}
+					checkOutput(t, p, buf.Bytes(), 30)
+				}
+			}
+
+			path := filepath.Join(testhelpers.TempDir, "export", "candles.csv")
+			total, err := ToFile(&Params{
+				DataType: Candles,
+				Format:   CSV,
+				Exchange: testExchange,
+				Base:     "BTC",
+				Quote:    "USDT",
+				Asset:    "spot",
+				Interval: 86400,
+				Start:    testStart,
+				End:      testEnd,
+			}, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != 30 {
+				t.Errorf("expected 30 records, received %v", total)
+			}
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkOutput(t, &Params{DataType: Candles, Format: CSV}, data, 30)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func checkOutput(t *testing.T, p *Params, data []byte, expected int64) {
+	t.Helper()
+	switch p.Format {
+	case CSV:
+		lines := bytes.Count(data, []byte("\n"))
+		if int64(lines) != expected+1 {
+			t.Errorf("%v csv: expected %v lines including header, received %v", p.DataType, expected+1, lines)
+		}
+	case JSONLines:
+		var lines int64
+		s := bufio.NewScanner(bytes.NewReader(data))
+		for s.Scan() {
+			var rec map[string]interface{}
+			if err := json.Unmarshal(s.Bytes(), &rec); err != nil {
+				t.Fatalf("%v jsonl: %v", p.DataType, err)
+			}
+			if rec["exchange"] != testExchange {
+				t.Errorf("%v jsonl: unexpected exchange %v", p.DataType, rec["exchange"])
+			}
+			lines++
+		}
+		if lines != expected {
+			t.Errorf("%v jsonl: expected %v lines, received %v", p.DataType, expected, lines)
+		}
+	case Parquet:
+		pf, err := buffer.NewBufferFile(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var schema interface{} = new(parquetTrade)
+		switch p.DataType {
+		case Candles:
+			schema = new(parquetCandle)
+		case Fills:
+			schema = new(parquetFill)
+		}
+		pr, err := reader.NewParquetReader(pf, schema, 1)
+		if err != nil {
+			t.Fatalf("%v parquet: %v", p.DataType, err)
+		}
+		if pr.GetNumRows() != expected {
+			t.Errorf("%v parquet: expected %v rows, received %v", p.DataType, expected, pr.GetNumRows())
+		}
+		pr.ReadStop()
+	}
+}
+
+func seedDB() error {
+	err := exchange.InsertMany([]exchange.Details{{Name: testExchange}})
+	if err != nil {
+		return err
+	}
+	exchange.ResetExchangeCache()
+	exchangeUUID, err := exchange.UUIDByName(testExchange)
+	if err != nil {
+		return err
+	}
+
+	candles := candle.Item{
+		ExchangeID: exchangeUUID.String(),
+		Base:       "BTC",
+		Quote:      "USDT",
+		Interval:   86400,
+		Asset:      "spot",
+	}
+	var trades []trade.Data
+	var fills []fill.Data
+	for i := 0; i < 30; i++ {
+		ts := testStart.AddDate(0, 0, i)
+		candles.Candles = append(candles.Candles, candle.Candle{
+			Timestamp: ts,
+			Open:      1000,
+			High:      1100,
+			Low:       900,
+			Close:     1050,
+			Volume:    float64(i),
+		})
+		trades = append(trades, trade.Data{
+			TID:       ts.String(),
+			Exchange:  testExchange,
+			Base:      "BTC",
+			Quote:     "USDT",
+			AssetType: "spot",
+			Price:     1000,
+			Amount:    float64(i + 1),
+			Side:      "buy",
+			Timestamp: ts,
+		})
+		fills = append(fills, fill.Data{
+			Exchange:  testExchange,
+			OrderID:   ts.String(),
+			TID:       ts.String(),
+			Base:      "BTC",
+			Quote:     "USDT",
+			AssetType: "spot",
+			Side:      "buy",
+			Price:     1000,
+			Amount:    float64(i + 1),
+			Fee:       0.1,
+			FeeAsset:  "USDT",
+			Timestamp: ts,
+		})
+	}
+	_, err = candle.Insert(&candles)
+	if err != nil {
+		return err
+	}
+	err = trade.Insert(trades...)
+	if err != nil {
+		return err
+	}
+	return fill.Insert(fills...)
+}
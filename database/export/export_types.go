@@ -0,0 +1,165 @@
+package export
+
+import (
+	"errors"
+	"time"
+)
+
+// Format defines a supported export file format
+type Format string
+
+// DataType defines which repository is exported
+type DataType string
+
+const (
+	// CSV exports records as comma separated values with a header row
+	CSV Format = "csv"
+	// JSONLines exports one JSON encoded record per line
+	JSONLines Format = "jsonl"
+	// Parquet exports records as an Apache Parquet file
+	Parquet Format = "parquet"
+
+	// Candles exports from the candle repository
+	Candles DataType = "candle"
+	// Trades exports from the trade repository
+	Trades DataType = "trade"
+	// Fills exports our own executions from the fill repository
+	Fills DataType = "fill"
+
+	// DefaultBatchSize is the number of rows loaded from the database at a
+	// time when no batch size is supplied
+	DefaultBatchSize = 10000
+
+	parquetRowGroupSize = 8 * 1024 * 1024
+)
+
+var (
+	// SupportedFormats lists all formats which can be exported
+	SupportedFormats = []Format{CSV, JSONLines, Parquet}
+	// SupportedDataTypes lists all data types which can be exported
+	SupportedDataTypes = []DataType{Candles, Trades, Fills}
+
+	errNilParams           = errors.New("export params cannot be nil")
+	errUnsupportedFormat   = errors.New("unsupported export format")
+	errUnsupportedDataType = errors.New("unsupported export data type")
+	errExchangeNameUnset   = errors.New("exchange name unset")
+	errCurrencyPairUnset   = errors.New("currency pair unset")
+	errAssetTypeUnset      = errors.New("asset type unset")
+	errIntervalUnset       = errors.New("candle interval must be greater than zero")
+	errInvalidTimeRange    = errors.New("start date must be before end date")
+	errNilWriter           = errors.New("export writer cannot be nil")
+	errUnsupportedRecord   = errors.New("unsupported record type for encoder")
+)
+
+// Params holds the filters and output options for an export
+type Params struct {
+	DataType  DataType
+	Format    Format
+	Exchange  string
+	Base      string
+	Quote     string
+	Asset     string
+	Interval  int64
+	Start     time.Time
+	End       time.Time
+	BatchSize int
+}
+
+// CandleRecord is a single exported candle row
+type CandleRecord struct {
+	Exchange  string    `json:"exchange"`
+	Base      string    `json:"base"`
+	Quote     string    `json:"quote"`
+	Asset     string    `json:"asset"`
+	Interval  int64     `json:"interval"`
+	Timestamp time.Time `json:"timestamp"`
+	Open      float64   `json:"open"`
+	High      float64   `json:"high"`
+	Low       float64   `json:"low"`
+	Close     float64   `json:"close"`
+	Volume    float64   `json:"volume"`
+}
+
+// TradeRecord is a single exported trade row
+type TradeRecord struct {
+	Exchange  string    `json:"exchange"`
+	Base      string    `json:"base"`
+	Quote     string    `json:"quote"`
+	Asset     string    `json:"asset"`
+	TID       string    `json:"tid"`
+	Timestamp time.Time `json:"timestamp"`
+	Price     float64   `json:"price"`
+	Amount    float64   `json:"amount"`
+	Side      string    `json:"side"`
+}
+
+// FillRecord is a single exported fill row
+type FillRecord struct {
+	Exchange  string    `json:"exchange"`
+	Base      string    `json:"base"`
+	Quote     string    `json:"quote"`
+	Asset     string    `json:"asset"`
+	OrderID   string    `json:"order_id"`
+	TID       string    `json:"tid"`
+	Timestamp time.Time `json:"timestamp"`
+	Side      string    `json:"side"`
+	Price     float64   `json:"price"`
+	Amount    float64   `json:"amount"`
+	Fee       float64   `json:"fee"`
+	FeeAsset  string    `json:"fee_asset"`
+	Strategy  string    `json:"strategy"`
+}
+
+// parquetCandle is the parquet schema for an exported candle, timestamps are
+// stored as unix milliseconds
+type parquetCandle struct {
+	Exchange  string  `parquet:"name=exchange, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Base      string  `parquet:"name=base, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Quote     string  `parquet:"name=quote, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Asset     string  `parquet:"name=asset, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Interval  int64   `parquet:"name=interval, type=INT64"`
+	Timestamp int64   `parquet:"name=timestamp, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Open      float64 `parquet:"name=open, type=DOUBLE"`
+	High      float64 `parquet:"name=high, type=DOUBLE"`
+	Low       float64 `parquet:"name=low, type=DOUBLE"`
+	Close     float64 `parquet:"name=close, type=DOUBLE"`
+	Volume    float64 `parquet:"name=volume, type=DOUBLE"`
+}
+
+// parquetTrade is the parquet schema for an exported trade, timestamps are
+// stored as unix milliseconds
+type parquetTrade struct {
+	Exchange  string  `parquet:"name=exchange, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Base      string  `parquet:"name=base, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Quote     string  `parquet:"name=quote, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Asset     string  `parquet:"name=asset, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TID       string  `parquet:"name=tid, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Timestamp int64   `parquet:"name=timestamp, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Price     float64 `parquet:"name=price, type=DOUBLE"`
+	Amount    float64 `parquet:"name=amount, type=DOUBLE"`
+	Side      string  `parquet:"name=side, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// parquetFill is the parquet schema for an exported fill, timestamps are
+// stored as unix milliseconds
+type parquetFill struct {
+	Exchange  string  `parquet:"name=exchange, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Base      string  `parquet:"name=base, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Quote     string  `parquet:"name=quote, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Asset     string  `parquet:"name=asset, type=BYTE_ARRAY, convertedtype=UTF8"`
+	OrderID   string  `parquet:"name=order_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TID       string  `parquet:"name=tid, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Timestamp int64   `parquet:"name=timestamp, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Side      string  `parquet:"name=side, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Price     float64 `parquet:"name=price, type=DOUBLE"`
+	Amount    float64 `parquet:"name=amount, type=DOUBLE"`
+	Fee       float64 `parquet:"name=fee, type=DOUBLE"`
+	FeeAsset  string  `parquet:"name=fee_asset, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Strategy  string  `parquet:"name=strategy, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// encoder writes records to an underlying writer in a specific format
+type encoder interface {
+	encode(interface{}) error
+	close() error
+}
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS balance_snapshot
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    account_id TEXT NOT NULL,
+    asset TEXT NOT NULL,
+    currency TEXT NOT NULL,
+    total REAL NOT NULL,
+    hold REAL NOT NULL,
+    reporting_currency TEXT NOT NULL,
+    reporting_value REAL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniquebalancesnapshot
+        unique(exchange_name_id, account_id, asset, currency, timestamp) ON CONFLICT IGNORE
+);
+CREATE INDEX IF NOT EXISTS balance_snapshot_timestamp ON balance_snapshot (timestamp);
+-- +goose Down
+DROP TABLE balance_snapshot;
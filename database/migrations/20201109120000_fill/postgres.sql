@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS fill
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    order_id varchar NOT NULL,
+    tid varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    side varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_asset varchar(30) NOT NULL,
+    strategy varchar NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquefillid
+        unique(exchange_name_id, base, quote, asset, tid)
+);
+CREATE INDEX IF NOT EXISTS fill_timestamp ON fill (timestamp);
+-- +goose Down
+DROP TABLE fill;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS fill
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    order_id TEXT NOT NULL,
+    tid TEXT NOT NULL,
+    base TEXT NOT NULL,
+    quote TEXT NOT NULL,
+    asset TEXT NOT NULL,
+    side TEXT NOT NULL,
+    price REAL NOT NULL,
+    amount REAL NOT NULL,
+    fee REAL NOT NULL,
+    fee_asset TEXT NOT NULL,
+    strategy TEXT NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniquefillid
+        unique(exchange_name_id, base, quote, asset, tid) ON CONFLICT IGNORE
+);
+CREATE INDEX IF NOT EXISTS fill_timestamp ON fill (timestamp);
+-- +goose Down
+DROP TABLE fill;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS funding_rate
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    rate DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquefundingrate
+        unique(exchange_name_id, base, quote, asset, timestamp)
+);
+CREATE INDEX IF NOT EXISTS funding_rate_timestamp ON funding_rate (timestamp);
+-- +goose Down
+DROP TABLE funding_rate;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS funding_rate
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base TEXT NOT NULL,
+    quote TEXT NOT NULL,
+    asset TEXT NOT NULL,
+    rate REAL NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniquefundingrate
+        unique(exchange_name_id, base, quote, asset, timestamp) ON CONFLICT IGNORE
+);
+CREATE INDEX IF NOT EXISTS funding_rate_timestamp ON funding_rate (timestamp);
+-- +goose Down
+DROP TABLE funding_rate;
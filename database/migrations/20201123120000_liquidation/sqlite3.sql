@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS liquidation
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base TEXT NOT NULL,
+    quote TEXT NOT NULL,
+    asset TEXT NOT NULL,
+    side TEXT NOT NULL,
+    price REAL NOT NULL,
+    amount REAL NOT NULL,
+    timestamp TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS liquidation_timestamp ON liquidation (timestamp);
+-- +goose Down
+DROP TABLE liquidation;
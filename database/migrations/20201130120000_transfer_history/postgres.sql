@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS transfer_history
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    exchange_id text NOT NULL,
+    status varchar(255) NOT NULL,
+    currency text NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    from_account text NOT NULL,
+    to_account text NOT NULL,
+    from_asset varchar NOT NULL,
+    to_asset varchar NOT NULL,
+    description text NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX IF NOT EXISTS transfer_history_created_at ON transfer_history (created_at);
+-- +goose Down
+DROP TABLE transfer_history;
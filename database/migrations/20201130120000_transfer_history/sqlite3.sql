@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS transfer_history
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    exchange_id text NOT NULL,
+    status text NOT NULL,
+    currency text NOT NULL,
+    amount REAL NOT NULL,
+    from_account text NOT NULL,
+    to_account text NOT NULL,
+    from_asset text NOT NULL,
+    to_asset text NOT NULL,
+    description text NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS transfer_history_created_at ON transfer_history (created_at);
+-- +goose Down
+DROP TABLE transfer_history;
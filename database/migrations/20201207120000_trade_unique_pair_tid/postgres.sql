@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE trade DROP CONSTRAINT uniquetradeid;
+
+ALTER TABLE trade ADD CONSTRAINT uniquetradeid
+    unique(exchange_name_id, base, quote, asset, tid);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE trade DROP CONSTRAINT uniquetradeid;
+
+ALTER TABLE trade ADD CONSTRAINT uniquetradeid
+    unique(exchange_name_id, tid);
+-- +goose StatementEnd
@@ -5,21 +5,31 @@ package postgres
 
 var TableNames = struct {
 	AuditEvent        string
+	BalanceSnapshot   string
 	Candle            string
 	Exchange          string
+	Fill              string
+	FundingRate       string
+	Liquidation       string
 	Script            string
 	ScriptExecution   string
 	Trade             string
+	TransferHistory   string
 	WithdrawalCrypto  string
 	WithdrawalFiat    string
 	WithdrawalHistory string
 }{
 	AuditEvent:        "audit_event",
+	BalanceSnapshot:   "balance_snapshot",
 	Candle:            "candle",
 	Exchange:          "exchange",
+	Fill:              "fill",
+	FundingRate:       "funding_rate",
+	Liquidation:       "liquidation",
 	Script:            "script",
 	ScriptExecution:   "script_execution",
 	Trade:             "trade",
+	TransferHistory:   "transfer_history",
 	WithdrawalCrypto:  "withdrawal_crypto",
 	WithdrawalFiat:    "withdrawal_fiat",
 	WithdrawalHistory: "withdrawal_history",
@@ -50,19 +50,34 @@ var ExchangeWhere = struct {
 
 // ExchangeRels is where relationship names are stored.
 var ExchangeRels = struct {
+	ExchangeNameBalanceSnapshots    string
 	ExchangeNameCandles             string
+	ExchangeNameFills               string
+	ExchangeNameFundingRates        string
+	ExchangeNameLiquidations        string
 	ExchangeNameTrades              string
+	ExchangeNameTransferHistories   string
 	ExchangeNameWithdrawalHistories string
 }{
+	ExchangeNameBalanceSnapshots:    "ExchangeNameBalanceSnapshots",
 	ExchangeNameCandles:             "ExchangeNameCandles",
+	ExchangeNameFills:               "ExchangeNameFills",
+	ExchangeNameFundingRates:        "ExchangeNameFundingRates",
+	ExchangeNameLiquidations:        "ExchangeNameLiquidations",
 	ExchangeNameTrades:              "ExchangeNameTrades",
+	ExchangeNameTransferHistories:   "ExchangeNameTransferHistories",
 	ExchangeNameWithdrawalHistories: "ExchangeNameWithdrawalHistories",
 }
 
 // exchangeR is where relationships are stored.
 type exchangeR struct {
+	ExchangeNameBalanceSnapshots    BalanceSnapshotSlice
 	ExchangeNameCandles             CandleSlice
+	ExchangeNameFills               FillSlice
+	ExchangeNameFundingRates        FundingRateSlice
+	ExchangeNameLiquidations        LiquidationSlice
 	ExchangeNameTrades              TradeSlice
+	ExchangeNameTransferHistories   TransferHistorySlice
 	ExchangeNameWithdrawalHistories WithdrawalHistorySlice
 }
 
@@ -356,6 +371,27 @@ func (q exchangeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (b
 	return count > 0, nil
 }
 
+// ExchangeNameBalanceSnapshots retrieves all the balance_snapshot's BalanceSnapshots with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameBalanceSnapshots(mods ...qm.QueryMod) balanceSnapshotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"balance_snapshot\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := BalanceSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"balance_snapshot\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"balance_snapshot\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameCandles retrieves all the candle's Candles with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameCandles(mods ...qm.QueryMod) candleQuery {
 	var queryMods []qm.QueryMod
@@ -377,6 +413,69 @@ func (o *Exchange) ExchangeNameCandles(mods ...qm.QueryMod) candleQuery {
 	return query
 }
 
+// ExchangeNameFills retrieves all the fill's Fills with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameFills(mods ...qm.QueryMod) fillQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"fill\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := Fills(queryMods...)
+	queries.SetFrom(query.Query, "\"fill\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"fill\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameFundingRates retrieves all the funding_rate's FundingRates with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameFundingRates(mods ...qm.QueryMod) fundingRateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"funding_rate\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_rate\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameLiquidations retrieves all the liquidation's Liquidations with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameLiquidations(mods ...qm.QueryMod) liquidationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"liquidation\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := Liquidations(queryMods...)
+	queries.SetFrom(query.Query, "\"liquidation\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"liquidation\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameTrades retrieves all the trade's Trades with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameTrades(mods ...qm.QueryMod) tradeQuery {
 	var queryMods []qm.QueryMod
@@ -398,6 +497,27 @@ func (o *Exchange) ExchangeNameTrades(mods ...qm.QueryMod) tradeQuery {
 	return query
 }
 
+// ExchangeNameTransferHistories retrieves all the transfer_history's TransferHistories with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameTransferHistories(mods ...qm.QueryMod) transferHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer_history\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := TransferHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"transfer_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transfer_history\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameWithdrawalHistories retrieves all the withdrawal_history's WithdrawalHistories with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameWithdrawalHistories(mods ...qm.QueryMod) withdrawalHistoryQuery {
 	var queryMods []qm.QueryMod
@@ -419,6 +539,101 @@ func (o *Exchange) ExchangeNameWithdrawalHistories(mods ...qm.QueryMod) withdraw
 	return query
 }
 
+// LoadExchangeNameBalanceSnapshots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameBalanceSnapshots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`balance_snapshot`), qm.WhereIn(`balance_snapshot.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load balance_snapshot")
+	}
+
+	var resultSlice []*BalanceSnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice balance_snapshot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on balance_snapshot")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for balance_snapshot")
+	}
+
+	if len(balanceSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameBalanceSnapshots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &balanceSnapshotR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameBalanceSnapshots = append(local.R.ExchangeNameBalanceSnapshots, foreign)
+				if foreign.R == nil {
+					foreign.R = &balanceSnapshotR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadExchangeNameCandles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
@@ -514,9 +729,9 @@ func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
-// LoadExchangeNameTrades allows an eager lookup of values, cached into the
+// LoadExchangeNameFills allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameFills(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -553,29 +768,29 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 		return nil
 	}
 
-	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`fill`), qm.WhereIn(`fill.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load trade")
+		return errors.Wrap(err, "failed to eager load fill")
 	}
 
-	var resultSlice []*Trade
+	var resultSlice []*Fill
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice trade")
+		return errors.Wrap(err, "failed to bind eager loaded slice fill")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on trade")
+		return errors.Wrap(err, "failed to close results in eager load on fill")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for fill")
 	}
 
-	if len(tradeAfterSelectHooks) != 0 {
+	if len(fillAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -583,10 +798,10 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.ExchangeNameTrades = resultSlice
+		object.R.ExchangeNameFills = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tradeR{}
+				foreign.R = &fillR{}
 			}
 			foreign.R.ExchangeName = object
 		}
@@ -596,9 +811,9 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameTrades = append(local.R.ExchangeNameTrades, foreign)
+				local.R.ExchangeNameFills = append(local.R.ExchangeNameFills, foreign)
 				if foreign.R == nil {
-					foreign.R = &tradeR{}
+					foreign.R = &fillR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -609,9 +824,9 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
+// LoadExchangeNameFundingRates allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameFundingRates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -648,29 +863,29 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 		return nil
 	}
 
-	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load withdrawal_history")
+		return errors.Wrap(err, "failed to eager load funding_rate")
 	}
 
-	var resultSlice []*WithdrawalHistory
+	var resultSlice []*FundingRate
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_rate")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+		return errors.Wrap(err, "failed to close results in eager load on funding_rate")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
 	}
 
-	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+	if len(fundingRateAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -678,10 +893,10 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 		}
 	}
 	if singular {
-		object.R.ExchangeNameWithdrawalHistories = resultSlice
+		object.R.ExchangeNameFundingRates = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &withdrawalHistoryR{}
+				foreign.R = &fundingRateR{}
 			}
 			foreign.R.ExchangeName = object
 		}
@@ -691,9 +906,9 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				local.R.ExchangeNameFundingRates = append(local.R.ExchangeNameFundingRates, foreign)
 				if foreign.R == nil {
-					foreign.R = &withdrawalHistoryR{}
+					foreign.R = &fundingRateR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -704,62 +919,654 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 	return nil
 }
 
-// AddExchangeNameCandles adds the given related objects to the existing relationships
-// of the exchange, optionally inserting them as new records.
-// Appends related to o.R.ExchangeNameCandles.
-// Sets related.R.ExchangeName appropriately.
-func (o *Exchange) AddExchangeNameCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ExchangeNameID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"candle\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
-				strmangle.WhereClause("\"", "\"", 2, candlePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadExchangeNameLiquidations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameLiquidations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
 
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
 			}
 
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
 			}
 
-			rel.ExchangeNameID = o.ID
+			args = append(args, obj.ID)
 		}
 	}
 
-	if o.R == nil {
-		o.R = &exchangeR{
-			ExchangeNameCandles: related,
-		}
-	} else {
-		o.R.ExchangeNameCandles = append(o.R.ExchangeNameCandles, related...)
+	if len(args) == 0 {
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &candleR{
-				ExchangeName: o,
-			}
-		} else {
-			rel.R.ExchangeName = o
-		}
+	query := NewQuery(qm.From(`liquidation`), qm.WhereIn(`liquidation.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return nil
-}
 
-// AddExchangeNameTrades adds the given related objects to the existing relationships
-// of the exchange, optionally inserting them as new records.
-// Appends related to o.R.ExchangeNameTrades.
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load liquidation")
+	}
+
+	var resultSlice []*Liquidation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice liquidation")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on liquidation")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for liquidation")
+	}
+
+	if len(liquidationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameLiquidations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &liquidationR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameLiquidations = append(local.R.ExchangeNameLiquidations, foreign)
+				if foreign.R == nil {
+					foreign.R = &liquidationR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameTrades allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load trade")
+	}
+
+	var resultSlice []*Trade
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice trade")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on trade")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+	}
+
+	if len(tradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameTrades = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tradeR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTrades = append(local.R.ExchangeNameTrades, foreign)
+				if foreign.R == nil {
+					foreign.R = &tradeR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameTransferHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameTransferHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`transfer_history`), qm.WhereIn(`transfer_history.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer_history")
+	}
+
+	var resultSlice []*TransferHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_history")
+	}
+
+	if len(transferHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameTransferHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferHistoryR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTransferHistories = append(local.R.ExchangeNameTransferHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferHistoryR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_history")
+	}
+
+	var resultSlice []*WithdrawalHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+	}
+
+	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameWithdrawalHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalHistoryR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalHistoryR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddExchangeNameBalanceSnapshots adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameBalanceSnapshots.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameBalanceSnapshots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BalanceSnapshot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"balance_snapshot\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, balanceSnapshotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameBalanceSnapshots: related,
+		}
+	} else {
+		o.R.ExchangeNameBalanceSnapshots = append(o.R.ExchangeNameBalanceSnapshots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &balanceSnapshotR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameCandles adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameCandles.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"candle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, candlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameCandles: related,
+		}
+	} else {
+		o.R.ExchangeNameCandles = append(o.R.ExchangeNameCandles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &candleR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameFills adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameFills.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameFills(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Fill) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"fill\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fillPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFills: related,
+		}
+	} else {
+		o.R.ExchangeNameFills = append(o.R.ExchangeNameFills, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fillR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameFundingRates adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameFundingRates.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameFundingRates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingRate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"funding_rate\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fundingRatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFundingRates: related,
+		}
+	} else {
+		o.R.ExchangeNameFundingRates = append(o.R.ExchangeNameFundingRates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fundingRateR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameLiquidations adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameLiquidations.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameLiquidations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Liquidation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"liquidation\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, liquidationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameLiquidations: related,
+		}
+	} else {
+		o.R.ExchangeNameLiquidations = append(o.R.ExchangeNameLiquidations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &liquidationR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameTrades adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameTrades.
 // Sets related.R.ExchangeName appropriately.
 func (o *Exchange) AddExchangeNameTrades(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Trade) error {
 	var err error
@@ -810,6 +1617,59 @@ func (o *Exchange) AddExchangeNameTrades(ctx context.Context, exec boil.ContextE
 	return nil
 }
 
+// AddExchangeNameTransferHistories adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameTransferHistories.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameTransferHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, transferHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameTransferHistories: related,
+		}
+	} else {
+		o.R.ExchangeNameTransferHistories = append(o.R.ExchangeNameTransferHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferHistoryR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameWithdrawalHistories adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameWithdrawalHistories.
@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTransferHistories(t *testing.T) {
+	t.Parallel()
+
+	query := TransferHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTransferHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TransferHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTransferHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TransferHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TransferHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TransferHistoryExists to return true, but got false.")
+	}
+}
+
+func testTransferHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	transferHistoryFound, err := FindTransferHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if transferHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTransferHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TransferHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TransferHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTransferHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	transferHistoryOne := &TransferHistory{}
+	transferHistoryTwo := &TransferHistory{}
+	if err = randomize.Struct(seed, transferHistoryOne, transferHistoryDBTypes, false, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferHistoryTwo, transferHistoryDBTypes, false, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTransferHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	transferHistoryOne := &TransferHistory{}
+	transferHistoryTwo := &TransferHistory{}
+	if err = randomize.Struct(seed, transferHistoryOne, transferHistoryDBTypes, false, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, transferHistoryTwo, transferHistoryDBTypes, false, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = transferHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = transferHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func transferHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func transferHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func transferHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func transferHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func transferHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func transferHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func transferHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func transferHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func transferHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TransferHistory) error {
+	*o = TransferHistory{}
+	return nil
+}
+
+func testTransferHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TransferHistory{}
+	o := &TransferHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TransferHistory object: %s", err)
+	}
+
+	AddTransferHistoryHook(boil.BeforeInsertHook, transferHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryBeforeInsertHooks = []TransferHistoryHook{}
+
+	AddTransferHistoryHook(boil.AfterInsertHook, transferHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryAfterInsertHooks = []TransferHistoryHook{}
+
+	AddTransferHistoryHook(boil.AfterSelectHook, transferHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryAfterSelectHooks = []TransferHistoryHook{}
+
+	AddTransferHistoryHook(boil.BeforeUpdateHook, transferHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryBeforeUpdateHooks = []TransferHistoryHook{}
+
+	AddTransferHistoryHook(boil.AfterUpdateHook, transferHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryAfterUpdateHooks = []TransferHistoryHook{}
+
+	AddTransferHistoryHook(boil.BeforeDeleteHook, transferHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryBeforeDeleteHooks = []TransferHistoryHook{}
+
+	AddTransferHistoryHook(boil.AfterDeleteHook, transferHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryAfterDeleteHooks = []TransferHistoryHook{}
+
+	AddTransferHistoryHook(boil.BeforeUpsertHook, transferHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryBeforeUpsertHooks = []TransferHistoryHook{}
+
+	AddTransferHistoryHook(boil.AfterUpsertHook, transferHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	transferHistoryAfterUpsertHooks = []TransferHistoryHook{}
+}
+
+func testTransferHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(transferHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTransferHistoryToOneExchangeUsingExchangeName(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TransferHistory
+	var foreign Exchange
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, transferHistoryDBTypes, false, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, exchangeDBTypes, false, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ExchangeNameID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ExchangeName().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TransferHistorySlice{&local}
+	if err = local.L.LoadExchangeName(ctx, tx, false, (*[]*TransferHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ExchangeName = nil
+	if err = local.L.LoadExchangeName(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTransferHistoryToOneSetOpExchangeUsingExchangeName(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TransferHistory
+	var b, c Exchange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, transferHistoryDBTypes, false, strmangle.SetComplement(transferHistoryPrimaryKeyColumns, transferHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Exchange{&b, &c} {
+		err = a.SetExchangeName(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ExchangeName != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExchangeNameTransferHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ExchangeNameID))
+		reflect.Indirect(reflect.ValueOf(&a.ExchangeNameID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID, x.ID)
+		}
+	}
+}
+
+func testTransferHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TransferHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTransferHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TransferHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	transferHistoryDBTypes = map[string]string{`ID`: `uuid`, `ExchangeNameID`: `uuid`, `ExchangeID`: `text`, `Status`: `character varying`, `Currency`: `text`, `Amount`: `double precision`, `FromAccount`: `text`, `ToAccount`: `text`, `FromAsset`: `character varying`, `ToAsset`: `character varying`, `Description`: `text`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                      = bytes.MinRead
+)
+
+func testTransferHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(transferHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(transferHistoryAllColumns) == len(transferHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTransferHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(transferHistoryAllColumns) == len(transferHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TransferHistory{}
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, transferHistoryDBTypes, true, transferHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(transferHistoryAllColumns, transferHistoryPrimaryKeyColumns) {
+		fields = transferHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			transferHistoryAllColumns,
+			transferHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TransferHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTransferHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(transferHistoryAllColumns) == len(transferHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TransferHistory{}
+	if err = randomize.Struct(seed, &o, transferHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferHistory: %s", err)
+	}
+
+	count, err := TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, transferHistoryDBTypes, false, transferHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TransferHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TransferHistory: %s", err)
+	}
+
+	count, err = TransferHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
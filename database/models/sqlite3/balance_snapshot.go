@@ -0,0 +1,1010 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// BalanceSnapshot is an object representing the database table.
+type BalanceSnapshot struct {
+	ID                string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID    string       `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	AccountID         string       `boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	Asset             string       `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Currency          string       `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Total             float64      `boil:"total" json:"total" toml:"total" yaml:"total"`
+	Hold              float64      `boil:"hold" json:"hold" toml:"hold" yaml:"hold"`
+	ReportingCurrency string       `boil:"reporting_currency" json:"reporting_currency" toml:"reporting_currency" yaml:"reporting_currency"`
+	ReportingValue    null.Float64 `boil:"reporting_value" json:"reporting_value,omitempty" toml:"reporting_value" yaml:"reporting_value,omitempty"`
+	Timestamp         string       `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *balanceSnapshotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L balanceSnapshotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BalanceSnapshotColumns = struct {
+	ID                string
+	ExchangeNameID    string
+	AccountID         string
+	Asset             string
+	Currency          string
+	Total             string
+	Hold              string
+	ReportingCurrency string
+	ReportingValue    string
+	Timestamp         string
+}{
+	ID:                "id",
+	ExchangeNameID:    "exchange_name_id",
+	AccountID:         "account_id",
+	Asset:             "asset",
+	Currency:          "currency",
+	Total:             "total",
+	Hold:              "hold",
+	ReportingCurrency: "reporting_currency",
+	ReportingValue:    "reporting_value",
+	Timestamp:         "timestamp",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var BalanceSnapshotWhere = struct {
+	ID                whereHelperstring
+	ExchangeNameID    whereHelperstring
+	AccountID         whereHelperstring
+	Asset             whereHelperstring
+	Currency          whereHelperstring
+	Total             whereHelperfloat64
+	Hold              whereHelperfloat64
+	ReportingCurrency whereHelperstring
+	ReportingValue    whereHelpernull_Float64
+	Timestamp         whereHelperstring
+}{
+	ID:                whereHelperstring{field: "\"balance_snapshot\".\"id\""},
+	ExchangeNameID:    whereHelperstring{field: "\"balance_snapshot\".\"exchange_name_id\""},
+	AccountID:         whereHelperstring{field: "\"balance_snapshot\".\"account_id\""},
+	Asset:             whereHelperstring{field: "\"balance_snapshot\".\"asset\""},
+	Currency:          whereHelperstring{field: "\"balance_snapshot\".\"currency\""},
+	Total:             whereHelperfloat64{field: "\"balance_snapshot\".\"total\""},
+	Hold:              whereHelperfloat64{field: "\"balance_snapshot\".\"hold\""},
+	ReportingCurrency: whereHelperstring{field: "\"balance_snapshot\".\"reporting_currency\""},
+	ReportingValue:    whereHelpernull_Float64{field: "\"balance_snapshot\".\"reporting_value\""},
+	Timestamp:         whereHelperstring{field: "\"balance_snapshot\".\"timestamp\""},
+}
+
+// BalanceSnapshotRels is where relationship names are stored.
+var BalanceSnapshotRels = struct {
+	ExchangeName string
+}{
+	ExchangeName: "ExchangeName",
+}
+
+// balanceSnapshotR is where relationships are stored.
+type balanceSnapshotR struct {
+	ExchangeName *Exchange
+}
+
+// NewStruct creates a new relationship struct
+func (*balanceSnapshotR) NewStruct() *balanceSnapshotR {
+	return &balanceSnapshotR{}
+}
+
+// balanceSnapshotL is where Load methods for each relationship are stored.
+type balanceSnapshotL struct{}
+
+var (
+	balanceSnapshotAllColumns            = []string{"id", "exchange_name_id", "account_id", "asset", "currency", "total", "hold", "reporting_currency", "reporting_value", "timestamp"}
+	balanceSnapshotColumnsWithoutDefault = []string{"id", "exchange_name_id", "account_id", "asset", "currency", "total", "hold", "reporting_currency", "reporting_value", "timestamp"}
+	balanceSnapshotColumnsWithDefault    = []string{}
+	balanceSnapshotPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// BalanceSnapshotSlice is an alias for a slice of pointers to BalanceSnapshot.
+	// This should generally be used opposed to []BalanceSnapshot.
+	BalanceSnapshotSlice []*BalanceSnapshot
+	// BalanceSnapshotHook is the signature for custom BalanceSnapshot hook methods
+	BalanceSnapshotHook func(context.Context, boil.ContextExecutor, *BalanceSnapshot) error
+
+	balanceSnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	balanceSnapshotType                 = reflect.TypeOf(&BalanceSnapshot{})
+	balanceSnapshotMapping              = queries.MakeStructMapping(balanceSnapshotType)
+	balanceSnapshotPrimaryKeyMapping, _ = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, balanceSnapshotPrimaryKeyColumns)
+	balanceSnapshotInsertCacheMut       sync.RWMutex
+	balanceSnapshotInsertCache          = make(map[string]insertCache)
+	balanceSnapshotUpdateCacheMut       sync.RWMutex
+	balanceSnapshotUpdateCache          = make(map[string]updateCache)
+	balanceSnapshotUpsertCacheMut       sync.RWMutex
+	balanceSnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var balanceSnapshotBeforeInsertHooks []BalanceSnapshotHook
+var balanceSnapshotBeforeUpdateHooks []BalanceSnapshotHook
+var balanceSnapshotBeforeDeleteHooks []BalanceSnapshotHook
+var balanceSnapshotBeforeUpsertHooks []BalanceSnapshotHook
+
+var balanceSnapshotAfterInsertHooks []BalanceSnapshotHook
+var balanceSnapshotAfterSelectHooks []BalanceSnapshotHook
+var balanceSnapshotAfterUpdateHooks []BalanceSnapshotHook
+var balanceSnapshotAfterDeleteHooks []BalanceSnapshotHook
+var balanceSnapshotAfterUpsertHooks []BalanceSnapshotHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BalanceSnapshot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BalanceSnapshot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BalanceSnapshot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BalanceSnapshot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BalanceSnapshot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BalanceSnapshot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BalanceSnapshot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BalanceSnapshot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BalanceSnapshot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBalanceSnapshotHook registers your hook function for all future operations.
+func AddBalanceSnapshotHook(hookPoint boil.HookPoint, balanceSnapshotHook BalanceSnapshotHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		balanceSnapshotBeforeInsertHooks = append(balanceSnapshotBeforeInsertHooks, balanceSnapshotHook)
+	case boil.BeforeUpdateHook:
+		balanceSnapshotBeforeUpdateHooks = append(balanceSnapshotBeforeUpdateHooks, balanceSnapshotHook)
+	case boil.BeforeDeleteHook:
+		balanceSnapshotBeforeDeleteHooks = append(balanceSnapshotBeforeDeleteHooks, balanceSnapshotHook)
+	case boil.BeforeUpsertHook:
+		balanceSnapshotBeforeUpsertHooks = append(balanceSnapshotBeforeUpsertHooks, balanceSnapshotHook)
+	case boil.AfterInsertHook:
+		balanceSnapshotAfterInsertHooks = append(balanceSnapshotAfterInsertHooks, balanceSnapshotHook)
+	case boil.AfterSelectHook:
+		balanceSnapshotAfterSelectHooks = append(balanceSnapshotAfterSelectHooks, balanceSnapshotHook)
+	case boil.AfterUpdateHook:
+		balanceSnapshotAfterUpdateHooks = append(balanceSnapshotAfterUpdateHooks, balanceSnapshotHook)
+	case boil.AfterDeleteHook:
+		balanceSnapshotAfterDeleteHooks = append(balanceSnapshotAfterDeleteHooks, balanceSnapshotHook)
+	case boil.AfterUpsertHook:
+		balanceSnapshotAfterUpsertHooks = append(balanceSnapshotAfterUpsertHooks, balanceSnapshotHook)
+	}
+}
+
+// One returns a single balanceSnapshot record from the query.
+func (q balanceSnapshotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BalanceSnapshot, error) {
+	o := &BalanceSnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for balance_snapshot")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BalanceSnapshot records from the query.
+func (q balanceSnapshotQuery) All(ctx context.Context, exec boil.ContextExecutor) (BalanceSnapshotSlice, error) {
+	var o []*BalanceSnapshot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to BalanceSnapshot slice")
+	}
+
+	if len(balanceSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BalanceSnapshot records in the query.
+func (q balanceSnapshotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count balance_snapshot rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q balanceSnapshotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if balance_snapshot exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeName pointed to by the foreign key.
+func (o *BalanceSnapshot) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ExchangeNameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Exchanges(queryMods...)
+	queries.SetFrom(query.Query, "\"exchange\"")
+
+	return query
+}
+
+// LoadExchangeName allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (balanceSnapshotL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBalanceSnapshot interface{}, mods queries.Applicator) error {
+	var slice []*BalanceSnapshot
+	var object *BalanceSnapshot
+
+	if singular {
+		object = maybeBalanceSnapshot.(*BalanceSnapshot)
+	} else {
+		slice = *maybeBalanceSnapshot.(*[]*BalanceSnapshot)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &balanceSnapshotR{}
+		}
+		args = append(args, object.ExchangeNameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &balanceSnapshotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ExchangeNameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ExchangeNameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`exchange`), qm.WhereIn(`exchange.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Exchange")
+	}
+
+	var resultSlice []*Exchange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Exchange")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange")
+	}
+
+	if len(balanceSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeName = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeR{}
+		}
+		foreign.R.ExchangeNameBalanceSnapshot = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ExchangeNameID == foreign.ID {
+				local.R.ExchangeName = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeR{}
+				}
+				foreign.R.ExchangeNameBalanceSnapshot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeName of the balanceSnapshot to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNameBalanceSnapshot.
+func (o *BalanceSnapshot) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"balance_snapshot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+		strmangle.WhereClause("\"", "\"", 0, balanceSnapshotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &balanceSnapshotR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNameBalanceSnapshot: o,
+		}
+	} else {
+		related.R.ExchangeNameBalanceSnapshot = o
+	}
+
+	return nil
+}
+
+// BalanceSnapshots retrieves all the records using an executor.
+func BalanceSnapshots(mods ...qm.QueryMod) balanceSnapshotQuery {
+	mods = append(mods, qm.From("\"balance_snapshot\""))
+	return balanceSnapshotQuery{NewQuery(mods...)}
+}
+
+// FindBalanceSnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBalanceSnapshot(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*BalanceSnapshot, error) {
+	balanceSnapshotObj := &BalanceSnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"balance_snapshot\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, balanceSnapshotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from balance_snapshot")
+	}
+
+	return balanceSnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BalanceSnapshot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no balance_snapshot provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(balanceSnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	balanceSnapshotInsertCacheMut.RLock()
+	cache, cached := balanceSnapshotInsertCache[key]
+	balanceSnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			balanceSnapshotAllColumns,
+			balanceSnapshotColumnsWithDefault,
+			balanceSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"balance_snapshot\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"balance_snapshot\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"balance_snapshot\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, balanceSnapshotPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into balance_snapshot")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for balance_snapshot")
+	}
+
+CacheNoHooks:
+	if !cached {
+		balanceSnapshotInsertCacheMut.Lock()
+		balanceSnapshotInsertCache[key] = cache
+		balanceSnapshotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BalanceSnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BalanceSnapshot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	balanceSnapshotUpdateCacheMut.RLock()
+	cache, cached := balanceSnapshotUpdateCache[key]
+	balanceSnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			balanceSnapshotAllColumns,
+			balanceSnapshotPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update balance_snapshot, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"balance_snapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, balanceSnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, append(wl, balanceSnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update balance_snapshot row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for balance_snapshot")
+	}
+
+	if !cached {
+		balanceSnapshotUpdateCacheMut.Lock()
+		balanceSnapshotUpdateCache[key] = cache
+		balanceSnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q balanceSnapshotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for balance_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for balance_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BalanceSnapshotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"balance_snapshot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, balanceSnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in balanceSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all balanceSnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single BalanceSnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BalanceSnapshot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no BalanceSnapshot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), balanceSnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"balance_snapshot\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from balance_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for balance_snapshot")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q balanceSnapshotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no balanceSnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from balance_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for balance_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BalanceSnapshotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(balanceSnapshotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"balance_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, balanceSnapshotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from balanceSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for balance_snapshot")
+	}
+
+	if len(balanceSnapshotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BalanceSnapshot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBalanceSnapshot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BalanceSnapshotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BalanceSnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"balance_snapshot\".* FROM \"balance_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, balanceSnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in BalanceSnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BalanceSnapshotExists checks if the BalanceSnapshot row exists.
+func BalanceSnapshotExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"balance_snapshot\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if balance_snapshot exists")
+	}
+
+	return exists, nil
+}
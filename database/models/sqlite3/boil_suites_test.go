@@ -13,11 +13,16 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("BalanceSnapshots", testBalanceSnapshots)
 	t.Run("Candles", testCandles)
 	t.Run("Exchanges", testExchanges)
+	t.Run("Fills", testFills)
+	t.Run("FundingRates", testFundingRates)
+	t.Run("Liquidations", testLiquidations)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
 	t.Run("Trades", testTrades)
+	t.Run("TransferHistories", testTransferHistories)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
 	t.Run("WithdrawalFiats", testWithdrawalFiats)
 	t.Run("WithdrawalHistories", testWithdrawalHistories)
@@ -25,11 +30,16 @@ func TestParent(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsDelete)
 	t.Run("Candles", testCandlesDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("Fills", testFillsDelete)
+	t.Run("FundingRates", testFundingRatesDelete)
+	t.Run("Liquidations", testLiquidationsDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
 	t.Run("Trades", testTradesDelete)
+	t.Run("TransferHistories", testTransferHistoriesDelete)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesDelete)
@@ -37,11 +47,16 @@ func TestDelete(t *testing.T) {
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("Fills", testFillsQueryDeleteAll)
+	t.Run("FundingRates", testFundingRatesQueryDeleteAll)
+	t.Run("Liquidations", testLiquidationsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
+	t.Run("TransferHistories", testTransferHistoriesQueryDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesQueryDeleteAll)
@@ -49,11 +64,16 @@ func TestQueryDeleteAll(t *testing.T) {
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("Fills", testFillsSliceDeleteAll)
+	t.Run("FundingRates", testFundingRatesSliceDeleteAll)
+	t.Run("Liquidations", testLiquidationsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
+	t.Run("TransferHistories", testTransferHistoriesSliceDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceDeleteAll)
@@ -61,11 +81,16 @@ func TestSliceDeleteAll(t *testing.T) {
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsExists)
 	t.Run("Candles", testCandlesExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("Fills", testFillsExists)
+	t.Run("FundingRates", testFundingRatesExists)
+	t.Run("Liquidations", testLiquidationsExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
 	t.Run("Trades", testTradesExists)
+	t.Run("TransferHistories", testTransferHistoriesExists)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesExists)
@@ -73,11 +98,16 @@ func TestExists(t *testing.T) {
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsFind)
 	t.Run("Candles", testCandlesFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("Fills", testFillsFind)
+	t.Run("FundingRates", testFundingRatesFind)
+	t.Run("Liquidations", testLiquidationsFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
 	t.Run("Trades", testTradesFind)
+	t.Run("TransferHistories", testTransferHistoriesFind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesFind)
@@ -85,11 +115,16 @@ func TestFind(t *testing.T) {
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsBind)
 	t.Run("Candles", testCandlesBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("Fills", testFillsBind)
+	t.Run("FundingRates", testFundingRatesBind)
+	t.Run("Liquidations", testLiquidationsBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
 	t.Run("Trades", testTradesBind)
+	t.Run("TransferHistories", testTransferHistoriesBind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesBind)
@@ -97,11 +132,16 @@ func TestBind(t *testing.T) {
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsOne)
 	t.Run("Candles", testCandlesOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("Fills", testFillsOne)
+	t.Run("FundingRates", testFundingRatesOne)
+	t.Run("Liquidations", testLiquidationsOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
 	t.Run("Trades", testTradesOne)
+	t.Run("TransferHistories", testTransferHistoriesOne)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesOne)
@@ -109,11 +149,16 @@ func TestOne(t *testing.T) {
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsAll)
 	t.Run("Candles", testCandlesAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("Fills", testFillsAll)
+	t.Run("FundingRates", testFundingRatesAll)
+	t.Run("Liquidations", testLiquidationsAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
 	t.Run("Trades", testTradesAll)
+	t.Run("TransferHistories", testTransferHistoriesAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesAll)
@@ -121,11 +166,16 @@ func TestAll(t *testing.T) {
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsCount)
 	t.Run("Candles", testCandlesCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("Fills", testFillsCount)
+	t.Run("FundingRates", testFundingRatesCount)
+	t.Run("Liquidations", testLiquidationsCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
 	t.Run("Trades", testTradesCount)
+	t.Run("TransferHistories", testTransferHistoriesCount)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesCount)
@@ -133,11 +183,16 @@ func TestCount(t *testing.T) {
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsHooks)
 	t.Run("Candles", testCandlesHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("Fills", testFillsHooks)
+	t.Run("FundingRates", testFundingRatesHooks)
+	t.Run("Liquidations", testLiquidationsHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
 	t.Run("Trades", testTradesHooks)
+	t.Run("TransferHistories", testTransferHistoriesHooks)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesHooks)
@@ -146,16 +201,26 @@ func TestHooks(t *testing.T) {
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsInsert)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("Fills", testFillsInsert)
+	t.Run("Fills", testFillsInsertWhitelist)
+	t.Run("FundingRates", testFundingRatesInsert)
+	t.Run("FundingRates", testFundingRatesInsertWhitelist)
+	t.Run("Liquidations", testLiquidationsInsert)
+	t.Run("Liquidations", testLiquidationsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
 	t.Run("Trades", testTradesInsert)
 	t.Run("Trades", testTradesInsertWhitelist)
+	t.Run("TransferHistories", testTransferHistoriesInsert)
+	t.Run("TransferHistories", testTransferHistoriesInsertWhitelist)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsertWhitelist)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsInsert)
@@ -167,9 +232,14 @@ func TestInsert(t *testing.T) {
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("BalanceSnapshotToExchangeUsingExchangeName", testBalanceSnapshotToOneExchangeUsingExchangeName)
 	t.Run("CandleToExchangeUsingExchangeName", testCandleToOneExchangeUsingExchangeName)
+	t.Run("FillToExchangeUsingExchangeName", testFillToOneExchangeUsingExchangeName)
+	t.Run("FundingRateToExchangeUsingExchangeName", testFundingRateToOneExchangeUsingExchangeName)
+	t.Run("LiquidationToExchangeUsingExchangeName", testLiquidationToOneExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
+	t.Run("TransferHistoryToExchangeUsingExchangeName", testTransferHistoryToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalHistoryToExchangeUsingExchangeName", testWithdrawalHistoryToOneExchangeUsingExchangeName)
@@ -178,13 +248,18 @@ func TestToOne(t *testing.T) {
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
+	t.Run("ExchangeToBalanceSnapshotUsingExchangeNameBalanceSnapshot", testExchangeOneToOneBalanceSnapshotUsingExchangeNameBalanceSnapshot)
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToFillUsingExchangeNameFill", testExchangeOneToOneFillUsingExchangeNameFill)
+	t.Run("ExchangeToFundingRateUsingExchangeNameFundingRate", testExchangeOneToOneFundingRateUsingExchangeNameFundingRate)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneTradeUsingExchangeNameTrade)
 }
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("ExchangeToExchangeNameLiquidations", testExchangeToManyExchangeNameLiquidations)
+	t.Run("ExchangeToExchangeNameTransferHistories", testExchangeToManyExchangeNameTransferHistories)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
 	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
@@ -194,9 +269,14 @@ func TestToMany(t *testing.T) {
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("BalanceSnapshotToExchangeUsingExchangeNameBalanceSnapshot", testBalanceSnapshotToOneSetOpExchangeUsingExchangeName)
 	t.Run("CandleToExchangeUsingExchangeNameCandle", testCandleToOneSetOpExchangeUsingExchangeName)
+	t.Run("FillToExchangeUsingExchangeNameFill", testFillToOneSetOpExchangeUsingExchangeName)
+	t.Run("FundingRateToExchangeUsingExchangeNameFundingRate", testFundingRateToOneSetOpExchangeUsingExchangeName)
+	t.Run("LiquidationToExchangeUsingExchangeNameLiquidations", testLiquidationToOneSetOpExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
+	t.Run("TransferHistoryToExchangeUsingExchangeNameTransferHistories", testTransferHistoryToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalFiats", testWithdrawalFiatToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalHistoryToExchangeUsingExchangeNameWithdrawalHistories", testWithdrawalHistoryToOneSetOpExchangeUsingExchangeName)
@@ -209,7 +289,10 @@ func TestToOneRemove(t *testing.T) {}
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
+	t.Run("ExchangeToBalanceSnapshotUsingExchangeNameBalanceSnapshot", testExchangeOneToOneSetOpBalanceSnapshotUsingExchangeNameBalanceSnapshot)
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneSetOpCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToFillUsingExchangeNameFill", testExchangeOneToOneSetOpFillUsingExchangeNameFill)
+	t.Run("ExchangeToFundingRateUsingExchangeNameFundingRate", testExchangeOneToOneSetOpFundingRateUsingExchangeNameFundingRate)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneSetOpTradeUsingExchangeNameTrade)
 }
 
@@ -220,6 +303,8 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("ExchangeToExchangeNameLiquidations", testExchangeToManyAddOpExchangeNameLiquidations)
+	t.Run("ExchangeToExchangeNameTransferHistories", testExchangeToManyAddOpExchangeNameTransferHistories)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
 	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
@@ -236,11 +321,16 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsReload)
 	t.Run("Candles", testCandlesReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("Fills", testFillsReload)
+	t.Run("FundingRates", testFundingRatesReload)
+	t.Run("Liquidations", testLiquidationsReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
 	t.Run("Trades", testTradesReload)
+	t.Run("TransferHistories", testTransferHistoriesReload)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReload)
@@ -248,11 +338,16 @@ func TestReload(t *testing.T) {
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("Fills", testFillsReloadAll)
+	t.Run("FundingRates", testFundingRatesReloadAll)
+	t.Run("Liquidations", testLiquidationsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
+	t.Run("TransferHistories", testTransferHistoriesReloadAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReloadAll)
@@ -260,11 +355,16 @@ func TestReloadAll(t *testing.T) {
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSelect)
 	t.Run("Candles", testCandlesSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("Fills", testFillsSelect)
+	t.Run("FundingRates", testFundingRatesSelect)
+	t.Run("Liquidations", testLiquidationsSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
 	t.Run("Trades", testTradesSelect)
+	t.Run("TransferHistories", testTransferHistoriesSelect)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSelect)
@@ -272,11 +372,16 @@ func TestSelect(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsUpdate)
 	t.Run("Candles", testCandlesUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("Fills", testFillsUpdate)
+	t.Run("FundingRates", testFundingRatesUpdate)
+	t.Run("Liquidations", testLiquidationsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
 	t.Run("Trades", testTradesUpdate)
+	t.Run("TransferHistories", testTransferHistoriesUpdate)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpdate)
@@ -284,11 +389,16 @@ func TestUpdate(t *testing.T) {
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("Fills", testFillsSliceUpdateAll)
+	t.Run("FundingRates", testFundingRatesSliceUpdateAll)
+	t.Run("Liquidations", testLiquidationsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
+	t.Run("TransferHistories", testTransferHistoriesSliceUpdateAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceUpdateAll)
@@ -49,19 +49,34 @@ var ExchangeWhere = struct {
 
 // ExchangeRels is where relationship names are stored.
 var ExchangeRels = struct {
+	ExchangeNameBalanceSnapshot     string
 	ExchangeNameCandle              string
+	ExchangeNameFill                string
+	ExchangeNameFundingRate         string
 	ExchangeNameTrade               string
+	ExchangeNameLiquidations        string
+	ExchangeNameTransferHistories   string
 	ExchangeNameWithdrawalHistories string
 }{
+	ExchangeNameBalanceSnapshot:     "ExchangeNameBalanceSnapshot",
 	ExchangeNameCandle:              "ExchangeNameCandle",
+	ExchangeNameFill:                "ExchangeNameFill",
+	ExchangeNameFundingRate:         "ExchangeNameFundingRate",
 	ExchangeNameTrade:               "ExchangeNameTrade",
+	ExchangeNameLiquidations:        "ExchangeNameLiquidations",
+	ExchangeNameTransferHistories:   "ExchangeNameTransferHistories",
 	ExchangeNameWithdrawalHistories: "ExchangeNameWithdrawalHistories",
 }
 
 // exchangeR is where relationships are stored.
 type exchangeR struct {
+	ExchangeNameBalanceSnapshot     *BalanceSnapshot
 	ExchangeNameCandle              *Candle
+	ExchangeNameFill                *Fill
+	ExchangeNameFundingRate         *FundingRate
 	ExchangeNameTrade               *Trade
+	ExchangeNameLiquidations        LiquidationSlice
+	ExchangeNameTransferHistories   TransferHistorySlice
 	ExchangeNameWithdrawalHistories WithdrawalHistorySlice
 }
 
@@ -355,6 +370,20 @@ func (q exchangeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (b
 	return count > 0, nil
 }
 
+// ExchangeNameBalanceSnapshot pointed to by the foreign key.
+func (o *Exchange) ExchangeNameBalanceSnapshot(mods ...qm.QueryMod) balanceSnapshotQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := BalanceSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"balance_snapshot\"")
+
+	return query
+}
+
 // ExchangeNameCandle pointed to by the foreign key.
 func (o *Exchange) ExchangeNameCandle(mods ...qm.QueryMod) candleQuery {
 	queryMods := []qm.QueryMod{
@@ -369,6 +398,34 @@ func (o *Exchange) ExchangeNameCandle(mods ...qm.QueryMod) candleQuery {
 	return query
 }
 
+// ExchangeNameFill pointed to by the foreign key.
+func (o *Exchange) ExchangeNameFill(mods ...qm.QueryMod) fillQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Fills(queryMods...)
+	queries.SetFrom(query.Query, "\"fill\"")
+
+	return query
+}
+
+// ExchangeNameFundingRate pointed to by the foreign key.
+func (o *Exchange) ExchangeNameFundingRate(mods ...qm.QueryMod) fundingRateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	return query
+}
+
 // ExchangeNameTrade pointed to by the foreign key.
 func (o *Exchange) ExchangeNameTrade(mods ...qm.QueryMod) tradeQuery {
 	queryMods := []qm.QueryMod{
@@ -383,6 +440,48 @@ func (o *Exchange) ExchangeNameTrade(mods ...qm.QueryMod) tradeQuery {
 	return query
 }
 
+// ExchangeNameLiquidations retrieves all the liquidation's Liquidations with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameLiquidations(mods ...qm.QueryMod) liquidationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"liquidation\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := Liquidations(queryMods...)
+	queries.SetFrom(query.Query, "\"liquidation\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"liquidation\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameTransferHistories retrieves all the transfer_history's TransferHistories with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameTransferHistories(mods ...qm.QueryMod) transferHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"transfer_history\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := TransferHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"transfer_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"transfer_history\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameWithdrawalHistories retrieves all the withdrawal_history's WithdrawalHistories with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameWithdrawalHistories(mods ...qm.QueryMod) withdrawalHistoryQuery {
 	var queryMods []qm.QueryMod
@@ -404,9 +503,9 @@ func (o *Exchange) ExchangeNameWithdrawalHistories(mods ...qm.QueryMod) withdraw
 	return query
 }
 
-// LoadExchangeNameCandle allows an eager lookup of values, cached into the
+// LoadExchangeNameBalanceSnapshot allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
-func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameBalanceSnapshot(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -443,26 +542,26 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 		return nil
 	}
 
-	query := NewQuery(qm.From(`candle`), qm.WhereIn(`candle.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`balance_snapshot`), qm.WhereIn(`balance_snapshot.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Candle")
+		return errors.Wrap(err, "failed to eager load BalanceSnapshot")
 	}
 
-	var resultSlice []*Candle
+	var resultSlice []*BalanceSnapshot
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Candle")
+		return errors.Wrap(err, "failed to bind eager loaded slice BalanceSnapshot")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for candle")
+		return errors.Wrap(err, "failed to close results of eager load for balance_snapshot")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for candle")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for balance_snapshot")
 	}
 
 	if len(exchangeAfterSelectHooks) != 0 {
@@ -479,9 +578,9 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.ExchangeNameCandle = foreign
+		object.R.ExchangeNameBalanceSnapshot = foreign
 		if foreign.R == nil {
-			foreign.R = &candleR{}
+			foreign.R = &balanceSnapshotR{}
 		}
 		foreign.R.ExchangeName = object
 	}
@@ -489,9 +588,9 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameCandle = foreign
+				local.R.ExchangeNameBalanceSnapshot = foreign
 				if foreign.R == nil {
-					foreign.R = &candleR{}
+					foreign.R = &balanceSnapshotR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -502,9 +601,9 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadExchangeNameTrade allows an eager lookup of values, cached into the
+// LoadExchangeNameCandle allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
-func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -541,26 +640,26 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 		return nil
 	}
 
-	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`candle`), qm.WhereIn(`candle.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Trade")
+		return errors.Wrap(err, "failed to eager load Candle")
 	}
 
-	var resultSlice []*Trade
+	var resultSlice []*Candle
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Trade")
+		return errors.Wrap(err, "failed to bind eager loaded slice Candle")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for trade")
+		return errors.Wrap(err, "failed to close results of eager load for candle")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for candle")
 	}
 
 	if len(exchangeAfterSelectHooks) != 0 {
@@ -577,9 +676,9 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.ExchangeNameTrade = foreign
+		object.R.ExchangeNameCandle = foreign
 		if foreign.R == nil {
-			foreign.R = &tradeR{}
+			foreign.R = &candleR{}
 		}
 		foreign.R.ExchangeName = object
 	}
@@ -587,9 +686,9 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameTrade = foreign
+				local.R.ExchangeNameCandle = foreign
 				if foreign.R == nil {
-					foreign.R = &tradeR{}
+					foreign.R = &candleR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -600,9 +699,9 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+// LoadExchangeNameFill allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameFill(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -639,52 +738,55 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 		return nil
 	}
 
-	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`fill`), qm.WhereIn(`fill.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load withdrawal_history")
+		return errors.Wrap(err, "failed to eager load Fill")
 	}
 
-	var resultSlice []*WithdrawalHistory
+	var resultSlice []*Fill
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+		return errors.Wrap(err, "failed to bind eager loaded slice Fill")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+		return errors.Wrap(err, "failed to close results of eager load for fill")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for fill")
 	}
 
-	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+	if len(exchangeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ExchangeNameWithdrawalHistories = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &withdrawalHistoryR{}
-			}
-			foreign.R.ExchangeName = object
+		foreign := resultSlice[0]
+		object.R.ExchangeNameFill = foreign
+		if foreign.R == nil {
+			foreign.R = &fillR{}
 		}
-		return nil
+		foreign.R.ExchangeName = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				local.R.ExchangeNameFill = foreign
 				if foreign.R == nil {
-					foreign.R = &withdrawalHistoryR{}
+					foreign.R = &fillR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -695,100 +797,734 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 	return nil
 }
 
-// SetExchangeNameCandle of the exchange to the related item.
-// Sets o.R.ExchangeNameCandle to related.
-// Adds o to related.R.ExchangeName.
-func (o *Exchange) SetExchangeNameCandle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Candle) error {
-	var err error
+// LoadExchangeNameFundingRate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameFundingRate(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
 
-	if insert {
-		related.ExchangeNameID = o.ID
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"candle\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
-			strmangle.WhereClause("\"", "\"", 0, candlePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.ID}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
-		}
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
 
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+			args = append(args, obj.ID)
 		}
+	}
 
-		related.ExchangeNameID = o.ID
-
+	if len(args) == 0 {
+		return nil
 	}
 
-	if o.R == nil {
-		o.R = &exchangeR{
-			ExchangeNameCandle: related,
-		}
-	} else {
-		o.R.ExchangeNameCandle = related
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	if related.R == nil {
-		related.R = &candleR{
-			ExchangeName: o,
-		}
-	} else {
-		related.R.ExchangeName = o
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FundingRate")
 	}
-	return nil
-}
 
-// SetExchangeNameTrade of the exchange to the related item.
-// Sets o.R.ExchangeNameTrade to related.
-// Adds o to related.R.ExchangeName.
-func (o *Exchange) SetExchangeNameTrade(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Trade) error {
-	var err error
+	var resultSlice []*FundingRate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FundingRate")
+	}
 
-	if insert {
-		related.ExchangeNameID = o.ID
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for funding_rate")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
+	}
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"trade\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
-			strmangle.WhereClause("\"", "\"", 0, tradePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.ID}
+	}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
-		}
+	if len(resultSlice) == 0 {
+		return nil
+	}
 
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameFundingRate = foreign
+		if foreign.R == nil {
+			foreign.R = &fundingRateR{}
 		}
-
-		related.ExchangeNameID = o.ID
-
+		foreign.R.ExchangeName = object
 	}
 
-	if o.R == nil {
-		o.R = &exchangeR{
-			ExchangeNameTrade: related,
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameFundingRate = foreign
+				if foreign.R == nil {
+					foreign.R = &fundingRateR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
 		}
-	} else {
-		o.R.ExchangeNameTrade = related
 	}
 
-	if related.R == nil {
-		related.R = &tradeR{
+	return nil
+}
+
+// LoadExchangeNameTrade allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Trade")
+	}
+
+	var resultSlice []*Trade
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Trade")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for trade")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameTrade = foreign
+		if foreign.R == nil {
+			foreign.R = &tradeR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTrade = foreign
+				if foreign.R == nil {
+					foreign.R = &tradeR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameLiquidations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameLiquidations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`liquidation`), qm.WhereIn(`liquidation.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load liquidation")
+	}
+
+	var resultSlice []*Liquidation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice liquidation")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on liquidation")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for liquidation")
+	}
+
+	if len(liquidationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameLiquidations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &liquidationR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameLiquidations = append(local.R.ExchangeNameLiquidations, foreign)
+				if foreign.R == nil {
+					foreign.R = &liquidationR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameTransferHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameTransferHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`transfer_history`), qm.WhereIn(`transfer_history.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transfer_history")
+	}
+
+	var resultSlice []*TransferHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transfer_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transfer_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transfer_history")
+	}
+
+	if len(transferHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameTransferHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transferHistoryR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTransferHistories = append(local.R.ExchangeNameTransferHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &transferHistoryR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_history")
+	}
+
+	var resultSlice []*WithdrawalHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+	}
+
+	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameWithdrawalHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalHistoryR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalHistoryR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeNameBalanceSnapshot of the exchange to the related item.
+// Sets o.R.ExchangeNameBalanceSnapshot to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameBalanceSnapshot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *BalanceSnapshot) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"balance_snapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, balanceSnapshotPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameBalanceSnapshot: related,
+		}
+	} else {
+		o.R.ExchangeNameBalanceSnapshot = related
+	}
+
+	if related.R == nil {
+		related.R = &balanceSnapshotR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameCandle of the exchange to the related item.
+// Sets o.R.ExchangeNameCandle to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameCandle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Candle) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"candle\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, candlePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameCandle: related,
+		}
+	} else {
+		o.R.ExchangeNameCandle = related
+	}
+
+	if related.R == nil {
+		related.R = &candleR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameFill of the exchange to the related item.
+// Sets o.R.ExchangeNameFill to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameFill(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Fill) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"fill\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, fillPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFill: related,
+		}
+	} else {
+		o.R.ExchangeNameFill = related
+	}
+
+	if related.R == nil {
+		related.R = &fillR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameFundingRate of the exchange to the related item.
+// Sets o.R.ExchangeNameFundingRate to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameFundingRate(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FundingRate) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"funding_rate\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, fundingRatePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFundingRate: related,
+		}
+	} else {
+		o.R.ExchangeNameFundingRate = related
+	}
+
+	if related.R == nil {
+		related.R = &fundingRateR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameTrade of the exchange to the related item.
+// Sets o.R.ExchangeNameTrade to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameTrade(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Trade) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"trade\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, tradePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameTrade: related,
+		}
+	} else {
+		o.R.ExchangeNameTrade = related
+	}
+
+	if related.R == nil {
+		related.R = &tradeR{
 			ExchangeName: o,
 		}
 	} else {
@@ -797,6 +1533,112 @@ func (o *Exchange) SetExchangeNameTrade(ctx context.Context, exec boil.ContextEx
 	return nil
 }
 
+// AddExchangeNameLiquidations adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameLiquidations.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameLiquidations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Liquidation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"liquidation\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 0, liquidationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameLiquidations: related,
+		}
+	} else {
+		o.R.ExchangeNameLiquidations = append(o.R.ExchangeNameLiquidations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &liquidationR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameTransferHistories adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameTransferHistories.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameTransferHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TransferHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"transfer_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 0, transferHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameTransferHistories: related,
+		}
+	} else {
+		o.R.ExchangeNameTransferHistories = append(o.R.ExchangeNameTransferHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &transferHistoryR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameWithdrawalHistories adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameWithdrawalHistories.
@@ -0,0 +1,793 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLiquidations(t *testing.T) {
+	t.Parallel()
+
+	query := Liquidations()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLiquidationsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Liquidations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiquidationsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Liquidations().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Liquidations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiquidationsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LiquidationSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Liquidations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiquidationsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LiquidationExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Liquidation exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LiquidationExists to return true, but got false.")
+	}
+}
+
+func testLiquidationsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	liquidationFound, err := FindLiquidation(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if liquidationFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLiquidationsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Liquidations().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiquidationsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Liquidations().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLiquidationsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	liquidationOne := &Liquidation{}
+	liquidationTwo := &Liquidation{}
+	if err = randomize.Struct(seed, liquidationOne, liquidationDBTypes, false, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+	if err = randomize.Struct(seed, liquidationTwo, liquidationDBTypes, false, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = liquidationOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = liquidationTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Liquidations().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLiquidationsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	liquidationOne := &Liquidation{}
+	liquidationTwo := &Liquidation{}
+	if err = randomize.Struct(seed, liquidationOne, liquidationDBTypes, false, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+	if err = randomize.Struct(seed, liquidationTwo, liquidationDBTypes, false, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = liquidationOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = liquidationTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Liquidations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func liquidationBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func liquidationAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func liquidationAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func liquidationBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func liquidationAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func liquidationBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func liquidationAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func liquidationBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func liquidationAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Liquidation) error {
+	*o = Liquidation{}
+	return nil
+}
+
+func testLiquidationsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Liquidation{}
+	o := &Liquidation{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, liquidationDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Liquidation object: %s", err)
+	}
+
+	AddLiquidationHook(boil.BeforeInsertHook, liquidationBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	liquidationBeforeInsertHooks = []LiquidationHook{}
+
+	AddLiquidationHook(boil.AfterInsertHook, liquidationAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	liquidationAfterInsertHooks = []LiquidationHook{}
+
+	AddLiquidationHook(boil.AfterSelectHook, liquidationAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	liquidationAfterSelectHooks = []LiquidationHook{}
+
+	AddLiquidationHook(boil.BeforeUpdateHook, liquidationBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	liquidationBeforeUpdateHooks = []LiquidationHook{}
+
+	AddLiquidationHook(boil.AfterUpdateHook, liquidationAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	liquidationAfterUpdateHooks = []LiquidationHook{}
+
+	AddLiquidationHook(boil.BeforeDeleteHook, liquidationBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	liquidationBeforeDeleteHooks = []LiquidationHook{}
+
+	AddLiquidationHook(boil.AfterDeleteHook, liquidationAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	liquidationAfterDeleteHooks = []LiquidationHook{}
+
+	AddLiquidationHook(boil.BeforeUpsertHook, liquidationBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	liquidationBeforeUpsertHooks = []LiquidationHook{}
+
+	AddLiquidationHook(boil.AfterUpsertHook, liquidationAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	liquidationAfterUpsertHooks = []LiquidationHook{}
+}
+
+func testLiquidationsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Liquidations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLiquidationsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(liquidationColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Liquidations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLiquidationToOneExchangeUsingExchangeName(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Liquidation
+	var foreign Exchange
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, liquidationDBTypes, false, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, exchangeDBTypes, false, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ExchangeNameID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ExchangeName().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := LiquidationSlice{&local}
+	if err = local.L.LoadExchangeName(ctx, tx, false, (*[]*Liquidation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ExchangeName = nil
+	if err = local.L.LoadExchangeName(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testLiquidationToOneSetOpExchangeUsingExchangeName(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Liquidation
+	var b, c Exchange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, liquidationDBTypes, false, strmangle.SetComplement(liquidationPrimaryKeyColumns, liquidationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Exchange{&b, &c} {
+		err = a.SetExchangeName(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ExchangeName != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExchangeNameLiquidations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ExchangeNameID))
+		reflect.Indirect(reflect.ValueOf(&a.ExchangeNameID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID, x.ID)
+		}
+	}
+}
+
+func testLiquidationsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiquidationsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LiquidationSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiquidationsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Liquidations().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	liquidationDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeNameID`: `UUID`, `Base`: `TEXT`, `Quote`: `TEXT`, `Asset`: `TEXT`, `Side`: `TEXT`, `Price`: `REAL`, `Amount`: `REAL`, `Timestamp`: `TIMESTAMP`}
+	_                  = bytes.MinRead
+)
+
+func testLiquidationsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(liquidationPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(liquidationAllColumns) == len(liquidationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Liquidations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLiquidationsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(liquidationAllColumns) == len(liquidationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Liquidation{}
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Liquidations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, liquidationDBTypes, true, liquidationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Liquidation struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(liquidationAllColumns, liquidationPrimaryKeyColumns) {
+		fields = liquidationAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			liquidationAllColumns,
+			liquidationPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LiquidationSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
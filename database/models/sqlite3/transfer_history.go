@@ -0,0 +1,987 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// TransferHistory is an object representing the database table.
+type TransferHistory struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID string      `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	ExchangeID     string      `boil:"exchange_id" json:"exchange_id" toml:"exchange_id" yaml:"exchange_id"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Currency       string      `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Amount         float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	FromAccount    string      `boil:"from_account" json:"from_account" toml:"from_account" yaml:"from_account"`
+	ToAccount      string      `boil:"to_account" json:"to_account" toml:"to_account" yaml:"to_account"`
+	FromAsset      string      `boil:"from_asset" json:"from_asset" toml:"from_asset" yaml:"from_asset"`
+	ToAsset        string      `boil:"to_asset" json:"to_asset" toml:"to_asset" yaml:"to_asset"`
+	Description    null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	CreatedAt      string      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      string      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *transferHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L transferHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TransferHistoryColumns = struct {
+	ID             string
+	ExchangeNameID string
+	ExchangeID     string
+	Status         string
+	Currency       string
+	Amount         string
+	FromAccount    string
+	ToAccount      string
+	FromAsset      string
+	ToAsset        string
+	Description    string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	ExchangeNameID: "exchange_name_id",
+	ExchangeID:     "exchange_id",
+	Status:         "status",
+	Currency:       "currency",
+	Amount:         "amount",
+	FromAccount:    "from_account",
+	ToAccount:      "to_account",
+	FromAsset:      "from_asset",
+	ToAsset:        "to_asset",
+	Description:    "description",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+// Generated where
+
+var TransferHistoryWhere = struct {
+	ID             whereHelperstring
+	ExchangeNameID whereHelperstring
+	ExchangeID     whereHelperstring
+	Status         whereHelperstring
+	Currency       whereHelperstring
+	Amount         whereHelperfloat64
+	FromAccount    whereHelperstring
+	ToAccount      whereHelperstring
+	FromAsset      whereHelperstring
+	ToAsset        whereHelperstring
+	Description    whereHelpernull_String
+	CreatedAt      whereHelperstring
+	UpdatedAt      whereHelperstring
+}{
+	ID:             whereHelperstring{field: "\"transfer_history\".\"id\""},
+	ExchangeNameID: whereHelperstring{field: "\"transfer_history\".\"exchange_name_id\""},
+	ExchangeID:     whereHelperstring{field: "\"transfer_history\".\"exchange_id\""},
+	Status:         whereHelperstring{field: "\"transfer_history\".\"status\""},
+	Currency:       whereHelperstring{field: "\"transfer_history\".\"currency\""},
+	Amount:         whereHelperfloat64{field: "\"transfer_history\".\"amount\""},
+	FromAccount:    whereHelperstring{field: "\"transfer_history\".\"from_account\""},
+	ToAccount:      whereHelperstring{field: "\"transfer_history\".\"to_account\""},
+	FromAsset:      whereHelperstring{field: "\"transfer_history\".\"from_asset\""},
+	ToAsset:        whereHelperstring{field: "\"transfer_history\".\"to_asset\""},
+	Description:    whereHelpernull_String{field: "\"transfer_history\".\"description\""},
+	CreatedAt:      whereHelperstring{field: "\"transfer_history\".\"created_at\""},
+	UpdatedAt:      whereHelperstring{field: "\"transfer_history\".\"updated_at\""},
+}
+
+// TransferHistoryRels is where relationship names are stored.
+var TransferHistoryRels = struct {
+	ExchangeName string
+}{
+	ExchangeName: "ExchangeName",
+}
+
+// transferHistoryR is where relationships are stored.
+type transferHistoryR struct {
+	ExchangeName *Exchange
+}
+
+// NewStruct creates a new relationship struct
+func (*transferHistoryR) NewStruct() *transferHistoryR {
+	return &transferHistoryR{}
+}
+
+// transferHistoryL is where Load methods for each relationship are stored.
+type transferHistoryL struct{}
+
+var (
+	transferHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "from_account", "to_account", "from_asset", "to_asset", "description", "created_at", "updated_at"}
+	transferHistoryColumnsWithoutDefault = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "from_account", "to_account", "from_asset", "to_asset", "description"}
+	transferHistoryColumnsWithDefault    = []string{"created_at", "updated_at"}
+	transferHistoryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// TransferHistorySlice is an alias for a slice of pointers to TransferHistory.
+	// This should generally be used opposed to []TransferHistory.
+	TransferHistorySlice []*TransferHistory
+	// TransferHistoryHook is the signature for custom TransferHistory hook methods
+	TransferHistoryHook func(context.Context, boil.ContextExecutor, *TransferHistory) error
+
+	transferHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	transferHistoryType                 = reflect.TypeOf(&TransferHistory{})
+	transferHistoryMapping              = queries.MakeStructMapping(transferHistoryType)
+	transferHistoryPrimaryKeyMapping, _ = queries.BindMapping(transferHistoryType, transferHistoryMapping, transferHistoryPrimaryKeyColumns)
+	transferHistoryInsertCacheMut       sync.RWMutex
+	transferHistoryInsertCache          = make(map[string]insertCache)
+	transferHistoryUpdateCacheMut       sync.RWMutex
+	transferHistoryUpdateCache          = make(map[string]updateCache)
+	transferHistoryUpsertCacheMut       sync.RWMutex
+	transferHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var transferHistoryBeforeInsertHooks []TransferHistoryHook
+var transferHistoryBeforeUpdateHooks []TransferHistoryHook
+var transferHistoryBeforeDeleteHooks []TransferHistoryHook
+var transferHistoryBeforeUpsertHooks []TransferHistoryHook
+
+var transferHistoryAfterInsertHooks []TransferHistoryHook
+var transferHistoryAfterSelectHooks []TransferHistoryHook
+var transferHistoryAfterUpdateHooks []TransferHistoryHook
+var transferHistoryAfterDeleteHooks []TransferHistoryHook
+var transferHistoryAfterUpsertHooks []TransferHistoryHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TransferHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TransferHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TransferHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TransferHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TransferHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TransferHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TransferHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TransferHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TransferHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range transferHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTransferHistoryHook registers your hook function for all future operations.
+func AddTransferHistoryHook(hookPoint boil.HookPoint, transferHistoryHook TransferHistoryHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		transferHistoryBeforeInsertHooks = append(transferHistoryBeforeInsertHooks, transferHistoryHook)
+	case boil.BeforeUpdateHook:
+		transferHistoryBeforeUpdateHooks = append(transferHistoryBeforeUpdateHooks, transferHistoryHook)
+	case boil.BeforeDeleteHook:
+		transferHistoryBeforeDeleteHooks = append(transferHistoryBeforeDeleteHooks, transferHistoryHook)
+	case boil.BeforeUpsertHook:
+		transferHistoryBeforeUpsertHooks = append(transferHistoryBeforeUpsertHooks, transferHistoryHook)
+	case boil.AfterInsertHook:
+		transferHistoryAfterInsertHooks = append(transferHistoryAfterInsertHooks, transferHistoryHook)
+	case boil.AfterSelectHook:
+		transferHistoryAfterSelectHooks = append(transferHistoryAfterSelectHooks, transferHistoryHook)
+	case boil.AfterUpdateHook:
+		transferHistoryAfterUpdateHooks = append(transferHistoryAfterUpdateHooks, transferHistoryHook)
+	case boil.AfterDeleteHook:
+		transferHistoryAfterDeleteHooks = append(transferHistoryAfterDeleteHooks, transferHistoryHook)
+	case boil.AfterUpsertHook:
+		transferHistoryAfterUpsertHooks = append(transferHistoryAfterUpsertHooks, transferHistoryHook)
+	}
+}
+
+// One returns a single transferHistory record from the query.
+func (q transferHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TransferHistory, error) {
+	o := &TransferHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for transfer_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TransferHistory records from the query.
+func (q transferHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (TransferHistorySlice, error) {
+	var o []*TransferHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to TransferHistory slice")
+	}
+
+	if len(transferHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TransferHistory records in the query.
+func (q transferHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count transfer_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q transferHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if transfer_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeName pointed to by the foreign key.
+func (o *TransferHistory) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ExchangeNameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Exchanges(queryMods...)
+	queries.SetFrom(query.Query, "\"exchange\"")
+
+	return query
+}
+
+// LoadExchangeName allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (transferHistoryL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTransferHistory interface{}, mods queries.Applicator) error {
+	var slice []*TransferHistory
+	var object *TransferHistory
+
+	if singular {
+		object = maybeTransferHistory.(*TransferHistory)
+	} else {
+		slice = *maybeTransferHistory.(*[]*TransferHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &transferHistoryR{}
+		}
+		args = append(args, object.ExchangeNameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &transferHistoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ExchangeNameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ExchangeNameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`exchange`), qm.WhereIn(`exchange.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Exchange")
+	}
+
+	var resultSlice []*Exchange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Exchange")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange")
+	}
+
+	if len(transferHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeName = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeR{}
+		}
+		foreign.R.ExchangeNameTransferHistories = append(foreign.R.ExchangeNameTransferHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ExchangeNameID == foreign.ID {
+				local.R.ExchangeName = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeR{}
+				}
+				foreign.R.ExchangeNameTransferHistories = append(foreign.R.ExchangeNameTransferHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeName of the transferHistory to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNameTransferHistories.
+func (o *TransferHistory) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"transfer_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+		strmangle.WhereClause("\"", "\"", 0, transferHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &transferHistoryR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNameTransferHistories: TransferHistorySlice{o},
+		}
+	} else {
+		related.R.ExchangeNameTransferHistories = append(related.R.ExchangeNameTransferHistories, o)
+	}
+
+	return nil
+}
+
+// TransferHistories retrieves all the records using an executor.
+func TransferHistories(mods ...qm.QueryMod) transferHistoryQuery {
+	mods = append(mods, qm.From("\"transfer_history\""))
+	return transferHistoryQuery{NewQuery(mods...)}
+}
+
+// FindTransferHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTransferHistory(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*TransferHistory, error) {
+	transferHistoryObj := &TransferHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"transfer_history\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, transferHistoryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from transfer_history")
+	}
+
+	return transferHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TransferHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no transfer_history provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(transferHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	transferHistoryInsertCacheMut.RLock()
+	cache, cached := transferHistoryInsertCache[key]
+	transferHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			transferHistoryAllColumns,
+			transferHistoryColumnsWithDefault,
+			transferHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(transferHistoryType, transferHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(transferHistoryType, transferHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"transfer_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"transfer_history\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"transfer_history\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, transferHistoryPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into transfer_history")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for transfer_history")
+	}
+
+CacheNoHooks:
+	if !cached {
+		transferHistoryInsertCacheMut.Lock()
+		transferHistoryInsertCache[key] = cache
+		transferHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TransferHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TransferHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	transferHistoryUpdateCacheMut.RLock()
+	cache, cached := transferHistoryUpdateCache[key]
+	transferHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			transferHistoryAllColumns,
+			transferHistoryPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update transfer_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"transfer_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, transferHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(transferHistoryType, transferHistoryMapping, append(wl, transferHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update transfer_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for transfer_history")
+	}
+
+	if !cached {
+		transferHistoryUpdateCacheMut.Lock()
+		transferHistoryUpdateCache[key] = cache
+		transferHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q transferHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for transfer_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for transfer_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TransferHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"transfer_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, transferHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in transferHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all transferHistory")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single TransferHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TransferHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no TransferHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), transferHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"transfer_history\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from transfer_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for transfer_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q transferHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no transferHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from transfer_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for transfer_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TransferHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(transferHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"transfer_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, transferHistoryPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from transferHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for transfer_history")
+	}
+
+	if len(transferHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TransferHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTransferHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TransferHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TransferHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), transferHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"transfer_history\".* FROM \"transfer_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, transferHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in TransferHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TransferHistoryExists checks if the TransferHistory row exists.
+func TransferHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"transfer_history\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if transfer_history exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,232 @@
+package balance
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves balance snapshots to the database, snapshots which already
+// exist for the same exchange, account, asset, currency and timestamp are
+// ignored
+func Insert(snapshots ...Snapshot) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	for i := range snapshots {
+		if snapshots[i].ExchangeNameID == "" && snapshots[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(snapshots[i].Exchange)
+			if err != nil {
+				return err
+			}
+			snapshots[i].ExchangeNameID = exchangeUUID.String()
+		} else if snapshots[i].ExchangeNameID == "" {
+			return errExchangeNameRequired
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, snapshots...)
+	} else {
+		err = insertPostgres(ctx, tx, snapshots...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	for i := range snapshots {
+		if snapshots[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			snapshots[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelSQLite.BalanceSnapshot{
+			ID:                snapshots[i].ID,
+			ExchangeNameID:    snapshots[i].ExchangeNameID,
+			AccountID:         snapshots[i].AccountID,
+			Asset:             strings.ToLower(snapshots[i].Asset),
+			Currency:          strings.ToUpper(snapshots[i].Currency),
+			Total:             snapshots[i].Total,
+			Hold:              snapshots[i].Hold,
+			ReportingCurrency: strings.ToUpper(snapshots[i].ReportingCurrency),
+			Timestamp:         snapshots[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		if snapshots[i].Valued {
+			tempEvent.ReportingValue.SetValid(snapshots[i].ReportingValue)
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	for i := range snapshots {
+		if snapshots[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			snapshots[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelPSQL.BalanceSnapshot{
+			ID:                snapshots[i].ID,
+			ExchangeNameID:    snapshots[i].ExchangeNameID,
+			AccountID:         snapshots[i].AccountID,
+			Asset:             strings.ToLower(snapshots[i].Asset),
+			Currency:          strings.ToUpper(snapshots[i].Currency),
+			Total:             snapshots[i].Total,
+			Hold:              snapshots[i].Hold,
+			ReportingCurrency: strings.ToUpper(snapshots[i].ReportingCurrency),
+			Timestamp:         snapshots[i].Timestamp.UTC(),
+		}
+		if snapshots[i].Valued {
+			tempEvent.ReportingValue.SetValid(snapshots[i].ReportingValue)
+		}
+		err := tempEvent.Upsert(ctx, tx, false, uniqueColumns, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetInRange returns all balance snapshots matching the filter in ascending
+// timestamp order
+func GetInRange(f *Filter) (snapshots []Snapshot, err error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if !f.Start.Before(f.End) {
+		return nil, errInvalidTimeRange
+	}
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", f.Start.UTC().Format(time.RFC3339), f.End.UTC().Format(time.RFC3339)),
+		qm.Load("ExchangeName"),
+		qm.OrderBy("timestamp asc, currency asc"),
+	}
+	if f.Exchange != "" {
+		var exchangeUUID uuid.UUID
+		exchangeUUID, err = exchange.UUIDByName(f.Exchange)
+		if err != nil {
+			return nil, err
+		}
+		query = append(query, qm.Where("exchange_name_id = ?", exchangeUUID.String()))
+	}
+	if f.AccountID != "" {
+		query = append(query, qm.Where("account_id = ?", f.AccountID))
+	}
+	if f.Asset != "" {
+		query = append(query, qm.Where("asset = ?", strings.ToLower(f.Asset)))
+	}
+	if f.Currency != "" {
+		query = append(query, qm.Where("currency = ?", strings.ToUpper(f.Currency)))
+	}
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		snapshots, err = getInRangeSQLite(query)
+		if err != nil {
+			return nil, fmt.Errorf("balance.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		snapshots, err = getInRangePostgres(query)
+		if err != nil {
+			return nil, fmt.Errorf("balance.GetInRange getInRangePostgres %w", err)
+		}
+	}
+	return snapshots, nil
+}
+
+func getInRangeSQLite(query []qm.QueryMod) ([]Snapshot, error) {
+	result, err := modelSQLite.BalanceSnapshots(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Snapshot, len(result))
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[i] = Snapshot{
+			ID:                result[i].ID,
+			ExchangeNameID:    result[i].ExchangeNameID,
+			AccountID:         result[i].AccountID,
+			Asset:             result[i].Asset,
+			Currency:          result[i].Currency,
+			Total:             result[i].Total,
+			Hold:              result[i].Hold,
+			ReportingCurrency: result[i].ReportingCurrency,
+			ReportingValue:    result[i].ReportingValue.Float64,
+			Valued:            result[i].ReportingValue.Valid,
+			Timestamp:         ts,
+		}
+		if result[i].R != nil && result[i].R.ExchangeName != nil {
+			snapshots[i].Exchange = result[i].R.ExchangeName.Name
+		}
+	}
+	return snapshots, nil
+}
+
+func getInRangePostgres(query []qm.QueryMod) ([]Snapshot, error) {
+	result, err := modelPSQL.BalanceSnapshots(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Snapshot, len(result))
+	for i := range result {
+		snapshots[i] = Snapshot{
+			ID:                result[i].ID,
+			ExchangeNameID:    result[i].ExchangeNameID,
+			AccountID:         result[i].AccountID,
+			Asset:             result[i].Asset,
+			Currency:          result[i].Currency,
+			Total:             result[i].Total,
+			Hold:              result[i].Hold,
+			ReportingCurrency: result[i].ReportingCurrency,
+			ReportingValue:    result[i].ReportingValue.Float64,
+			Valued:            result[i].ReportingValue.Valid,
+			Timestamp:         result[i].Timestamp,
+		}
+		if result[i].R != nil && result[i].R.ExchangeName != nil {
+			snapshots[i].Exchange = result[i].R.ExchangeName.Name
+		}
+	}
+	return snapshots, nil
+}
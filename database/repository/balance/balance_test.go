@@ -0,0 +1,178 @@
+package balance
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose   = false
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestSnapshots(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = exchange.InsertMany([]exchange.Details{{Name: "one"}, {Name: "two"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			exchange.ResetExchangeCache()
+
+			snapshotTester(t)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func snapshotTester(t *testing.T) {
+	err := Insert(Snapshot{Currency: "BTC", Timestamp: testStart})
+	if !errors.Is(err, errExchangeNameRequired) {
+		t.Errorf("expected %v, received %v", errExchangeNameRequired, err)
+	}
+
+	var snapshots []Snapshot
+	for i := 0; i < 10; i++ {
+		ts := testStart.Add(time.Hour * time.Duration(i))
+		snapshots = append(snapshots,
+			Snapshot{
+				Exchange:          "one",
+				AccountID:         "main",
+				Asset:             "spot",
+				Currency:          "btc",
+				Total:             1,
+				Hold:              0.5,
+				ReportingCurrency: "usd",
+				ReportingValue:    10000,
+				Valued:            true,
+				Timestamp:         ts,
+			},
+			Snapshot{
+				Exchange:          "two",
+				AccountID:         "main",
+				Asset:             "spot",
+				Currency:          "DOGE",
+				Total:             100,
+				ReportingCurrency: "USD",
+				Timestamp:         ts,
+			})
+	}
+	err = Insert(snapshots...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// duplicates are ignored
+	for i := range snapshots {
+		snapshots[i].ID = ""
+	}
+	err = Insert(snapshots...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetInRange(&Filter{Start: testStart, End: testStart})
+	if !errors.Is(err, errInvalidTimeRange) {
+		t.Errorf("expected %v, received %v", errInvalidTimeRange, err)
+	}
+
+	resp, err := GetInRange(&Filter{Start: testStart, End: testStart.Add(time.Hour * 24)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 20 {
+		t.Fatalf("expected 20 snapshots, received %v", len(resp))
+	}
+
+	resp, err = GetInRange(&Filter{
+		Exchange: "one",
+		Currency: "BTC",
+		Start:    testStart,
+		End:      testStart.Add(time.Hour * 4),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 5 {
+		t.Fatalf("expected 5 snapshots, received %v", len(resp))
+	}
+	if resp[0].Exchange != "one" ||
+		resp[0].Currency != "BTC" ||
+		resp[0].ReportingCurrency != "USD" ||
+		!resp[0].Valued ||
+		resp[0].ReportingValue != 10000 ||
+		!resp[0].Timestamp.Equal(testStart) {
+		t.Errorf("unexpected snapshot %+v", resp[0])
+	}
+
+	resp, err = GetInRange(&Filter{
+		Exchange: "two",
+		Start:    testStart,
+		End:      testStart.Add(time.Hour * 24),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 10 {
+		t.Fatalf("expected 10 snapshots, received %v", len(resp))
+	}
+	if resp[0].Valued {
+		t.Error("expected snapshot without a conversion rate to be unvalued")
+	}
+}
@@ -0,0 +1,44 @@
+package balance
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// uniqueColumns matches the uniquebalancesnapshot constraint so repeated
+	// postgres inserts are ignored in the same way as sqlite
+	uniqueColumns = []string{"exchange_name_id", "account_id", "asset", "currency", "timestamp"}
+
+	errExchangeNameRequired = errors.New("exchange name/uuid not set, cannot insert")
+	errInvalidTimeRange     = errors.New("start date must be before end date")
+)
+
+// Snapshot defines a single currency balance held on an exchange account at
+// a point in time in its simplest db friendly form
+type Snapshot struct {
+	ID                string
+	Exchange          string
+	ExchangeNameID    string
+	AccountID         string
+	Asset             string
+	Currency          string
+	Total             float64
+	Hold              float64
+	ReportingCurrency string
+	ReportingValue    float64
+	// Valued is false when no conversion rate was available to value the
+	// balance in the reporting currency
+	Valued    bool
+	Timestamp time.Time
+}
+
+// Filter narrows a snapshot query, empty fields match everything
+type Filter struct {
+	Exchange  string
+	AccountID string
+	Asset     string
+	Currency  string
+	Start     time.Time
+	End       time.Time
+}
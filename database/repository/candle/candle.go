@@ -41,18 +41,107 @@ func Series(exchangeName, base, quote string, interval int64, asset string, star
 		return out, errS
 	}
 	queries = append(queries, qm.Where("exchange_name_id = ?", exchangeUUID.String()))
+	out.Candles, err = getCandles(queries, start, end)
+	if err != nil {
+		return out, err
+	}
+	if len(out.Candles) < 1 {
+		return out, fmt.Errorf(errNoCandleDataFound, exchangeName, base, quote, interval, asset)
+	}
+
+	out.ExchangeID = exchangeName
+	out.Interval = interval
+	out.Base = base
+	out.Quote = quote
+	out.Asset = asset
+	return out, err
+}
+
+// StreamSeries loads candle data in ascending timestamp order in batches of
+// batchSize and passes each batch to fn, so large date ranges do not need to
+// be held in memory
+func StreamSeries(exchangeName, base, quote string, interval int64, asset string, start, end time.Time, batchSize int, fn func(*Item) error) error {
+	return streamSeries(exchangeName, base, quote, interval, asset, start, end, batchSize, fn)
+}
+
+// StreamSeriesBefore loads candle data with a timestamp before the supplied
+// time in ascending timestamp order in batches of batchSize and passes each
+// batch to fn. The bound is exclusive and matches DeleteBefore, so a stream
+// followed by a delete covers exactly the same candles
+func StreamSeriesBefore(exchangeName, base, quote string, interval int64, asset string, before time.Time, batchSize int, fn func(*Item) error) error {
+	bound := qm.Where("timestamp < ?", before.UTC())
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		bound = qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339))
+	}
+	return streamSeries(exchangeName, base, quote, interval, asset, time.Unix(0, 0), before, batchSize, fn, bound)
+}
+
+func streamSeries(exchangeName, base, quote string, interval int64, asset string, start, end time.Time, batchSize int, fn func(*Item) error, extra ...qm.QueryMod) error {
+	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
+		return errInvalidInput
+	}
+	if batchSize <= 0 {
+		return errInvalidBatchSize
+	}
+	if fn == nil {
+		return errNilBatchHandler
+	}
+
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return err
+	}
+	queries := []qm.QueryMod{
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("interval = ?", interval),
+		qm.Where("asset = ?", strings.ToLower(asset)),
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.OrderBy("timestamp asc"),
+		qm.Limit(batchSize),
+	}
+	queries = append(queries, extra...)
+	for offset := 0; ; offset += batchSize {
+		batch := &Item{
+			ExchangeID: exchangeName,
+			Base:       base,
+			Quote:      quote,
+			Interval:   interval,
+			Asset:      asset,
+		}
+		batch.Candles, err = getCandles(append(queries[:len(queries):len(queries)], qm.Offset(offset)), start, end)
+		if err != nil {
+			return err
+		}
+		if len(batch.Candles) == 0 {
+			return nil
+		}
+		err = fn(batch)
+		if err != nil {
+			return err
+		}
+		if len(batch.Candles) < batchSize {
+			return nil
+		}
+	}
+}
+
+// getCandles applies the date range to the supplied queries for the current
+// SQL dialect and returns matching candles
+func getCandles(queries []qm.QueryMod, start, end time.Time) ([]Candle, error) {
+	var candles []Candle
 	if repository.GetSQLDialect() == database.DBSQLite3 {
 		queries = append(queries, qm.Where("timestamp between ? and ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
-		retCandle, errC := modelSQLite.Candles(queries...).All(context.Background(), database.DB.SQL)
-		if errC != nil {
-			return out, errC
+		retCandle, err := modelSQLite.Candles(queries...).All(context.Background(), database.DB.SQL)
+		if err != nil {
+			return nil, err
 		}
 		for x := range retCandle {
-			t, errT := time.Parse(time.RFC3339, retCandle[x].Timestamp)
-			if errT != nil {
-				return out, errT
+			t, err := time.Parse(time.RFC3339, retCandle[x].Timestamp)
+			if err != nil {
+				return nil, err
 			}
-			out.Candles = append(out.Candles, Candle{
+			candles = append(candles, Candle{
 				Timestamp: t,
 				Open:      retCandle[x].Open,
 				High:      retCandle[x].High,
@@ -61,34 +150,25 @@ func Series(exchangeName, base, quote string, interval int64, asset string, star
 				Volume:    retCandle[x].Volume,
 			})
 		}
-	} else {
-		queries = append(queries, qm.Where("timestamp between ? and ?", start.UTC(), end.UTC()))
-		retCandle, errC := modelPSQL.Candles(queries...).All(context.Background(), database.DB.SQL)
-		if errC != nil {
-			return out, errC
-		}
+		return candles, nil
+	}
 
-		for x := range retCandle {
-			out.Candles = append(out.Candles, Candle{
-				Timestamp: retCandle[x].Timestamp,
-				Open:      retCandle[x].Open,
-				High:      retCandle[x].High,
-				Low:       retCandle[x].Low,
-				Close:     retCandle[x].Close,
-				Volume:    retCandle[x].Volume,
-			})
-		}
+	queries = append(queries, qm.Where("timestamp between ? and ?", start.UTC(), end.UTC()))
+	retCandle, err := modelPSQL.Candles(queries...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
 	}
-	if len(out.Candles) < 1 {
-		return out, fmt.Errorf(errNoCandleDataFound, exchangeName, base, quote, interval, asset)
+	for x := range retCandle {
+		candles = append(candles, Candle{
+			Timestamp: retCandle[x].Timestamp,
+			Open:      retCandle[x].Open,
+			High:      retCandle[x].High,
+			Low:       retCandle[x].Low,
+			Close:     retCandle[x].Close,
+			Volume:    retCandle[x].Volume,
+		})
 	}
-
-	out.ExchangeID = exchangeName
-	out.Interval = interval
-	out.Base = base
-	out.Quote = quote
-	out.Asset = asset
-	return out, err
+	return candles, nil
 }
 
 // DeleteCandles will delete all existing matching candles
@@ -171,6 +251,104 @@ func deletePostgres(ctx context.Context, queries []qm.QueryMod) (int64, error) {
 	return totalDeleted, nil
 }
 
+// DeleteBefore deletes all candles with a timestamp before the supplied time
+// in a single statement, empty filters match everything
+func DeleteBefore(exchangeName, base, quote, asset string, interval int64, before time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	queries, err := olderThanQuery(exchangeName, base, quote, asset, interval)
+	if err != nil {
+		return 0, err
+	}
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		queries = append(queries, qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339)))
+		return modelSQLite.Candles(queries...).DeleteAll(ctx, database.DB.SQL)
+	}
+	queries = append(queries, qm.Where("timestamp < ?", before.UTC()))
+	return modelPSQL.Candles(queries...).DeleteAll(ctx, database.DB.SQL)
+}
+
+// SeriesBefore returns each distinct exchange, pair, asset and interval which
+// has candles with a timestamp before the supplied time, empty filters match
+// everything. The returned items hold the exchange name and no candles
+func SeriesBefore(exchangeName string, interval int64, before time.Time) ([]Item, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	queries, err := olderThanQuery(exchangeName, "", "", "", interval)
+	if err != nil {
+		return nil, err
+	}
+	queries = append(queries,
+		qm.Select("distinct exchange_name_id, base, quote, asset, interval"),
+		qm.Load("ExchangeName"))
+
+	var out []Item
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		queries = append(queries, qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339)))
+		retCandle, err := modelSQLite.Candles(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retCandle {
+			i, err := strconv.ParseInt(retCandle[x].Interval, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, Item{
+				ExchangeID: retCandle[x].R.ExchangeName.Name,
+				Base:       retCandle[x].Base,
+				Quote:      retCandle[x].Quote,
+				Interval:   i,
+				Asset:      retCandle[x].Asset,
+			})
+		}
+		return out, nil
+	}
+	queries = append(queries, qm.Where("timestamp < ?", before.UTC()))
+	retCandle, err := modelPSQL.Candles(queries...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retCandle {
+		out = append(out, Item{
+			ExchangeID: retCandle[x].R.ExchangeName.Name,
+			Base:       retCandle[x].Base,
+			Quote:      retCandle[x].Quote,
+			Interval:   retCandle[x].Interval,
+			Asset:      retCandle[x].Asset,
+		})
+	}
+	return out, nil
+}
+
+func olderThanQuery(exchangeName, base, quote, asset string, interval int64) ([]qm.QueryMod, error) {
+	var queries []qm.QueryMod
+	if exchangeName != "" {
+		exchangeUUID, err := exchange.UUIDByName(exchangeName)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, qm.Where("exchange_name_id = ?", exchangeUUID.String()))
+	}
+	if base != "" {
+		queries = append(queries, qm.Where("base = ?", strings.ToUpper(base)))
+	}
+	if quote != "" {
+		queries = append(queries, qm.Where("quote = ?", strings.ToUpper(quote)))
+	}
+	if asset != "" {
+		queries = append(queries, qm.Where("asset = ?", strings.ToLower(asset)))
+	}
+	if interval > 0 {
+		queries = append(queries, qm.Where("interval = ?", interval))
+	}
+	return queries, nil
+}
+
 // Insert series of candles
 func Insert(in *Item) (uint64, error) {
 	if database.DB.SQL == nil {
@@ -241,6 +241,39 @@ func TestSeries(t *testing.T) {
 				t.Errorf("unexpected number of results received:  %v", len(ret.Candles))
 			}
 
+			var streamed, batches int
+			var last time.Time
+			err = StreamSeries(testExchanges[0].Name,
+				"BTC", "USDT",
+				86400, "spot",
+				start, end, 100,
+				func(i *Item) error {
+					for j := range i.Candles {
+						if !i.Candles[j].Timestamp.After(last) {
+							return fmt.Errorf("candle %v received out of order", i.Candles[j].Timestamp)
+						}
+						last = i.Candles[j].Timestamp
+					}
+					streamed += len(i.Candles)
+					batches++
+					return nil
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if streamed != 365 || batches != 4 {
+				t.Errorf("expected 365 candles in 4 batches, received %v candles in %v batches", streamed, batches)
+			}
+
+			err = StreamSeries(testExchanges[0].Name,
+				"BTC", "USDT",
+				86400, "spot",
+				start, end, 0,
+				func(*Item) error { return nil })
+			if !errors.Is(err, errInvalidBatchSize) {
+				t.Errorf("expected %v, received %v", errInvalidBatchSize, err)
+			}
+
 			ret, err = Series("", "", "", 0, "", start, end)
 			if err != nil {
 				if !errors.Is(err, errInvalidInput) {
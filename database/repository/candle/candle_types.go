@@ -12,6 +12,9 @@ const (
 var (
 	errInvalidInput = errors.New("exchange, base, quote, asset, interval, start & end cannot be empty")
 	errNoCandleData = errors.New("no candle data provided")
+
+	errInvalidBatchSize = errors.New("batch size must be greater than zero")
+	errNilBatchHandler  = errors.New("batch handler function cannot be nil")
 )
 
 // Item generic candle holder for modelPSQL & modelSQLite
@@ -0,0 +1,291 @@
+package fill
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves fills to the database, fills which already exist for the same
+// exchange, pair, asset and trade ID are ignored so order history can be
+// imported repeatedly
+func Insert(fills ...Data) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	for i := range fills {
+		if fills[i].TID == "" {
+			return errTIDRequired
+		}
+		if fills[i].ExchangeNameID == "" && fills[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(fills[i].Exchange)
+			if err != nil {
+				return err
+			}
+			fills[i].ExchangeNameID = exchangeUUID.String()
+		} else if fills[i].ExchangeNameID == "" {
+			return errExchangeNameRequired
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, fills...)
+	} else {
+		err = insertPostgres(ctx, tx, fills...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, fills ...Data) error {
+	for i := range fills {
+		if fills[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			fills[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelSQLite.Fill{
+			ID:             fills[i].ID,
+			ExchangeNameID: fills[i].ExchangeNameID,
+			OrderID:        fills[i].OrderID,
+			Tid:            fills[i].TID,
+			Base:           strings.ToUpper(fills[i].Base),
+			Quote:          strings.ToUpper(fills[i].Quote),
+			Asset:          strings.ToLower(fills[i].AssetType),
+			Side:           strings.ToUpper(fills[i].Side),
+			Price:          fills[i].Price,
+			Amount:         fills[i].Amount,
+			Fee:            fills[i].Fee,
+			FeeAsset:       strings.ToUpper(fills[i].FeeAsset),
+			Strategy:       fills[i].Strategy,
+			Timestamp:      fills[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, fills ...Data) error {
+	for i := range fills {
+		if fills[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			fills[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelPSQL.Fill{
+			ID:             fills[i].ID,
+			ExchangeNameID: fills[i].ExchangeNameID,
+			OrderID:        fills[i].OrderID,
+			Tid:            fills[i].TID,
+			Base:           strings.ToUpper(fills[i].Base),
+			Quote:          strings.ToUpper(fills[i].Quote),
+			Asset:          strings.ToLower(fills[i].AssetType),
+			Side:           strings.ToUpper(fills[i].Side),
+			Price:          fills[i].Price,
+			Amount:         fills[i].Amount,
+			Fee:            fills[i].Fee,
+			FeeAsset:       strings.ToUpper(fills[i].FeeAsset),
+			Strategy:       fills[i].Strategy,
+			Timestamp:      fills[i].Timestamp.UTC(),
+		}
+		err := tempEvent.Upsert(ctx, tx, false, uniqueColumns, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetInRange returns all fills matching the filter in ascending timestamp
+// order
+func GetInRange(f *Filter) ([]Data, error) {
+	query, err := generateQuery(f)
+	if err != nil {
+		return nil, err
+	}
+	return getInRange(query)
+}
+
+// StreamInRange loads fills matching the filter in ascending timestamp order
+// in batches of batchSize and passes each batch to fn, so large date ranges
+// do not need to be held in memory
+func StreamInRange(f *Filter, batchSize int, fn func([]Data) error) error {
+	if batchSize <= 0 {
+		return errInvalidBatchSize
+	}
+	if fn == nil {
+		return errNilBatchHandler
+	}
+	query, err := generateQuery(f)
+	if err != nil {
+		return err
+	}
+	for offset := 0; ; offset += batchSize {
+		var fills []Data
+		fills, err = getInRange(append(query, qm.Limit(batchSize), qm.Offset(offset)))
+		if err != nil {
+			return err
+		}
+		if len(fills) == 0 {
+			return nil
+		}
+		err = fn(fills)
+		if err != nil {
+			return err
+		}
+		if len(fills) < batchSize {
+			return nil
+		}
+	}
+}
+
+func generateQuery(f *Filter) ([]qm.QueryMod, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if !f.Start.Before(f.End) {
+		return nil, errInvalidTimeRange
+	}
+	query := []qm.QueryMod{
+		qm.Where("timestamp BETWEEN ? AND ?", f.Start.UTC().Format(time.RFC3339), f.End.UTC().Format(time.RFC3339)),
+		qm.Load("ExchangeName"),
+		qm.OrderBy("timestamp asc, tid asc"),
+	}
+	if f.Exchange != "" {
+		exchangeUUID, err := exchange.UUIDByName(f.Exchange)
+		if err != nil {
+			return nil, err
+		}
+		query = append(query, qm.Where("exchange_name_id = ?", exchangeUUID.String()))
+	}
+	if f.Base != "" {
+		query = append(query, qm.Where("base = ?", strings.ToUpper(f.Base)))
+	}
+	if f.Quote != "" {
+		query = append(query, qm.Where("quote = ?", strings.ToUpper(f.Quote)))
+	}
+	if f.AssetType != "" {
+		query = append(query, qm.Where("asset = ?", strings.ToLower(f.AssetType)))
+	}
+	if f.Strategy != "" {
+		query = append(query, qm.Where("strategy = ?", f.Strategy))
+	}
+	return query, nil
+}
+
+func getInRange(query []qm.QueryMod) (fills []Data, err error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		fills, err = getInRangeSQLite(query)
+		if err != nil {
+			return nil, fmt.Errorf("fill.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		fills, err = getInRangePostgres(query)
+		if err != nil {
+			return nil, fmt.Errorf("fill.GetInRange getInRangePostgres %w", err)
+		}
+	}
+	return fills, nil
+}
+
+func getInRangeSQLite(query []qm.QueryMod) ([]Data, error) {
+	result, err := modelSQLite.Fills(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	fills := make([]Data, len(result))
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		fills[i] = Data{
+			ID:             result[i].ID,
+			ExchangeNameID: result[i].ExchangeNameID,
+			OrderID:        result[i].OrderID,
+			TID:            result[i].Tid,
+			Base:           result[i].Base,
+			Quote:          result[i].Quote,
+			AssetType:      result[i].Asset,
+			Side:           result[i].Side,
+			Price:          result[i].Price,
+			Amount:         result[i].Amount,
+			Fee:            result[i].Fee,
+			FeeAsset:       result[i].FeeAsset,
+			Strategy:       result[i].Strategy,
+			Timestamp:      ts,
+		}
+		if result[i].R != nil && result[i].R.ExchangeName != nil {
+			fills[i].Exchange = result[i].R.ExchangeName.Name
+		}
+	}
+	return fills, nil
+}
+
+func getInRangePostgres(query []qm.QueryMod) ([]Data, error) {
+	result, err := modelPSQL.Fills(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	fills := make([]Data, len(result))
+	for i := range result {
+		fills[i] = Data{
+			ID:             result[i].ID,
+			ExchangeNameID: result[i].ExchangeNameID,
+			OrderID:        result[i].OrderID,
+			TID:            result[i].Tid,
+			Base:           result[i].Base,
+			Quote:          result[i].Quote,
+			AssetType:      result[i].Asset,
+			Side:           result[i].Side,
+			Price:          result[i].Price,
+			Amount:         result[i].Amount,
+			Fee:            result[i].Fee,
+			FeeAsset:       result[i].FeeAsset,
+			Strategy:       result[i].Strategy,
+			Timestamp:      result[i].Timestamp,
+		}
+		if result[i].R != nil && result[i].R.ExchangeName != nil {
+			fills[i].Exchange = result[i].R.ExchangeName.Name
+		}
+	}
+	return fills, nil
+}
@@ -0,0 +1,195 @@
+package fill
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose   = false
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestFills(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = exchange.InsertMany([]exchange.Details{{Name: "one"}, {Name: "two"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			exchange.ResetExchangeCache()
+
+			fillTester(t)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func fillTester(t *testing.T) {
+	err := Insert(Data{Exchange: "one", Timestamp: testStart})
+	if !errors.Is(err, errTIDRequired) {
+		t.Errorf("expected %v, received %v", errTIDRequired, err)
+	}
+	err = Insert(Data{TID: "1", Timestamp: testStart})
+	if !errors.Is(err, errExchangeNameRequired) {
+		t.Errorf("expected %v, received %v", errExchangeNameRequired, err)
+	}
+
+	var fills []Data
+	for i := 0; i < 10; i++ {
+		strategy := "alpha"
+		if i%2 == 0 {
+			strategy = "beta"
+		}
+		fills = append(fills, Data{
+			Exchange:  "one",
+			OrderID:   "order",
+			TID:       strconv.Itoa(i),
+			Base:      "btc",
+			Quote:     "usd",
+			AssetType: "SPOT",
+			Side:      "buy",
+			Price:     float64(1000 + i),
+			Amount:    1,
+			Fee:       0.5,
+			FeeAsset:  "usd",
+			Strategy:  strategy,
+			Timestamp: testStart.Add(time.Minute * time.Duration(i)),
+		})
+	}
+	err = Insert(fills...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// fills are deduplicated by trade ID
+	for i := range fills {
+		fills[i].ID = ""
+	}
+	err = Insert(fills...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// trade IDs are only unique per pair so a matching ID on another pair is
+	// kept
+	otherPair := fills[0]
+	otherPair.Quote = "usdt"
+	otherPair.Strategy = "gamma"
+	err = Insert(otherPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetInRange(&Filter{Start: testStart, End: testStart})
+	if !errors.Is(err, errInvalidTimeRange) {
+		t.Errorf("expected %v, received %v", errInvalidTimeRange, err)
+	}
+
+	resp, err := GetInRange(&Filter{
+		Exchange:  "one",
+		Base:      "BTC",
+		Quote:     "USD",
+		AssetType: "spot",
+		Start:     testStart,
+		End:       testStart.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 10 {
+		t.Fatalf("expected 10 fills, received %v", len(resp))
+	}
+	if resp[0].Exchange != "one" ||
+		resp[0].Side != "BUY" ||
+		resp[0].FeeAsset != "USD" ||
+		resp[0].TID != "0" ||
+		!resp[0].Timestamp.Equal(testStart) {
+		t.Errorf("unexpected fill %+v", resp[0])
+	}
+
+	resp, err = GetInRange(&Filter{
+		Strategy: "alpha",
+		Start:    testStart,
+		End:      testStart.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 5 {
+		t.Fatalf("expected 5 fills, received %v", len(resp))
+	}
+
+	var streamed, batches int
+	err = StreamInRange(&Filter{Start: testStart, End: testStart.Add(time.Hour)}, 3, func(d []Data) error {
+		streamed += len(d)
+		batches++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if streamed != 11 || batches != 4 {
+		t.Errorf("expected 11 fills in 4 batches, received %v in %v", streamed, batches)
+	}
+	err = StreamInRange(&Filter{Start: testStart, End: testStart.Add(time.Hour)}, 0, nil)
+	if !errors.Is(err, errInvalidBatchSize) {
+		t.Errorf("expected %v, received %v", errInvalidBatchSize, err)
+	}
+}
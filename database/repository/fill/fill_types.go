@@ -0,0 +1,49 @@
+package fill
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// uniqueColumns matches the uniquefillid constraint so repeated postgres
+	// imports are ignored in the same way as sqlite
+	uniqueColumns = []string{"exchange_name_id", "base", "quote", "asset", "tid"}
+
+	errExchangeNameRequired = errors.New("exchange name/uuid not set, cannot insert")
+	errTIDRequired          = errors.New("fill trade id not set, cannot insert")
+	errInvalidTimeRange     = errors.New("start date must be before end date")
+	errInvalidBatchSize     = errors.New("batch size must be greater than zero")
+	errNilBatchHandler      = errors.New("batch handler function cannot be nil")
+)
+
+// Data defines a single execution of one of our own orders in its simplest
+// db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	OrderID        string
+	TID            string
+	Base           string
+	Quote          string
+	AssetType      string
+	Side           string
+	Price          float64
+	Amount         float64
+	Fee            float64
+	FeeAsset       string
+	Strategy       string
+	Timestamp      time.Time
+}
+
+// Filter narrows a fill query, empty fields match everything
+type Filter struct {
+	Exchange  string
+	Base      string
+	Quote     string
+	AssetType string
+	Strategy  string
+	Start     time.Time
+	End       time.Time
+}
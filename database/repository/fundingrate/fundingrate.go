@@ -0,0 +1,206 @@
+package fundingrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves funding rates to the database, rates which already exist for
+// the same contract and funding time are ignored so overlapping history can
+// be synced repeatedly
+func Insert(rates ...Data) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	for i := range rates {
+		if rates[i].Base == "" || rates[i].Quote == "" {
+			return errPairRequired
+		}
+		if rates[i].AssetType == "" {
+			return errAssetRequired
+		}
+		if rates[i].ExchangeNameID == "" && rates[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(rates[i].Exchange)
+			if err != nil {
+				return err
+			}
+			rates[i].ExchangeNameID = exchangeUUID.String()
+		} else if rates[i].ExchangeNameID == "" {
+			return errExchangeNameRequired
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, rates...)
+	} else {
+		err = insertPostgres(ctx, tx, rates...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, rates ...Data) error {
+	for i := range rates {
+		if rates[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			rates[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelSQLite.FundingRate{
+			ID:             rates[i].ID,
+			ExchangeNameID: rates[i].ExchangeNameID,
+			Base:           strings.ToUpper(rates[i].Base),
+			Quote:          strings.ToUpper(rates[i].Quote),
+			Asset:          strings.ToLower(rates[i].AssetType),
+			Rate:           rates[i].Rate,
+			Timestamp:      rates[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, rates ...Data) error {
+	for i := range rates {
+		if rates[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			rates[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelPSQL.FundingRate{
+			ID:             rates[i].ID,
+			ExchangeNameID: rates[i].ExchangeNameID,
+			Base:           strings.ToUpper(rates[i].Base),
+			Quote:          strings.ToUpper(rates[i].Quote),
+			Asset:          strings.ToLower(rates[i].AssetType),
+			Rate:           rates[i].Rate,
+			Timestamp:      rates[i].Timestamp.UTC(),
+		}
+		err := tempEvent.Upsert(ctx, tx, false, uniqueColumns, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetInRange returns the funding rates of a contract between the start and
+// end dates in ascending timestamp order
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (rates []Data, err error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if !startDate.Before(endDate) {
+		return nil, errInvalidTimeRange
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	query := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("asset = ?", strings.ToLower(assetType)),
+		qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC().Format(time.RFC3339), endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp asc"),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		rates, err = getInRangeSQLite(query)
+		if err != nil {
+			return nil, fmt.Errorf("fundingrate.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		rates, err = getInRangePostgres(query)
+		if err != nil {
+			return nil, fmt.Errorf("fundingrate.GetInRange getInRangePostgres %w", err)
+		}
+	}
+	for i := range rates {
+		rates[i].Exchange = exchangeName
+	}
+	return rates, nil
+}
+
+func getInRangeSQLite(query []qm.QueryMod) ([]Data, error) {
+	result, err := modelSQLite.FundingRates(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]Data, len(result))
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		rates[i] = Data{
+			ID:             result[i].ID,
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           result[i].Base,
+			Quote:          result[i].Quote,
+			AssetType:      result[i].Asset,
+			Rate:           result[i].Rate,
+			Timestamp:      ts,
+		}
+	}
+	return rates, nil
+}
+
+func getInRangePostgres(query []qm.QueryMod) ([]Data, error) {
+	result, err := modelPSQL.FundingRates(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]Data, len(result))
+	for i := range result {
+		rates[i] = Data{
+			ID:             result[i].ID,
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           result[i].Base,
+			Quote:          result[i].Quote,
+			AssetType:      result[i].Asset,
+			Rate:           result[i].Rate,
+			Timestamp:      result[i].Timestamp,
+		}
+	}
+	return rates, nil
+}
@@ -0,0 +1,142 @@
+package fundingrate
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose   = false
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestFundingRates(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = exchange.InsertMany([]exchange.Details{{Name: "one"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			exchange.ResetExchangeCache()
+
+			fundingRateTester(t)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func fundingRateTester(t *testing.T) {
+	err := Insert(Data{Exchange: "one", AssetType: "perpetualswap"})
+	if !errors.Is(err, errPairRequired) {
+		t.Errorf("expected %v, received %v", errPairRequired, err)
+	}
+	err = Insert(Data{Exchange: "one", Base: "btc", Quote: "usd"})
+	if !errors.Is(err, errAssetRequired) {
+		t.Errorf("expected %v, received %v", errAssetRequired, err)
+	}
+	err = Insert(Data{Base: "btc", Quote: "usd", AssetType: "perpetualswap"})
+	if !errors.Is(err, errExchangeNameRequired) {
+		t.Errorf("expected %v, received %v", errExchangeNameRequired, err)
+	}
+
+	var rates []Data
+	for i := 0; i < 6; i++ {
+		rates = append(rates, Data{
+			Exchange:  "one",
+			Base:      "btc",
+			Quote:     "usd",
+			AssetType: "PERPETUALSWAP",
+			Rate:      float64(i) / 10000,
+			Timestamp: testStart.Add(time.Hour * 8 * time.Duration(i)),
+		})
+	}
+	err = Insert(rates...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rates are deduplicated by contract and funding time
+	for i := range rates {
+		rates[i].ID = ""
+	}
+	err = Insert(rates...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetInRange("one", "perpetualswap", "btc", "usd", testStart, testStart)
+	if !errors.Is(err, errInvalidTimeRange) {
+		t.Errorf("expected %v, received %v", errInvalidTimeRange, err)
+	}
+
+	resp, err := GetInRange("one", "perpetualswap", "BTC", "USD", testStart, testStart.Add(time.Hour*24))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 4 {
+		t.Fatalf("expected 4 funding rates, received %v", len(resp))
+	}
+	if resp[0].Exchange != "one" ||
+		resp[0].Base != "BTC" ||
+		resp[0].AssetType != "perpetualswap" ||
+		resp[3].Rate != 0.0003 ||
+		!resp[3].Timestamp.Equal(testStart.Add(time.Hour*24)) {
+		t.Errorf("unexpected funding rates %+v", resp)
+	}
+}
@@ -0,0 +1,30 @@
+package fundingrate
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// uniqueColumns matches the uniquefundingrate constraint so repeated
+	// postgres syncs are ignored in the same way as sqlite
+	uniqueColumns = []string{"exchange_name_id", "base", "quote", "asset", "timestamp"}
+
+	errExchangeNameRequired = errors.New("exchange name/uuid not set, cannot insert")
+	errPairRequired         = errors.New("base and quote currency required")
+	errAssetRequired        = errors.New("asset type required")
+	errInvalidTimeRange     = errors.New("start date must be before end date")
+)
+
+// Data defines a funding rate applied to a perpetual contract at a funding
+// time in its simplest db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	Rate           float64
+	Timestamp      time.Time
+}
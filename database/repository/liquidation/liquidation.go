@@ -0,0 +1,215 @@
+package liquidation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Insert saves liquidation events to the database
+func Insert(liquidations ...Data) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	for i := range liquidations {
+		if liquidations[i].Base == "" || liquidations[i].Quote == "" {
+			return errPairRequired
+		}
+		if liquidations[i].AssetType == "" {
+			return errAssetRequired
+		}
+		if liquidations[i].Side == "" {
+			return errSideRequired
+		}
+		if liquidations[i].ExchangeNameID == "" && liquidations[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(liquidations[i].Exchange)
+			if err != nil {
+				return err
+			}
+			liquidations[i].ExchangeNameID = exchangeUUID.String()
+		} else if liquidations[i].ExchangeNameID == "" {
+			return errExchangeNameRequired
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, liquidations...)
+	} else {
+		err = insertPostgres(ctx, tx, liquidations...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, liquidations ...Data) error {
+	for i := range liquidations {
+		if liquidations[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			liquidations[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelSQLite.Liquidation{
+			ID:             liquidations[i].ID,
+			ExchangeNameID: liquidations[i].ExchangeNameID,
+			Base:           strings.ToUpper(liquidations[i].Base),
+			Quote:          strings.ToUpper(liquidations[i].Quote),
+			Asset:          strings.ToLower(liquidations[i].AssetType),
+			Side:           strings.ToUpper(liquidations[i].Side),
+			Price:          liquidations[i].Price,
+			Amount:         liquidations[i].Amount,
+			Timestamp:      liquidations[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, liquidations ...Data) error {
+	for i := range liquidations {
+		if liquidations[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			liquidations[i].ID = freshUUID.String()
+		}
+		var tempEvent = modelPSQL.Liquidation{
+			ID:             liquidations[i].ID,
+			ExchangeNameID: liquidations[i].ExchangeNameID,
+			Base:           strings.ToUpper(liquidations[i].Base),
+			Quote:          strings.ToUpper(liquidations[i].Quote),
+			Asset:          strings.ToLower(liquidations[i].AssetType),
+			Side:           strings.ToUpper(liquidations[i].Side),
+			Price:          liquidations[i].Price,
+			Amount:         liquidations[i].Amount,
+			Timestamp:      liquidations[i].Timestamp.UTC(),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetInRange returns the liquidation events of a contract between the start
+// and end dates in ascending timestamp order
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (liquidations []Data, err error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if !startDate.Before(endDate) {
+		return nil, errInvalidTimeRange
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	query := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("asset = ?", strings.ToLower(assetType)),
+		qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC().Format(time.RFC3339), endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp asc"),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		liquidations, err = getInRangeSQLite(query)
+		if err != nil {
+			return nil, fmt.Errorf("liquidation.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		liquidations, err = getInRangePostgres(query)
+		if err != nil {
+			return nil, fmt.Errorf("liquidation.GetInRange getInRangePostgres %w", err)
+		}
+	}
+	for i := range liquidations {
+		liquidations[i].Exchange = exchangeName
+	}
+	return liquidations, nil
+}
+
+func getInRangeSQLite(query []qm.QueryMod) ([]Data, error) {
+	result, err := modelSQLite.Liquidations(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	liquidations := make([]Data, len(result))
+	for i := range result {
+		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		liquidations[i] = Data{
+			ID:             result[i].ID,
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           result[i].Base,
+			Quote:          result[i].Quote,
+			AssetType:      result[i].Asset,
+			Side:           result[i].Side,
+			Price:          result[i].Price,
+			Amount:         result[i].Amount,
+			Timestamp:      ts,
+		}
+	}
+	return liquidations, nil
+}
+
+func getInRangePostgres(query []qm.QueryMod) ([]Data, error) {
+	result, err := modelPSQL.Liquidations(query...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	liquidations := make([]Data, len(result))
+	for i := range result {
+		liquidations[i] = Data{
+			ID:             result[i].ID,
+			ExchangeNameID: result[i].ExchangeNameID,
+			Base:           result[i].Base,
+			Quote:          result[i].Quote,
+			AssetType:      result[i].Asset,
+			Side:           result[i].Side,
+			Price:          result[i].Price,
+			Amount:         result[i].Amount,
+			Timestamp:      result[i].Timestamp,
+		}
+	}
+	return liquidations, nil
+}
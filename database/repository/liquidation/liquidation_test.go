@@ -0,0 +1,142 @@
+package liquidation
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose   = false
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestLiquidations(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = exchange.InsertMany([]exchange.Details{{Name: "one"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			exchange.ResetExchangeCache()
+
+			liquidationTester(t)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func liquidationTester(t *testing.T) {
+	err := Insert(Data{Exchange: "one", AssetType: "futures", Side: "LONG"})
+	if !errors.Is(err, errPairRequired) {
+		t.Errorf("expected %v, received %v", errPairRequired, err)
+	}
+	err = Insert(Data{Exchange: "one", Base: "btc", Quote: "usd", Side: "LONG"})
+	if !errors.Is(err, errAssetRequired) {
+		t.Errorf("expected %v, received %v", errAssetRequired, err)
+	}
+	err = Insert(Data{Exchange: "one", Base: "btc", Quote: "usd", AssetType: "futures"})
+	if !errors.Is(err, errSideRequired) {
+		t.Errorf("expected %v, received %v", errSideRequired, err)
+	}
+	err = Insert(Data{Base: "btc", Quote: "usd", AssetType: "futures", Side: "LONG"})
+	if !errors.Is(err, errExchangeNameRequired) {
+		t.Errorf("expected %v, received %v", errExchangeNameRequired, err)
+	}
+
+	var liquidations []Data
+	for i := 0; i < 6; i++ {
+		liquidations = append(liquidations, Data{
+			Exchange:  "one",
+			Base:      "btc",
+			Quote:     "usd",
+			AssetType: "FUTURES",
+			Side:      "short",
+			Price:     10000 + float64(i),
+			Amount:    float64(i + 1),
+			Timestamp: testStart.Add(time.Minute * time.Duration(i)),
+		})
+	}
+	err = Insert(liquidations...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetInRange("one", "futures", "btc", "usd", testStart, testStart)
+	if !errors.Is(err, errInvalidTimeRange) {
+		t.Errorf("expected %v, received %v", errInvalidTimeRange, err)
+	}
+
+	resp, err := GetInRange("one", "futures", "BTC", "USD", testStart, testStart.Add(time.Minute*3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 4 {
+		t.Fatalf("expected 4 liquidations, received %v", len(resp))
+	}
+	if resp[0].Exchange != "one" ||
+		resp[0].Base != "BTC" ||
+		resp[0].AssetType != "futures" ||
+		resp[0].Side != "SHORT" ||
+		resp[3].Price != 10003 ||
+		resp[3].Amount != 4 ||
+		!resp[3].Timestamp.Equal(testStart.Add(time.Minute*3)) {
+		t.Errorf("unexpected liquidations %+v", resp)
+	}
+}
@@ -0,0 +1,28 @@
+package liquidation
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errExchangeNameRequired = errors.New("exchange name/uuid not set, cannot insert")
+	errPairRequired         = errors.New("base and quote currency required")
+	errAssetRequired        = errors.New("asset type required")
+	errSideRequired         = errors.New("liquidated side required")
+	errInvalidTimeRange     = errors.New("start date must be before end date")
+)
+
+// Data defines a liquidation event in its simplest db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	Side           string
+	Price          float64
+	Amount         float64
+	Timestamp      time.Time
+}
@@ -1,10 +1,16 @@
 package trade
 
 import (
+	"bufio"
+	"compress/gzip"
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +20,7 @@ import (
 	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
@@ -219,7 +226,7 @@ func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate
 	return td, nil
 }
 
-func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time, mods ...qm.QueryMod) (td []Data, err error) {
 	var exchangeUUID uuid.UUID
 	exchangeUUID, err = exchange.UUIDByName(exchangeName)
 	if err != nil {
@@ -232,7 +239,7 @@ func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, en
 		"quote":            strings.ToUpper(quote),
 	}
 	q := generateQuery(wheres, startDate, endDate)
-	query := modelSQLite.Trades(q...)
+	query := modelSQLite.Trades(append(q, mods...)...)
 	var result []*modelSQLite.Trade
 	result, err = query.All(context.Background(), database.DB.SQL)
 	if err != nil {
@@ -256,12 +263,15 @@ func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, en
 		if result[i].Side.Valid {
 			t.Side = result[i].Side.String
 		}
+		if result[i].Tid.Valid {
+			t.TID = result[i].Tid.String
+		}
 		td = append(td, t)
 	}
 	return td, nil
 }
 
-func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time, mods ...qm.QueryMod) (td []Data, err error) {
 	var exchangeUUID uuid.UUID
 	exchangeUUID, err = exchange.UUIDByName(exchangeName)
 	if err != nil {
@@ -274,7 +284,7 @@ func getInRangePostgres(exchangeName, assetType, base, quote string, startDate,
 		"quote":            strings.ToUpper(quote),
 	}
 	q := generateQuery(wheres, startDate, endDate)
-	query := modelPSQL.Trades(q...)
+	query := modelPSQL.Trades(append(q, mods...)...)
 	var result []*modelPSQL.Trade
 	result, err = query.All(context.Background(), database.DB.SQL)
 	if err != nil {
@@ -294,11 +304,69 @@ func getInRangePostgres(exchangeName, assetType, base, quote string, startDate,
 		if result[i].Side.Valid {
 			t.Side = result[i].Side.String
 		}
+		if result[i].Tid.Valid {
+			t.TID = result[i].Tid.String
+		}
 		td = append(td, t)
 	}
 	return td, nil
 }
 
+// StreamInRange loads trades by an exchange in a date range in ascending
+// timestamp order in batches of batchSize and passes each batch to fn, so
+// large date ranges do not need to be held in memory
+func StreamInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time, batchSize int, fn func([]Data) error) error {
+	return streamInRange(exchangeName, assetType, base, quote, startDate, endDate, batchSize, fn)
+}
+
+// StreamBefore loads trades with a timestamp before the supplied time in
+// ascending timestamp order in batches of batchSize and passes each batch to
+// fn. The bound is exclusive and matches DeleteBefore, so a stream followed by
+// a delete covers exactly the same trades
+func StreamBefore(exchangeName, assetType, base, quote string, before time.Time, batchSize int, fn func([]Data) error) error {
+	return streamInRange(exchangeName, assetType, base, quote, time.Unix(0, 0), before, batchSize, fn,
+		qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339)))
+}
+
+func streamInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time, batchSize int, fn func([]Data) error, extra ...qm.QueryMod) error {
+	if batchSize <= 0 {
+		return errInvalidBatchSize
+	}
+	if fn == nil {
+		return errNilBatchHandler
+	}
+	for offset := 0; ; offset += batchSize {
+		mods := append([]qm.QueryMod{
+			qm.OrderBy("timestamp asc, id asc"),
+			qm.Limit(batchSize),
+			qm.Offset(offset),
+		}, extra...)
+		var td []Data
+		var err error
+		if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+			td, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate, mods...)
+			if err != nil {
+				return fmt.Errorf("trade.StreamInRange getInRangeSQLite %w", err)
+			}
+		} else {
+			td, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate, mods...)
+			if err != nil {
+				return fmt.Errorf("trade.StreamInRange getInRangePostgres %w", err)
+			}
+		}
+		if len(td) == 0 {
+			return nil
+		}
+		err = fn(td)
+		if err != nil {
+			return err
+		}
+		if len(td) < batchSize {
+			return nil
+		}
+	}
+}
+
 // DeleteTrades will remove trades from the database using trade.Data
 func DeleteTrades(trades ...Data) error {
 	ctx := context.Background()
@@ -348,6 +416,93 @@ func deleteTradesPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error
 	return err
 }
 
+// DeleteBefore removes all trades with a timestamp before the supplied time
+// in a single statement, empty filters match everything
+func DeleteBefore(exchangeName, assetType, base, quote string, before time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	queries, err := olderThanQuery(exchangeName, assetType, base, quote, before)
+	if err != nil {
+		return 0, err
+	}
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		return modelSQLite.Trades(queries...).DeleteAll(ctx, database.DB.SQL)
+	}
+	return modelPSQL.Trades(queries...).DeleteAll(ctx, database.DB.SQL)
+}
+
+// SeriesBefore returns each distinct exchange, asset and pair which has trades
+// with a timestamp before the supplied time, an empty exchange name matches
+// every exchange. The returned trades only hold the series fields
+func SeriesBefore(exchangeName string, before time.Time) ([]Data, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	queries, err := olderThanQuery(exchangeName, "", "", "", before)
+	if err != nil {
+		return nil, err
+	}
+	queries = append(queries,
+		qm.Select("distinct exchange_name_id, base, quote, asset"),
+		qm.Load("ExchangeName"))
+
+	var td []Data
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		result, err := modelSQLite.Trades(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range result {
+			td = append(td, Data{
+				Exchange:  result[i].R.ExchangeName.Name,
+				Base:      strings.ToUpper(result[i].Base),
+				Quote:     strings.ToUpper(result[i].Quote),
+				AssetType: strings.ToLower(result[i].Asset),
+			})
+		}
+		return td, nil
+	}
+	result, err := modelPSQL.Trades(queries...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		td = append(td, Data{
+			Exchange:  result[i].R.ExchangeName.Name,
+			Base:      strings.ToUpper(result[i].Base),
+			Quote:     strings.ToUpper(result[i].Quote),
+			AssetType: strings.ToLower(result[i].Asset),
+		})
+	}
+	return td, nil
+}
+
+func olderThanQuery(exchangeName, assetType, base, quote string, before time.Time) ([]qm.QueryMod, error) {
+	queries := []qm.QueryMod{
+		qm.Where("timestamp < ?", before.UTC().Format(time.RFC3339)),
+	}
+	if exchangeName != "" {
+		exchangeUUID, err := exchange.UUIDByName(exchangeName)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, qm.Where("exchange_name_id = ?", exchangeUUID.String()))
+	}
+	if assetType != "" {
+		queries = append(queries, qm.Where("asset = ?", strings.ToLower(assetType)))
+	}
+	if base != "" {
+		queries = append(queries, qm.Where("base = ?", strings.ToUpper(base)))
+	}
+	if quote != "" {
+		queries = append(queries, qm.Where("quote = ?", strings.ToUpper(quote)))
+	}
+	return queries, nil
+}
+
 func generateQuery(clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
 	query := []qm.QueryMod{
 		qm.Where("timestamp BETWEEN ? AND ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)),
@@ -357,3 +512,229 @@ func generateQuery(clauses map[string]interface{}, start, end time.Time) []qm.Qu
 	}
 	return query
 }
+
+// ParseFileFormat returns a supported FileFormat from a string
+func ParseFileFormat(f string) (FileFormat, error) {
+	f = strings.ToLower(f)
+	for i := range SupportedFileFormats {
+		if string(SupportedFileFormats[i]) == f {
+			return SupportedFileFormats[i], nil
+		}
+	}
+	return "", fmt.Errorf("%w %s", errUnsupportedFormat, f)
+}
+
+// InsertFromFile loads a csv trade data dump, which may be gzip compressed,
+// and inserts the trades in batches of batchSize. Trades are deduplicated on
+// the exchange, pair, asset and TID, as most exchanges number trades per
+// pair, so the same file can safely be imported more than once. It returns
+// the number of trades read from the file
+func InsertFromFile(exchangeName, base, quote, assetType string, format FileFormat, fileName string, batchSize int) (uint64, error) {
+	if exchangeName == "" {
+		return 0, errExchangeNameRequired
+	}
+	if batchSize <= 0 {
+		return 0, errInvalidBatchSize
+	}
+	parser, ok := fileParsers[format]
+	if !ok {
+		return 0, fmt.Errorf("%w %s", errUnsupportedFormat, format)
+	}
+
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := os.Open(fileName)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		errClose := f.Close()
+		if errClose != nil {
+			log.Errorln(log.DatabaseMgr, errClose)
+		}
+	}()
+
+	r, err := decompress(f)
+	if err != nil {
+		return 0, err
+	}
+	csvData := csv.NewReader(r)
+	csvData.FieldsPerRecord = -1
+	csvData.ReuseRecord = true
+
+	batch := make([]Data, 0, batchSize)
+	var total uint64
+	for line := 1; ; line++ {
+		row, errCSV := csvData.Read()
+		if errCSV != nil {
+			if errCSV == io.EOF {
+				break
+			}
+			return total, errCSV
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(row[0]), parser.header) {
+			continue
+		}
+
+		td := Data{
+			Exchange:       exchangeName,
+			ExchangeNameID: exchangeUUID.String(),
+			Base:           strings.ToUpper(base),
+			Quote:          strings.ToUpper(quote),
+			AssetType:      strings.ToLower(assetType),
+		}
+		include, errParse := parser.parse(row, &td)
+		if errParse != nil {
+			return total, fmt.Errorf("%s line %d: %w", fileName, line, errParse)
+		}
+		if !include {
+			continue
+		}
+		if td.TID == "" {
+			return total, fmt.Errorf("%s line %d: %w", fileName, line, errTIDUnset)
+		}
+
+		batch = append(batch, td)
+		if len(batch) == batchSize {
+			err = Insert(batch...)
+			if err != nil {
+				return total, err
+			}
+			total += uint64(len(batch))
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		err = Insert(batch...)
+		if err != nil {
+			return total, err
+		}
+		total += uint64(len(batch))
+	}
+	return total, nil
+}
+
+// decompress transparently wraps gzip compressed input based on its magic
+// number so files do not need to follow a naming convention
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		return gzip.NewReader(br)
+	}
+	return br, nil
+}
+
+// fileParser converts a single row of a trade dump, header holds the first
+// column name of an optional header row
+type fileParser struct {
+	header string
+	// parse populates td from the row and returns false if the row belongs
+	// to a different currency pair or asset and should be skipped
+	parse func(row []string, td *Data) (bool, error)
+}
+
+var fileParsers = map[FileFormat]fileParser{
+	GCTFormat:     {header: "exchange", parse: parseGCTRow},
+	BinanceFormat: {header: "id", parse: parseBinanceRow},
+	BitmexFormat:  {header: "timestamp", parse: parseBitmexRow},
+}
+
+func parseGCTRow(row []string, td *Data) (bool, error) {
+	if len(row) < 9 {
+		return false, fmt.Errorf("%w expected 9 received %d", errInvalidRowLength, len(row))
+	}
+	if !strings.EqualFold(row[1], td.Base) ||
+		!strings.EqualFold(row[2], td.Quote) ||
+		!strings.EqualFold(row[3], td.AssetType) {
+		return false, nil
+	}
+	var err error
+	td.TID = row[4]
+	td.Timestamp, err = time.Parse(time.RFC3339, row[5])
+	if err != nil {
+		return false, err
+	}
+	td.Price, err = strconv.ParseFloat(row[6], 64)
+	if err != nil {
+		return false, err
+	}
+	td.Amount, err = strconv.ParseFloat(row[7], 64)
+	if err != nil {
+		return false, err
+	}
+	td.Side = strings.ToUpper(row[8])
+	return true, nil
+}
+
+func parseBinanceRow(row []string, td *Data) (bool, error) {
+	if len(row) < 6 {
+		return false, fmt.Errorf("%w expected 6 received %d", errInvalidRowLength, len(row))
+	}
+	var err error
+	td.TID = row[0]
+	td.Price, err = strconv.ParseFloat(row[1], 64)
+	if err != nil {
+		return false, err
+	}
+	td.Amount, err = strconv.ParseFloat(row[2], 64)
+	if err != nil {
+		return false, err
+	}
+	ts, err := strconv.ParseInt(row[4], 10, 64)
+	if err != nil {
+		return false, err
+	}
+	// newer dumps are published with microsecond precision
+	if ts > 1e15 {
+		td.Timestamp = time.Unix(0, ts*int64(time.Microsecond)).UTC()
+	} else {
+		td.Timestamp = time.Unix(0, ts*int64(time.Millisecond)).UTC()
+	}
+	buyerMaker, err := strconv.ParseBool(row[5])
+	if err != nil {
+		return false, err
+	}
+	// the taker side is recorded, a maker buyer means the taker sold
+	if buyerMaker {
+		td.Side = order.Sell.String()
+	} else {
+		td.Side = order.Buy.String()
+	}
+	return true, nil
+}
+
+func parseBitmexRow(row []string, td *Data) (bool, error) {
+	if len(row) < 7 {
+		return false, fmt.Errorf("%w expected 7 received %d", errInvalidRowLength, len(row))
+	}
+	if !strings.EqualFold(row[1], td.Base+td.Quote) {
+		return false, nil
+	}
+	var err error
+	td.Timestamp, err = time.Parse(bitmexDumpTimeFormat, strings.Replace(row[0], "D", "T", 1))
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToUpper(row[2]) {
+	case order.Buy.String():
+		td.Side = order.Buy.String()
+	case order.Sell.String():
+		td.Side = order.Sell.String()
+	default:
+		return false, fmt.Errorf("%w %s", errInvalidTradeSide, row[2])
+	}
+	td.Amount, err = strconv.ParseFloat(row[3], 64)
+	if err != nil {
+		return false, err
+	}
+	td.Price, err = strconv.ParseFloat(row[4], 64)
+	if err != nil {
+		return false, err
+	}
+	td.TID = row[6]
+	return true, nil
+}
@@ -1,10 +1,14 @@
 package trade
 
 import (
+	"compress/gzip"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -166,6 +170,27 @@ func tradeSQLTester(t *testing.T) {
 		t.Error("Bad get!")
 	}
 
+	var streamed, batches int
+	err = StreamInRange(
+		testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USD.String(),
+		time.Now().Add(-time.Hour),
+		time.Now().Add(time.Hour),
+		7,
+		func(d []Data) error {
+			streamed += len(d)
+			batches++
+			return nil
+		})
+	if err != nil {
+		t.Error(err)
+	}
+	if streamed != 20 || batches != 3 {
+		t.Errorf("expected 20 trades in 3 batches, received %v trades in %v batches", streamed, batches)
+	}
+
 	err = DeleteTrades(trades...)
 	if err != nil {
 		t.Error(err)
@@ -191,6 +216,185 @@ func tradeSQLTester(t *testing.T) {
 	}
 }
 
+func TestParseFileFormat(t *testing.T) {
+	t.Parallel()
+	f, err := ParseFileFormat("BINANCE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != BinanceFormat {
+		t.Errorf("expected %v, received %v", BinanceFormat, f)
+	}
+	_, err = ParseFileFormat("kraken")
+	if !errors.Is(err, errUnsupportedFormat) {
+		t.Errorf("expected %v, received %v", errUnsupportedFormat, err)
+	}
+}
+
+func TestInsertFromFile(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			insertFromFileTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func insertFromFileTester(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var binanceDump strings.Builder
+	binanceDump.WriteString("id,price,qty,quote_qty,time,is_buyer_maker,is_best_match\n")
+	for i := 0; i < 10; i++ {
+		fmt.Fprintf(&binanceDump, "%d,7200.5,0.%d,720.05,%d,%v,True\n",
+			1000+i, i+1, start.Add(time.Duration(i)*time.Second).UnixNano()/int64(time.Millisecond), i%2 == 0)
+	}
+	binanceFile := filepath.Join(testhelpers.TempDir, "BTCUSDT-trades-2020-01-01.csv.gz")
+	writeGzip(t, binanceFile, binanceDump.String())
+
+	bitmexDump := "timestamp,symbol,side,size,price,tickDirection,trdMatchID,grossValue,homeNotional,foreignNotional\n" +
+		"2020-01-01D00:00:01.123456000,XBTUSD,Buy,100,7200.5,PlusTick,00000000-006d-1000-0000-000000000001,1388800,0.013888,100\n" +
+		"2020-01-01D00:00:02.123456000,ETHUSD,Sell,5,130.1,MinusTick,00000000-006d-1000-0000-000000000002,3836000,0.03836,5\n" +
+		"2020-01-01D00:00:03.123456000,XBTUSD,Sell,250,7200,MinusTick,00000000-006d-1000-0000-000000000003,3472000,0.03472,250\n"
+	bitmexFile := filepath.Join(testhelpers.TempDir, "bitmex-20200101.csv")
+	err := ioutil.WriteFile(bitmexFile, []byte(bitmexDump), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// import each file twice to ensure trades are deduplicated on TID
+	for i := 0; i < 2; i++ {
+		count, err := InsertFromFile(testExchanges[1].Name, "BTC", "USDT", asset.Spot.String(), BinanceFormat, binanceFile, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 10 {
+			t.Errorf("expected 10 trades to be read, received %v", count)
+		}
+		count, err = InsertFromFile(testExchanges[1].Name, "XBT", "USD", asset.PerpetualContract.String(), BitmexFormat, bitmexFile, DefaultImportBatchSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 2 {
+			t.Errorf("expected 2 trades to be read, received %v", count)
+		}
+	}
+
+	resp, err := GetInRange(testExchanges[1].Name, asset.Spot.String(), "BTC", "USDT", start, start.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 10 {
+		t.Fatalf("expected 10 binance trades, received %v", len(resp))
+	}
+	resp, err = GetInRange(testExchanges[1].Name, asset.PerpetualContract.String(), "XBT", "USD", start, start.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 bitmex trades, received %v", len(resp))
+	}
+	for i := range resp {
+		if resp[i].TID == "00000000-006d-1000-0000-000000000001" && resp[i].Side != order.Buy.String() {
+			t.Errorf("expected side %v, received %v", order.Buy, resp[i].Side)
+		}
+	}
+
+	// trade IDs are numbered per pair, so another pair's dump with the same
+	// TIDs must not be treated as duplicates
+	ethFile := filepath.Join(testhelpers.TempDir, "ETHUSDT-trades-2020-01-01.csv.gz")
+	writeGzip(t, ethFile, strings.Replace(binanceDump.String(), "7200.5", "130.1", -1))
+	count, err := InsertFromFile(testExchanges[1].Name, "ETH", "USDT", asset.Spot.String(), BinanceFormat, ethFile, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 10 {
+		t.Errorf("expected 10 trades to be read, received %v", count)
+	}
+	resp, err = GetInRange(testExchanges[1].Name, asset.Spot.String(), "ETH", "USDT", start, start.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 10 {
+		t.Fatalf("expected 10 ETH trades with overlapping TIDs, received %v", len(resp))
+	}
+	resp, err = GetInRange(testExchanges[1].Name, asset.Spot.String(), "BTC", "USDT", start, start.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 10 {
+		t.Fatalf("expected 10 BTC trades after importing ETH, received %v", len(resp))
+	}
+
+	_, err = InsertFromFile(testExchanges[1].Name, "BTC", "USDT", asset.Spot.String(), "kraken", binanceFile, 3)
+	if !errors.Is(err, errUnsupportedFormat) {
+		t.Errorf("expected %v, received %v", errUnsupportedFormat, err)
+	}
+	_, err = InsertFromFile(testExchanges[1].Name, "BTC", "USDT", asset.Spot.String(), GCTFormat, binanceFile, 3)
+	if !errors.Is(err, errInvalidRowLength) {
+		t.Errorf("expected %v, received %v", errInvalidRowLength, err)
+	}
+}
+
+func writeGzip(t *testing.T, fileName, data string) {
+	t.Helper()
+	f, err := os.Create(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := gzip.NewWriter(f)
+	_, err = w.Write([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func seedDB() error {
 	err := exchange.InsertMany(testExchanges)
 	if err != nil {
@@ -1,6 +1,46 @@
 package trade
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// FileFormat defines the layout of a trade data dump which can be imported
+type FileFormat string
+
+const (
+	// GCTFormat is the layout produced by dbexport trade csv exports:
+	// exchange,base,quote,asset,tid,timestamp,price,amount,side with RFC3339
+	// timestamps
+	GCTFormat FileFormat = "gct"
+	// BinanceFormat is the layout of the Binance public spot trade dumps:
+	// id,price,qty,quoteQty,time,isBuyerMaker,isBestMatch with unix
+	// millisecond or microsecond timestamps
+	BinanceFormat FileFormat = "binance"
+	// BitmexFormat is the layout of the BitMEX public trade dumps:
+	// timestamp,symbol,side,size,price,tickDirection,trdMatchID,... rows for
+	// other symbols in the dump are skipped
+	BitmexFormat FileFormat = "bitmex"
+
+	// DefaultImportBatchSize is the number of trades inserted per transaction
+	// when no batch size is supplied
+	DefaultImportBatchSize = 5000
+
+	bitmexDumpTimeFormat = "2006-01-02T15:04:05.999999999"
+)
+
+var (
+	// SupportedFileFormats lists all trade dump layouts which can be imported
+	SupportedFileFormats = []FileFormat{GCTFormat, BinanceFormat, BitmexFormat}
+
+	errInvalidBatchSize     = errors.New("batch size must be greater than zero")
+	errNilBatchHandler      = errors.New("batch handler function cannot be nil")
+	errUnsupportedFormat    = errors.New("unsupported trade file format")
+	errInvalidRowLength     = errors.New("invalid number of columns")
+	errTIDUnset             = errors.New("trade id unset, cannot deduplicate")
+	errInvalidTradeSide     = errors.New("invalid trade side")
+	errExchangeNameRequired = errors.New("exchange name required")
+)
 
 // Data defines trade data in its simplest
 // db friendly form
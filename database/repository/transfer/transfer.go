@@ -0,0 +1,287 @@
+package transfer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var (
+	// ErrNoResults is the error returned if no results are found
+	ErrNoResults = errors.New("no results found")
+)
+
+// Event stores transfer response details in the database
+func Event(res *transfer.Response) {
+	if database.DB.SQL == nil {
+		return
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	exchangeUUID, err := exchangeDB.UUIDByName(res.Exchange.Name)
+	if err != nil {
+		log.Error(log.DatabaseMgr, err)
+		return
+	}
+	if res.CreatedAt.IsZero() {
+		res.CreatedAt = time.Now().UTC()
+	}
+	if res.UpdatedAt.IsZero() {
+		res.UpdatedAt = res.CreatedAt
+	}
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Event transaction being failed: %v", err)
+		return
+	}
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = addSQLiteEvent(ctx, tx, exchangeUUID.String(), res)
+	} else {
+		err = addPSQLEvent(ctx, tx, exchangeUUID.String(), res)
+	}
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
+		err = tx.Rollback()
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
+		}
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Event Transaction commit failed: %v", err)
+	}
+}
+
+func addPSQLEvent(ctx context.Context, tx *sql.Tx, exchangeNameID string, res *transfer.Response) error {
+	var tempEvent = modelPSQL.TransferHistory{
+		ExchangeNameID: exchangeNameID,
+		ExchangeID:     res.Exchange.ID,
+		Status:         res.Exchange.Status,
+		Currency:       res.RequestDetails.Currency.String(),
+		Amount:         res.RequestDetails.Amount,
+		FromAccount:    res.RequestDetails.FromAccount,
+		ToAccount:      res.RequestDetails.ToAccount,
+		FromAsset:      res.RequestDetails.FromAsset.String(),
+		ToAsset:        res.RequestDetails.ToAsset.String(),
+		CreatedAt:      res.CreatedAt.UTC(),
+		UpdatedAt:      res.UpdatedAt.UTC(),
+	}
+	if res.RequestDetails.Description != "" {
+		tempEvent.Description.SetValid(res.RequestDetails.Description)
+	}
+	err := tempEvent.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return err
+	}
+	res.ID, err = uuid.FromString(tempEvent.ID)
+	return err
+}
+
+func addSQLiteEvent(ctx context.Context, tx *sql.Tx, exchangeNameID string, res *transfer.Response) error {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	var tempEvent = modelSQLite.TransferHistory{
+		ID:             newUUID.String(),
+		ExchangeNameID: exchangeNameID,
+		ExchangeID:     res.Exchange.ID,
+		Status:         res.Exchange.Status,
+		Currency:       res.RequestDetails.Currency.String(),
+		Amount:         res.RequestDetails.Amount,
+		FromAccount:    res.RequestDetails.FromAccount,
+		ToAccount:      res.RequestDetails.ToAccount,
+		FromAsset:      res.RequestDetails.FromAsset.String(),
+		ToAsset:        res.RequestDetails.ToAsset.String(),
+		CreatedAt:      res.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      res.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if res.RequestDetails.Description != "" {
+		tempEvent.Description.SetValid(res.RequestDetails.Description)
+	}
+	err = tempEvent.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return err
+	}
+	res.ID = newUUID
+	return nil
+}
+
+// GetEventByUUID returns the requested transfer by ID
+func GetEventByUUID(id string) (*transfer.Response, error) {
+	resp, err := getByQuery(qm.Where("id = ?", id), qm.Limit(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, ErrNoResults
+	}
+	return resp[0], nil
+}
+
+// GetEventsByExchange returns the most recent transfers of an exchange
+func GetEventsByExchange(exchange string, limit int) ([]*transfer.Response, error) {
+	exch, err := exchangeDB.UUIDByName(exchange)
+	if err != nil {
+		return nil, err
+	}
+	query := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exch.String()),
+		qm.OrderBy("created_at desc"),
+	}
+	if limit > 0 {
+		query = append(query, qm.Limit(limit))
+	}
+	return getByQuery(query...)
+}
+
+// GetEventsByDate returns the transfers between the start and end dates, an
+// empty exchange returns the transfers of all exchanges
+func GetEventsByDate(exchange string, start, end time.Time, limit int) ([]*transfer.Response, error) {
+	query := []qm.QueryMod{
+		qm.Where("created_at BETWEEN ? AND ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)),
+		qm.OrderBy("created_at desc"),
+	}
+	if limit > 0 {
+		query = append(query, qm.Limit(limit))
+	}
+	if exchange != "" {
+		exch, err := exchangeDB.UUIDByName(exchange)
+		if err != nil {
+			return nil, err
+		}
+		query = append(query, qm.Where("exchange_name_id = ?", exch.String()))
+	}
+	return getByQuery(query...)
+}
+
+func getByQuery(query ...qm.QueryMod) ([]*transfer.Response, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		resp, err := getSQLite(query)
+		if err != nil {
+			return nil, fmt.Errorf("transfer getSQLite %w", err)
+		}
+		return resp, nil
+	}
+	resp, err := getPostgres(query)
+	if err != nil {
+		return nil, fmt.Errorf("transfer getPostgres %w", err)
+	}
+	return resp, nil
+}
+
+func getSQLite(query []qm.QueryMod) ([]*transfer.Response, error) {
+	ctx := context.Background()
+	result, err := modelSQLite.TransferHistories(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*transfer.Response, len(result))
+	for i := range result {
+		id, err := uuid.FromString(result[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		createdAt, err := time.Parse(time.RFC3339, result[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		updatedAt, err := time.Parse(time.RFC3339, result[i].UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = &transfer.Response{
+			ID: id,
+			Exchange: transfer.ExchangeResponse{
+				ID:     result[i].ExchangeID,
+				Status: result[i].Status,
+			},
+			RequestDetails: transfer.Request{
+				Currency:    currency.NewCode(result[i].Currency),
+				Amount:      result[i].Amount,
+				Description: result[i].Description.String,
+				FromAccount: result[i].FromAccount,
+				ToAccount:   result[i].ToAccount,
+				FromAsset:   asset.Item(result[i].FromAsset),
+				ToAsset:     asset.Item(result[i].ToAsset),
+			},
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		}
+		exchangeName, err := result[i].ExchangeName().One(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		setExchange(resp[i], exchangeName.ID, exchangeName.Name)
+	}
+	return resp, nil
+}
+
+func getPostgres(query []qm.QueryMod) ([]*transfer.Response, error) {
+	ctx := context.Background()
+	result, err := modelPSQL.TransferHistories(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*transfer.Response, len(result))
+	for i := range result {
+		id, err := uuid.FromString(result[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = &transfer.Response{
+			ID: id,
+			Exchange: transfer.ExchangeResponse{
+				ID:     result[i].ExchangeID,
+				Status: result[i].Status,
+			},
+			RequestDetails: transfer.Request{
+				Currency:    currency.NewCode(result[i].Currency),
+				Amount:      result[i].Amount,
+				Description: result[i].Description.String,
+				FromAccount: result[i].FromAccount,
+				ToAccount:   result[i].ToAccount,
+				FromAsset:   asset.Item(result[i].FromAsset),
+				ToAsset:     asset.Item(result[i].ToAsset),
+			},
+			CreatedAt: result[i].CreatedAt,
+			UpdatedAt: result[i].UpdatedAt,
+		}
+		exchangeName, err := result[i].ExchangeName().One(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		setExchange(resp[i], exchangeName.ID, exchangeName.Name)
+	}
+	return resp, nil
+}
+
+func setExchange(resp *transfer.Response, id, name string) {
+	resp.Exchange.Name = name
+	resp.Exchange.UUID, _ = uuid.FromString(id)
+	resp.RequestDetails.Exchange = name
+}
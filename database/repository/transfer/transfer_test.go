@@ -0,0 +1,145 @@
+package transfer
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
+)
+
+var (
+	verbose   = false
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestTransfers(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = exchange.InsertMany([]exchange.Details{{Name: "one"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			exchange.ResetExchangeCache()
+
+			transferTester(t)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func transferTester(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		Event(&transfer.Response{
+			Exchange: transfer.ExchangeResponse{
+				Name:   "one",
+				ID:     "transfer",
+				Status: "complete",
+			},
+			RequestDetails: transfer.Request{
+				Exchange:    "one",
+				Currency:    currency.BTC,
+				Amount:      float64(i + 1),
+				Description: "rebalance",
+				ToAccount:   "sub",
+				FromAsset:   asset.Spot,
+				ToAsset:     asset.Futures,
+			},
+			CreatedAt: testStart.Add(time.Minute * time.Duration(i)),
+		})
+	}
+
+	resp, err := GetEventsByExchange("one", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 transfers, received %v", len(resp))
+	}
+	if resp[0].Exchange.Name != "one" ||
+		resp[0].RequestDetails.Amount != 5 ||
+		resp[0].RequestDetails.ToAccount != "sub" ||
+		resp[0].RequestDetails.FromAsset != asset.Spot ||
+		resp[0].RequestDetails.ToAsset != asset.Futures ||
+		!resp[0].RequestDetails.Currency.Match(currency.BTC) ||
+		!resp[0].CreatedAt.Equal(testStart.Add(time.Minute*4)) {
+		t.Errorf("unexpected transfer %+v", resp[0])
+	}
+
+	single, err := GetEventByUUID(resp[1].ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single.RequestDetails.Amount != 4 {
+		t.Errorf("expected amount 4, received %v", single.RequestDetails.Amount)
+	}
+	_, err = GetEventByUUID(transfer.DryRunID.String())
+	if !errors.Is(err, ErrNoResults) {
+		t.Errorf("expected %v, received %v", ErrNoResults, err)
+	}
+
+	resp, err = GetEventsByDate("one", testStart, testStart.Add(time.Minute*2), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 3 {
+		t.Errorf("expected 3 transfers, received %v", len(resp))
+	}
+}
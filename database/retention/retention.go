@@ -0,0 +1,270 @@
+package retention
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+)
+
+// Validate checks a retention rule can be applied
+func Validate(r *database.RetentionRule) error {
+	switch strings.ToLower(r.Table) {
+	case database.RetentionTableTrade, database.RetentionTableCandle:
+	default:
+		return fmt.Errorf("%w: %q", errUnsupportedTable, r.Table)
+	}
+	if r.MaxAge <= 0 {
+		return errInvalidMaxAge
+	}
+	if r.DownsampleInterval == 0 {
+		return nil
+	}
+	if r.DownsampleInterval < 0 {
+		return errInvalidDownsampleInterval
+	}
+	if strings.EqualFold(r.Table, database.RetentionTableCandle) {
+		if r.Interval <= 0 {
+			return errCandleIntervalUnset
+		}
+		if r.DownsampleInterval <= r.Interval || r.DownsampleInterval%r.Interval != 0 {
+			return errInvalidDownsampleInterval
+		}
+	}
+	return nil
+}
+
+// Run applies each rule in order and returns a result for every rule, a
+// failing rule does not stop the remaining rules from being applied
+func Run(rules []database.RetentionRule, now time.Time) []Result {
+	results := make([]Result, len(rules))
+	for i := range rules {
+		results[i] = Apply(&rules[i], now)
+	}
+	return results
+}
+
+// Apply removes the rows matched by a rule which are older than its max age.
+// When the rule downsamples, the cutoff is aligned down to the downsample
+// interval so that only complete buckets are aggregated and removed, rows
+// newer than the aligned cutoff are left for a later run
+func Apply(r *database.RetentionRule, now time.Time) Result {
+	result := Result{Rule: *r}
+	result.Err = Validate(r)
+	if result.Err != nil {
+		return result
+	}
+	if database.DB.SQL == nil {
+		result.Err = database.ErrDatabaseSupportDisabled
+		return result
+	}
+
+	result.Cutoff = now.UTC().Add(-r.MaxAge)
+	if r.DownsampleInterval > 0 {
+		result.Cutoff = result.Cutoff.Truncate(time.Duration(r.DownsampleInterval) * time.Second)
+	}
+
+	switch strings.ToLower(r.Table) {
+	case database.RetentionTableTrade:
+		result.Downsampled, result.Deleted, result.Err = applyTrades(r, result.Cutoff)
+	case database.RetentionTableCandle:
+		result.Downsampled, result.Deleted, result.Err = applyCandles(r, result.Cutoff)
+	}
+	return result
+}
+
+func applyTrades(r *database.RetentionRule, cutoff time.Time) (downsampled uint64, deleted int64, err error) {
+	if r.DownsampleInterval == 0 {
+		deleted, err = trade.DeleteBefore(r.Exchange, "", "", "", cutoff)
+		return 0, deleted, err
+	}
+	series, err := trade.SeriesBefore(r.Exchange, cutoff)
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := range series {
+		agg, err := newAggregator(series[i].Exchange,
+			series[i].Base,
+			series[i].Quote,
+			series[i].AssetType,
+			r.DownsampleInterval)
+		if err != nil {
+			return downsampled, deleted, err
+		}
+		err = trade.StreamBefore(series[i].Exchange,
+			series[i].AssetType,
+			series[i].Base,
+			series[i].Quote,
+			cutoff,
+			streamBatchSize,
+			func(td []trade.Data) error {
+				for j := range td {
+					err := agg.add(td[j].Timestamp, td[j].Price, td[j].Price, td[j].Price, td[j].Price, td[j].Amount)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			return downsampled, deleted, err
+		}
+		err = agg.flush()
+		downsampled += agg.saved
+		if err != nil {
+			return downsampled, deleted, err
+		}
+		d, err := trade.DeleteBefore(series[i].Exchange,
+			series[i].AssetType,
+			series[i].Base,
+			series[i].Quote,
+			cutoff)
+		deleted += d
+		if err != nil {
+			return downsampled, deleted, err
+		}
+	}
+	return downsampled, deleted, nil
+}
+
+func applyCandles(r *database.RetentionRule, cutoff time.Time) (downsampled uint64, deleted int64, err error) {
+	if r.DownsampleInterval == 0 {
+		deleted, err = candle.DeleteBefore(r.Exchange, "", "", "", r.Interval, cutoff)
+		return 0, deleted, err
+	}
+	series, err := candle.SeriesBefore(r.Exchange, r.Interval, cutoff)
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := range series {
+		agg, err := newAggregator(series[i].ExchangeID,
+			series[i].Base,
+			series[i].Quote,
+			series[i].Asset,
+			r.DownsampleInterval)
+		if err != nil {
+			return downsampled, deleted, err
+		}
+		err = candle.StreamSeriesBefore(series[i].ExchangeID,
+			series[i].Base,
+			series[i].Quote,
+			series[i].Interval,
+			series[i].Asset,
+			cutoff,
+			streamBatchSize,
+			func(item *candle.Item) error {
+				for j := range item.Candles {
+					c := &item.Candles[j]
+					err := agg.add(c.Timestamp, c.Open, c.High, c.Low, c.Close, c.Volume)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			return downsampled, deleted, err
+		}
+		err = agg.flush()
+		downsampled += agg.saved
+		if err != nil {
+			return downsampled, deleted, err
+		}
+		d, err := candle.DeleteBefore(series[i].ExchangeID,
+			series[i].Base,
+			series[i].Quote,
+			series[i].Asset,
+			series[i].Interval,
+			cutoff)
+		deleted += d
+		if err != nil {
+			return downsampled, deleted, err
+		}
+	}
+	return downsampled, deleted, nil
+}
+
+// aggregator builds candles of a fixed interval from rows received in
+// ascending timestamp order and saves them in batches
+type aggregator struct {
+	item     candle.Item
+	interval time.Duration
+	current  *candle.Candle
+	saved    uint64
+}
+
+func newAggregator(exchangeName, base, quote, asset string, interval int64) (*aggregator, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	return &aggregator{
+		item: candle.Item{
+			ExchangeID: exchangeUUID.String(),
+			Base:       base,
+			Quote:      quote,
+			Interval:   interval,
+			Asset:      asset,
+		},
+		interval: time.Duration(interval) * time.Second,
+	}, nil
+}
+
+func (a *aggregator) add(ts time.Time, open, high, low, closePrice, volume float64) error {
+	bucket := ts.UTC().Truncate(a.interval)
+	if a.current != nil && a.current.Timestamp.Equal(bucket) {
+		if high > a.current.High {
+			a.current.High = high
+		}
+		if low < a.current.Low {
+			a.current.Low = low
+		}
+		a.current.Close = closePrice
+		a.current.Volume += volume
+		return nil
+	}
+	if a.current != nil {
+		a.item.Candles = append(a.item.Candles, *a.current)
+		if len(a.item.Candles) >= insertBatchSize {
+			err := a.save()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	a.current = &candle.Candle{
+		Timestamp: bucket,
+		Open:      open,
+		High:      high,
+		Low:       low,
+		Close:     closePrice,
+		Volume:    volume,
+	}
+	return nil
+}
+
+// flush saves the candle being built along with any unsaved candles
+func (a *aggregator) flush() error {
+	if a.current != nil {
+		a.item.Candles = append(a.item.Candles, *a.current)
+		a.current = nil
+	}
+	return a.save()
+}
+
+func (a *aggregator) save() error {
+	if len(a.item.Candles) == 0 {
+		return nil
+	}
+	n, err := candle.Insert(&a.item)
+	if err != nil {
+		return err
+	}
+	a.saved += n
+	a.item.Candles = a.item.Candles[:0]
+	return nil
+}
@@ -0,0 +1,296 @@
+package retention
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose   = false
+	testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	testNow   = time.Date(2020, 1, 10, 0, 30, 0, 0, time.UTC)
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MigrationDir = filepath.Join("..", "migrations")
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		rule database.RetentionRule
+		err  error
+	}{
+		{rule: database.RetentionRule{Table: "orders", MaxAge: time.Hour}, err: errUnsupportedTable},
+		{rule: database.RetentionRule{Table: "trade"}, err: errInvalidMaxAge},
+		{rule: database.RetentionRule{Table: "trade", MaxAge: time.Hour, DownsampleInterval: -1}, err: errInvalidDownsampleInterval},
+		{rule: database.RetentionRule{Table: "trade", MaxAge: time.Hour, DownsampleInterval: 3600}},
+		{rule: database.RetentionRule{Table: "candle", MaxAge: time.Hour, DownsampleInterval: 3600}, err: errCandleIntervalUnset},
+		{rule: database.RetentionRule{Table: "candle", MaxAge: time.Hour, Interval: 3600, DownsampleInterval: 5400}, err: errInvalidDownsampleInterval},
+		{rule: database.RetentionRule{Table: "candle", MaxAge: time.Hour, Interval: 3600, DownsampleInterval: 3600}, err: errInvalidDownsampleInterval},
+		{rule: database.RetentionRule{Table: "CANDLE", MaxAge: time.Hour, Interval: 60, DownsampleInterval: 3600}},
+		{rule: database.RetentionRule{Table: "candle", MaxAge: time.Hour}},
+	}
+	for i := range testCases {
+		err := Validate(&testCases[i].rule)
+		if !errors.Is(err, testCases[i].err) {
+			t.Errorf("rule %d expected %v, received %v", i, testCases[i].err, err)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = seedDB()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			runTester(t)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func runTester(t *testing.T) {
+	results := Run([]database.RetentionRule{
+		{
+			Table:              database.RetentionTableTrade,
+			MaxAge:             time.Hour * 24 * 7,
+			DownsampleInterval: 3600,
+		},
+		{
+			Table:              database.RetentionTableCandle,
+			Exchange:           "two",
+			Interval:           3600,
+			MaxAge:             time.Hour * 24,
+			DownsampleInterval: 86400,
+		},
+		{
+			Table: "orders",
+		},
+	}, testNow)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, received %v", len(results))
+	}
+
+	// trades are aggregated into hourly candles and removed, the recent
+	// trade is kept
+	if results[0].Err != nil {
+		t.Fatal(results[0].Err)
+	}
+	if results[0].Deleted != 121 || results[0].Downsampled != 3 {
+		t.Errorf("expected 121 trades deleted and 3 candles saved, received %v and %v",
+			results[0].Deleted,
+			results[0].Downsampled)
+	}
+	if !results[0].Cutoff.Equal(time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected cutoff %v", results[0].Cutoff)
+	}
+	remaining, err := trade.GetInRange("one", "spot", "BTC", "USD", testStart, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(remaining) != 1 {
+		t.Errorf("expected 1 trade to remain, received %v", len(remaining))
+	}
+	hourly, err := candle.Series("one", "BTC", "USD", 3600, "spot", testStart, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hourly.Candles) != 2 {
+		t.Fatalf("expected 2 hourly candles, received %v", len(hourly.Candles))
+	}
+	c := hourly.Candles[0]
+	if c.Open != 100 || c.High != 159 || c.Low != 100 || c.Close != 159 || c.Volume != 30 {
+		t.Errorf("unexpected downsampled candle %+v", c)
+	}
+
+	// a trade just before the cutoff is downsampled before it is deleted
+	edge, err := candle.Series("one", "ETH", "USD", 3600, "spot", testStart, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(edge.Candles) != 1 || edge.Candles[0].Volume != 2 {
+		t.Errorf("expected the trade before the cutoff to be downsampled, received %+v", edge.Candles)
+	}
+
+	// hourly candles on exchange two are aggregated into daily candles
+	if results[1].Err != nil {
+		t.Fatal(results[1].Err)
+	}
+	if results[1].Deleted != 48 || results[1].Downsampled != 2 {
+		t.Errorf("expected 48 candles deleted and 2 candles saved, received %v and %v",
+			results[1].Deleted,
+			results[1].Downsampled)
+	}
+	daily, err := candle.Series("two", "BTC", "USD", 86400, "spot", testStart, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(daily.Candles) != 2 {
+		t.Fatalf("expected 2 daily candles, received %v", len(daily.Candles))
+	}
+	c = daily.Candles[1]
+	if c.Open != 24 || c.High != 48 || c.Low != 23 || c.Close != 47 || c.Volume != 24 {
+		t.Errorf("unexpected downsampled candle %+v", c)
+	}
+
+	if !errors.Is(results[2].Err, errUnsupportedTable) {
+		t.Errorf("expected %v, received %v", errUnsupportedTable, results[2].Err)
+	}
+
+	// rules without downsampling only delete
+	result := Apply(&database.RetentionRule{
+		Table:    database.RetentionTableCandle,
+		Exchange: "one",
+		Interval: 3600,
+		MaxAge:   time.Hour,
+	}, testNow)
+	if result.Err != nil {
+		t.Fatal(result.Err)
+	}
+	if result.Deleted != 3 || result.Downsampled != 0 {
+		t.Errorf("expected 3 candles deleted and none saved, received %v and %v",
+			result.Deleted,
+			result.Downsampled)
+	}
+	result = Apply(&database.RetentionRule{
+		Table:  database.RetentionTableTrade,
+		MaxAge: time.Hour,
+	}, testNow)
+	if result.Err != nil {
+		t.Fatal(result.Err)
+	}
+	if result.Deleted != 0 {
+		t.Errorf("expected no trades deleted, received %v", result.Deleted)
+	}
+}
+
+func seedDB() error {
+	err := exchange.InsertMany([]exchange.Details{{Name: "one"}, {Name: "two"}})
+	if err != nil {
+		return err
+	}
+	exchange.ResetExchangeCache()
+
+	var trades []trade.Data
+	for i := 0; i < 120; i++ {
+		trades = append(trades, trade.Data{
+			TID:       strconv.Itoa(i),
+			Exchange:  "one",
+			Base:      "BTC",
+			Quote:     "USD",
+			AssetType: "spot",
+			Price:     float64(100 + i%60 + (i/60)*1000),
+			Amount:    0.5,
+			Side:      "buy",
+			Timestamp: testStart.Add(time.Minute * time.Duration(i)),
+		})
+	}
+	trades = append(trades, trade.Data{
+		TID:       "recent",
+		Exchange:  "one",
+		Base:      "BTC",
+		Quote:     "USD",
+		AssetType: "spot",
+		Price:     100,
+		Amount:    1,
+		Timestamp: testNow.Add(-time.Hour),
+	}, trade.Data{
+		TID:       "edge",
+		Exchange:  "one",
+		Base:      "ETH",
+		Quote:     "USD",
+		AssetType: "spot",
+		Price:     10,
+		Amount:    2,
+		Timestamp: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC).Add(-time.Millisecond * 500),
+	})
+	err = trade.Insert(trades...)
+	if err != nil {
+		return err
+	}
+
+	exchangeUUID, err := exchange.UUIDByName("two")
+	if err != nil {
+		return err
+	}
+	item := candle.Item{
+		ExchangeID: exchangeUUID.String(),
+		Base:       "BTC",
+		Quote:      "USD",
+		Interval:   3600,
+		Asset:      "spot",
+	}
+	for i := 0; i < 48; i++ {
+		item.Candles = append(item.Candles, candle.Candle{
+			Timestamp: testStart.Add(time.Hour * time.Duration(i)),
+			Open:      float64(i),
+			High:      float64(i + 1),
+			Low:       float64(i - 1),
+			Close:     float64(i),
+			Volume:    1,
+		})
+	}
+	_, err = candle.Insert(&item)
+	return err
+}
@@ -0,0 +1,36 @@
+package retention
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+const (
+	// streamBatchSize is the number of rows loaded at a time while
+	// downsampling
+	streamBatchSize = 5000
+	// insertBatchSize is the number of downsampled candles saved per
+	// transaction
+	insertBatchSize = 1000
+)
+
+var (
+	errUnsupportedTable          = errors.New("unsupported retention table")
+	errInvalidMaxAge             = errors.New("retention max age must be greater than zero")
+	errInvalidDownsampleInterval = errors.New("downsample interval must be a positive multiple of the candle interval")
+	errCandleIntervalUnset       = errors.New("candle interval must be set to downsample candles")
+)
+
+// Result holds the outcome of applying a single retention rule
+type Result struct {
+	Rule database.RetentionRule
+	// Cutoff is the time rows were removed before
+	Cutoff time.Time
+	// Deleted is the number of rows removed
+	Deleted int64
+	// Downsampled is the number of candles saved from the removed rows
+	Downsampled uint64
+	Err         error
+}
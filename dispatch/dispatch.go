@@ -57,6 +57,21 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// QueueDepth returns the number of jobs waiting for a worker and the jobs
+// limit, both are zero when the dispatch service is not running
+func QueueDepth() (jobs, limit int) {
+	if dispatcher == nil {
+		return 0, 0
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	if !dispatcher.isRunning() {
+		return 0, 0
+	}
+	return len(dispatcher.jobs), cap(dispatcher.jobs)
+}
+
 // DropWorker drops a worker routine
 func DropWorker() error {
 	if dispatcher == nil {
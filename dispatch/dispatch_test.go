@@ -308,3 +308,17 @@ func BenchmarkSubscribe(b *testing.B) {
 		}
 	}
 }
+
+func TestQueueDepth(t *testing.T) {
+	if !IsRunning() {
+		err := Start(DefaultMaxWorkers, DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	jobs, limit := QueueDepth()
+	if jobs < 0 || limit != DefaultJobsLimit {
+		t.Errorf("expected a jobs limit of %d, received %d jobs and %d limit",
+			DefaultJobsLimit, jobs, limit)
+	}
+}
@@ -0,0 +1,201 @@
+package engine
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/balance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// vars for the balance snapshot manager
+var (
+	BalanceSnapshotDelay = time.Hour
+)
+
+type balanceSnapshotManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+}
+
+func (b *balanceSnapshotManager) Started() bool {
+	return atomic.LoadInt32(&b.started) == 1
+}
+
+func (b *balanceSnapshotManager) Start() error {
+	if atomic.AddInt32(&b.started, 1) != 1 {
+		return errors.New("balance snapshot manager already started")
+	}
+
+	log.Debugln(log.PortfolioMgr, "Balance snapshot manager starting...")
+	b.shutdown = make(chan struct{})
+	go b.run()
+	return nil
+}
+
+func (b *balanceSnapshotManager) Stop() error {
+	if atomic.AddInt32(&b.stopped, 1) != 1 {
+		return errors.New("balance snapshot manager is already stopped")
+	}
+
+	log.Debugln(log.PortfolioMgr, "Balance snapshot manager shutting down...")
+	close(b.shutdown)
+	return nil
+}
+
+func (b *balanceSnapshotManager) run() {
+	log.Debugln(log.PortfolioMgr, "Balance snapshot manager started.")
+	Bot.ServicesWG.Add(1)
+	tick := time.NewTicker(Bot.Settings.BalanceSnapshotDelay)
+	defer func() {
+		atomic.CompareAndSwapInt32(&b.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&b.started, 1, 0)
+		tick.Stop()
+		Bot.ServicesWG.Done()
+		log.Debugf(log.PortfolioMgr, "Balance snapshot manager shutdown.")
+	}()
+
+	b.processSnapshots()
+	for {
+		select {
+		case <-b.shutdown:
+			return
+		case <-tick.C:
+			b.processSnapshots()
+		}
+	}
+}
+
+func (b *balanceSnapshotManager) processSnapshots() {
+	if !Bot.Config.Database.Enabled || database.DB.SQL == nil {
+		log.Warnln(log.PortfolioMgr,
+			"Balance snapshot manager: database support is disabled, skipping snapshot")
+		return
+	}
+	snapshots := buildBalanceSnapshots(Bot.GetAllEnabledExchangeAccountInfo().Data,
+		Bot.Config.Currency.FiatDisplayCurrency,
+		time.Now())
+	if len(snapshots) == 0 {
+		return
+	}
+	err := balance.Insert(snapshots...)
+	if err != nil {
+		log.Errorf(log.PortfolioMgr,
+			"Balance snapshot manager: unable to save %d snapshots: %s\n",
+			len(snapshots),
+			err)
+		return
+	}
+	log.Debugf(log.PortfolioMgr,
+		"Balance snapshot manager: Successfully saved %d balance snapshots\n",
+		len(snapshots))
+}
+
+// buildBalanceSnapshots converts exchange holdings into balance snapshots
+// valued in the reporting currency, timestamps are truncated to the second so
+// snapshots taken in the same pass share a timestamp
+func buildBalanceSnapshots(holdings []account.Holdings, reporting currency.Code, ts time.Time) []balance.Snapshot {
+	ts = ts.UTC().Truncate(time.Second)
+	var snapshots []balance.Snapshot
+	for x := range holdings {
+		for y := range holdings[x].Accounts {
+			subAccount := &holdings[x].Accounts[y]
+			for z := range subAccount.Currencies {
+				bal := &subAccount.Currencies[z]
+				if bal.TotalValue == 0 && bal.Hold == 0 {
+					continue
+				}
+				value, valued := valueBalance(holdings[x].Exchange,
+					bal.CurrencyName,
+					bal.TotalValue,
+					reporting)
+				if !valued {
+					log.Debugf(log.PortfolioMgr,
+						"Balance snapshot manager: no %s conversion rate available for %s %s\n",
+						reporting,
+						holdings[x].Exchange,
+						bal.CurrencyName)
+				}
+				snapshots = append(snapshots, balance.Snapshot{
+					Exchange:          holdings[x].Exchange,
+					AccountID:         subAccount.ID,
+					Asset:             subAccount.AssetType.String(),
+					Currency:          bal.CurrencyName.String(),
+					Total:             bal.TotalValue,
+					Hold:              bal.Hold,
+					ReportingCurrency: reporting.String(),
+					ReportingValue:    value,
+					Valued:            valued,
+					Timestamp:         ts,
+				})
+			}
+		}
+	}
+	return snapshots
+}
+
+// valueBalance returns the value of an amount of a currency in the reporting
+// currency. Fiat amounts are converted with the forex rates held by the
+// currency storage, cryptocurrency amounts are priced from the exchange's own
+// spot tickers quoted in the reporting currency, USD or USDT with the USD
+// value then converted to the reporting currency, the next quote is tried when
+// that conversion fails. It returns false when no rate is available
+func valueBalance(exchName string, code currency.Code, amount float64, reporting currency.Code) (float64, bool) {
+	if code.Match(reporting) {
+		return amount, true
+	}
+	if amount == 0 {
+		return 0, true
+	}
+	if code.IsFiatCurrency() && reporting.IsFiatCurrency() {
+		value, err := currency.ConvertCurrency(amount, code, reporting)
+		if err != nil {
+			return 0, false
+		}
+		return value, true
+	}
+
+	for _, quote := range []currency.Code{reporting, currency.USD, currency.USDT} {
+		t, err := ticker.GetTicker(exchName, currency.NewPair(code, quote), asset.Spot)
+		if err != nil || t.Last <= 0 {
+			continue
+		}
+		value := amount * t.Last
+		if quote.Match(reporting) {
+			return value, true
+		}
+		if converted, ok := convertUSDValue(exchName, value, quote, reporting); ok {
+			return converted, true
+		}
+	}
+	return 0, false
+}
+
+// convertUSDValue converts a value quoted in USD or USDT to the reporting
+// currency. Fiat reporting currencies use the forex rates held by the currency
+// storage, cryptocurrency reporting currencies are priced from the exchange's
+// spot ticker of the reporting currency in the same quote
+func convertUSDValue(exchName string, value float64, quote, reporting currency.Code) (float64, bool) {
+	if reporting.Match(currency.USD) {
+		return value, true
+	}
+	if reporting.IsFiatCurrency() {
+		converted, err := currency.ConvertCurrency(value, currency.USD, reporting)
+		if err != nil {
+			return 0, false
+		}
+		return converted, true
+	}
+	t, err := ticker.GetTicker(exchName, currency.NewPair(reporting, quote), asset.Spot)
+	if err != nil || t.Last <= 0 {
+		return 0, false
+	}
+	return value / t.Last, true
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const balanceSnapshotTestExchange = "balancesnapshottest"
+
+func TestValueBalance(t *testing.T) {
+	v, ok := valueBalance(balanceSnapshotTestExchange, currency.USD, 100, currency.USD)
+	if !ok || v != 100 {
+		t.Errorf("expected reporting currency to be valued at 100, received %v %v", v, ok)
+	}
+
+	_, ok = valueBalance(balanceSnapshotTestExchange, currency.LTC, 100, currency.USD)
+	if ok {
+		t.Error("expected balance without a ticker to be unvalued")
+	}
+
+	err := ticker.ProcessTicker(&ticker.Price{
+		Pair:         currency.NewPair(currency.LTC, currency.USDT),
+		ExchangeName: balanceSnapshotTestExchange,
+		AssetType:    asset.Spot,
+		Last:         50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, ok = valueBalance(balanceSnapshotTestExchange, currency.LTC, 2, currency.USD)
+	if !ok || v != 100 {
+		t.Errorf("expected LTC to be valued at 100, received %v %v", v, ok)
+	}
+
+	err = ticker.ProcessTicker(&ticker.Price{
+		Pair:         currency.NewPair(currency.LTC, currency.EUR),
+		ExchangeName: balanceSnapshotTestExchange,
+		AssetType:    asset.Spot,
+		Last:         40,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, ok = valueBalance(balanceSnapshotTestExchange, currency.LTC, 2, currency.EUR)
+	if !ok || v != 80 {
+		t.Errorf("expected LTC to be valued at 80 EUR, received %v %v", v, ok)
+	}
+
+	_, ok = valueBalance(balanceSnapshotTestExchange, currency.LTC, 2, currency.BTC)
+	if ok {
+		t.Error("expected balance without a reporting currency ticker to be unvalued")
+	}
+	err = ticker.ProcessTicker(&ticker.Price{
+		Pair:         currency.NewPair(currency.BTC, currency.USDT),
+		ExchangeName: balanceSnapshotTestExchange,
+		AssetType:    asset.Spot,
+		Last:         20000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, ok = valueBalance(balanceSnapshotTestExchange, currency.LTC, 2, currency.BTC)
+	if !ok || v != 0.005 {
+		t.Errorf("expected LTC to be valued at 0.005 BTC, received %v %v", v, ok)
+	}
+}
+
+func TestBuildBalanceSnapshots(t *testing.T) {
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 500, time.UTC)
+	snapshots := buildBalanceSnapshots([]account.Holdings{
+		{
+			Exchange: balanceSnapshotTestExchange,
+			Accounts: []account.SubAccount{
+				{
+					ID:        "main",
+					AssetType: asset.Spot,
+					Currencies: []account.Balance{
+						{CurrencyName: currency.USD, TotalValue: 10, Hold: 1},
+						{CurrencyName: currency.BTC},
+						{CurrencyName: currency.XRP, TotalValue: 5},
+					},
+				},
+			},
+		},
+	}, currency.USD, ts)
+	if len(snapshots) != 2 {
+		t.Fatalf("expected empty balances to be skipped, received %v snapshots", len(snapshots))
+	}
+	if !snapshots[0].Valued ||
+		snapshots[0].ReportingValue != 10 ||
+		snapshots[0].AccountID != "main" ||
+		snapshots[0].Asset != "spot" ||
+		!snapshots[0].Timestamp.Equal(ts.Truncate(time.Second)) {
+		t.Errorf("unexpected snapshot %+v", snapshots[0])
+	}
+	if snapshots[1].Valued {
+		t.Error("expected XRP snapshot without a ticker to be unvalued")
+	}
+}
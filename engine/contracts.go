@@ -0,0 +1,213 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// DefaultFuturesRolloverWindow is how long before expiry open futures
+// positions are rolled to the next contract
+const DefaultFuturesRolloverWindow = time.Hour * 24
+
+// OrderManagerContractDelay is the delay between contract metadata updates
+var OrderManagerContractDelay = time.Hour
+
+var (
+	errPositionSizeInvalid = errors.New("position size must be above zero")
+	errRollReopenFailed    = errors.New("position closed but not reopened")
+)
+
+// processContracts refreshes the contract metadata of the derivative assets
+// of all loaded exchanges, rolling aliased pairs and, when enabled, open
+// positions which are about to expire
+func (o *orderManager) processContracts() {
+	exchanges := Bot.GetExchanges()
+	for x := range exchanges {
+		assets := exchanges[x].GetAssetTypes()
+		for y := range assets {
+			if !assets[y].IsDerivative() {
+				continue
+			}
+			rolls, err := exchanges[x].UpdateContracts(assets[y])
+			if err != nil {
+				if !errors.Is(err, common.ErrFunctionNotSupported) {
+					log.Warnf(log.OrderMgr,
+						"Order manager: Unable to update %s %s contracts: %s",
+						exchanges[x].GetName(),
+						assets[y],
+						err)
+				}
+				continue
+			}
+			if len(rolls) > 0 {
+				o.followRolls(exchanges[x], rolls)
+			}
+			for z := range rolls {
+				Bot.CommsManager.PushEvent(base.Event{
+					Type: "contract",
+					Message: fmt.Sprintf("Exchange %s rolled enabled %s %s contract %s to %s.",
+						exchanges[x].GetName(),
+						assets[y],
+						strings.ToLower(rolls[z].Type.String()),
+						rolls[z].From,
+						rolls[z].To),
+				})
+			}
+			if !Bot.Settings.RollFuturesPositions ||
+				!exchanges[x].GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+				continue
+			}
+			o.rollPositions(exchanges[x], assets[y], time.Now())
+		}
+	}
+}
+
+// followRolls moves the websocket subscriptions and syncer to the contracts
+// enabled pairs were rolled to
+func (o *orderManager) followRolls(exch exchange.IBotExchange, rolls []contract.Roll) {
+	if exch.IsWebsocketEnabled() {
+		err := exch.FlushWebsocketChannels()
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Order manager: Unable to flush %s websocket subscriptions after a contract roll: %s",
+				exch.GetName(),
+				err)
+		}
+	}
+	if !Bot.Settings.EnableExchangeSyncManager || Bot.ExchangeCurrencyPairManager == nil {
+		return
+	}
+	for i := range rolls {
+		Bot.ExchangeCurrencyPairManager.addPair(exch, rolls[i].To, rolls[i].Asset)
+	}
+}
+
+// rollPositions rolls the open positions of an asset whose contract expires
+// within the rollover window
+func (o *orderManager) rollPositions(exch exchange.IBotExchange, a asset.Item, now time.Time) {
+	positions, err := exch.GetDerivativePositions(a)
+	if err != nil {
+		if !errors.Is(err, common.ErrFunctionNotSupported) {
+			log.Warnf(log.OrderMgr,
+				"Order manager: Unable to get %s %s positions: %s",
+				exch.GetName(),
+				a,
+				err)
+		}
+		return
+	}
+	for i := range positions {
+		c, err := exch.GetContract(a, positions[i].Pair)
+		if err != nil {
+			continue
+		}
+		if c.Type == contract.Perpetual ||
+			c.Expiry.Sub(now) > Bot.Settings.FuturesRolloverWindow {
+			continue
+		}
+		next, err := o.rollPosition(exch, &positions[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Order manager: Unable to roll %s %s position %s: %s",
+				exch.GetName(),
+				a,
+				positions[i].Pair,
+				err)
+			continue
+		}
+		msg := fmt.Sprintf("Order manager: Exchange %s rolled %s %s position size=%v from %s expiring %s to %s.",
+			exch.GetName(),
+			a,
+			positions[i].Side,
+			positions[i].Size,
+			positions[i].Pair,
+			c.Expiry.Format(common.SimpleTimeFormat),
+			next.Pair)
+		log.Infoln(log.OrderMgr, msg)
+		Bot.CommsManager.PushEvent(base.Event{
+			Type:    "position",
+			Message: msg,
+		})
+	}
+}
+
+// rollPosition closes a position at market and reopens the same side and
+// size in the contract it rolls over to. Both orders are sent through the
+// order manager and the reopening order is validated before the position is
+// closed
+func (o *orderManager) rollPosition(exch exchange.IBotExchange, p *position.Position) (contract.Contract, error) {
+	if p.Size <= 0 {
+		return contract.Contract{}, errPositionSizeInvalid
+	}
+	var side order.Side
+	switch p.Side {
+	case order.Long:
+		side = order.Buy
+	case order.Short:
+		side = order.Sell
+	default:
+		return contract.Contract{}, fmt.Errorf("%w %s", order.ErrSideIsInvalid, p.Side)
+	}
+	next, err := exch.GetRolloverContract(p.Asset, p.Pair)
+	if err != nil {
+		return contract.Contract{}, err
+	}
+	closing, err := position.CloseOrder([]position.Position{*p}, &position.Close{
+		Exchange: exch.GetName(),
+		Pair:     p.Pair,
+		Asset:    p.Asset,
+		Side:     p.Side,
+	})
+	if err != nil {
+		return contract.Contract{}, err
+	}
+	opening := &order.Submit{
+		Exchange:  exch.GetName(),
+		Pair:      next.Pair,
+		AssetType: p.Asset,
+		Side:      side,
+		Type:      order.Market,
+		Amount:    p.Size,
+		Leverage:  p.Leverage,
+		Offset:    "open",
+	}
+	if p.Hedged {
+		opening.PositionSide = p.Side
+	}
+	_, err = o.validate(opening)
+	if err != nil {
+		return contract.Contract{}, fmt.Errorf("%s cannot be opened: %w", next.Pair, err)
+	}
+	_, err = o.Submit(closing)
+	if err != nil {
+		return contract.Contract{}, err
+	}
+	_, err = o.Submit(opening)
+	if err != nil {
+		err = fmt.Errorf("%w, %s could not be opened: %v", errRollReopenFailed, next.Pair, err)
+		Bot.CommsManager.PushEvent(base.Event{
+			Type: "position",
+			Message: fmt.Sprintf("Order manager: Exchange %s closed %s %s position size=%v in %s but the roll to %s failed: %s",
+				exch.GetName(),
+				p.Asset,
+				p.Side,
+				p.Size,
+				p.Pair,
+				next.Pair,
+				err),
+		})
+		return contract.Contract{}, err
+	}
+	return next, nil
+}
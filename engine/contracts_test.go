@@ -0,0 +1,273 @@
+package engine
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
+)
+
+const fakeRollExchange = "FakeRollExchange"
+
+var (
+	rollThisQuarter = currency.NewPairWithDelimiter("BTC-USD", "210625", currency.UnderscoreDelimiter)
+	rollNextQuarter = currency.NewPairWithDelimiter("BTC-USD", "210924", currency.UnderscoreDelimiter)
+	rollJune        = time.Date(2021, 6, 25, 8, 0, 0, 0, time.UTC)
+)
+
+var errFakeRejected = errors.New("order rejected")
+
+// fakeRollingExchange records submitted orders so rolled positions can be
+// verified, orders for the reject pair fail
+type fakeRollingExchange struct {
+	FakePassingExchange
+	positions []position.Position
+	submitted []order.Submit
+	reject    currency.Pair
+	rolls     []contract.Roll
+	flushed   int
+}
+
+func (f *fakeRollingExchange) GetName() string { return fakeRollExchange }
+
+func (f *fakeRollingExchange) UpdateContracts(_ asset.Item) ([]contract.Roll, error) {
+	return f.rolls, nil
+}
+
+func (f *fakeRollingExchange) GetAssetTypes() asset.Items {
+	return f.CurrencyPairs.GetAssetTypes()
+}
+
+func (f *fakeRollingExchange) IsWebsocketEnabled() bool { return true }
+
+func (f *fakeRollingExchange) FlushWebsocketChannels() error {
+	f.flushed++
+	return nil
+}
+
+func (f *fakeRollingExchange) GetDerivativePositions(_ asset.Item) ([]position.Position, error) {
+	return f.positions, nil
+}
+
+func (f *fakeRollingExchange) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+	if !f.reject.IsEmpty() && s.Pair.Equal(f.reject) {
+		return order.SubmitResponse{}, errFakeRejected
+	}
+	f.submitted = append(f.submitted, *s)
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		FullyMatched:  true,
+		OrderID:       s.Pair.String() + s.Side.String(),
+	}, nil
+}
+
+func setupRollingExchange(t *testing.T) *fakeRollingExchange {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	f := &fakeRollingExchange{
+		FakePassingExchange: FakePassingExchange{Base: exchange.Base{Name: fakeRollExchange}},
+	}
+	f.CurrencyPairs.StorePairs(asset.Futures, currency.Pairs{rollThisQuarter, rollNextQuarter}, false)
+	contracts := []contract.Contract{
+		{
+			Pair:               rollThisQuarter,
+			Asset:              asset.Futures,
+			Underlying:         currency.NewPair(currency.BTC, currency.USD),
+			Type:               contract.ThisQuarter,
+			Expiry:             rollJune,
+			SettlementCurrency: currency.BTC,
+		},
+		{
+			Pair:               rollNextQuarter,
+			Asset:              asset.Futures,
+			Underlying:         currency.NewPair(currency.BTC, currency.USD),
+			Type:               contract.NextQuarter,
+			Expiry:             rollJune.AddDate(0, 3, 0),
+			SettlementCurrency: currency.BTC,
+		},
+	}
+	_, err := f.LoadContracts(asset.Futures, contracts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	Bot.exchangeManager.add(f)
+	return f
+}
+
+func TestRollPosition(t *testing.T) {
+	f := setupRollingExchange(t)
+	defer func() {
+		_ = Bot.exchangeManager.removeExchange(fakeRollExchange)
+	}()
+	var o orderManager
+	o.orderStore.Orders = make(map[string][]*order.Detail)
+
+	_, err := o.rollPosition(f, &position.Position{Pair: rollThisQuarter, Asset: asset.Futures, Side: order.Long})
+	if !errors.Is(err, errPositionSizeInvalid) {
+		t.Errorf("received %v, expected %v", err, errPositionSizeInvalid)
+	}
+	_, err = o.rollPosition(f, &position.Position{Pair: rollThisQuarter, Asset: asset.Futures, Side: order.Buy, Size: 1})
+	if !errors.Is(err, order.ErrSideIsInvalid) {
+		t.Errorf("received %v, expected %v", err, order.ErrSideIsInvalid)
+	}
+	_, err = o.rollPosition(f, &position.Position{Pair: rollNextQuarter, Asset: asset.Futures, Side: order.Long, Size: 1})
+	if !errors.Is(err, contract.ErrNoRolloverContract) {
+		t.Errorf("received %v, expected %v", err, contract.ErrNoRolloverContract)
+	}
+
+	next, err := o.rollPosition(f, &position.Position{
+		Pair:   rollThisQuarter,
+		Asset:  asset.Futures,
+		Side:   order.Short,
+		Size:   5,
+		Hedged: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.Pair.Equal(rollNextQuarter) {
+		t.Errorf("received %s, expected %s", next.Pair, rollNextQuarter)
+	}
+	if len(f.submitted) != 2 {
+		t.Fatalf("received %d submitted orders, expected 2", len(f.submitted))
+	}
+	closing, opening := f.submitted[0], f.submitted[1]
+	if !closing.Pair.Equal(rollThisQuarter) ||
+		closing.Side != order.Buy ||
+		closing.PositionSide != order.Short ||
+		!closing.ReduceOnly ||
+		closing.Amount != 5 {
+		t.Errorf("unexpected closing order %+v", closing)
+	}
+	if !opening.Pair.Equal(rollNextQuarter) ||
+		opening.Side != order.Sell ||
+		opening.PositionSide != order.Short ||
+		opening.ReduceOnly ||
+		opening.Amount != 5 {
+		t.Errorf("unexpected rollover order %+v", opening)
+	}
+	if orders := o.orderStore.get()[strings.ToLower(fakeRollExchange)]; len(orders) != 2 {
+		t.Fatalf("received %d tracked orders, expected 2", len(orders))
+	}
+
+	f.submitted = nil
+	f.reject = rollNextQuarter
+	_, err = o.rollPosition(f, &position.Position{
+		Pair:  rollThisQuarter,
+		Asset: asset.Futures,
+		Side:  order.Long,
+		Size:  1,
+	})
+	if !errors.Is(err, errRollReopenFailed) {
+		t.Fatalf("received %v, expected %v", err, errRollReopenFailed)
+	}
+	if len(f.submitted) != 1 || f.submitted[0].Side != order.Sell {
+		t.Errorf("expected only the closing order to be submitted, received %+v", f.submitted)
+	}
+
+	f.submitted = nil
+	o.cfg.EnforceLimitConfig = true
+	o.cfg.AllowedPairs = currency.Pairs{rollThisQuarter}
+	_, err = o.rollPosition(f, &position.Position{
+		Pair:  rollThisQuarter,
+		Asset: asset.Futures,
+		Side:  order.Long,
+		Size:  1,
+	})
+	if err == nil {
+		t.Fatal("expected an error when the rollover order is not allowed")
+	}
+	if len(f.submitted) != 0 {
+		t.Errorf("expected no orders submitted when the rollover order is not allowed, received %+v", f.submitted)
+	}
+}
+
+func TestRollPositions(t *testing.T) {
+	f := setupRollingExchange(t)
+	defer func() {
+		_ = Bot.exchangeManager.removeExchange(fakeRollExchange)
+	}()
+	window := Bot.Settings.FuturesRolloverWindow
+	Bot.Settings.FuturesRolloverWindow = DefaultFuturesRolloverWindow
+	defer func() {
+		Bot.Settings.FuturesRolloverWindow = window
+	}()
+	var o orderManager
+	o.orderStore.Orders = make(map[string][]*order.Detail)
+	f.positions = []position.Position{
+		{Pair: rollThisQuarter, Asset: asset.Futures, Side: order.Long, Size: 1},
+		{Pair: rollNextQuarter, Asset: asset.Futures, Side: order.Long, Size: 2},
+	}
+
+	o.rollPositions(f, asset.Futures, rollJune.Add(-DefaultFuturesRolloverWindow*2))
+	if len(f.submitted) != 0 {
+		t.Fatalf("expected no positions rolled outside the window, received %v", f.submitted)
+	}
+
+	o.rollPositions(f, asset.Futures, rollJune.Add(-time.Hour))
+	if len(f.submitted) != 2 ||
+		!f.submitted[0].Pair.Equal(rollThisQuarter) ||
+		!f.submitted[1].Pair.Equal(rollNextQuarter) {
+		t.Errorf("unexpected submitted orders %v", f.submitted)
+	}
+	if orders := o.orderStore.get()[strings.ToLower(fakeRollExchange)]; len(orders) != 2 {
+		t.Errorf("received %d orders, expected 2", len(orders))
+	}
+}
+
+func TestProcessContractsFollowsRolls(t *testing.T) {
+	f := setupRollingExchange(t)
+	defer func() {
+		_ = Bot.exchangeManager.removeExchange(fakeRollExchange)
+	}()
+	syncManager, roll := Bot.Settings.EnableExchangeSyncManager, Bot.Settings.RollFuturesPositions
+	syncer := Bot.ExchangeCurrencyPairManager
+	defer func() {
+		Bot.Settings.EnableExchangeSyncManager = syncManager
+		Bot.Settings.RollFuturesPositions = roll
+		Bot.ExchangeCurrencyPairManager = syncer
+	}()
+	Bot.Settings.EnableExchangeSyncManager = true
+	Bot.Settings.RollFuturesPositions = false
+	Bot.ExchangeCurrencyPairManager = &ExchangeCurrencyPairSyncer{
+		Cfg:               CurrencyPairSyncerConfig{SyncTicker: true},
+		initSyncCompleted: 1,
+	}
+	var o orderManager
+
+	o.processContracts()
+	if f.flushed != 0 {
+		t.Error("websocket should not be flushed without a roll")
+	}
+
+	f.rolls = []contract.Roll{{
+		Asset: asset.Futures,
+		Type:  contract.ThisQuarter,
+		From:  rollThisQuarter,
+		To:    rollNextQuarter,
+	}}
+	o.processContracts()
+	if f.flushed != 1 {
+		t.Errorf("expected the websocket to be flushed once after a roll, received %d", f.flushed)
+	}
+	c, err := Bot.ExchangeCurrencyPairManager.get(fakeRollExchange, rollNextQuarter, asset.Futures)
+	if err != nil {
+		t.Fatal("expected the rolled contract to be synced", err)
+	}
+	if !c.Ticker.IsUsingREST && !c.Ticker.IsUsingWebsocket {
+		t.Error("expected the rolled contract ticker to be synced")
+	}
+
+	o.processContracts()
+	if n := len(Bot.ExchangeCurrencyPairManager.CurrencyPairs); n != 1 {
+		t.Errorf("expected a single sync agent for the rolled contract, received %d", n)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	dbpsql "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/database/retention"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 )
@@ -100,6 +102,23 @@ func (a *databaseManager) run(bot *Engine) {
 
 	t := time.NewTicker(time.Second * 2)
 
+	// retention rules are applied on their own schedule, a nil channel is
+	// never selected when retention is disabled
+	var retentionC <-chan time.Time
+	if cfg := bot.Config.Database.Retention; cfg != nil && cfg.Enabled {
+		interval := cfg.Interval
+		if interval <= 0 {
+			interval = database.DefaultRetentionInterval
+		}
+		rt := time.NewTicker(interval)
+		defer rt.Stop()
+		retentionC = rt.C
+		log.Debugf(log.DatabaseMgr,
+			"Database manager: applying %d retention rules every %s\n",
+			len(cfg.Rules),
+			interval)
+	}
+
 	defer func() {
 		t.Stop()
 		atomic.CompareAndSwapInt32(&a.stopped, 1, 0)
@@ -116,7 +135,43 @@ func (a *databaseManager) run(bot *Engine) {
 			return
 		case <-t.C:
 			a.checkConnection()
+		case <-retentionC:
+			a.applyRetention(bot.Config.Database.Retention.Rules)
+		}
+	}
+}
+
+// applyRetention applies the configured retention rules and reports the
+// number of rows affected by each rule to the log and audit table
+func (a *databaseManager) applyRetention(rules []database.RetentionRule) {
+	if !dbConn.Connected {
+		log.Warnln(log.DatabaseMgr,
+			"Database manager: database is not connected, skipping retention")
+		return
+	}
+	results := retention.Run(rules, time.Now())
+	for i := range results {
+		r := &results[i]
+		desc := fmt.Sprintf("%s rule exchange: %q interval: %d max age: %s",
+			r.Rule.Table,
+			r.Rule.Exchange,
+			r.Rule.Interval,
+			r.Rule.MaxAge)
+		if r.Err != nil {
+			log.Errorf(log.DatabaseMgr,
+				"Database manager: retention %s failed: %v\n",
+				desc,
+				r.Err)
+			audit.Event("retention", "error", desc+" failed: "+r.Err.Error())
+			continue
 		}
+		msg := fmt.Sprintf("%s deleted %d rows before %s and saved %d downsampled candles",
+			desc,
+			r.Deleted,
+			r.Cutoff.Format(time.RFC3339),
+			r.Downsampled)
+		log.Infof(log.DatabaseMgr, "Database manager: retention %s\n", msg)
+		audit.Event("retention", "info", msg)
 	}
 }
 
@@ -15,10 +15,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/currency/coinmarketcap"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
@@ -31,17 +33,20 @@ type Engine struct {
 	Portfolio                   *portfolio.Base
 	ExchangeCurrencyPairManager *ExchangeCurrencyPairSyncer
 	NTPManager                  ntpManager
+	LatencyMonitor              latencyMonitor
 	ConnectionManager           connectionManager
 	DatabaseManager             databaseManager
 	GctScriptManager            *gctscript.GctScriptManager
 	OrderManager                orderManager
 	PortfolioManager            portfolioManager
+	BalanceSnapshotManager      balanceSnapshotManager
 	CommsManager                commsManager
 	exchangeManager             exchangeManager
 	DepositAddressManager       *DepositAddressManager
 	Settings                    Settings
 	Uptime                      time.Time
 	ServicesWG                  sync.WaitGroup
+	metricsCollectors           []metrics.Collector
 }
 
 // Vars for engine
@@ -144,6 +149,14 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 	b.Settings.EnableDispatcher = s.EnableDispatcher
 	b.Settings.EnablePortfolioManager = s.EnablePortfolioManager
 	b.Settings.WithdrawCacheSize = s.WithdrawCacheSize
+	b.Settings.EnableBalanceSnapshots = s.EnableBalanceSnapshots
+	if b.Settings.EnableBalanceSnapshots {
+		if s.BalanceSnapshotDelay > 0 {
+			b.Settings.BalanceSnapshotDelay = s.BalanceSnapshotDelay
+		} else {
+			b.Settings.BalanceSnapshotDelay = BalanceSnapshotDelay
+		}
+	}
 	if b.Settings.EnablePortfolioManager {
 		if b.Settings.PortfolioManagerDelay == time.Duration(0) && s.PortfolioManagerDelay > 0 {
 			b.Settings.PortfolioManagerDelay = s.PortfolioManagerDelay
@@ -199,10 +212,18 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 	b.Settings.EnableConnectivityMonitor = s.EnableConnectivityMonitor
 	b.Settings.EnableNTPClient = s.EnableNTPClient
 	b.Settings.EnableOrderManager = s.EnableOrderManager
+	b.Settings.RoundOrdersToExecutionLimits = s.RoundOrdersToExecutionLimits
+	b.Settings.RollFuturesPositions = s.RollFuturesPositions
+	if s.FuturesRolloverWindow > 0 {
+		b.Settings.FuturesRolloverWindow = s.FuturesRolloverWindow
+	} else {
+		b.Settings.FuturesRolloverWindow = DefaultFuturesRolloverWindow
+	}
 	b.Settings.EnableExchangeSyncManager = s.EnableExchangeSyncManager
 	b.Settings.EnableTickerSyncing = s.EnableTickerSyncing
 	b.Settings.EnableOrderbookSyncing = s.EnableOrderbookSyncing
 	b.Settings.EnableTradeSyncing = s.EnableTradeSyncing
+	b.Settings.EnableDerivativeSync = s.EnableDerivativeSync
 	b.Settings.SyncWorkers = s.SyncWorkers
 	b.Settings.SyncTimeout = s.SyncTimeout
 	b.Settings.SyncContinuously = s.SyncContinuously
@@ -235,6 +256,17 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 		}
 	}
 
+	b.Settings.LiquidationBufferProcessingInterval = s.LiquidationBufferProcessingInterval
+	if b.Settings.LiquidationBufferProcessingInterval != liquidation.DefaultProcessorIntervalTime {
+		if b.Settings.LiquidationBufferProcessingInterval >= time.Second {
+			liquidation.BufferProcessorIntervalTime = b.Settings.LiquidationBufferProcessingInterval
+		} else {
+			b.Settings.LiquidationBufferProcessingInterval = liquidation.DefaultProcessorIntervalTime
+			gctlog.Warnf(gctlog.Global, "-liquidationprocessinginterval must be >= to 1 second, using default value of %v",
+				liquidation.DefaultProcessorIntervalTime)
+		}
+	}
+
 	b.Settings.RequestMaxRetryAttempts = s.RequestMaxRetryAttempts
 	if b.Settings.RequestMaxRetryAttempts != request.DefaultMaxRetryAttempts && s.RequestMaxRetryAttempts > 0 {
 		request.MaxRetryAttempts = b.Settings.RequestMaxRetryAttempts
@@ -279,6 +311,8 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable coinmarketcap analaysis: %v", s.EnableCoinmarketcapAnalysis)
 	gctlog.Debugf(gctlog.Global, "\t Enable portfolio manager: %v", s.EnablePortfolioManager)
 	gctlog.Debugf(gctlog.Global, "\t Portfolio manager sleep delay: %v\n", s.PortfolioManagerDelay)
+	gctlog.Debugf(gctlog.Global, "\t Enable balance snapshots: %v", s.EnableBalanceSnapshots)
+	gctlog.Debugf(gctlog.Global, "\t Balance snapshot delay: %v", s.BalanceSnapshotDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable gPRC: %v", s.EnableGRPC)
 	gctlog.Debugf(gctlog.Global, "\t Enable gRPC Proxy: %v", s.EnableGRPCProxy)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket RPC: %v", s.EnableWebsocketRPC)
@@ -287,6 +321,9 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable event manager: %v", s.EnableEventManager)
 	gctlog.Debugf(gctlog.Global, "\t Event manager sleep delay: %v", s.EventManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable order manager: %v", s.EnableOrderManager)
+	gctlog.Debugf(gctlog.Global, "\t Round orders to execution limits: %v", s.RoundOrdersToExecutionLimits)
+	gctlog.Debugf(gctlog.Global, "\t Roll futures positions: %v", s.RollFuturesPositions)
+	gctlog.Debugf(gctlog.Global, "\t Futures rollover window: %v", s.FuturesRolloverWindow)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange sync manager: %v", s.EnableExchangeSyncManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
@@ -301,6 +338,7 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable ticker syncing: %v\n", s.EnableTickerSyncing)
 	gctlog.Debugf(gctlog.Global, "\t Enable orderbook syncing: %v\n", s.EnableOrderbookSyncing)
 	gctlog.Debugf(gctlog.Global, "\t Enable trade syncing: %v\n", s.EnableTradeSyncing)
+	gctlog.Debugf(gctlog.Global, "\t Enable derivative price syncing: %v\n", s.EnableDerivativeSync)
 	gctlog.Debugf(gctlog.Global, "\t Exchange sync timeout: %v\n", s.SyncTimeout)
 	gctlog.Debugf(gctlog.Global, "- FOREX SETTINGS:")
 	gctlog.Debugf(gctlog.Global, "\t Enable currency conveter: %v", s.EnableCurrencyConverter)
@@ -317,6 +355,7 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Max HTTP request jobs: %v", s.MaxHTTPRequestJobsLimit)
 	gctlog.Debugf(gctlog.Global, "\t HTTP request max retry attempts: %v", s.RequestMaxRetryAttempts)
 	gctlog.Debugf(gctlog.Global, "\t Trade buffer processing interval: %v", s.TradeBufferProcessingInterval)
+	gctlog.Debugf(gctlog.Global, "\t Liquidation buffer processing interval: %v", s.LiquidationBufferProcessingInterval)
 	gctlog.Debugf(gctlog.Global, "\t HTTP timeout: %v", s.HTTPTimeout)
 	gctlog.Debugf(gctlog.Global, "\t HTTP user agent: %v", s.HTTPUserAgent)
 	gctlog.Debugf(gctlog.Global, "- GCTSCRIPT SETTINGS: ")
@@ -338,6 +377,12 @@ func (bot *Engine) Start() error {
 		return errors.New("engine instance is nil")
 	}
 
+	if bot.Config.Metrics.Enabled {
+		if err := bot.startMetrics(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics unable to start: %v", err)
+		}
+	}
+
 	if bot.Settings.EnableDatabaseManager {
 		if err := bot.DatabaseManager.Start(bot); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to start: %v", err)
@@ -363,6 +408,12 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Config.LatencyMonitor.Enabled {
+		if err := bot.LatencyMonitor.Start(bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Latency monitor unable to start: %v", err)
+		}
+	}
+
 	bot.Uptime = time.Now()
 	gctlog.Debugf(gctlog.Global, "Bot '%s' started.\n", bot.Config.Name)
 	gctlog.Debugf(gctlog.Global, "Using data dir: %s\n", bot.Settings.DataDir)
@@ -439,6 +490,12 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableBalanceSnapshots {
+		if err = bot.BalanceSnapshotManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Balance snapshot manager unable to start: %v", err)
+		}
+	}
+
 	if bot.Settings.EnableDepositAddressManager {
 		bot.DepositAddressManager = new(DepositAddressManager)
 		go bot.DepositAddressManager.Sync()
@@ -455,6 +512,7 @@ func (bot *Engine) Start() error {
 			SyncTicker:       bot.Settings.EnableTickerSyncing,
 			SyncOrderbook:    bot.Settings.EnableOrderbookSyncing,
 			SyncTrades:       bot.Settings.EnableTradeSyncing,
+			SyncDerivatives:  bot.Settings.EnableDerivativeSync,
 			SyncContinuously: bot.Settings.SyncContinuously,
 			NumWorkers:       bot.Settings.SyncWorkers,
 			Verbose:          bot.Settings.Verbose,
@@ -511,6 +569,12 @@ func (bot *Engine) Stop() {
 		}
 	}
 
+	if bot.LatencyMonitor.Started() {
+		if err := bot.LatencyMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Latency monitor unable to stop. Error: %v", err)
+		}
+	}
+
 	if bot.CommsManager.Started() {
 		if err := bot.CommsManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Communication manager unable to stop. Error: %v", err)
@@ -523,6 +587,12 @@ func (bot *Engine) Stop() {
 		}
 	}
 
+	if bot.BalanceSnapshotManager.Started() {
+		if err := bot.BalanceSnapshotManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Balance snapshot manager unable to stop. Error: %v", err)
+		}
+	}
+
 	if bot.ConnectionManager.Started() {
 		if err := bot.ConnectionManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Connection manager unable to stop. Error: %v", err)
@@ -541,6 +611,8 @@ func (bot *Engine) Stop() {
 		}
 	}
 
+	bot.stopMetrics()
+
 	if err := currency.ShutdownStorageUpdater(); err != nil {
 		gctlog.Errorf(gctlog.Global, "Currency storage system. Error: %v", err)
 	}
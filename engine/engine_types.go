@@ -12,33 +12,39 @@ type Settings struct {
 	CheckParamInteraction bool
 
 	// Core Settings
-	EnableDryRun                bool
-	EnableAllExchanges          bool
-	EnableAllPairs              bool
-	EnableCoinmarketcapAnalysis bool
-	EnablePortfolioManager      bool
-	PortfolioManagerDelay       time.Duration
-	EnableGRPC                  bool
-	EnableGRPCProxy             bool
-	EnableWebsocketRPC          bool
-	EnableDeprecatedRPC         bool
-	EnableCommsRelayer          bool
-	EnableExchangeSyncManager   bool
-	EnableDepositAddressManager bool
-	EnableEventManager          bool
-	EnableOrderManager          bool
-	EnableConnectivityMonitor   bool
-	EnableDatabaseManager       bool
-	EnableGCTScriptManager      bool
-	EnableNTPClient             bool
-	EnableWebsocketRoutine      bool
-	EventManagerDelay           time.Duration
-	Verbose                     bool
+	EnableDryRun                 bool
+	EnableAllExchanges           bool
+	EnableAllPairs               bool
+	EnableCoinmarketcapAnalysis  bool
+	EnablePortfolioManager       bool
+	PortfolioManagerDelay        time.Duration
+	EnableBalanceSnapshots       bool
+	BalanceSnapshotDelay         time.Duration
+	EnableGRPC                   bool
+	EnableGRPCProxy              bool
+	EnableWebsocketRPC           bool
+	EnableDeprecatedRPC          bool
+	EnableCommsRelayer           bool
+	EnableExchangeSyncManager    bool
+	EnableDepositAddressManager  bool
+	EnableEventManager           bool
+	EnableOrderManager           bool
+	RoundOrdersToExecutionLimits bool
+	RollFuturesPositions         bool
+	FuturesRolloverWindow        time.Duration
+	EnableConnectivityMonitor    bool
+	EnableDatabaseManager        bool
+	EnableGCTScriptManager       bool
+	EnableNTPClient              bool
+	EnableWebsocketRoutine       bool
+	EventManagerDelay            time.Duration
+	Verbose                      bool
 
 	// Exchange syncer settings
 	EnableTickerSyncing    bool
 	EnableOrderbookSyncing bool
 	EnableTradeSyncing     bool
+	EnableDerivativeSync   bool
 	SyncWorkers            int
 	SyncContinuously       bool
 	SyncTimeout            time.Duration
@@ -50,17 +56,18 @@ type Settings struct {
 	EnableOpenExchangeRates bool
 
 	// Exchange tuning settings
-	EnableExchangeHTTPRateLimiter  bool
-	EnableExchangeHTTPDebugging    bool
-	EnableExchangeVerbose          bool
-	ExchangePurgeCredentials       bool
-	EnableExchangeAutoPairUpdates  bool
-	DisableExchangeAutoPairUpdates bool
-	EnableExchangeRESTSupport      bool
-	EnableExchangeWebsocketSupport bool
-	MaxHTTPRequestJobsLimit        int
-	TradeBufferProcessingInterval  time.Duration
-	RequestMaxRetryAttempts        int
+	EnableExchangeHTTPRateLimiter       bool
+	EnableExchangeHTTPDebugging         bool
+	EnableExchangeVerbose               bool
+	ExchangePurgeCredentials            bool
+	EnableExchangeAutoPairUpdates       bool
+	DisableExchangeAutoPairUpdates      bool
+	EnableExchangeRESTSupport           bool
+	EnableExchangeWebsocketSupport      bool
+	MaxHTTPRequestJobsLimit             int
+	TradeBufferProcessingInterval       time.Duration
+	LiquidationBufferProcessingInterval time.Duration
+	RequestMaxRetryAttempts             int
 
 	// Global HTTP related settings
 	GlobalHTTPTimeout   time.Duration
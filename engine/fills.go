@@ -0,0 +1,233 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/pnl"
+)
+
+var errNoFillsFound = errors.New("no fills found")
+
+// PNLSummary holds the profit and loss of the fills for a single exchange,
+// asset, pair and strategy
+type PNLSummary struct {
+	Exchange string
+	Asset    string
+	Pair     currency.Pair
+	Strategy string
+	Fills    int
+	// UnvaluedFees counts fills whose fee was charged in a currency other
+	// than the base or quote and were excluded from the calculation
+	UnvaluedFees int
+	*pnl.Result
+}
+
+// ImportFillsFromOrderHistory fetches order history for an exchange asset and saves every
+// execution to the fills ledger, tagging them with the supplied strategy. It
+// returns the number of fills processed, previously imported fills are
+// ignored by the database
+func (bot *Engine) ImportFillsFromOrderHistory(exchName string, a asset.Item, pairs currency.Pairs, start, end time.Time, strategy string) (int, error) {
+	exch := bot.GetExchangeByName(exchName)
+	if exch == nil {
+		return 0, ErrExchangeNotFound
+	}
+	orders, err := exch.GetOrderHistory(&order.GetOrdersRequest{
+		Side:       order.AnySide,
+		Type:       order.AnyType,
+		StartTicks: start,
+		EndTicks:   end,
+		Pairs:      pairs,
+		AssetType:  a,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var fills []fill.Data
+	for i := range orders {
+		fills = append(fills, fillsFromOrder(exch.GetName(), a, &orders[i], strategy)...)
+	}
+	if len(fills) == 0 {
+		return 0, nil
+	}
+	err = fill.Insert(fills...)
+	if err != nil {
+		return 0, err
+	}
+	return len(fills), nil
+}
+
+// fillsFromOrder converts the executions of an order into fills. Orders
+// which an exchange does not return individual trades for are stored as a
+// single fill at the average execution price keyed on the order ID
+func fillsFromOrder(exchName string, a asset.Item, d *order.Detail, strategy string) []fill.Data {
+	if d.AssetType != "" {
+		a = d.AssetType
+	}
+	base := fill.Data{
+		Exchange:  exchName,
+		OrderID:   d.ID,
+		Base:      d.Pair.Base.String(),
+		Quote:     d.Pair.Quote.String(),
+		AssetType: a.String(),
+		Side:      d.Side.String(),
+		Strategy:  strategy,
+	}
+	var fills []fill.Data
+	for i := range d.Trades {
+		if d.Trades[i].Amount <= 0 || d.Trades[i].Price <= 0 {
+			continue
+		}
+		f := base
+		f.TID = d.Trades[i].TID
+		if f.TID == "" {
+			f.TID = d.ID + "-" + strconv.Itoa(i)
+		}
+		if d.Trades[i].Side != "" && d.Trades[i].Side != order.UnknownSide {
+			f.Side = d.Trades[i].Side.String()
+		}
+		f.Price = d.Trades[i].Price
+		f.Amount = d.Trades[i].Amount
+		f.Fee = d.Trades[i].Fee
+		f.FeeAsset = d.Trades[i].FeeAsset
+		f.Timestamp = d.Trades[i].Timestamp
+		if f.Timestamp.IsZero() {
+			f.Timestamp = d.Date
+		}
+		fills = append(fills, f)
+	}
+	if len(fills) > 0 || d.ExecutedAmount <= 0 {
+		return fills
+	}
+
+	price := d.Price
+	if d.Cost > 0 {
+		price = d.Cost / d.ExecutedAmount
+	}
+	if price <= 0 {
+		return nil
+	}
+	base.TID = d.ID
+	base.Price = price
+	base.Amount = d.ExecutedAmount
+	base.Fee = d.Fee
+	base.Timestamp = d.Date
+	if !d.CloseTime.IsZero() {
+		base.Timestamp = d.CloseTime
+	}
+	return append(fills, base)
+}
+
+// CalculatePNL loads fills matching the filter and calculates the profit and
+// loss per exchange, asset, pair and strategy. Fills before the start of the
+// filter are loaded to seed the open lots so sells in range are matched
+// against the buys preceding it. Open positions are valued at the last traded
+// price of the exchange's ticker when one is available
+func CalculatePNL(f *fill.Filter, method pnl.Method) ([]PNLSummary, error) {
+	if !f.Start.Before(f.End) {
+		return nil, errInvalidTimes
+	}
+	history := *f
+	history.Start = time.Time{}
+	fills, err := fill.GetInRange(&history)
+	if err != nil {
+		return nil, err
+	}
+	return summarisePNL(fills, method, f.Start)
+}
+
+type pnlKey struct {
+	exchange string
+	asset    string
+	base     string
+	quote    string
+	strategy string
+}
+
+func (k pnlKey) String() string {
+	return k.exchange + " " + k.asset + " " + k.base + k.quote + " " + k.strategy
+}
+
+func summarisePNL(fills []fill.Data, method pnl.Method, since time.Time) ([]PNLSummary, error) {
+	grouped := make(map[pnlKey][]fill.Data)
+	inRange := make(map[pnlKey]int)
+	var keys []pnlKey
+	for i := range fills {
+		k := pnlKey{
+			exchange: strings.ToLower(fills[i].Exchange),
+			asset:    strings.ToLower(fills[i].AssetType),
+			base:     strings.ToUpper(fills[i].Base),
+			quote:    strings.ToUpper(fills[i].Quote),
+			strategy: fills[i].Strategy,
+		}
+		grouped[k] = append(grouped[k], fills[i])
+		if fills[i].Timestamp.Before(since) {
+			continue
+		}
+		if inRange[k] == 0 {
+			keys = append(keys, k)
+		}
+		inRange[k]++
+	}
+	if len(keys) == 0 {
+		return nil, errNoFillsFound
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	summaries := make([]PNLSummary, 0, len(keys))
+	for i := range keys {
+		group := grouped[keys[i]]
+		s := PNLSummary{
+			Exchange: keys[i].exchange,
+			Asset:    keys[i].asset,
+			Pair:     currency.NewPairWithDelimiter(keys[i].base, keys[i].quote, currency.DashDelimiter),
+			Strategy: keys[i].strategy,
+			Fills:    inRange[keys[i]],
+		}
+		lotFills := make([]pnl.Fill, len(group))
+		for j := range group {
+			side, err := order.StringToOrderSide(group[j].Side)
+			if err != nil {
+				return nil, err
+			}
+			lotFills[j] = pnl.Fill{
+				Side:      side,
+				Price:     group[j].Price,
+				Amount:    group[j].Amount,
+				Timestamp: group[j].Timestamp,
+			}
+			switch {
+			case group[j].Fee == 0:
+			case group[j].FeeAsset == "" || strings.EqualFold(group[j].FeeAsset, group[j].Quote):
+				lotFills[j].Fee = group[j].Fee
+			case strings.EqualFold(group[j].FeeAsset, group[j].Base):
+				lotFills[j].BaseFee = group[j].Fee
+			case !group[j].Timestamp.Before(since):
+				// fees charged in any other currency cannot be valued
+				s.UnvaluedFees++
+			}
+		}
+		var markPrice float64
+		t, err := ticker.GetTicker(s.Exchange, s.Pair, asset.Item(s.Asset))
+		if err == nil {
+			markPrice = t.Last
+		}
+		s.Result, err = pnl.CalculateSince(method, lotFills, markPrice, since)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s %s: %w", s.Exchange, s.Asset, s.Pair, err)
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries, nil
+}
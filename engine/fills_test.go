@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/pnl"
+)
+
+func TestFillsFromOrder(t *testing.T) {
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &order.Detail{
+		ID:        "1337",
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		Side:      order.Buy,
+		AssetType: asset.Spot,
+		Date:      ts,
+		Trades: []order.TradeHistory{
+			{TID: "1", Price: 100, Amount: 1, Fee: 0.1, FeeAsset: "USDT", Timestamp: ts},
+			{Price: 101, Amount: 2},
+			{TID: "3"},
+		},
+	}
+	fills := fillsFromOrder(testExchange, asset.Margin, d, "alpha")
+	if len(fills) != 2 {
+		t.Fatalf("expected 2 fills, received %v", len(fills))
+	}
+	if fills[0].TID != "1" || fills[0].AssetType != "spot" || fills[0].Side != "BUY" || fills[0].Strategy != "alpha" {
+		t.Errorf("unexpected fill %+v", fills[0])
+	}
+	if fills[1].TID != "1337-1" || !fills[1].Timestamp.Equal(ts) {
+		t.Errorf("expected generated trade ID and order date, received %+v", fills[1])
+	}
+
+	d.Trades = nil
+	d.ExecutedAmount = 2
+	d.Cost = 300
+	fills = fillsFromOrder(testExchange, asset.Spot, d, "")
+	if len(fills) != 1 {
+		t.Fatalf("expected 1 fill, received %v", len(fills))
+	}
+	if fills[0].TID != "1337" || fills[0].Price != 150 || fills[0].Amount != 2 {
+		t.Errorf("unexpected order level fill %+v", fills[0])
+	}
+
+	d.ExecutedAmount = 0
+	if fills = fillsFromOrder(testExchange, asset.Spot, d, ""); len(fills) != 0 {
+		t.Errorf("expected unexecuted order to produce no fills, received %v", len(fills))
+	}
+}
+
+func TestSummarisePNL(t *testing.T) {
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	fills := []fill.Data{
+		{Exchange: testExchange, Base: "BTC", Quote: "USDT", AssetType: "spot", Side: "BUY", Price: 100, Amount: 1, Fee: 0.01, FeeAsset: "BTC", Timestamp: ts},
+		{Exchange: testExchange, Base: "BTC", Quote: "USDT", AssetType: "spot", Side: "SELL", Price: 200, Amount: 0.99, Fee: 1, FeeAsset: "BNB", Timestamp: ts.Add(time.Hour)},
+		{Exchange: testExchange, Base: "BTC", Quote: "USDT", AssetType: "spot", Side: "BUY", Price: 100, Amount: 1, Strategy: "alpha", Timestamp: ts},
+	}
+	summaries, err := summarisePNL(fills, pnl.FIFO, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("expected 2 summaries, received %v", len(summaries))
+	}
+	if summaries[0].Strategy != "" || summaries[0].Fills != 2 {
+		t.Errorf("unexpected summary %+v", summaries[0])
+	}
+	// the base fee reduces the amount bought and the BNB fee is excluded
+	if summaries[0].RealisedPNL != 98 || summaries[0].UnvaluedFees != 1 || summaries[0].ShortOpened {
+		t.Errorf("expected realised 98 with 1 unvalued fee, received %v %v",
+			summaries[0].RealisedPNL,
+			summaries[0].UnvaluedFees)
+	}
+	if summaries[1].Strategy != "alpha" || summaries[1].OpenAmount != 1 {
+		t.Errorf("unexpected summary %+v", summaries[1])
+	}
+
+	// fills before the range seed the open lots but are not counted
+	summaries, err = summarisePNL(fills, pnl.FIFO, ts.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaries) != 1 || summaries[0].Fills != 1 {
+		t.Fatalf("expected 1 summary of 1 fill, received %+v", summaries)
+	}
+	if summaries[0].RealisedPNL != 98 || summaries[0].ShortOpened {
+		t.Errorf("expected the sell to close the seeded buy, received %+v", summaries[0].Result)
+	}
+
+	if _, err = summarisePNL(fills, pnl.FIFO, ts.Add(time.Hour*2)); !errors.Is(err, errNoFillsFound) {
+		t.Errorf("expected %v, received %v", errNoFillsFound, err)
+	}
+
+	fills[0].Side = "sideways"
+	if _, err = summarisePNL(fills, pnl.FIFO, time.Time{}); err == nil {
+		t.Error(unexpectedLackOfError)
+	}
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -45,7 +46,9 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 	systems["internet_monitor"] = bot.ConnectionManager.Started()
 	systems["orders"] = bot.OrderManager.Started()
 	systems["portfolio"] = bot.PortfolioManager.Started()
+	systems["balance_snapshots"] = bot.BalanceSnapshotManager.Started()
 	systems["ntp_timekeeper"] = bot.NTPManager.Started()
+	systems["latency_monitor"] = bot.LatencyMonitor.Started()
 	systems["database"] = bot.DatabaseManager.Started()
 	systems["exchange_syncer"] = bot.Settings.EnableExchangeSyncManager
 	systems["grpc"] = bot.Settings.EnableGRPC
@@ -108,11 +111,24 @@ func (bot *Engine) SetSubsystem(subsys string, enable bool) error {
 			return bot.PortfolioManager.Start()
 		}
 		return bot.OrderManager.Stop()
+	case "balance_snapshots":
+		if enable {
+			if bot.Settings.BalanceSnapshotDelay <= 0 {
+				bot.Settings.BalanceSnapshotDelay = BalanceSnapshotDelay
+			}
+			return bot.BalanceSnapshotManager.Start()
+		}
+		return bot.BalanceSnapshotManager.Stop()
 	case "ntp_timekeeper":
 		if enable {
 			return bot.NTPManager.Start()
 		}
 		return bot.NTPManager.Stop()
+	case "latency_monitor":
+		if enable {
+			return bot.LatencyMonitor.Start(bot)
+		}
+		return bot.LatencyMonitor.Stop()
 	case "database":
 		if enable {
 			return bot.DatabaseManager.Start(bot)
@@ -892,3 +908,14 @@ func genCert(targetDir string) error {
 	log.Infof(log.Global, "gRPC TLS key.pem and cert.pem files written to %s\n", targetDir)
 	return nil
 }
+
+// prioritiseRequests tags the requests an exchange sends without a priority of
+// their own at the priority of ctx, set with request.WithPriority, until the
+// returned function is called
+func prioritiseRequests(ctx context.Context, exch exchange.IBotExchange) func() {
+	b := exch.GetBase()
+	if b == nil || b.Requester == nil {
+		return func() {}
+	}
+	return b.Requester.Prioritise(ctx)
+}
@@ -0,0 +1,291 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errLatencyMonitorNotStarted = errors.New("latency monitor not started")
+	errLatencyMonitorNilEngine  = errors.New("latency monitor engine is nil")
+)
+
+// Started returns if the latency monitor is running
+func (l *latencyMonitor) Started() bool {
+	return atomic.LoadInt32(&l.started) == 1
+}
+
+// Start starts the latency monitor checking the exchanges at the configured
+// interval
+func (l *latencyMonitor) Start(bot *Engine) error {
+	if bot == nil {
+		return errLatencyMonitorNilEngine
+	}
+	if atomic.AddInt32(&l.started, 1) != 1 {
+		return errors.New("latency monitor already started")
+	}
+
+	log.Debugln(log.TimeMgr, "Latency monitor starting...")
+	l.m.Lock()
+	l.bot = bot
+	l.delays = make(map[string]*durationRing)
+	l.offsets = make(map[string]*durationRing)
+	l.breached = make(map[string]bool)
+	l.unsupported = make(map[string]bool)
+	l.m.Unlock()
+	l.shutdown = make(chan struct{})
+	go l.run(bot.Config.LatencyMonitor.CheckInterval)
+	log.Debugln(log.TimeMgr, "Latency monitor started.")
+	return nil
+}
+
+// Stop stops the latency monitor
+func (l *latencyMonitor) Stop() error {
+	if atomic.LoadInt32(&l.started) == 0 {
+		return errLatencyMonitorNotStarted
+	}
+
+	if atomic.AddInt32(&l.stopped, 1) != 1 {
+		return errors.New("latency monitor is already stopped")
+	}
+
+	close(l.shutdown)
+	log.Debugln(log.TimeMgr, "Latency monitor shutting down...")
+	return nil
+}
+
+func (l *latencyMonitor) run(interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	t := time.NewTicker(interval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&l.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&l.started, 1, 0)
+		log.Debugln(log.TimeMgr, "Latency monitor shutdown.")
+	}()
+
+	for {
+		select {
+		case <-l.shutdown:
+			return
+		case <-t.C:
+			l.checkServerTimes()
+			stats, err := l.GetLatency("")
+			if err != nil {
+				log.Errorf(log.TimeMgr, "Latency monitor: %v", err)
+				continue
+			}
+			l.check(stats)
+		}
+	}
+}
+
+// observeWebsocketDelay records the delay between an exchange timestamp and
+// the receipt of its websocket message from the connection
+func (l *latencyMonitor) observeWebsocketDelay(exchName string, exchangeTime, received time.Time) {
+	if !l.Started() || exchangeTime.IsZero() || received.IsZero() {
+		return
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	key := strings.ToLower(exchName)
+	r, ok := l.delays[key]
+	if !ok {
+		r = new(durationRing)
+		l.delays[key] = r
+	}
+	r.add(received.Sub(exchangeTime))
+}
+
+// checkServerTimes requests the server time of each exchange which supports
+// it and records the exchange clock offset
+func (l *latencyMonitor) checkServerTimes() {
+	l.m.Lock()
+	bot := l.bot
+	l.m.Unlock()
+	exchanges := bot.GetExchanges()
+	for i := range exchanges {
+		sent := time.Now()
+		serverTime, err := exchanges[i].GetCurrentServerTime()
+		received := time.Now()
+		if err != nil {
+			if errors.Is(err, common.ErrFunctionNotSupported) {
+				l.logServerTimeUnsupported(exchanges[i].GetName())
+				continue
+			}
+			log.Errorf(log.TimeMgr, "Latency monitor: %s server time error: %v",
+				exchanges[i].GetName(), err)
+			continue
+		}
+		l.observeServerOffset(exchanges[i].GetName(), serverTime, sent, received)
+	}
+}
+
+// logServerTimeUnsupported logs once for each exchange which cannot report its
+// server time, as its clock offset is not measured
+func (l *latencyMonitor) logServerTimeUnsupported(exchName string) {
+	key := strings.ToLower(exchName)
+	l.m.Lock()
+	logged := l.unsupported[key]
+	l.unsupported[key] = true
+	l.m.Unlock()
+	if !logged {
+		log.Infof(log.TimeMgr, "Latency monitor: %s does not support server time, clock offset will not be measured",
+			exchName)
+	}
+}
+
+// observeServerOffset records the exchange clock minus the local clock at the
+// midpoint of a server time request
+func (l *latencyMonitor) observeServerOffset(exchName string, serverTime, sent, received time.Time) {
+	if serverTime.IsZero() {
+		return
+	}
+	midpoint := sent.Add(received.Sub(sent) / 2)
+	l.m.Lock()
+	defer l.m.Unlock()
+	key := strings.ToLower(exchName)
+	r, ok := l.offsets[key]
+	if !ok {
+		r = new(durationRing)
+		l.offsets[key] = r
+	}
+	r.add(serverTime.Sub(midpoint))
+}
+
+// GetLatency returns the latency statistics of an exchange, or of every
+// loaded exchange when exchName is empty
+func (l *latencyMonitor) GetLatency(exchName string) ([]ExchangeLatency, error) {
+	if !l.Started() {
+		return nil, errLatencyMonitorNotStarted
+	}
+	l.m.Lock()
+	bot := l.bot
+	l.m.Unlock()
+
+	exchanges := bot.GetExchanges()
+	var result []ExchangeLatency
+	for i := range exchanges {
+		name := exchanges[i].GetName()
+		if exchName != "" && !strings.EqualFold(name, exchName) {
+			continue
+		}
+		stats := ExchangeLatency{Exchange: name}
+		if b := exchanges[i].GetBase(); b != nil && b.Requester != nil {
+			var roundTrips []time.Duration
+			samples := b.Requester.GetLatencySamples()
+			for j := range samples {
+				roundTrips = append(roundTrips, samples[j].RoundTrip)
+			}
+			stats.RoundTrip = latencyStats(roundTrips)
+		}
+		l.m.Lock()
+		if r, ok := l.delays[strings.ToLower(name)]; ok {
+			stats.WebsocketDelay = latencyStats(r.get())
+		}
+		if r, ok := l.offsets[strings.ToLower(name)]; ok {
+			stats.ServerOffset = latencyStats(r.get())
+		}
+		l.m.Unlock()
+		result = append(result, stats)
+	}
+	if exchName != "" && len(result) == 0 {
+		return nil, fmt.Errorf("%s %w", exchName, errExchangeNotLoaded)
+	}
+	return result, nil
+}
+
+// check alerts through the communications manager when an exchange measure
+// first breaches its threshold and logs when it recovers
+func (l *latencyMonitor) check(stats []ExchangeLatency) {
+	l.m.Lock()
+	cfg := l.bot.Config.LatencyMonitor
+	l.m.Unlock()
+	for i := range stats {
+		l.evaluate(stats[i].Exchange, "REST round trip p90",
+			stats[i].RoundTrip.P90, cfg.MaxRoundTrip, stats[i].RoundTrip.Samples)
+		l.evaluate(stats[i].Exchange, "websocket delay p90",
+			stats[i].WebsocketDelay.P90, cfg.MaxWebsocketDelay, stats[i].WebsocketDelay.Samples)
+		offset := stats[i].ServerOffset.P50
+		if offset < 0 {
+			offset = -offset
+		}
+		l.evaluate(stats[i].Exchange, "server clock offset",
+			offset, cfg.MaxServerOffset, stats[i].ServerOffset.Samples)
+	}
+}
+
+func (l *latencyMonitor) evaluate(exchName, measure string, value, threshold time.Duration, samples int) {
+	if threshold <= 0 || samples == 0 {
+		return
+	}
+	key := exchName + " " + measure
+	l.m.Lock()
+	wasBreached := l.breached[key]
+	breached := value > threshold
+	l.breached[key] = breached
+	bot := l.bot
+	l.m.Unlock()
+
+	switch {
+	case breached && !wasBreached:
+		msg := fmt.Sprintf("Latency monitor: %s %s of %s exceeds threshold %s",
+			exchName, measure, value, threshold)
+		log.Warnln(log.TimeMgr, msg)
+		bot.CommsManager.PushEvent(base.Event{Type: "latency", Message: msg})
+	case !breached && wasBreached:
+		log.Infof(log.TimeMgr, "Latency monitor: %s %s of %s has recovered below threshold %s",
+			exchName, measure, value, threshold)
+	}
+}
+
+// latencyStats returns the percentiles of the durations
+func latencyStats(d []time.Duration) LatencyStats {
+	if len(d) == 0 {
+		return LatencyStats{}
+	}
+	sorted := append([]time.Duration(nil), d...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return LatencyStats{
+		Samples: len(sorted),
+		P50:     percentile(sorted, 0.5),
+		P90:     percentile(sorted, 0.9),
+		P99:     percentile(sorted, 0.99),
+		Max:     sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest rank percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func (r *durationRing) add(d time.Duration) {
+	r.samples[r.next] = d
+	r.next = (r.next + 1) % delayWindow
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+func (r *durationRing) get() []time.Duration {
+	if !r.full {
+		return append([]time.Duration(nil), r.samples[:r.next]...)
+	}
+	return append([]time.Duration(nil), r.samples[:]...)
+}
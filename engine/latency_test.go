@@ -0,0 +1,167 @@
+package engine
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestLatencyStats(t *testing.T) {
+	t.Parallel()
+	if s := latencyStats(nil); s.Samples != 0 {
+		t.Errorf("expected no samples, received %d", s.Samples)
+	}
+	var d []time.Duration
+	for i := 100; i > 0; i-- {
+		d = append(d, time.Duration(i)*time.Millisecond)
+	}
+	s := latencyStats(d)
+	if s.Samples != 100 ||
+		s.P50 != 50*time.Millisecond ||
+		s.P90 != 90*time.Millisecond ||
+		s.P99 != 99*time.Millisecond ||
+		s.Max != 100*time.Millisecond {
+		t.Errorf("unexpected percentiles %+v", s)
+	}
+	if d[0] != 100*time.Millisecond {
+		t.Error("input durations should not be sorted in place")
+	}
+}
+
+func TestDurationRing(t *testing.T) {
+	t.Parallel()
+	var r durationRing
+	for i := 0; i < delayWindow+10; i++ {
+		r.add(time.Duration(i))
+	}
+	if samples := r.get(); len(samples) != delayWindow {
+		t.Errorf("expected %d samples, received %d", delayWindow, len(samples))
+	}
+}
+
+func TestLatencyMonitor(t *testing.T) {
+	e := SetupTestHelpers(t)
+	var l latencyMonitor
+	if _, err := l.GetLatency(""); !errors.Is(err, errLatencyMonitorNotStarted) {
+		t.Fatalf("expected %v, received %v", errLatencyMonitorNotStarted, err)
+	}
+	if err := l.Start(nil); !errors.Is(err, errLatencyMonitorNilEngine) {
+		t.Fatalf("expected %v, received %v", errLatencyMonitorNilEngine, err)
+	}
+	if err := l.Start(e); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := l.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	now := time.Now()
+	l.observeWebsocketDelay(testExchange, now.Add(-time.Second), now)
+	l.observeWebsocketDelay(testExchange, time.Time{}, now)
+	l.observeWebsocketDelay(testExchange, now.Add(-time.Second), time.Time{})
+	// exchange clock two seconds ahead at the midpoint of the request
+	l.observeServerOffset(testExchange, now.Add(2*time.Second), now.Add(-time.Second), now.Add(time.Second))
+	l.observeServerOffset(testExchange, time.Time{}, now, now)
+	// the test exchange does not support server time requests
+	l.checkServerTimes()
+	stats, err := l.GetLatency(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 1 || stats[0].WebsocketDelay.Samples != 1 ||
+		stats[0].WebsocketDelay.P50 != time.Second {
+		t.Errorf("expected a single websocket delay of 1s, received %+v", stats)
+	}
+	if stats[0].ServerOffset.Samples != 1 || stats[0].ServerOffset.P50 != 2*time.Second {
+		t.Errorf("expected a single server offset of 2s, received %+v", stats[0].ServerOffset)
+	}
+	if !l.unsupported[strings.ToLower(testExchange)] {
+		t.Error("expected unsupported server time to be logged")
+	}
+	if _, err = l.GetLatency("unknown"); !errors.Is(err, errExchangeNotLoaded) {
+		t.Errorf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+}
+
+func TestLatencyMonitorWebsocketDelay(t *testing.T) {
+	e := SetupTestHelpers(t)
+	if err := e.LatencyMonitor.Start(e); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := e.LatencyMonitor.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	now := time.Now()
+	// local timestamps are not exchange timestamps and are not measured
+	err := e.WebsocketDataHandler(testExchange, &ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		LastUpdated:  now.Add(-time.Minute),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	err = e.WebsocketDataHandler(testExchange, stream.Delay{
+		ExchangeTime: now.Add(-time.Second),
+		Received:     now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := e.LatencyMonitor.GetLatency(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats[0].WebsocketDelay.Samples != 1 || stats[0].WebsocketDelay.P50 != time.Second {
+		t.Errorf("expected a single websocket delay of 1s, received %+v", stats[0].WebsocketDelay)
+	}
+}
+
+func TestLatencyMonitorCheck(t *testing.T) {
+	l := latencyMonitor{
+		bot: &Engine{Config: &config.Config{
+			LatencyMonitor: config.LatencyMonitorConfig{
+				MaxRoundTrip:      time.Second,
+				MaxWebsocketDelay: time.Second,
+				MaxServerOffset:   time.Second,
+			},
+		}},
+		breached: make(map[string]bool),
+	}
+	stats := []ExchangeLatency{{
+		Exchange:     testExchange,
+		RoundTrip:    LatencyStats{Samples: 1, P90: 2 * time.Second},
+		ServerOffset: LatencyStats{Samples: 1, P50: -2 * time.Second},
+		// no samples are not alerted on
+		WebsocketDelay: LatencyStats{P90: 2 * time.Second},
+	}}
+	l.check(stats)
+	if !l.breached[testExchange+" REST round trip p90"] {
+		t.Error("expected round trip breach")
+	}
+	if !l.breached[testExchange+" server clock offset"] {
+		t.Error("expected negative server offset breach")
+	}
+	if l.breached[testExchange+" websocket delay p90"] {
+		t.Error("websocket delay without samples should not breach")
+	}
+
+	stats[0].RoundTrip.P90 = time.Millisecond
+	l.check(stats)
+	if l.breached[testExchange+" REST round trip p90"] {
+		t.Error("expected round trip to recover")
+	}
+}
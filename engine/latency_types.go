@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"sync"
+	"time"
+)
+
+// delayWindow is the number of recent websocket messages and server time
+// checks kept for delay and offset statistics for each exchange
+const delayWindow = 256
+
+// latencyMonitor tracks REST round trips, websocket message delays and
+// server clock offsets for each exchange and alerts when they breach the
+// configured thresholds
+type latencyMonitor struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	bot      *Engine
+
+	m       sync.Mutex
+	delays  map[string]*durationRing
+	offsets map[string]*durationRing
+	// breached holds the measures currently over their threshold, keyed by
+	// exchange and measure, so each breach is only alerted once
+	breached map[string]bool
+	// unsupported holds the exchanges which cannot report their server time
+	unsupported map[string]bool
+}
+
+// durationRing holds the most recent durations
+type durationRing struct {
+	samples [delayWindow]time.Duration
+	next    int
+	full    bool
+}
+
+// LatencyStats holds percentiles of a latency measure
+type LatencyStats struct {
+	Samples int
+	P50     time.Duration
+	P90     time.Duration
+	P99     time.Duration
+	Max     time.Duration
+}
+
+// ExchangeLatency holds the latency statistics of an exchange. Websocket
+// delays are the time between the exchange timestamp of a message and its
+// receipt from the connection, only exchanges reporting stream.Delay are
+// measured. Server offsets are the exchange clock minus the local clock,
+// measured from the exchange server time endpoint against the midpoint of the
+// request
+type ExchangeLatency struct {
+	Exchange       string
+	RoundTrip      LatencyStats
+	WebsocketDelay LatencyStats
+	ServerOffset   LatencyStats
+}
@@ -0,0 +1,160 @@
+package engine
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	gctlog "github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+// metricsPath is the path metrics are served on
+const metricsPath = "/metrics"
+
+// startMetrics starts recording metrics and registers the engine subsystem
+// gauges, which are read from the subsystems on each scrape
+func (bot *Engine) startMetrics() error {
+	if len(bot.metricsCollectors) > 0 {
+		return nil
+	}
+	collectors := []metrics.Collector{
+		metrics.NewGaugeFunc("gct_subsystem_running",
+			"Whether the engine subsystem is running",
+			[]string{"subsystem"},
+			bot.subsystemSamples),
+		metrics.NewGaugeFunc("gct_syncer_lag_seconds",
+			"Longest time since a synced item was last updated, or since it was added when it has no data yet",
+			[]string{"exchange", "asset", "item"},
+			func() []metrics.Sample {
+				if bot.ExchangeCurrencyPairManager == nil {
+					return nil
+				}
+				return bot.ExchangeCurrencyPairManager.lag(time.Now())
+			}),
+		metrics.NewGaugeFunc("gct_dispatch_queue_depth",
+			"Dispatch jobs waiting for a worker",
+			nil,
+			func() []metrics.Sample {
+				jobs, _ := dispatch.QueueDepth()
+				return []metrics.Sample{{Value: float64(jobs)}}
+			}),
+		metrics.NewGaugeFunc("gct_dispatch_queue_limit",
+			"Dispatch jobs limit",
+			nil,
+			func() []metrics.Sample {
+				_, limit := dispatch.QueueDepth()
+				return []metrics.Sample{{Value: float64(limit)}}
+			}),
+		metrics.NewGaugeFunc("gct_order_manager_orders",
+			"Orders tracked by the order manager",
+			[]string{"exchange", "status"},
+			bot.OrderManager.orderStore.counts),
+		metrics.NewGaugeFunc("gct_gctscript_virtual_machines",
+			"Running GCTScript virtual machines",
+			nil,
+			func() []metrics.Sample {
+				return []metrics.Sample{{Value: float64(gctscript.VMSCount.Len())}}
+			}),
+	}
+	err := metrics.Register(collectors...)
+	if err != nil {
+		return err
+	}
+	bot.metricsCollectors = collectors
+	metrics.Enable()
+	gctlog.Debugf(gctlog.Global, "Metrics enabled and served on %s", metricsPath)
+	return nil
+}
+
+// stopMetrics stops recording metrics and removes the engine subsystem
+// gauges
+func (bot *Engine) stopMetrics() {
+	metrics.Disable()
+	metrics.Unregister(bot.metricsCollectors...)
+	bot.metricsCollectors = nil
+}
+
+// metricsHandler serves the metrics in the Prometheus text format
+func metricsHandler(w http.ResponseWriter, r *http.Request) {
+	metrics.Handler().ServeHTTP(w, r)
+}
+
+func (bot *Engine) subsystemSamples() []metrics.Sample {
+	status := bot.GetSubsystemsStatus()
+	samples := make([]metrics.Sample, 0, len(status))
+	for name, running := range status {
+		var v float64
+		if running {
+			v = 1
+		}
+		samples = append(samples, metrics.Sample{
+			LabelValues: []string{name},
+			Value:       v,
+		})
+	}
+	return samples
+}
+
+// lag returns the longest time since each exchange, asset and sync item was
+// last updated
+func (e *ExchangeCurrencyPairSyncer) lag(now time.Time) []metrics.Sample {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	longest := make(map[[3]string]time.Duration)
+	observe := func(c *CurrencyPairSyncAgent, item string, s *SyncBase) {
+		since := c.Created
+		if s.HaveData {
+			since = s.LastUpdated
+		}
+		key := [3]string{c.Exchange, c.AssetType.String(), item}
+		d := now.Sub(since)
+		if existing, ok := longest[key]; !ok || d > existing {
+			longest[key] = d
+		}
+	}
+	for x := range e.CurrencyPairs {
+		c := &e.CurrencyPairs[x]
+		if e.Cfg.SyncTicker {
+			observe(c, "ticker", &c.Ticker)
+		}
+		if e.Cfg.SyncOrderbook {
+			observe(c, "orderbook", &c.Orderbook)
+		}
+		if e.Cfg.SyncTrades {
+			observe(c, "trade", &c.Trade)
+		}
+		if e.Cfg.SyncDerivatives && c.AssetType.IsDerivative() {
+			observe(c, "derivative", &c.Derivative)
+		}
+	}
+	samples := make([]metrics.Sample, 0, len(longest))
+	for k, d := range longest {
+		samples = append(samples, metrics.Sample{
+			LabelValues: []string{k[0], k[1], k[2]},
+			Value:       d.Seconds(),
+		})
+	}
+	return samples
+}
+
+// counts returns the number of stored orders for each exchange and status
+func (o *orderStore) counts() []metrics.Sample {
+	o.m.RLock()
+	defer o.m.RUnlock()
+	var samples []metrics.Sample
+	for exch, orders := range o.Orders {
+		byStatus := make(map[string]int)
+		for i := range orders {
+			byStatus[orders[i].Status.String()]++
+		}
+		for status, count := range byStatus {
+			samples = append(samples, metrics.Sample{
+				LabelValues: []string{exch, status},
+				Value:       float64(count),
+			})
+		}
+	}
+	return samples
+}
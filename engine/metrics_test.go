@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	e := SetupTestHelpers(t)
+	req, err := http.NewRequest(http.MethodGet, metricsPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "localhost:9050"
+	resp := httptest.NewRecorder()
+	newRouter(e, true).ServeHTTP(resp, req)
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("expected %v when disabled, received %v", http.StatusNotFound, resp.Code)
+	}
+
+	e.Config.Metrics.Enabled = true
+	defer func() { e.Config.Metrics.Enabled = false }()
+	if err = e.startMetrics(); err != nil {
+		t.Fatal(err)
+	}
+	defer e.stopMetrics()
+	// starting again leaves the registered gauges in place
+	if err = e.startMetrics(); err != nil {
+		t.Fatal(err)
+	}
+
+	resp = httptest.NewRecorder()
+	newRouter(e, true).ServeHTTP(resp, req)
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected %v, received %v", http.StatusOK, resp.Code)
+	}
+	for _, expected := range []string{
+		"# TYPE gct_subsystem_running gauge",
+		"gct_dispatch_queue_depth ",
+		"gct_gctscript_virtual_machines ",
+	} {
+		if !strings.Contains(resp.Body.String(), expected) {
+			t.Errorf("expected %s in metrics", expected)
+		}
+	}
+}
+
+func TestSyncerLag(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	e := ExchangeCurrencyPairSyncer{
+		Cfg: CurrencyPairSyncerConfig{SyncTicker: true},
+		CurrencyPairs: []CurrencyPairSyncAgent{
+			{
+				Created:   now.Add(-time.Minute),
+				Exchange:  testExchange,
+				AssetType: asset.Spot,
+				Pair:      currency.NewPair(currency.BTC, currency.USD),
+				Ticker:    SyncBase{HaveData: true, LastUpdated: now.Add(-time.Second)},
+			},
+			{
+				Created:   now.Add(-time.Minute),
+				Exchange:  testExchange,
+				AssetType: asset.Spot,
+				Pair:      currency.NewPair(currency.LTC, currency.USD),
+				Ticker:    SyncBase{HaveData: true, LastUpdated: now.Add(-5 * time.Second)},
+			},
+		},
+	}
+	samples := e.lag(now)
+	if len(samples) != 1 {
+		t.Fatalf("expected one sample per exchange, asset and item, received %v", samples)
+	}
+	if samples[0].Value != 5 || samples[0].LabelValues[2] != "ticker" {
+		t.Errorf("expected longest ticker lag of 5 seconds, received %v", samples[0])
+	}
+
+	// pairs without data report the time since they were added
+	e.CurrencyPairs[1].Ticker.HaveData = false
+	if samples = e.lag(now); samples[0].Value != 60 {
+		t.Errorf("expected 60 seconds, received %v", samples[0].Value)
+	}
+}
+
+func TestOrderStoreCounts(t *testing.T) {
+	t.Parallel()
+	o := orderStore{Orders: map[string][]*order.Detail{
+		testExchange: {
+			{Status: order.New},
+			{Status: order.New},
+			{Status: order.Filled},
+		},
+	}}
+	samples := o.counts()
+	if len(samples) != 2 {
+		t.Fatalf("expected two statuses, received %v", samples)
+	}
+	for i := range samples {
+		if samples[i].LabelValues[1] == order.New.String() && samples[i].Value != 2 {
+			t.Errorf("expected two new orders, received %v", samples[i].Value)
+		}
+	}
+}
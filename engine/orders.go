@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -11,8 +12,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -174,13 +178,16 @@ func (o *orderManager) gracefulShutdown() {
 func (o *orderManager) run() {
 	log.Debugln(log.OrderBook, "Order manager started.")
 	tick := time.NewTicker(OrderManagerDelay)
+	contractTick := time.NewTicker(OrderManagerContractDelay)
 	Bot.ServicesWG.Add(1)
 	defer func() {
 		log.Debugln(log.OrderMgr, "Order manager shutdown.")
 		tick.Stop()
+		contractTick.Stop()
 		Bot.ServicesWG.Done()
 	}()
 
+	o.processContracts()
 	for {
 		select {
 		case <-o.shutdown:
@@ -188,6 +195,8 @@ func (o *orderManager) run() {
 			return
 		case <-tick.C:
 			o.processOrders()
+		case <-contractTick.C:
+			o.processContracts()
 		}
 	}
 }
@@ -265,7 +274,9 @@ func (o *orderManager) Cancel(cancel *order.Cancel) error {
 	log.Debugf(log.OrderMgr, "Order manager: Cancelling order ID %v [%+v]",
 		cancel.ID, cancel)
 
+	release := prioritiseRequests(request.WithPriority(context.Background(), request.CriticalPriority), exch)
 	err = exch.CancelOrder(cancel)
+	release()
 	if err != nil {
 		err = fmt.Errorf("%v - Failed to cancel order: %v", cancel.Exchange, err)
 		return err
@@ -313,9 +324,9 @@ func (o *orderManager) GetOrderInfo(exchangeName, orderID string, cp currency.Pa
 	return result, nil
 }
 
-// Submit will take in an order struct, send it to the exchange and
-// populate it in the orderManager if successful
-func (o *orderManager) Submit(newOrder *order.Submit) (*orderSubmitResponse, error) {
+// validate checks an order against the order manager's configured limits and
+// the exchange's execution limits, returning the exchange to submit it to
+func (o *orderManager) validate(newOrder *order.Submit) (exchange.IBotExchange, error) {
 	if newOrder == nil {
 		return nil, errors.New("order cannot be nil")
 	}
@@ -351,7 +362,23 @@ func (o *orderManager) Submit(newOrder *order.Submit) (*orderSubmitResponse, err
 	if exch == nil {
 		return nil, ErrExchangeNotFound
 	}
+	err := checkExecutionLimits(exch, newOrder, Bot.Settings.RoundOrdersToExecutionLimits)
+	if err != nil {
+		return nil, err
+	}
+	return exch, nil
+}
+
+// Submit will take in an order struct, send it to the exchange and
+// populate it in the orderManager if successful
+func (o *orderManager) Submit(newOrder *order.Submit) (*orderSubmitResponse, error) {
+	exch, err := o.validate(newOrder)
+	if err != nil {
+		return nil, err
+	}
+	release := prioritiseRequests(request.WithPriority(context.Background(), request.CriticalPriority), exch)
 	result, err := exch.SubmitOrder(newOrder)
+	release()
 	if err != nil {
 		return nil, err
 	}
@@ -490,3 +517,33 @@ func (o *orderManager) processOrders() {
 		}
 	}
 }
+
+// checkExecutionLimits validates an order against the pair execution limits
+// loaded by the exchange, the price and amount are rounded to the limits
+// first when round is set. Market orders are checked against the minimum
+// notional value at the last ticker price when one is stored. Orders for pairs
+// without loaded limits are not checked
+func checkExecutionLimits(exch exchange.IBotExchange, newOrder *order.Submit, round bool) error {
+	l, err := exch.GetOrderExecutionLimits(newOrder.AssetType, newOrder.Pair)
+	if err != nil {
+		if errors.Is(err, order.ErrExecutionLimitsNotLoaded) {
+			return nil
+		}
+		return err
+	}
+	if round {
+		newOrder.Amount = l.RoundAmount(newOrder.Amount)
+		if newOrder.Type != order.Market {
+			newOrder.Price = l.RoundPrice(newOrder.Price)
+		}
+	}
+	price := newOrder.Price
+	if newOrder.Type == order.Market {
+		price = 0
+		t, err := ticker.GetTicker(exch.GetName(), newOrder.Pair, newOrder.AssetType)
+		if err == nil {
+			price = t.Last
+		}
+	}
+	return l.Conforms(price, newOrder.Amount, newOrder.Type)
+}
@@ -1,12 +1,14 @@
 package engine
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 var ordersSetupRan bool
@@ -414,6 +416,77 @@ func TestSubmit(t *testing.T) {
 	}
 }
 
+func TestSubmitExecutionLimits(t *testing.T) {
+	OrdersSetup(t)
+	exch, ok := Bot.GetExchangeByName(fakePassExchange).(*FakePassingExchange)
+	if !ok {
+		t.Fatal("expected fake exchange to be loaded")
+	}
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	err := exch.LoadExecutionLimits([]order.Limits{{
+		Pair:        pair,
+		Asset:       asset.Spot,
+		PriceTick:   0.01,
+		MinAmount:   0.001,
+		AmountStep:  0.001,
+		MinNotional: 0.1,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := &order.Submit{
+		Exchange:  fakePassExchange,
+		ID:        "FakePassingExchangeLimitsOrder",
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     100.006,
+		Amount:    0.0015,
+	}
+	_, err = Bot.OrderManager.Submit(o)
+	if !errors.Is(err, order.ErrAmountExceedsStep) {
+		t.Fatalf("expected %v, received %v", order.ErrAmountExceedsStep, err)
+	}
+
+	err = checkExecutionLimits(exch, o, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Amount != 0.001 || o.Price != 100.01 {
+		t.Errorf("expected rounded price 100.01 and amount 0.001, received %v %v", o.Price, o.Amount)
+	}
+
+	o.Amount = 0.0001
+	err = checkExecutionLimits(exch, o, true)
+	if !errors.Is(err, order.ErrAmountBelowMin) {
+		t.Fatalf("expected %v, received %v", order.ErrAmountBelowMin, err)
+	}
+
+	o.Type = order.Market
+	o.Amount = 0.001
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: fakePassExchange,
+		Pair:         pair,
+		AssetType:    asset.Spot,
+		Last:         50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkExecutionLimits(exch, o, false)
+	if !errors.Is(err, order.ErrNotionalValueBelowMin) {
+		t.Fatalf("expected %v, received %v", order.ErrNotionalValueBelowMin, err)
+	}
+
+	o.Type = order.Limit
+	o.Pair = currency.NewPair(currency.ETH, currency.USD)
+	err = checkExecutionLimits(exch, o, false)
+	if err != nil {
+		t.Errorf("expected pairs without limits to pass, received %v", err)
+	}
+}
+
 func TestProcessOrders(t *testing.T) {
 	OrdersSetup(t)
 	Bot.OrderManager.processOrders()
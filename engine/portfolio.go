@@ -5,6 +5,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 )
@@ -90,4 +92,30 @@ func (p *portfolioManager) processPortfolio() {
 			value)
 	}
 	SeedExchangeAccountInfo(Bot.GetAllEnabledExchangeAccountInfo().Data)
+	p.updateExchangeLoans(pf)
+}
+
+// updateExchangeLoans refreshes the tracked loans for all enabled exchanges
+// with authenticated API support, exchanges without lending support are
+// skipped
+func (p *portfolioManager) updateExchangeLoans(pf *portfolio.Base) {
+	exchanges := Bot.GetExchanges()
+	for x := range exchanges {
+		if exchanges[x] == nil ||
+			!exchanges[x].IsEnabled() ||
+			!exchanges[x].GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			continue
+		}
+		loans, err := exchanges[x].GetLoans()
+		if err != nil {
+			if !errors.Is(err, common.ErrFunctionNotSupported) {
+				log.Errorf(log.PortfolioMgr,
+					"Portfolio manager: unable to update %s loans: %s\n",
+					exchanges[x].GetName(),
+					err)
+			}
+			continue
+		}
+		pf.UpdateExchangeLoans(exchanges[x].GetName(), loans)
+	}
 }
@@ -95,6 +95,10 @@ func newRouter(bot *Engine, isREST bool) *mux.Router {
 				common.ExtractPort(listenAddr))
 			router.PathPrefix("/debug/pprof/").HandlerFunc(pprof.Index)
 		}
+
+		if bot.Config.Metrics.Enabled {
+			routes = append(routes, Route{"Metrics", http.MethodGet, metricsPath, metricsHandler})
+		}
 	} else {
 		routes = []Route{
 			{"ws", http.MethodGet, "/ws", WebsocketClientHandler},
@@ -9,6 +9,8 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
@@ -178,6 +180,31 @@ func printOrderbookSummary(result *orderbook.Base, protocol string, err error) {
 	)
 }
 
+func printDerivativePriceSummary(result *derivative.Price, protocol string, err error) {
+	if err != nil {
+		if err == common.ErrNotYetImplemented || err == common.ErrFunctionNotSupported {
+			log.Warnf(log.Ticker, "Failed to get %s derivative price. Error: %s\n",
+				protocol,
+				err)
+			return
+		}
+		log.Errorf(log.Ticker, "Failed to get %s derivative price. Error: %s\n",
+			protocol,
+			err)
+		return
+	}
+
+	log.Infof(log.Ticker, "%s %s %s %s: DERIVATIVE: Mark %.8f Index %.8f Open interest %.8f Estimated settlement %.8f\n",
+		result.ExchangeName,
+		protocol,
+		FormatCurrency(result.Pair),
+		strings.ToUpper(result.AssetType.String()),
+		result.MarkPrice,
+		result.IndexPrice,
+		result.OpenInterest,
+		result.EstimatedSettlementPrice)
+}
+
 func relayWebsocketEvent(result interface{}, event, assetType, exchangeName string) {
 	evt := WebsocketEvent{
 		Data:      result,
@@ -285,6 +312,8 @@ func (bot *Engine) WebsocketDataHandler(exchName string, data interface{}) error
 		log.Info(log.WebsocketMgr, d)
 	case error:
 		return fmt.Errorf("routines.go exchange %s websocket error - %s", exchName, data)
+	case stream.Delay:
+		bot.LatencyMonitor.observeWebsocketDelay(exchName, d.ExchangeTime, d.Received)
 	case stream.FundingData:
 		if bot.Settings.Verbose {
 			log.Infof(log.WebsocketMgr, "%s websocket %s %s funding updated %+v",
@@ -303,6 +332,38 @@ func (bot *Engine) WebsocketDataHandler(exchName string, data interface{}) error
 		}
 		err := ticker.ProcessTicker(d)
 		printTickerSummary(d, "websocket", err)
+	case *derivative.Price:
+		if bot.Settings.EnableExchangeSyncManager && bot.ExchangeCurrencyPairManager != nil {
+			bot.ExchangeCurrencyPairManager.update(exchName,
+				d.Pair,
+				d.AssetType,
+				SyncItemDerivative,
+				nil)
+		}
+		err := derivative.ProcessPrice(d)
+		if err != nil {
+			printDerivativePriceSummary(d, "websocket", err)
+			break
+		}
+		// Websocket updates are frequently partial, so print the merged price
+		merged, err := derivative.GetPrice(d.ExchangeName, d.Pair, d.AssetType)
+		printDerivativePriceSummary(merged, "websocket", err)
+	case []liquidation.Data:
+		err := liquidation.Process(d...)
+		if err != nil {
+			return err
+		}
+		if bot.Settings.Verbose {
+			for i := range d {
+				log.Infof(log.WebsocketMgr, "%s websocket %s %s liquidation %s %f @ %f",
+					exchName,
+					FormatCurrency(d[i].CurrencyPair),
+					d[i].AssetType,
+					d[i].Side,
+					d[i].Amount,
+					d[i].Price)
+			}
+		}
 	case stream.KlineData:
 		if bot.Settings.Verbose {
 			log.Infof(log.WebsocketMgr, "%s websocket %s %s kline updated %+v",
@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -41,6 +44,31 @@ func TestHandleData(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	err = b.WebsocketDataHandler(exchName, &derivative.Price{
+		ExchangeName: fakePassExchange,
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.PerpetualContract,
+		MarkPrice:    1337,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	err = b.WebsocketDataHandler(exchName, []liquidation.Data{{
+		Exchange:     fakePassExchange,
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.PerpetualContract,
+		Side:         order.Sell,
+		Price:        1337,
+		Amount:       1,
+		Timestamp:    time.Now(),
+	}})
+	if err != nil {
+		t.Error(err)
+	}
+	err = b.WebsocketDataHandler(exchName, []liquidation.Data{{}})
+	if err == nil {
+		t.Error("expected an error for invalid liquidation data")
+	}
 	err = b.WebsocketDataHandler(exchName, stream.KlineData{})
 	if err != nil {
 		t.Error(err)
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
@@ -25,15 +26,25 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/timeperiods"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/export"
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/balance"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/option"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
@@ -42,6 +53,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/pnl"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
 	"google.golang.org/grpc"
@@ -56,12 +69,18 @@ const (
 	errAssetTypeUnset     = "asset type unset"
 	errDispatchSystem     = "dispatch system offline"
 	invalidArguments      = "invalid arguments received"
+
+	exportChunkSize = 64 * 1024
 )
 
 var (
 	errExchangeNotLoaded    = errors.New("exchange is not loaded/doesn't exist")
 	errExchangeBaseNotFound = errors.New("cannot get exchange base")
+	errRequesterNotFound    = errors.New("exchange requester not set")
 	errInvalidArguments     = errors.New(invalidArguments)
+	errOfferIDUnset         = errors.New("offer ID unset")
+	errInvalidTimes         = errors.New("start time must be before end time")
+	errUnderlyingUnset      = errors.New("underlying currency unset")
 )
 
 // RPCServer struct
@@ -169,8 +188,16 @@ func (s *RPCServer) StartRPCRESTProxy() {
 		return
 	}
 
+	var handler http.Handler = mux
+	if s.Config.Metrics.Enabled {
+		serveMux := http.NewServeMux()
+		serveMux.HandleFunc(metricsPath, metricsHandler)
+		serveMux.Handle("/", mux)
+		handler = serveMux
+	}
+
 	go func() {
-		if err := http.ListenAndServe(s.Config.RemoteControl.GRPC.GRPCProxyListenAddress, mux); err != nil {
+		if err := http.ListenAndServe(s.Config.RemoteControl.GRPC.GRPCProxyListenAddress, handler); err != nil {
 			log.Errorf(log.GRPCSys, "gRPC proxy failed to server: %s\n", err)
 			return
 		}
@@ -2865,6 +2892,73 @@ func (s *RPCServer) SetExchangeTradeProcessing(_ context.Context, r *gctrpc.SetE
 	}, nil
 }
 
+// ExportSavedData streams candle, trade or fill data from the database to the
+// client in the requested file format
+func (s *RPCServer) ExportSavedData(r *gctrpc.ExportSavedDataRequest, stream gctrpc.GoCryptoTrader_ExportSavedDataServer) error {
+	if r.End == "" || r.Start == "" || r.Exchange == "" || r.Pair == nil || r.AssetType == "" || r.Pair.String() == "" {
+		return errInvalidArguments
+	}
+	if !s.Config.Database.Enabled {
+		return database.ErrDatabaseSupportDisabled
+	}
+	a := asset.Item(strings.ToLower(r.AssetType))
+	if !a.IsValid() {
+		return errors.New("invalid asset")
+	}
+	dataType, err := export.ParseDataType(r.DataType)
+	if err != nil {
+		return err
+	}
+	format, err := export.ParseFormat(r.Format)
+	if err != nil {
+		return err
+	}
+	var UTCStartTime, UTCEndTime time.Time
+	UTCStartTime, err = time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return err
+	}
+	UTCEndTime, err = time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return err
+	}
+
+	buf := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+	total, err := export.Write(&export.Params{
+		DataType:  dataType,
+		Format:    format,
+		Exchange:  r.Exchange,
+		Base:      r.Pair.Base,
+		Quote:     r.Pair.Quote,
+		Asset:     a.String(),
+		Interval:  r.Interval,
+		Start:     UTCStartTime,
+		End:       UTCEndTime,
+		BatchSize: int(r.BatchSize),
+	}, buf)
+	if err != nil {
+		return err
+	}
+	err = buf.Flush()
+	if err != nil {
+		return err
+	}
+	return stream.Send(&gctrpc.ExportSavedDataResponse{TotalRecords: total})
+}
+
+// exportStreamWriter sends each write as a chunk of exported data
+type exportStreamWriter struct {
+	stream gctrpc.GoCryptoTrader_ExportSavedDataServer
+}
+
+func (e *exportStreamWriter) Write(p []byte) (int, error) {
+	err := e.stream.Send(&gctrpc.ExportSavedDataResponse{Data: p})
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
 // GetHistoricTrades returns trades between a set of dates
 func (s *RPCServer) GetHistoricTrades(r *gctrpc.GetSavedTradesRequest, stream gctrpc.GoCryptoTrader_GetHistoricTradesServer) error {
 	if r.Exchange == "" || r.Pair == nil || r.AssetType == "" || r.Pair.String() == "" {
@@ -2992,3 +3086,1255 @@ func (s *RPCServer) GetRecentTrades(_ context.Context, r *gctrpc.GetSavedTradesR
 
 	return resp, nil
 }
+
+// GetBalanceSnapshots returns saved account balance snapshots between a set of
+// dates along with the total reporting currency value held at each snapshot
+func (s *RPCServer) GetBalanceSnapshots(_ context.Context, r *gctrpc.GetBalanceSnapshotsRequest) (*gctrpc.GetBalanceSnapshotsResponse, error) {
+	if r.Start == "" || r.End == "" {
+		return nil, errInvalidArguments
+	}
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, err
+	}
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, err
+	}
+	if r.AssetType != "" && !asset.Item(strings.ToLower(r.AssetType)).IsValid() {
+		return nil, errors.New("invalid asset")
+	}
+
+	snapshots, err := balance.GetInRange(&balance.Filter{
+		Exchange:  r.Exchange,
+		AccountID: r.AccountId,
+		Asset:     r.AssetType,
+		Currency:  r.Currency,
+		Start:     UTCStartTime,
+		End:       UTCEndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetBalanceSnapshotsResponse{}
+	var total *gctrpc.BalanceSnapshotTotal
+	for i := range snapshots {
+		ts := snapshots[i].Timestamp.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+		resp.Snapshots = append(resp.Snapshots, &gctrpc.BalanceSnapshot{
+			Exchange:          snapshots[i].Exchange,
+			AccountId:         snapshots[i].AccountID,
+			AssetType:         snapshots[i].Asset,
+			Currency:          snapshots[i].Currency,
+			Total:             snapshots[i].Total,
+			Hold:              snapshots[i].Hold,
+			ReportingCurrency: snapshots[i].ReportingCurrency,
+			ReportingValue:    snapshots[i].ReportingValue,
+			Valued:            snapshots[i].Valued,
+			Timestamp:         ts,
+		})
+		if total == nil || total.Timestamp != ts {
+			total = &gctrpc.BalanceSnapshotTotal{Timestamp: ts}
+			resp.Totals = append(resp.Totals, total)
+		}
+		if snapshots[i].Valued {
+			total.ReportingValue += snapshots[i].ReportingValue
+		} else {
+			total.UnvaluedBalances++
+		}
+	}
+	return resp, nil
+}
+
+// ImportFills imports an exchange's order history into the fills ledger
+func (s *RPCServer) ImportFills(_ context.Context, r *gctrpc.ImportFillsRequest) (*gctrpc.ImportFillsResponse, error) {
+	if r.Exchange == "" || r.AssetType == "" || r.Start == "" || r.End == "" {
+		return nil, errInvalidArguments
+	}
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	a := asset.Item(strings.ToLower(r.AssetType))
+	if !a.IsValid() {
+		return nil, errors.New("invalid asset")
+	}
+	var pairs currency.Pairs
+	if r.Pair != nil && r.Pair.String() != "" {
+		cp, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+		if err != nil {
+			return nil, err
+		}
+		pairs = currency.Pairs{cp}
+	} else {
+		var err error
+		pairs, err = exch.GetEnabledPairs(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, err
+	}
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, err
+	}
+	processed, err := s.ImportFillsFromOrderHistory(r.Exchange, a, pairs, UTCStartTime, UTCEndTime, r.Strategy)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ImportFillsResponse{FillsProcessed: int64(processed)}, nil
+}
+
+// fillFilterFromRequest builds a fill query from the common gRPC fields
+func fillFilterFromRequest(exchName string, pair *gctrpc.CurrencyPair, assetType, strategy, start, end string) (*fill.Filter, error) {
+	if start == "" || end == "" {
+		return nil, errInvalidArguments
+	}
+	UTCStartTime, err := time.Parse(common.SimpleTimeFormat, start)
+	if err != nil {
+		return nil, err
+	}
+	UTCEndTime, err := time.Parse(common.SimpleTimeFormat, end)
+	if err != nil {
+		return nil, err
+	}
+	if assetType != "" && !asset.Item(strings.ToLower(assetType)).IsValid() {
+		return nil, errors.New("invalid asset")
+	}
+	f := &fill.Filter{
+		Exchange:  exchName,
+		AssetType: assetType,
+		Strategy:  strategy,
+		Start:     UTCStartTime,
+		End:       UTCEndTime,
+	}
+	if pair != nil {
+		f.Base = pair.Base
+		f.Quote = pair.Quote
+	}
+	return f, nil
+}
+
+// GetFills returns our own executions from the fills ledger
+func (s *RPCServer) GetFills(_ context.Context, r *gctrpc.GetFillsRequest) (*gctrpc.GetFillsResponse, error) {
+	f, err := fillFilterFromRequest(r.Exchange, r.Pair, r.AssetType, r.Strategy, r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	fills, err := fill.GetInRange(f)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFillsResponse{}
+	for i := range fills {
+		resp.Fills = append(resp.Fills, &gctrpc.Fill{
+			Exchange: fills[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: currency.DashDelimiter,
+				Base:      fills[i].Base,
+				Quote:     fills[i].Quote,
+			},
+			AssetType: fills[i].AssetType,
+			OrderId:   fills[i].OrderID,
+			TradeId:   fills[i].TID,
+			Side:      fills[i].Side,
+			Price:     fills[i].Price,
+			Amount:    fills[i].Amount,
+			Fee:       fills[i].Fee,
+			FeeAsset:  fills[i].FeeAsset,
+			Strategy:  fills[i].Strategy,
+			Timestamp: fills[i].Timestamp.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		})
+	}
+	return resp, nil
+}
+
+// GetPNL calculates realised and unrealised profit and loss from the fills
+// ledger per exchange, asset, pair and strategy
+func (s *RPCServer) GetPNL(_ context.Context, r *gctrpc.GetPNLRequest) (*gctrpc.GetPNLResponse, error) {
+	f, err := fillFilterFromRequest(r.Exchange, r.Pair, r.AssetType, r.Strategy, r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	if r.Method == "" {
+		r.Method = string(pnl.FIFO)
+	}
+	method, err := pnl.ParseMethod(r.Method)
+	if err != nil {
+		return nil, err
+	}
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	summaries, err := CalculatePNL(f, method)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPNLResponse{}
+	for i := range summaries {
+		summary := &gctrpc.PNLSummary{
+			Exchange: summaries[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: summaries[i].Pair.Delimiter,
+				Base:      summaries[i].Pair.Base.String(),
+				Quote:     summaries[i].Pair.Quote.String(),
+			},
+			AssetType:        summaries[i].Asset,
+			Strategy:         summaries[i].Strategy,
+			Method:           string(summaries[i].Method),
+			Fills:            int64(summaries[i].Fills),
+			RealisedPnl:      summaries[i].RealisedPNL,
+			UnrealisedPnl:    summaries[i].UnrealisedPNL,
+			Fees:             summaries[i].Fees,
+			UnvaluedFees:     int64(summaries[i].UnvaluedFees),
+			OpenAmount:       summaries[i].OpenAmount,
+			AverageOpenPrice: summaries[i].AverageOpenPrice,
+			MarkPrice:        summaries[i].MarkPrice,
+			ShortOpened:      summaries[i].ShortOpened,
+		}
+		if r.IncludeDisposals {
+			for j := range summaries[i].Disposals {
+				d := &summaries[i].Disposals[j]
+				summary.Disposals = append(summary.Disposals, &gctrpc.PNLDisposal{
+					Short:      d.Short,
+					Amount:     d.Amount,
+					OpenPrice:  d.OpenPrice,
+					ClosePrice: d.ClosePrice,
+					Fee:        d.Fee,
+					Pnl:        d.PNL,
+					OpenTime:   d.OpenTime.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+					CloseTime:  d.CloseTime.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+				})
+			}
+		}
+		resp.Summaries = append(resp.Summaries, summary)
+	}
+	return resp, nil
+}
+
+// GetRateLimitBudget returns an exchange's rate limit budget as last reported
+// by its REST responses
+func (s *RPCServer) GetRateLimitBudget(_ context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GetRateLimitBudgetResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	base := exch.GetBase()
+	if base == nil {
+		return nil, errExchangeBaseNotFound
+	}
+	if base.Requester == nil {
+		return nil, errRequesterNotFound
+	}
+	b := base.Requester.GetRateLimitBudget()
+	return &gctrpc.GetRateLimitBudgetResponse{
+		Exchange:           base.Name,
+		RateLimiterEnabled: b.Enabled,
+		Limit:              b.Limit,
+		Remaining:          b.Remaining,
+		ResetAt:            formatBudgetTime(b.ResetAt),
+		BlockedUntil:       formatBudgetTime(b.BlockedUntil),
+		RateLimited:        b.RateLimited,
+		LastUpdated:        formatBudgetTime(b.LastUpdated),
+	}, nil
+}
+
+// formatBudgetTime formats a rate limit budget time, leaving unset times
+// empty
+func formatBudgetTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+}
+
+// GetLatencyStats returns REST round trip, websocket delay and server clock
+// offset percentiles for an exchange, or for every exchange when none is
+// specified
+func (s *RPCServer) GetLatencyStats(_ context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GetLatencyStatsResponse, error) {
+	stats, err := s.LatencyMonitor.GetLatency(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetLatencyStatsResponse{
+		Exchanges: make([]*gctrpc.ExchangeLatency, len(stats)),
+	}
+	for i := range stats {
+		resp.Exchanges[i] = &gctrpc.ExchangeLatency{
+			Exchange:       stats[i].Exchange,
+			RoundTrip:      latencyPercentiles(&stats[i].RoundTrip),
+			WebsocketDelay: latencyPercentiles(&stats[i].WebsocketDelay),
+			ServerOffset:   latencyPercentiles(&stats[i].ServerOffset),
+		}
+	}
+	return resp, nil
+}
+
+// GetPositions returns an exchange's open positions for a derivatives asset
+// type
+func (s *RPCServer) GetPositions(_ context.Context, r *gctrpc.GetPositionsRequest) (*gctrpc.GetPositionsResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := exch.GetDerivativePositions(a)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPositionsResponse{
+		Positions: make([]*gctrpc.Position, len(positions)),
+	}
+	for i := range positions {
+		resp.Positions[i] = &gctrpc.Position{
+			Exchange: positions[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: positions[i].Pair.Delimiter,
+				Base:      positions[i].Pair.Base.String(),
+				Quote:     positions[i].Pair.Quote.String(),
+			},
+			Asset:            positions[i].Asset.String(),
+			Side:             positions[i].Side.String(),
+			Size:             positions[i].Size,
+			EntryPrice:       positions[i].EntryPrice,
+			MarkPrice:        positions[i].MarkPrice,
+			LiquidationPrice: positions[i].LiquidationPrice,
+			Leverage:         positions[i].Leverage,
+			UnrealisedPnl:    positions[i].UnrealisedPNL,
+			MarginType:       string(positions[i].MarginType),
+			UpdatedAt:        positions[i].UpdatedAt.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// ClosePosition closes all or part of an open derivatives position, with a
+// market order when no price is set
+func (s *RPCServer) ClosePosition(_ context.Context, r *gctrpc.ClosePositionRequest) (*gctrpc.ClosePositionResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	c := &position.Close{
+		Exchange:      exch.GetName(),
+		Pair:          p,
+		Asset:         a,
+		Amount:        r.Amount,
+		Price:         r.Price,
+		ClientOrderID: r.ClientOrderId,
+	}
+	if r.Side != "" {
+		c.Side, err = order.StringToOrderSide(r.Side)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = c.Validate()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := exch.CloseDerivativePosition(c)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ClosePositionResponse{
+		OrderPlaced: resp.IsOrderPlaced,
+		OrderId:     resp.OrderID,
+	}, nil
+}
+
+// GetLatestFundingRate returns the current funding rate of a perpetual
+// contract
+func (s *RPCServer) GetLatestFundingRate(_ context.Context, r *gctrpc.GetLatestFundingRateRequest) (*gctrpc.GetLatestFundingRateResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := exch.GetLatestFundingRate(p, a)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetLatestFundingRateResponse{
+		Exchange:      rate.Exchange,
+		Pair:          r.Pair,
+		Asset:         rate.Asset.String(),
+		Rate:          rate.Rate,
+		PredictedRate: rate.PredictedRate,
+		UpdatedAt:     rate.UpdatedAt.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}
+	if !rate.NextFundingTime.IsZero() {
+		resp.NextFundingTime = rate.NextFundingTime.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistory returns the funding rates applied to a perpetual
+// contract over a date range from the exchange or the database, rates fetched
+// from the exchange can be synced to the database for later research
+func (s *RPCServer) GetFundingRateHistory(_ context.Context, r *gctrpc.GetFundingRateHistoryRequest) (*gctrpc.GetFundingRateHistoryResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	if r.Start == "" || r.End == "" {
+		return nil, errors.New(errStartEndTimesUnset)
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, err
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates *fundingrate.HistoricalRates
+	if r.UseDb {
+		rates, err = fundingrate.LoadFromDatabase(r.Exchange, p, a, start, end)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		exch := s.GetExchangeByName(r.Exchange)
+		if exch == nil {
+			return nil, errExchangeNotLoaded
+		}
+		rates, err = exch.GetFundingRateHistory(&fundingrate.HistoricalRatesRequest{
+			Pair:  p,
+			Asset: a,
+			Start: start,
+			End:   end,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if r.Sync && len(rates.Rates) > 0 {
+			err = fundingrate.StoreInDatabase(rates)
+			if err != nil {
+				if errors.Is(err, exchangeDB.ErrNoExchangeFound) {
+					return nil, errors.New("exchange was not found in database, you can seed existing data or insert a new exchange via the dbseed")
+				}
+				return nil, err
+			}
+		}
+	}
+
+	resp := &gctrpc.GetFundingRateHistoryResponse{
+		Exchange: rates.Exchange,
+		Pair:     r.Pair,
+		Asset:    a.String(),
+		Start:    r.Start,
+		End:      r.End,
+		Rates:    make([]*gctrpc.FundingRate, len(rates.Rates)),
+	}
+	for i := range rates.Rates {
+		resp.Rates[i] = &gctrpc.FundingRate{
+			Time: rates.Rates[i].Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+			Rate: rates.Rates[i].Rate,
+		}
+	}
+	return resp, nil
+}
+
+// GetLeverage returns the leverage and margin type an exchange applies to
+// orders in a derivatives contract
+func (s *RPCServer) GetLeverage(_ context.Context, r *gctrpc.GetLeverageRequest) (*gctrpc.GetLeverageResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	l, err := exch.GetLeverage(p, a)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetLeverageResponse{
+		Exchange:   l.Exchange,
+		Pair:       r.Pair,
+		Asset:      l.Asset.String(),
+		Leverage:   l.Leverage,
+		MarginType: string(l.MarginType),
+	}, nil
+}
+
+// SetLeverage sets the leverage an exchange applies to orders in a
+// derivatives contract
+func (s *RPCServer) SetLeverage(_ context.Context, r *gctrpc.SetLeverageRequest) (*gctrpc.GenericResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	err = position.ValidateLeverage(r.Leverage)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.SetLeverage(p, a, r.Leverage)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// SetMarginType sets whether a derivatives contract uses isolated or cross
+// margin
+func (s *RPCServer) SetMarginType(_ context.Context, r *gctrpc.SetMarginTypeRequest) (*gctrpc.GenericResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	m := position.StringToMarginType(r.MarginType)
+	err = m.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = exch.SetMarginType(p, a, m)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// GetLoans returns the outstanding borrowed and lent funds, loans are fetched
+// from the exchange when one is specified, otherwise the loans tracked by the
+// portfolio manager are returned
+func (s *RPCServer) GetLoans(_ context.Context, r *gctrpc.GetLoansRequest) (*gctrpc.GetLoansResponse, error) {
+	pf := portfolio.GetPortfolio()
+	if r.Exchange != "" {
+		exch := s.GetExchangeByName(r.Exchange)
+		if exch == nil {
+			return nil, errExchangeNotLoaded
+		}
+		loans, err := exch.GetLoans()
+		if err != nil {
+			return nil, err
+		}
+		pf.UpdateExchangeLoans(exch.GetName(), loans)
+	}
+	loans := pf.GetExchangeLoans(r.Exchange)
+	resp := &gctrpc.GetLoansResponse{}
+	for i := range loans {
+		l := &gctrpc.LoanDetails{
+			Exchange: loans[i].Exchange,
+			Id:       loans[i].ID,
+			Currency: loans[i].Currency.String(),
+			Side:     string(loans[i].Side),
+			Amount:   loans[i].Amount,
+			Interest: loans[i].Interest,
+			Rate:     loans[i].Rate,
+			Period:   loans[i].Period,
+		}
+		if !loans[i].CreatedAt.IsZero() {
+			l.CreatedAt = loans[i].CreatedAt.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+		}
+		resp.Loans = append(resp.Loans, l)
+	}
+	return resp, nil
+}
+
+// Borrow borrows funds against an exchange margin account
+func (s *RPCServer) Borrow(_ context.Context, r *gctrpc.BorrowRequest) (*gctrpc.LendingTransactionResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	b := &lending.Borrow{
+		Exchange: exch.GetName(),
+		Currency: currency.NewCode(r.Currency),
+		Amount:   r.Amount,
+	}
+	err := b.Validate()
+	if err != nil {
+		return nil, err
+	}
+	id, err := exch.Borrow(b)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshLoans(exch)
+	return &gctrpc.LendingTransactionResponse{Id: id}, nil
+}
+
+// Repay repays borrowed funds, a zero amount repays the whole loan
+func (s *RPCServer) Repay(_ context.Context, r *gctrpc.RepayRequest) (*gctrpc.LendingTransactionResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	repay := &lending.Repay{
+		Exchange: exch.GetName(),
+		Currency: currency.NewCode(r.Currency),
+		Amount:   r.Amount,
+		LoanID:   r.LoanId,
+	}
+	err := repay.Validate()
+	if err != nil {
+		return nil, err
+	}
+	id, err := exch.Repay(repay)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshLoans(exch)
+	return &gctrpc.LendingTransactionResponse{Id: id}, nil
+}
+
+// OfferFunds offers funds to be lent to margin traders
+func (s *RPCServer) OfferFunds(_ context.Context, r *gctrpc.OfferFundsRequest) (*gctrpc.LendingTransactionResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	o := &lending.Offer{
+		Exchange: exch.GetName(),
+		Currency: currency.NewCode(r.Currency),
+		Amount:   r.Amount,
+		Rate:     r.Rate,
+		Period:   r.Period,
+	}
+	err := o.Validate()
+	if err != nil {
+		return nil, err
+	}
+	id, err := exch.OfferFunds(o)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.LendingTransactionResponse{Id: id}, nil
+}
+
+// CancelFundingOffer cancels an open lending offer
+func (s *RPCServer) CancelFundingOffer(_ context.Context, r *gctrpc.CancelFundingOfferRequest) (*gctrpc.GenericResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if r.Id == "" {
+		return nil, errOfferIDUnset
+	}
+	err := exch.CancelFundingOffer(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// refreshLoans updates the loans tracked for an exchange after a borrow or
+// repayment, failures are logged as the transaction itself succeeded
+func (s *RPCServer) refreshLoans(exch exchange.IBotExchange) {
+	loans, err := exch.GetLoans()
+	if err != nil {
+		log.Errorf(log.GRPCSys, "Unable to refresh %s loans: %s\n", exch.GetName(), err)
+		return
+	}
+	portfolio.GetPortfolio().UpdateExchangeLoans(exch.GetName(), loans)
+}
+
+// GetDerivativePrice returns the mark price, index price, open interest and
+// estimated settlement price for a derivatives contract
+func (s *RPCServer) GetDerivativePrice(_ context.Context, r *gctrpc.GetDerivativePriceRequest) (*gctrpc.DerivativePriceResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	price, err := derivative.GetPrice(r.Exchange, p, a)
+	if err != nil {
+		price, err = exch.UpdateDerivativePrice(p, a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return derivativePriceToRPC(price), nil
+}
+
+// GetDerivativePriceStream streams derivative price updates for a contract
+func (s *RPCServer) GetDerivativePriceStream(r *gctrpc.GetDerivativePriceStreamRequest, stream gctrpc.GoCryptoTrader_GetDerivativePriceStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	if r.Pair == nil {
+		return errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return errors.New(errAssetTypeUnset)
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return err
+	}
+
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return err
+	}
+
+	pipe, err := derivative.SubscribePrice(r.Exchange, p, a)
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		price := (*data.(*interface{})).(derivative.Price)
+		err := stream.Send(derivativePriceToRPC(&price))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetExchangeDerivativePriceStream streams all derivative price updates for
+// an exchange
+func (s *RPCServer) GetExchangeDerivativePriceStream(r *gctrpc.GetExchangeDerivativePriceStreamRequest, stream gctrpc.GoCryptoTrader_GetExchangeDerivativePriceStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	pipe, err := derivative.SubscribeToExchangePrices(r.Exchange)
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		price := (*data.(*interface{})).(derivative.Price)
+		err := stream.Send(derivativePriceToRPC(&price))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func derivativePriceToRPC(p *derivative.Price) *gctrpc.DerivativePriceResponse {
+	return &gctrpc.DerivativePriceResponse{
+		Exchange: p.ExchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Pair.Delimiter,
+			Base:      p.Pair.Base.String(),
+			Quote:     p.Pair.Quote.String(),
+		},
+		Asset:                    p.AssetType.String(),
+		MarkPrice:                p.MarkPrice,
+		IndexPrice:               p.IndexPrice,
+		OpenInterest:             p.OpenInterest,
+		EstimatedSettlementPrice: p.EstimatedSettlementPrice,
+		LastUpdated:              p.LastUpdated.Unix(),
+	}
+}
+
+// GetOptionChain returns the option contracts of an underlying currency,
+// optionally limited to a single expiry
+func (s *RPCServer) GetOptionChain(_ context.Context, r *gctrpc.GetOptionChainRequest) (*gctrpc.OptionChainResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	if r.Underlying == "" {
+		return nil, errUnderlyingUnset
+	}
+	chain, err := exch.GetOptionChain(currency.NewCode(r.Underlying))
+	if err != nil {
+		return nil, err
+	}
+	quotes := chain.Quotes
+	if r.Expiry != 0 {
+		quotes = chain.GetByExpiry(time.Unix(r.Expiry, 0))
+	}
+	resp := &gctrpc.OptionChainResponse{
+		Exchange:    chain.Exchange,
+		Underlying:  chain.Underlying.String(),
+		Quotes:      make([]*gctrpc.OptionQuote, len(quotes)),
+		LastUpdated: chain.LastUpdated.Unix(),
+	}
+	for i := range quotes {
+		resp.Quotes[i] = optionQuoteToRPC(&quotes[i])
+	}
+	return resp, nil
+}
+
+func optionQuoteToRPC(q *option.Quote) *gctrpc.OptionQuote {
+	return &gctrpc.OptionQuote{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: q.Pair.Delimiter,
+			Base:      q.Pair.Base.String(),
+			Quote:     q.Pair.Quote.String(),
+		},
+		Underlying:      q.Underlying.String(),
+		Strike:          q.Strike,
+		Expiry:          q.Expiry.Unix(),
+		Type:            q.Type.String(),
+		Bid:             q.Bid,
+		Ask:             q.Ask,
+		Last:            q.Last,
+		Mark:            q.Mark,
+		MarkIv:          q.MarkIV,
+		UnderlyingPrice: q.UnderlyingPrice,
+		OpenInterest:    q.OpenInterest,
+		Volume:          q.Volume,
+	}
+}
+
+// GetContracts returns the type, expiry and settlement currency of the
+// contracts of a derivatives asset, contracts are fetched from the exchange
+// when they have not yet been loaded by the order manager
+func (s *RPCServer) GetContracts(_ context.Context, r *gctrpc.GetContractsRequest) (*gctrpc.GetContractsResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := exch.GetContracts(a)
+	if err != nil {
+		return nil, err
+	}
+	if len(contracts) == 0 {
+		_, err = exch.UpdateContracts(a)
+		if err != nil {
+			return nil, err
+		}
+		contracts, err = exch.GetContracts(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp := &gctrpc.GetContractsResponse{
+		Exchange:  exch.GetName(),
+		AssetType: a.String(),
+		Contracts: make([]*gctrpc.ContractInfo, len(contracts)),
+	}
+	for i := range contracts {
+		var expiry int64
+		if !contracts[i].Expiry.IsZero() {
+			expiry = contracts[i].Expiry.Unix()
+		}
+		resp.Contracts[i] = &gctrpc.ContractInfo{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: contracts[i].Pair.Delimiter,
+				Base:      contracts[i].Pair.Base.String(),
+				Quote:     contracts[i].Pair.Quote.String(),
+			},
+			Underlying:         contracts[i].Underlying.String(),
+			Type:               contracts[i].Type.String(),
+			Expiry:             expiry,
+			SettlementCurrency: contracts[i].SettlementCurrency.String(),
+		}
+	}
+	return resp, nil
+}
+
+// GetSubAccounts returns the sub-accounts registered under the master account
+// of an exchange
+func (s *RPCServer) GetSubAccounts(_ context.Context, r *gctrpc.GetSubAccountsRequest) (*gctrpc.GetSubAccountsResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	subs, err := exch.GetSubAccounts()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetSubAccountsResponse{
+		Exchange:    exch.GetName(),
+		SubAccounts: make([]*gctrpc.SubAccountInfo, len(subs)),
+	}
+	for i := range subs {
+		var createdAt int64
+		if !subs[i].CreatedAt.IsZero() {
+			createdAt = subs[i].CreatedAt.Unix()
+		}
+		resp.SubAccounts[i] = &gctrpc.SubAccountInfo{
+			Id:        subs[i].ID,
+			Name:      subs[i].Name,
+			Disabled:  subs[i].Disabled,
+			CreatedAt: createdAt,
+		}
+	}
+	return resp, nil
+}
+
+// TransferFunds moves funds between the wallets of an exchange account or
+// between the master account and its sub-accounts
+func (s *RPCServer) TransferFunds(_ context.Context, r *gctrpc.TransferFundsRequest) (*gctrpc.TransferFundsResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	fromAsset, err := asset.New(r.FromAsset)
+	if err != nil {
+		return nil, err
+	}
+	toAsset, err := asset.New(r.ToAsset)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := SubmitTransfer(&transfer.Request{
+		Exchange:    exch.GetName(),
+		Currency:    currency.NewCode(strings.ToUpper(r.Currency)),
+		Amount:      r.Amount,
+		Description: r.Description,
+		FromAccount: r.FromAccount,
+		ToAccount:   r.ToAccount,
+		FromAsset:   fromAsset,
+		ToAsset:     toAsset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.TransferFundsResponse{
+		Id:         resp.ID.String(),
+		ExchangeId: resp.Exchange.ID,
+		Status:     resp.Exchange.Status,
+	}, nil
+}
+
+// TransferEventByID returns a previous transfer request by ID
+func (s *RPCServer) TransferEventByID(_ context.Context, r *gctrpc.TransferEventByIDRequest) (*gctrpc.TransferEventsResponse, error) {
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	v, err := TransferEventByID(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return parseTransferEvents([]*transfer.Response{v}), nil
+}
+
+// TransferEventsByExchange returns the most recent transfer requests of an
+// exchange
+func (s *RPCServer) TransferEventsByExchange(_ context.Context, r *gctrpc.TransferEventsByExchangeRequest) (*gctrpc.TransferEventsResponse, error) {
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	ret, err := TransferEventsByExchange(r.Exchange, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return parseTransferEvents(ret), nil
+}
+
+// TransferEventsByDate returns the transfer requests between two dates
+func (s *RPCServer) TransferEventsByDate(_ context.Context, r *gctrpc.TransferEventsByDateRequest) (*gctrpc.TransferEventsResponse, error) {
+	if !s.Config.Database.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, err
+	}
+	if !start.Before(end) {
+		return nil, errInvalidTimes
+	}
+	ret, err := TransferEventsByDate(r.Exchange, start, end, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return parseTransferEvents(ret), nil
+}
+
+func parseTransferEvents(ret []*transfer.Response) *gctrpc.TransferEventsResponse {
+	resp := &gctrpc.TransferEventsResponse{
+		Events: make([]*gctrpc.TransferEvent, len(ret)),
+	}
+	for i := range ret {
+		createdAt, err := ptypes.TimestampProto(ret[i].CreatedAt)
+		if err != nil {
+			log.Errorf(log.Global, "failed to convert time: %v", err)
+		}
+		updatedAt, err := ptypes.TimestampProto(ret[i].UpdatedAt)
+		if err != nil {
+			log.Errorf(log.Global, "failed to convert time: %v", err)
+		}
+		resp.Events[i] = &gctrpc.TransferEvent{
+			Id:          ret[i].ID.String(),
+			Exchange:    ret[i].Exchange.Name,
+			ExchangeId:  ret[i].Exchange.ID,
+			Status:      ret[i].Exchange.Status,
+			Currency:    ret[i].RequestDetails.Currency.String(),
+			Amount:      ret[i].RequestDetails.Amount,
+			Description: ret[i].RequestDetails.Description,
+			FromAccount: ret[i].RequestDetails.FromAccount,
+			ToAccount:   ret[i].RequestDetails.ToAccount,
+			FromAsset:   ret[i].RequestDetails.FromAsset.String(),
+			ToAsset:     ret[i].RequestDetails.ToAsset.String(),
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		}
+	}
+	return resp
+}
+
+// GetLiquidations returns the public liquidation events of a derivatives
+// contract between the start and end dates from the exchange
+func (s *RPCServer) GetLiquidations(_ context.Context, r *gctrpc.GetLiquidationsRequest) (*gctrpc.LiquidationsResponse, error) {
+	exch, p, a, start, end, err := s.parseLiquidationsRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	liquidations, err := exch.GetLiquidations(p, a, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return liquidationsToRPC(exch.GetName(), p, a, liquidations), nil
+}
+
+// GetSavedLiquidations returns liquidation events saved to the database
+// between the start and end dates
+func (s *RPCServer) GetSavedLiquidations(_ context.Context, r *gctrpc.GetLiquidationsRequest) (*gctrpc.LiquidationsResponse, error) {
+	exch, p, a, start, end, err := s.parseLiquidationsRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	liquidations, err := liquidation.GetLiquidationsInRange(exch.GetName(),
+		a.String(),
+		p.Base.String(),
+		p.Quote.String(),
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	return liquidationsToRPC(exch.GetName(), p, a, liquidations), nil
+}
+
+// GetLiquidationStream streams liquidation events for an exchange, optionally
+// filtered by currency pair and asset type
+func (s *RPCServer) GetLiquidationStream(r *gctrpc.GetLiquidationStreamRequest, stream gctrpc.GoCryptoTrader_GetLiquidationStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	var p currency.Pair
+	if r.Pair != nil && (r.Pair.Base != "" || r.Pair.Quote != "") {
+		var err error
+		p, err = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+		if err != nil {
+			return err
+		}
+	}
+
+	var a asset.Item
+	if r.AssetType != "" {
+		var err error
+		a, err = asset.New(r.AssetType)
+		if err != nil {
+			return err
+		}
+	}
+
+	pipe, err := liquidation.SubscribeToExchangeLiquidations(r.Exchange)
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		l := (*data.(*interface{})).(liquidation.Data)
+		if !p.IsEmpty() && !l.CurrencyPair.Equal(p) {
+			continue
+		}
+		if a != "" && l.AssetType != a {
+			continue
+		}
+		err := stream.Send(liquidationsToRPC(l.Exchange,
+			l.CurrencyPair,
+			l.AssetType,
+			[]liquidation.Data{l}))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// SetExchangeLiquidationProcessing allows the setting of exchange liquidation
+// processing
+func (s *RPCServer) SetExchangeLiquidationProcessing(_ context.Context, r *gctrpc.SetExchangeLiquidationProcessingRequest) (*gctrpc.GenericResponse, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errExchangeNotLoaded
+	}
+	b := exch.GetBase()
+	b.SetSaveLiquidationDataStatus(r.Status)
+
+	return &gctrpc.GenericResponse{
+		Status: "success",
+	}, nil
+}
+
+func (s *RPCServer) parseLiquidationsRequest(r *gctrpc.GetLiquidationsRequest) (exchange.IBotExchange, currency.Pair, asset.Item, time.Time, time.Time, error) {
+	exch := s.GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, currency.Pair{}, "", time.Time{}, time.Time{}, errExchangeNotLoaded
+	}
+	if r.Pair == nil {
+		return nil, currency.Pair{}, "", time.Time{}, time.Time{}, errors.New(errCurrencyPairUnset)
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, currency.Pair{}, "", time.Time{}, time.Time{}, err
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, currency.Pair{}, "", time.Time{}, time.Time{}, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, currency.Pair{}, "", time.Time{}, time.Time{}, err
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, currency.Pair{}, "", time.Time{}, time.Time{}, err
+	}
+	if !start.Before(end) {
+		return nil, currency.Pair{}, "", time.Time{}, time.Time{}, errInvalidTimes
+	}
+	return exch, p, a, start, end, nil
+}
+
+func liquidationsToRPC(exchName string, p currency.Pair, a asset.Item, liquidations []liquidation.Data) *gctrpc.LiquidationsResponse {
+	resp := &gctrpc.LiquidationsResponse{
+		ExchangeName: exchName,
+		Asset:        a.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+	}
+	for i := range liquidations {
+		resp.Liquidations = append(resp.Liquidations, &gctrpc.LiquidationDetail{
+			Price:     liquidations[i].Price,
+			Amount:    liquidations[i].Amount,
+			Side:      liquidations[i].Side.String(),
+			Timestamp: liquidations[i].Timestamp.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		})
+	}
+	return resp
+}
+
+// latencyPercentiles converts latency statistics to milliseconds
+func latencyPercentiles(l *LatencyStats) *gctrpc.LatencyPercentiles {
+	ms := func(d time.Duration) float64 {
+		return float64(d) / float64(time.Millisecond)
+	}
+	return &gctrpc.LatencyPercentiles{
+		Samples: int64(l.Samples),
+		P50Ms:   ms(l.P50),
+		P90Ms:   ms(l.P90),
+		P99Ms:   ms(l.P99),
+		MaxMs:   ms(l.Max),
+	}
+}
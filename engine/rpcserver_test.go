@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,14 +18,23 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/balance"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fill"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/goose"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -786,6 +796,219 @@ func TestGetHistoricTrades(t *testing.T) {
 	}
 }
 
+type exportSavedDataStream struct {
+	grpc.ServerStream
+	data  []byte
+	total uint64
+}
+
+func (e *exportSavedDataStream) Send(r *gctrpc.ExportSavedDataResponse) error {
+	e.data = append(e.data, r.Data...)
+	e.total += r.TotalRecords
+	return nil
+}
+
+func TestExportSavedData(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	stream := &exportSavedDataStream{}
+	err := s.ExportSavedData(&gctrpc.ExportSavedDataRequest{}, stream)
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("expected %v, received %v", errInvalidArguments, err)
+	}
+	req := &gctrpc.ExportSavedDataRequest{
+		Exchange: testExchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: currency.DashDelimiter,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+		},
+		AssetType: asset.Spot.String(),
+		DataType:  "trade",
+		Format:    "xlsx",
+		Start:     time.Date(2020, 0, 0, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat),
+		End:       time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC).Format(common.SimpleTimeFormat),
+	}
+	err = s.ExportSavedData(req, stream)
+	if err == nil {
+		t.Error(unexpectedLackOfError)
+	}
+	err = sqltrade.Insert(sqltrade.Data{
+		TID:       "1337",
+		Timestamp: time.Date(2020, 0, 0, 0, 0, 1, 0, time.UTC),
+		Exchange:  testExchange,
+		Base:      currency.BTC.String(),
+		Quote:     currency.USD.String(),
+		AssetType: asset.Spot.String(),
+		Price:     1337,
+		Amount:    1337,
+		Side:      order.Buy.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Format = "csv"
+	err = s.ExportSavedData(req, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream.total != 1 {
+		t.Errorf("expected 1 record, received %v", stream.total)
+	}
+	if !strings.Contains(string(stream.data), "1337") {
+		t.Errorf("expected exported trade in output, received %s", stream.data)
+	}
+}
+
+func TestGetBalanceSnapshots(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	_, err := s.GetBalanceSnapshots(context.Background(), &gctrpc.GetBalanceSnapshotsRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("expected %v, received %v", errInvalidArguments, err)
+	}
+	ts := time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+	err = balance.Insert(
+		balance.Snapshot{
+			Exchange:          testExchange,
+			AccountID:         "main",
+			Asset:             asset.Spot.String(),
+			Currency:          currency.BTC.String(),
+			Total:             2,
+			ReportingCurrency: currency.USD.String(),
+			ReportingValue:    20000,
+			Valued:            true,
+			Timestamp:         ts,
+		},
+		balance.Snapshot{
+			Exchange:          testExchange,
+			AccountID:         "main",
+			Asset:             asset.Spot.String(),
+			Currency:          currency.USD.String(),
+			Total:             500,
+			ReportingCurrency: currency.USD.String(),
+			ReportingValue:    500,
+			Valued:            true,
+			Timestamp:         ts,
+		},
+		balance.Snapshot{
+			Exchange:          testExchange,
+			AccountID:         "main",
+			Asset:             asset.Spot.String(),
+			Currency:          currency.DOGE.String(),
+			Total:             1000,
+			ReportingCurrency: currency.USD.String(),
+			Timestamp:         ts,
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.GetBalanceSnapshots(context.Background(), &gctrpc.GetBalanceSnapshotsRequest{
+		Exchange: testExchange,
+		Start:    ts.Add(-time.Hour).Format(common.SimpleTimeFormat),
+		End:      ts.Add(time.Hour).Format(common.SimpleTimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Snapshots) != 3 {
+		t.Fatalf("expected 3 snapshots, received %v", len(resp.Snapshots))
+	}
+	if len(resp.Totals) != 1 {
+		t.Fatalf("expected 1 total, received %v", len(resp.Totals))
+	}
+	if resp.Totals[0].ReportingValue != 20500 {
+		t.Errorf("expected total of 20500, received %v", resp.Totals[0].ReportingValue)
+	}
+	if resp.Totals[0].UnvaluedBalances != 1 {
+		t.Errorf("expected 1 unvalued balance, received %v", resp.Totals[0].UnvaluedBalances)
+	}
+}
+
+func TestGetFillsAndPNL(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	_, err := s.GetFills(context.Background(), &gctrpc.GetFillsRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("expected %v, received %v", errInvalidArguments, err)
+	}
+	_, err = s.ImportFills(context.Background(), &gctrpc.ImportFillsRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("expected %v, received %v", errInvalidArguments, err)
+	}
+	ts := time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+	err = fill.Insert(
+		fill.Data{
+			Exchange:  testExchange,
+			OrderID:   "1",
+			TID:       "1",
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+			AssetType: asset.Spot.String(),
+			Side:      order.Buy.String(),
+			Price:     1000,
+			Amount:    2,
+			Timestamp: ts,
+		},
+		fill.Data{
+			Exchange:  testExchange,
+			OrderID:   "2",
+			TID:       "2",
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+			AssetType: asset.Spot.String(),
+			Side:      order.Sell.String(),
+			Price:     1500,
+			Amount:    1,
+			Fee:       5,
+			FeeAsset:  currency.USD.String(),
+			Timestamp: ts.Add(time.Minute),
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fills, err := s.GetFills(context.Background(), &gctrpc.GetFillsRequest{
+		Exchange: testExchange,
+		Start:    ts.Add(-time.Hour).Format(common.SimpleTimeFormat),
+		End:      ts.Add(time.Hour).Format(common.SimpleTimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills.Fills) != 2 {
+		t.Fatalf("expected 2 fills, received %v", len(fills.Fills))
+	}
+
+	req := &gctrpc.GetPNLRequest{
+		Exchange:         testExchange,
+		Start:            ts.Add(-time.Hour).Format(common.SimpleTimeFormat),
+		End:              ts.Add(time.Hour).Format(common.SimpleTimeFormat),
+		Method:           "hifo",
+		IncludeDisposals: true,
+	}
+	_, err = s.GetPNL(context.Background(), req)
+	if err == nil {
+		t.Error(unexpectedLackOfError)
+	}
+	req.Method = ""
+	resp, err := s.GetPNL(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Summaries) != 1 {
+		t.Fatalf("expected 1 summary, received %v", len(resp.Summaries))
+	}
+	if resp.Summaries[0].RealisedPnl != 495 {
+		t.Errorf("expected realised pnl of 495, received %v", resp.Summaries[0].RealisedPnl)
+	}
+	if resp.Summaries[0].OpenAmount != 1 || len(resp.Summaries[0].Disposals) != 1 {
+		t.Errorf("unexpected summary %+v", resp.Summaries[0])
+	}
+}
+
 func TestGetAccountInfo(t *testing.T) {
 	bot := SetupTestHelpers(t)
 	s := RPCServer{Engine: bot}
@@ -818,3 +1041,608 @@ func TestUpdateAccountInfo(t *testing.T) {
 		t.Fatalf("TestGetAccountInfo: Unexpected value of the 'TotalValue'")
 	}
 }
+
+func TestGetRateLimitBudget(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetRateLimitBudget(context.Background(), &gctrpc.GenericExchangeNameRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+
+	resp, err := s.GetRateLimitBudget(context.Background(), &gctrpc.GenericExchangeNameRequest{Exchange: testExchange})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Exchange != testExchange || resp.LastUpdated != "" {
+		t.Errorf("unexpected budget %+v", resp)
+	}
+}
+
+func TestGetLatencyStats(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetLatencyStats(context.Background(), &gctrpc.GenericExchangeNameRequest{})
+	if !errors.Is(err, errLatencyMonitorNotStarted) {
+		t.Fatalf("expected %v, received %v", errLatencyMonitorNotStarted, err)
+	}
+
+	if err = engerino.LatencyMonitor.Start(engerino); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = engerino.LatencyMonitor.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	now := time.Now()
+	engerino.LatencyMonitor.observeWebsocketDelay(testExchange, now.Add(-time.Millisecond*5), now)
+	resp, err := s.GetLatencyStats(context.Background(), &gctrpc.GenericExchangeNameRequest{Exchange: testExchange})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Exchanges) != 1 || resp.Exchanges[0].WebsocketDelay.P50Ms != 5 {
+		t.Errorf("unexpected latency stats %+v", resp.Exchanges)
+	}
+}
+
+func TestGetPositions(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetPositions(context.Background(), &gctrpc.GetPositionsRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetPositions(context.Background(), &gctrpc.GetPositionsRequest{
+		Exchange: testExchange,
+		Asset:    asset.Futures.String(),
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestClosePosition(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.ClosePosition(context.Background(), &gctrpc.ClosePositionRequest{Exchange: testExchange})
+	if err == nil || err.Error() != errCurrencyPairUnset {
+		t.Fatalf("expected %v, received %v", errCurrencyPairUnset, err)
+	}
+	req := &gctrpc.ClosePositionRequest{
+		Exchange: testExchange,
+		Pair:     &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Asset:    asset.Spot.String(),
+	}
+	_, err = s.ClosePosition(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected an error closing a spot position")
+	}
+	req.Asset = asset.Futures.String()
+	req.Side = "sideways"
+	_, err = s.ClosePosition(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected an error closing an unrecognised side")
+	}
+	req.Side = order.Short.String()
+	_, err = s.ClosePosition(context.Background(), req)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetLatestFundingRate(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetLatestFundingRate(context.Background(), &gctrpc.GetLatestFundingRateRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetLatestFundingRate(context.Background(), &gctrpc.GetLatestFundingRateRequest{Exchange: testExchange})
+	if err == nil || err.Error() != errCurrencyPairUnset {
+		t.Fatalf("expected %v, received %v", errCurrencyPairUnset, err)
+	}
+	_, err = s.GetLatestFundingRate(context.Background(), &gctrpc.GetLatestFundingRateRequest{
+		Exchange: testExchange,
+		Pair:     &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Asset:    asset.PerpetualSwap.String(),
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetFundingRateHistory(context.Background(), &gctrpc.GetFundingRateHistoryRequest{
+		Exchange: testExchange,
+		Pair:     &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+	})
+	if err == nil || err.Error() != errStartEndTimesUnset {
+		t.Fatalf("expected %v, received %v", errStartEndTimesUnset, err)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := &gctrpc.GetFundingRateHistoryRequest{
+		Exchange: testExchange,
+		Pair:     &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Asset:    asset.PerpetualSwap.String(),
+		Start:    start.Format(common.SimpleTimeFormat),
+		End:      start.Add(time.Hour * 24).Format(common.SimpleTimeFormat),
+	}
+	_, err = s.GetFundingRateHistory(context.Background(), req)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+
+	err = fundingrate.StoreInDatabase(&fundingrate.HistoricalRates{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.PerpetualSwap,
+		Rates: []fundingrate.Rate{
+			{Time: start, Rate: 0.0001},
+			{Time: start.Add(time.Hour * 8), Rate: 0.0002},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.UseDb = true
+	resp, err := s.GetFundingRateHistory(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Rates) != 2 || resp.Rates[1].Rate != 0.0002 {
+		t.Errorf("unexpected funding rates %v", resp.Rates)
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetLeverage(context.Background(), &gctrpc.GetLeverageRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetLeverage(context.Background(), &gctrpc.GetLeverageRequest{Exchange: testExchange})
+	if err == nil || err.Error() != errCurrencyPairUnset {
+		t.Fatalf("expected %v, received %v", errCurrencyPairUnset, err)
+	}
+	_, err = s.GetLeverage(context.Background(), &gctrpc.GetLeverageRequest{
+		Exchange: testExchange,
+		Pair:     &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Asset:    asset.PerpetualSwap.String(),
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	req := &gctrpc.SetLeverageRequest{
+		Exchange: testExchange,
+		Pair:     &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Asset:    asset.PerpetualSwap.String(),
+	}
+	_, err := s.SetLeverage(context.Background(), req)
+	if err == nil || errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected a leverage validation error, received %v", err)
+	}
+	req.Leverage = 10
+	_, err = s.SetLeverage(context.Background(), req)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	req := &gctrpc.SetMarginTypeRequest{
+		Exchange:   testExchange,
+		Pair:       &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Asset:      asset.PerpetualSwap.String(),
+		MarginType: "leveraged",
+	}
+	_, err := s.SetMarginType(context.Background(), req)
+	if err == nil || errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected a margin type validation error, received %v", err)
+	}
+	req.MarginType = "isolated"
+	_, err = s.SetMarginType(context.Background(), req)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetLoans(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetLoans(context.Background(), &gctrpc.GetLoansRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetLoans(context.Background(), &gctrpc.GetLoansRequest{Exchange: testExchange})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+
+	pf := portfolio.GetPortfolio()
+	pf.UpdateExchangeLoans("rpctest", []lending.Loan{{
+		Exchange:  "rpctest",
+		ID:        "1337",
+		Currency:  currency.BTC,
+		Side:      lending.Borrowed,
+		Amount:    1,
+		CreatedAt: time.Now(),
+	}})
+	defer pf.UpdateExchangeLoans("rpctest", nil)
+	resp, err := s.GetLoans(context.Background(), &gctrpc.GetLoansRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Loans) != 1 || resp.Loans[0].Id != "1337" || resp.Loans[0].Side != string(lending.Borrowed) {
+		t.Errorf("unexpected loans %v", resp.Loans)
+	}
+}
+
+func TestBorrowAndRepay(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.Borrow(context.Background(), &gctrpc.BorrowRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.Borrow(context.Background(), &gctrpc.BorrowRequest{Exchange: testExchange, Currency: "BTC"})
+	if err == nil || errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected an amount validation error, received %v", err)
+	}
+	_, err = s.Borrow(context.Background(), &gctrpc.BorrowRequest{Exchange: testExchange, Currency: "BTC", Amount: 1})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	_, err = s.Repay(context.Background(), &gctrpc.RepayRequest{Exchange: testExchange, Currency: "BTC"})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestOfferFunds(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	req := &gctrpc.OfferFundsRequest{
+		Exchange: testExchange,
+		Currency: "USD",
+		Amount:   100,
+		Rate:     0.0002,
+	}
+	_, err := s.OfferFunds(context.Background(), req)
+	if err == nil || errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected a period validation error, received %v", err)
+	}
+	req.Period = 2
+	_, err = s.OfferFunds(context.Background(), req)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	_, err = s.CancelFundingOffer(context.Background(), &gctrpc.CancelFundingOfferRequest{Exchange: testExchange})
+	if !errors.Is(err, errOfferIDUnset) {
+		t.Fatalf("expected %v, received %v", errOfferIDUnset, err)
+	}
+	_, err = s.CancelFundingOffer(context.Background(), &gctrpc.CancelFundingOfferRequest{Exchange: testExchange, Id: "1"})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetOptionChain(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetOptionChain(context.Background(), &gctrpc.GetOptionChainRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetOptionChain(context.Background(), &gctrpc.GetOptionChainRequest{Exchange: testExchange})
+	if !errors.Is(err, errUnderlyingUnset) {
+		t.Fatalf("expected %v, received %v", errUnderlyingUnset, err)
+	}
+	_, err = s.GetOptionChain(context.Background(), &gctrpc.GetOptionChainRequest{
+		Exchange:   testExchange,
+		Underlying: "BTC",
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetContracts(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetContracts(context.Background(), &gctrpc.GetContractsRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetContracts(context.Background(), &gctrpc.GetContractsRequest{
+		Exchange:  testExchange,
+		AssetType: "meow",
+	})
+	if err == nil {
+		t.Fatal("expected an error for an invalid asset type")
+	}
+	_, err = s.GetContracts(context.Background(), &gctrpc.GetContractsRequest{
+		Exchange:  testExchange,
+		AssetType: asset.Spot.String(),
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetSubAccounts(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetSubAccounts(context.Background(), &gctrpc.GetSubAccountsRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetSubAccounts(context.Background(), &gctrpc.GetSubAccountsRequest{Exchange: testExchange})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.TransferFunds(context.Background(), &gctrpc.TransferFundsRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.TransferFunds(context.Background(), &gctrpc.TransferFundsRequest{
+		Exchange:  testExchange,
+		Currency:  "btc",
+		Amount:    1,
+		FromAsset: "meow",
+		ToAsset:   asset.Spot.String(),
+	})
+	if err == nil {
+		t.Fatal("expected an error for an invalid asset type")
+	}
+	_, err = s.TransferFunds(context.Background(), &gctrpc.TransferFundsRequest{
+		Exchange:  testExchange,
+		Currency:  "btc",
+		Amount:    1,
+		FromAsset: asset.Spot.String(),
+		ToAsset:   asset.Spot.String(),
+	})
+	if !errors.Is(err, transfer.ErrSameSourceAndDestination) {
+		t.Fatalf("expected %v, received %v", transfer.ErrSameSourceAndDestination, err)
+	}
+}
+
+func TestTransferEvents(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.TransferEventByID(context.Background(), &gctrpc.TransferEventByIDRequest{Id: "not-an-id"})
+	if err == nil {
+		t.Error("expected an error for an unknown transfer")
+	}
+	_, err = s.TransferEventsByDate(context.Background(), &gctrpc.TransferEventsByDateRequest{
+		Exchange: testExchange,
+		Start:    time.Now().UTC().Format(common.SimpleTimeFormat),
+		End:      time.Now().UTC().Add(-time.Hour).Format(common.SimpleTimeFormat),
+	})
+	if !errors.Is(err, errInvalidTimes) {
+		t.Errorf("expected %v, received %v", errInvalidTimes, err)
+	}
+
+	engerino.Config.Database.Enabled = false
+	_, err = s.TransferEventsByExchange(context.Background(), &gctrpc.TransferEventsByExchangeRequest{Exchange: testExchange})
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("expected %v, received %v", database.ErrDatabaseSupportDisabled, err)
+	}
+	engerino.Config.Database.Enabled = true
+}
+
+func TestGetDerivativePrice(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetDerivativePrice(context.Background(), &gctrpc.GetDerivativePriceRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetDerivativePrice(context.Background(), &gctrpc.GetDerivativePriceRequest{Exchange: testExchange})
+	if err == nil || err.Error() != errCurrencyPairUnset {
+		t.Fatalf("expected %v, received %v", errCurrencyPairUnset, err)
+	}
+	req := &gctrpc.GetDerivativePriceRequest{
+		Exchange:  testExchange,
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		AssetType: asset.Futures.String(),
+	}
+	_, err = s.GetDerivativePrice(context.Background(), req)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+
+	err = derivative.ProcessPrice(&derivative.Price{
+		ExchangeName: testExchange,
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Futures,
+		MarkPrice:    1337,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.GetDerivativePrice(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.MarkPrice != 1337 {
+		t.Errorf("expected mark price 1337, received %v", resp.MarkPrice)
+	}
+}
+
+func TestGetDerivativePriceStream(t *testing.T) {
+	s := RPCServer{}
+	err := s.GetDerivativePriceStream(&gctrpc.GetDerivativePriceStreamRequest{}, nil)
+	if err == nil || err.Error() != errExchangeNameUnset {
+		t.Fatalf("expected %v, received %v", errExchangeNameUnset, err)
+	}
+	err = s.GetDerivativePriceStream(&gctrpc.GetDerivativePriceStreamRequest{Exchange: testExchange}, nil)
+	if err == nil || err.Error() != errCurrencyPairUnset {
+		t.Fatalf("expected %v, received %v", errCurrencyPairUnset, err)
+	}
+	err = s.GetDerivativePriceStream(&gctrpc.GetDerivativePriceStreamRequest{
+		Exchange: testExchange,
+		Pair:     &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+	}, nil)
+	if err == nil || err.Error() != errAssetTypeUnset {
+		t.Fatalf("expected %v, received %v", errAssetTypeUnset, err)
+	}
+	err = s.GetExchangeDerivativePriceStream(&gctrpc.GetExchangeDerivativePriceStreamRequest{}, nil)
+	if err == nil || err.Error() != errExchangeNameUnset {
+		t.Fatalf("expected %v, received %v", errExchangeNameUnset, err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.GetLiquidations(context.Background(), &gctrpc.GetLiquidationsRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.GetLiquidations(context.Background(), &gctrpc.GetLiquidationsRequest{Exchange: testExchange})
+	if err == nil || err.Error() != errCurrencyPairUnset {
+		t.Fatalf("expected %v, received %v", errCurrencyPairUnset, err)
+	}
+	req := &gctrpc.GetLiquidationsRequest{
+		Exchange:  testExchange,
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		AssetType: asset.Futures.String(),
+		Start:     time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC).Format(common.SimpleTimeFormat),
+		End:       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat),
+	}
+	_, err = s.GetLiquidations(context.Background(), req)
+	if !errors.Is(err, errInvalidTimes) {
+		t.Fatalf("expected %v, received %v", errInvalidTimes, err)
+	}
+	req.Start, req.End = req.End, req.Start
+	_, err = s.GetLiquidations(context.Background(), req)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetSavedLiquidations(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	tt := time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)
+	err := liquidation.SaveLiquidationsToDatabase(liquidation.Data{
+		Exchange:     testExchange,
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Futures,
+		Side:         order.Sell,
+		Price:        1337,
+		Amount:       1,
+		Timestamp:    tt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.GetSavedLiquidations(context.Background(), &gctrpc.GetLiquidationsRequest{
+		Exchange:  testExchange,
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		AssetType: asset.Futures.String(),
+		Start:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat),
+		End:       time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC).Format(common.SimpleTimeFormat),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Liquidations) != 1 {
+		t.Fatalf("expected 1 liquidation, received %v", len(resp.Liquidations))
+	}
+	if resp.Liquidations[0].Price != 1337 || resp.Liquidations[0].Side != order.Sell.String() {
+		t.Errorf("unexpected liquidation %+v", resp.Liquidations[0])
+	}
+}
+
+func TestGetLiquidationStream(t *testing.T) {
+	s := RPCServer{}
+	err := s.GetLiquidationStream(&gctrpc.GetLiquidationStreamRequest{}, nil)
+	if err == nil || err.Error() != errExchangeNameUnset {
+		t.Fatalf("expected %v, received %v", errExchangeNameUnset, err)
+	}
+	err = s.GetLiquidationStream(&gctrpc.GetLiquidationStreamRequest{
+		Exchange:  testExchange,
+		AssetType: "bad",
+	}, nil)
+	if err == nil {
+		t.Fatal(unexpectedLackOfError)
+	}
+}
+
+func TestSetExchangeLiquidationProcessing(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+
+	_, err := s.SetExchangeLiquidationProcessing(context.Background(),
+		&gctrpc.SetExchangeLiquidationProcessingRequest{Exchange: "fake"})
+	if !errors.Is(err, errExchangeNotLoaded) {
+		t.Fatalf("expected %v, received %v", errExchangeNotLoaded, err)
+	}
+	_, err = s.SetExchangeLiquidationProcessing(context.Background(),
+		&gctrpc.SetExchangeLiquidationProcessingRequest{Exchange: testExchange, Status: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !engerino.GetExchangeByName(testExchange).GetBase().IsSaveLiquidationDataEnabled() {
+		t.Error("expected liquidation saving to be enabled")
+	}
+}
@@ -1,13 +1,16 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -17,6 +20,7 @@ const (
 	SyncItemTicker = iota
 	SyncItemOrderbook
 	SyncItemTrade
+	SyncItemDerivative
 
 	DefaultSyncerWorkers = 15
 	DefaultSyncerTimeout = time.Second * 15
@@ -29,7 +33,7 @@ var (
 
 // NewCurrencyPairSyncer starts a new CurrencyPairSyncer
 func NewCurrencyPairSyncer(c CurrencyPairSyncerConfig) (*ExchangeCurrencyPairSyncer, error) {
-	if !c.SyncOrderbook && !c.SyncTicker && !c.SyncTrades {
+	if !c.SyncOrderbook && !c.SyncTicker && !c.SyncTrades && !c.SyncDerivatives {
 		return nil, errors.New("no sync items enabled")
 	}
 
@@ -46,6 +50,7 @@ func NewCurrencyPairSyncer(c CurrencyPairSyncerConfig) (*ExchangeCurrencyPairSyn
 			SyncTicker:       c.SyncTicker,
 			SyncOrderbook:    c.SyncOrderbook,
 			SyncTrades:       c.SyncTrades,
+			SyncDerivatives:  c.SyncDerivatives,
 			SyncContinuously: c.SyncContinuously,
 			SyncTimeout:      c.SyncTimeout,
 			NumWorkers:       c.NumWorkers,
@@ -56,9 +61,9 @@ func NewCurrencyPairSyncer(c CurrencyPairSyncerConfig) (*ExchangeCurrencyPairSyn
 
 	log.Debugf(log.SyncMgr,
 		"Exchange currency pair syncer config: continuous: %v ticker: %v"+
-			" orderbook: %v trades: %v workers: %v verbose: %v timeout: %v\n",
+			" orderbook: %v trades: %v derivatives: %v workers: %v verbose: %v timeout: %v\n",
 		s.Cfg.SyncContinuously, s.Cfg.SyncTicker, s.Cfg.SyncOrderbook,
-		s.Cfg.SyncTrades, s.Cfg.NumWorkers, s.Cfg.Verbose, s.Cfg.SyncTimeout)
+		s.Cfg.SyncTrades, s.Cfg.SyncDerivatives, s.Cfg.NumWorkers, s.Cfg.Verbose, s.Cfg.SyncTimeout)
 	return &s, nil
 }
 
@@ -134,10 +139,65 @@ func (e *ExchangeCurrencyPairSyncer) add(c *CurrencyPairSyncAgent) {
 		}
 	}
 
+	if e.Cfg.SyncDerivatives && c.AssetType.IsDerivative() {
+		if e.Cfg.Verbose {
+			log.Debugf(log.SyncMgr,
+				"%s: Added derivative price sync item %v: using websocket: %v using REST: %v\n",
+				c.Exchange, FormatCurrency(c.Pair).String(), c.Derivative.IsUsingWebsocket,
+				c.Derivative.IsUsingREST)
+		}
+		if atomic.LoadInt32(&e.initSyncCompleted) != 1 {
+			e.initSyncWG.Add(1)
+			createdCounter++
+		}
+	}
+
 	c.Created = time.Now()
 	e.CurrencyPairs = append(e.CurrencyPairs, *c)
 }
 
+// newAgent returns a sync agent for each enabled sync item of a pair
+func (e *ExchangeCurrencyPairSyncer) newAgent(exchangeName string, p currency.Pair, a asset.Item, usingREST, usingWebsocket bool) CurrencyPairSyncAgent {
+	c := CurrencyPairSyncAgent{
+		AssetType: a,
+		Exchange:  exchangeName,
+		Pair:      p,
+	}
+	b := SyncBase{
+		IsUsingREST:      usingREST,
+		IsUsingWebsocket: usingWebsocket,
+	}
+	if e.Cfg.SyncTicker {
+		c.Ticker = b
+	}
+	if e.Cfg.SyncOrderbook {
+		c.Orderbook = b
+	}
+	if e.Cfg.SyncTrades {
+		c.Trade = b
+	}
+	if e.Cfg.SyncDerivatives && a.IsDerivative() {
+		c.Derivative = b
+	}
+	return c
+}
+
+// addPair starts syncing a pair which was enabled after the syncer started,
+// such as a contract an exchange has rolled to. The websocket is used when it
+// is connected and REST otherwise
+func (e *ExchangeCurrencyPairSyncer) addPair(exch exchange.IBotExchange, p currency.Pair, a asset.Item) {
+	if e.exists(exch.GetName(), p, a) {
+		return
+	}
+	var usingWebsocket bool
+	if exch.SupportsWebsocket() && exch.IsWebsocketEnabled() {
+		ws, err := exch.GetWebsocket()
+		usingWebsocket = err == nil && ws != nil && ws.IsConnected()
+	}
+	c := e.newAgent(exch.GetName(), p, a, !usingWebsocket && exch.SupportsREST(), usingWebsocket)
+	e.add(&c)
+}
+
 func (e *ExchangeCurrencyPairSyncer) remove(c *CurrencyPairSyncAgent) {
 	e.mux.Lock()
 	defer e.mux.Unlock()
@@ -167,6 +227,8 @@ func (e *ExchangeCurrencyPairSyncer) isProcessing(exchangeName string, p currenc
 				return e.CurrencyPairs[x].Orderbook.IsProcessing
 			case SyncItemTrade:
 				return e.CurrencyPairs[x].Trade.IsProcessing
+			case SyncItemDerivative:
+				return e.CurrencyPairs[x].Derivative.IsProcessing
 			}
 		}
 	}
@@ -189,6 +251,8 @@ func (e *ExchangeCurrencyPairSyncer) setProcessing(exchangeName string, p curren
 				e.CurrencyPairs[x].Orderbook.IsProcessing = processing
 			case SyncItemTrade:
 				e.CurrencyPairs[x].Trade.IsProcessing = processing
+			case SyncItemDerivative:
+				e.CurrencyPairs[x].Derivative.IsProcessing = processing
 			}
 		}
 	}
@@ -214,6 +278,11 @@ func (e *ExchangeCurrencyPairSyncer) update(exchangeName string, p currency.Pair
 		if !e.Cfg.SyncTrades {
 			return
 		}
+
+	case SyncItemDerivative:
+		if !e.Cfg.SyncDerivatives || !a.IsDerivative() {
+			return
+		}
 	default:
 		log.Warnf(log.SyncMgr, "ExchangeCurrencyPairSyncer: unknown sync item %v\n", syncType)
 		return
@@ -280,6 +349,24 @@ func (e *ExchangeCurrencyPairSyncer) update(exchangeName string, p currency.Pair
 						createdCounter)
 					e.initSyncWG.Done()
 				}
+
+			case SyncItemDerivative:
+				origHadData := e.CurrencyPairs[x].Derivative.HaveData
+				e.CurrencyPairs[x].Derivative.LastUpdated = time.Now()
+				if err != nil {
+					e.CurrencyPairs[x].Derivative.NumErrors++
+				}
+				e.CurrencyPairs[x].Derivative.HaveData = true
+				e.CurrencyPairs[x].Derivative.IsProcessing = false
+				if atomic.LoadInt32(&e.initSyncCompleted) != 1 && !origHadData {
+					removedCounter++
+					log.Debugf(log.SyncMgr, "%s derivative price sync complete %v [%d/%d].\n",
+						exchangeName,
+						FormatCurrency(p).String(),
+						removedCounter,
+						createdCounter)
+					e.initSyncWG.Done()
+				}
 			}
 		}
 	}
@@ -292,6 +379,8 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 	}
 	defer cleanup()
 
+	// the syncer's REST polls wait behind order and account requests
+	marketData := request.WithPriority(context.Background(), request.MarketDataPriority)
 	for atomic.LoadInt32(&e.shutdown) != 1 {
 		exchanges := Bot.GetExchanges()
 		for x := range exchanges {
@@ -339,33 +428,7 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 					}
 
 					if !e.exists(exchangeName, enabledPairs[i], assetTypes[y]) {
-						c := CurrencyPairSyncAgent{
-							AssetType: assetTypes[y],
-							Exchange:  exchangeName,
-							Pair:      enabledPairs[i],
-						}
-
-						if e.Cfg.SyncTicker {
-							c.Ticker = SyncBase{
-								IsUsingREST:      usingREST,
-								IsUsingWebsocket: usingWebsocket,
-							}
-						}
-
-						if e.Cfg.SyncOrderbook {
-							c.Orderbook = SyncBase{
-								IsUsingREST:      usingREST,
-								IsUsingWebsocket: usingWebsocket,
-							}
-						}
-
-						if e.Cfg.SyncTrades {
-							c.Trade = SyncBase{
-								IsUsingREST:      usingREST,
-								IsUsingWebsocket: usingWebsocket,
-							}
-						}
-
+						c := e.newAgent(exchangeName, enabledPairs[i], assetTypes[y], usingREST, usingWebsocket)
 						e.add(&c)
 					}
 
@@ -409,6 +472,7 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 									var result *ticker.Price
 									var err error
 
+									release := prioritiseRequests(marketData, exchanges[x])
 									if supportsRESTTickerBatching {
 										e.mux.Lock()
 										batchLastDone, ok := e.tickerBatchLastRequested[exchangeName]
@@ -434,6 +498,7 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 									} else {
 										result, err = exchanges[x].UpdateTicker(c.Pair, c.AssetType)
 									}
+									release()
 									printTickerSummary(result, "REST", err)
 									if err == nil {
 										if Bot.Config.RemoteControl.WebsocketRPC.Enabled {
@@ -448,6 +513,48 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 						}
 					}
 
+					if e.Cfg.SyncDerivatives && c.AssetType.IsDerivative() {
+						if !e.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemDerivative) {
+							if c.Derivative.LastUpdated.IsZero() || time.Since(c.Derivative.LastUpdated) > e.Cfg.SyncTimeout {
+								if c.Derivative.IsUsingWebsocket {
+									if time.Since(c.Created) < e.Cfg.SyncTimeout {
+										continue
+									}
+									if supportsREST {
+										e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemDerivative, true)
+										c.Derivative.IsUsingWebsocket = false
+										c.Derivative.IsUsingREST = true
+										log.Warnf(log.SyncMgr,
+											"%s %s %s: No derivative price update after %s, switching from websocket to rest\n",
+											c.Exchange,
+											FormatCurrency(c.Pair).String(),
+											strings.ToUpper(c.AssetType.String()),
+											e.Cfg.SyncTimeout,
+										)
+										switchedToRest = true
+										e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemDerivative, false)
+									}
+								}
+
+								if c.Derivative.IsUsingREST {
+									e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemDerivative, true)
+									release := prioritiseRequests(marketData, exchanges[x])
+									result, err := exchanges[x].UpdateDerivativePrice(c.Pair, c.AssetType)
+									release()
+									printDerivativePriceSummary(result, "REST", err)
+									if err == nil {
+										if Bot.Config.RemoteControl.WebsocketRPC.Enabled {
+											relayWebsocketEvent(result, "derivative_price_update", c.AssetType.String(), exchangeName)
+										}
+									}
+									e.update(c.Exchange, c.Pair, c.AssetType, SyncItemDerivative, err)
+								}
+							} else {
+								time.Sleep(time.Millisecond * 50)
+							}
+						}
+					}
+
 					if e.Cfg.SyncOrderbook {
 						if !e.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemOrderbook) {
 							if c.Orderbook.LastUpdated.IsZero() || time.Since(c.Orderbook.LastUpdated) > e.Cfg.SyncTimeout {
@@ -472,7 +579,9 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 								}
 
 								e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemOrderbook, true)
+								release := prioritiseRequests(marketData, exchanges[x])
 								result, err := exchanges[x].UpdateOrderbook(c.Pair, c.AssetType)
+								release()
 								printOrderbookSummary(result, "REST", err)
 								if err == nil {
 									if Bot.Config.RemoteControl.WebsocketRPC.Enabled {
@@ -573,33 +682,7 @@ func (e *ExchangeCurrencyPairSyncer) Start() {
 					continue
 				}
 
-				c := CurrencyPairSyncAgent{
-					AssetType: assetTypes[y],
-					Exchange:  exchangeName,
-					Pair:      enabledPairs[i],
-				}
-
-				if e.Cfg.SyncTicker {
-					c.Ticker = SyncBase{
-						IsUsingREST:      usingREST,
-						IsUsingWebsocket: usingWebsocket,
-					}
-				}
-
-				if e.Cfg.SyncOrderbook {
-					c.Orderbook = SyncBase{
-						IsUsingREST:      usingREST,
-						IsUsingWebsocket: usingWebsocket,
-					}
-				}
-
-				if e.Cfg.SyncTrades {
-					c.Trade = SyncBase{
-						IsUsingREST:      usingREST,
-						IsUsingWebsocket: usingWebsocket,
-					}
-				}
-
+				c := e.newAgent(exchangeName, enabledPairs[i], assetTypes[y], usingREST, usingWebsocket)
 				e.add(&c)
 			}
 		}
@@ -13,6 +13,7 @@ type CurrencyPairSyncerConfig struct {
 	SyncTicker       bool
 	SyncOrderbook    bool
 	SyncTrades       bool
+	SyncDerivatives  bool
 	SyncContinuously bool
 	SyncTimeout      time.Duration
 	NumWorkers       int
@@ -51,11 +52,12 @@ type SyncBase struct {
 
 // CurrencyPairSyncAgent stores the sync agent info
 type CurrencyPairSyncAgent struct {
-	Created   time.Time
-	Exchange  string
-	AssetType asset.Item
-	Pair      currency.Pair
-	Ticker    SyncBase
-	Orderbook SyncBase
-	Trade     SyncBase
+	Created    time.Time
+	Exchange   string
+	AssetType  asset.Item
+	Pair       currency.Pair
+	Ticker     SyncBase
+	Orderbook  SyncBase
+	Trade      SyncBase
+	Derivative SyncBase
 }
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	transferDataStore "github.com/thrasher-corp/gocryptotrader/database/repository/transfer"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
+)
+
+// ErrTransferRequestNotFound message to display when no record is found
+const ErrTransferRequestNotFound = "%v not found"
+
+// SubmitTransfer performs validation and submits a new transfer request to
+// the exchange, recording the outcome for auditing
+func SubmitTransfer(req *transfer.Request) (*transfer.Response, error) {
+	if req == nil {
+		return nil, transfer.ErrRequestCannotBeNil
+	}
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exch := Bot.GetExchangeByName(req.Exchange)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+
+	resp := &transfer.Response{
+		Exchange: transfer.ExchangeResponse{
+			Name: exch.GetName(),
+		},
+		RequestDetails: *req,
+		CreatedAt:      time.Now().UTC(),
+	}
+	resp.UpdatedAt = resp.CreatedAt
+
+	if Bot.Settings.EnableDryRun {
+		log.Warnln(log.Global, "Dry run enabled, no transfer request will be submitted or have an event created")
+		resp.ID = transfer.DryRunID
+		resp.Exchange.Status = "dryrun"
+		resp.Exchange.ID = transfer.DryRunID.String()
+	} else {
+		var ret *transfer.ExchangeResponse
+		ret, err = exch.TransferFunds(req)
+		if err != nil {
+			resp.Exchange.ID = StatusError
+			resp.Exchange.Status = err.Error()
+		} else {
+			resp.Exchange.ID = ret.ID
+			resp.Exchange.Status = ret.Status
+		}
+		transferDataStore.Event(resp)
+	}
+	if err == nil {
+		transfer.Cache.Add(resp.ID.String(), resp)
+	}
+	return resp, nil
+}
+
+// TransferEventByID returns a transfer request by ID
+func TransferEventByID(id string) (*transfer.Response, error) {
+	v := transfer.Cache.Get(id)
+	if v != nil {
+		return v.(*transfer.Response), nil
+	}
+
+	l, err := transferDataStore.GetEventByUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf(ErrTransferRequestNotFound, id)
+	}
+	transfer.Cache.Add(id, l)
+	return l, nil
+}
+
+// TransferEventsByExchange returns the most recent transfer requests of an
+// exchange
+func TransferEventsByExchange(exchange string, limit int) ([]*transfer.Response, error) {
+	return transferDataStore.GetEventsByExchange(exchange, limit)
+}
+
+// TransferEventsByDate returns the transfer requests between two dates
+func TransferEventsByDate(exchange string, start, end time.Time, limit int) ([]*transfer.Response, error) {
+	return transferDataStore.GetEventsByDate(exchange, start, end, limit)
+}
@@ -0,0 +1,116 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
+)
+
+const fakeTransferExchange = "FakeTransferExchange"
+
+var errTransferRejected = errors.New("transfer rejected")
+
+// fakeTransferringExchange records submitted transfers and rejects transfers
+// into sub-accounts
+type fakeTransferringExchange struct {
+	FakePassingExchange
+	transfers []transfer.Request
+}
+
+func (f *fakeTransferringExchange) GetName() string { return fakeTransferExchange }
+
+func (f *fakeTransferringExchange) TransferFunds(r *transfer.Request) (*transfer.ExchangeResponse, error) {
+	if r.ToAccount != "" {
+		return nil, errTransferRejected
+	}
+	f.transfers = append(f.transfers, *r)
+	return &transfer.ExchangeResponse{Name: fakeTransferExchange, ID: "1337", Status: "complete"}, nil
+}
+
+func TestSubmitTransfer(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	f := &fakeTransferringExchange{
+		FakePassingExchange: FakePassingExchange{Base: exchange.Base{Name: fakeTransferExchange}},
+	}
+	Bot.exchangeManager.add(f)
+	defer func() {
+		_ = Bot.exchangeManager.removeExchange(fakeTransferExchange)
+	}()
+	dryRun := Bot.Settings.EnableDryRun
+	defer func() {
+		Bot.Settings.EnableDryRun = dryRun
+	}()
+
+	_, err := SubmitTransfer(nil)
+	if !errors.Is(err, transfer.ErrRequestCannotBeNil) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrRequestCannotBeNil)
+	}
+	req := &transfer.Request{
+		Exchange:  fakeTransferExchange,
+		Currency:  currency.BTC,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Spot,
+	}
+	_, err = SubmitTransfer(req)
+	if !errors.Is(err, transfer.ErrSameSourceAndDestination) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrSameSourceAndDestination)
+	}
+	req.ToAsset = asset.Futures
+	req.Exchange = "bruh"
+	_, err = SubmitTransfer(req)
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received %v, expected %v", err, ErrExchangeNotFound)
+	}
+	req.Exchange = fakeTransferExchange
+
+	Bot.Settings.EnableDryRun = true
+	resp, err := SubmitTransfer(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ID != transfer.DryRunID || len(f.transfers) != 0 {
+		t.Errorf("expected dry run transfer, received %+v", resp)
+	}
+
+	Bot.Settings.EnableDryRun = false
+	resp, err = SubmitTransfer(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Exchange.ID != "1337" || resp.Exchange.Status != "complete" || len(f.transfers) != 1 {
+		t.Errorf("unexpected transfer response %+v", resp)
+	}
+
+	req.ToAsset = asset.Spot
+	req.ToAccount = "sub"
+	resp, err = SubmitTransfer(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Exchange.ID != StatusError || resp.Exchange.Status != errTransferRejected.Error() {
+		t.Errorf("expected rejected transfer to be recorded, received %+v", resp)
+	}
+}
+
+func TestTransferEventByID(t *testing.T) {
+	resp := &transfer.Response{ID: transfer.DryRunID}
+	transfer.Cache.Add(transfer.DryRunID.String(), resp)
+	v, err := TransferEventByID(transfer.DryRunID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != resp {
+		t.Error("expected cached transfer to be returned")
+	}
+	_, err = TransferEventByID("not-an-id")
+	if err == nil {
+		t.Error("expected error for unknown transfer")
+	}
+}
@@ -2,6 +2,7 @@ package account
 
 import (
 	"sync"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -41,6 +42,15 @@ type SubAccount struct {
 	Currencies []Balance
 }
 
+// SubAccountDetails describes a sub-account registered under the master
+// account. ID is the identifier exchanges use to address transfers
+type SubAccountDetails struct {
+	ID        string
+	Name      string
+	Disabled  bool
+	CreatedAt time.Time
+}
+
 // Balance is a sub type to store currency name and individual totals
 type Balance struct {
 	CurrencyName currency.Code
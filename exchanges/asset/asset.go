@@ -25,6 +25,7 @@ const (
 	DownsideProfitContract = Item("downsideprofitcontract")
 	CoinMarginedFutures    = Item("coinmarginedfutures")
 	USDTMarginedFutures    = Item("usdtmarginedfutures")
+	Options                = Item("options")
 )
 
 var supported = Items{
@@ -40,6 +41,7 @@ var supported = Items{
 	DownsideProfitContract,
 	CoinMarginedFutures,
 	USDTMarginedFutures,
+	Options,
 }
 
 // Supported returns a list of supported asset types
@@ -93,6 +95,24 @@ func (a Item) IsValid() bool {
 	return false
 }
 
+// IsDerivative returns whether the asset type is a contract which can hold a
+// long or short position
+func (a Item) IsDerivative() bool {
+	switch a {
+	case Binary,
+		PerpetualContract,
+		PerpetualSwap,
+		Futures,
+		UpsideProfitContract,
+		DownsideProfitContract,
+		CoinMarginedFutures,
+		USDTMarginedFutures,
+		Options:
+		return true
+	}
+	return false
+}
+
 // New takes an input matches to relevant package assets
 func New(input string) (Item, error) {
 	input = strings.ToLower(input)
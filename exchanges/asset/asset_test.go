@@ -61,6 +61,16 @@ func TestIsValid(t *testing.T) {
 	}
 }
 
+func TestIsDerivative(t *testing.T) {
+	if Spot.IsDerivative() || Margin.IsDerivative() {
+		t.Fatal("TestIsDerivative returned an unexpected result")
+	}
+
+	if !Futures.IsDerivative() || !PerpetualSwap.IsDerivative() || !Options.IsDerivative() {
+		t.Fatal("TestIsDerivative returned an unexpected result")
+	}
+}
+
 func TestNew(t *testing.T) {
 	_, err := New("Spota")
 	if err == nil {
@@ -39,6 +39,7 @@ const (
 
 	// Public endpoints
 	exchangeInfo      = "/api/v3/exchangeInfo"
+	serverTime        = "/api/v3/time"
 	orderBookDepth    = "/api/v3/depth"
 	recentTrades      = "/api/v3/trades"
 	aggregatedTrades  = "/api/v3/aggTrades"
@@ -57,6 +58,16 @@ const (
 	openOrders    = "/api/v3/openOrders"
 	allOrders     = "/api/v3/allOrders"
 
+	// Margin endpoints
+	marginLoan    = "/sapi/v1/margin/loan"
+	marginRepay   = "/sapi/v1/margin/repay"
+	marginAccount = "/sapi/v1/margin/account"
+
+	// Sub-account and wallet transfer endpoints
+	subAccountList     = "/sapi/v1/sub-account/list"
+	subAccountTransfer = "/sapi/v1/sub-account/universalTransfer"
+	walletTransfer     = "/sapi/v1/asset/transfer"
+
 	// Withdraw API endpoints
 	withdrawEndpoint                       = "/wapi/v3/withdraw.html"
 	depositHistory                         = "/wapi/v3/depositHistory.html"
@@ -102,6 +113,15 @@ func (b *Binance) GetExchangeInfo() (ExchangeInfo, error) {
 	return resp, b.SendHTTPRequest(exchange.RestSpotSupplementary, exchangeInfo, limitDefault, &resp)
 }
 
+// GetServerTime returns the current server time
+func (b *Binance) GetServerTime() (time.Time, error) {
+	var resp struct {
+		ServerTime binanceTime `json:"serverTime"`
+	}
+	err := b.SendHTTPRequest(exchange.RestSpotSupplementary, serverTime, limitDefault, &resp)
+	return resp.ServerTime.Time(), err
+}
+
 // GetOrderBook returns full orderbook information
 //
 // OrderBookDataRequestParams contains the following members
@@ -645,6 +665,71 @@ func (b *Binance) GetAccount() (*Account, error) {
 	return &resp.Account, nil
 }
 
+// MarginBorrow borrows an asset on the cross margin account
+func (b *Binance) MarginBorrow(asset string, amount float64) (int64, error) {
+	return b.marginTransaction(marginLoan, asset, amount)
+}
+
+// MarginRepay repays a borrowed asset on the cross margin account
+func (b *Binance) MarginRepay(asset string, amount float64) (int64, error) {
+	return b.marginTransaction(marginRepay, asset, amount)
+}
+
+func (b *Binance) marginTransaction(path, asset string, amount float64) (int64, error) {
+	params := url.Values{}
+	params.Set("asset", asset)
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	var resp MarginTransaction
+	return resp.TransactionID, b.SendAuthHTTPRequest(exchange.RestSpotSupplementary, http.MethodPost, path, params, request.Unset, &resp)
+}
+
+// GetMarginAccount returns the cross margin account details including
+// borrowed amounts and accrued interest
+func (b *Binance) GetMarginAccount() (*MarginAccount, error) {
+	var resp MarginAccount
+	return &resp, b.SendAuthHTTPRequest(exchange.RestSpotSupplementary, http.MethodGet, marginAccount, nil, request.Unset, &resp)
+}
+
+// GetSubAccountList returns the sub-accounts registered under the master
+// account
+func (b *Binance) GetSubAccountList() ([]SubAccount, error) {
+	params := url.Values{}
+	params.Set("limit", "200")
+	var resp struct {
+		SubAccounts []SubAccount `json:"subAccounts"`
+	}
+	return resp.SubAccounts, b.SendAuthHTTPRequest(exchange.RestSpotSupplementary, http.MethodGet, subAccountList, params, request.Unset, &resp)
+}
+
+// WalletTransfer moves an asset between the wallets of the master account,
+// transfer types are formatted as FROM_TO e.g. MAIN_UMFUTURE
+func (b *Binance) WalletTransfer(transferType, asset string, amount float64) (int64, error) {
+	params := url.Values{}
+	params.Set("type", transferType)
+	params.Set("asset", asset)
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	var resp MarginTransaction
+	return resp.TransactionID, b.SendAuthHTTPRequest(exchange.RestSpotSupplementary, http.MethodPost, walletTransfer, params, request.Unset, &resp)
+}
+
+// SubAccountTransfer moves an asset between the master account and its
+// sub-accounts, an empty email refers to the master account
+func (b *Binance) SubAccountTransfer(fromEmail, toEmail, fromAccountType, toAccountType, asset string, amount float64) (int64, error) {
+	params := url.Values{}
+	if fromEmail != "" {
+		params.Set("fromEmail", fromEmail)
+	}
+	if toEmail != "" {
+		params.Set("toEmail", toEmail)
+	}
+	params.Set("fromAccountType", fromAccountType)
+	params.Set("toAccountType", toAccountType)
+	params.Set("asset", asset)
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	var resp MarginTransaction
+	return resp.TransactionID, b.SendAuthHTTPRequest(exchange.RestSpotSupplementary, http.MethodPost, subAccountTransfer, params, request.Unset, &resp)
+}
+
 // SendHTTPRequest sends an unauthenticated request
 func (b *Binance) SendHTTPRequest(ePath exchange.URL, path string, f request.EndpointLimit, result interface{}) error {
 	endpointPath, err := b.API.Endpoints.GetURL(ePath)
@@ -2,6 +2,10 @@ package binance
 
 import (
 	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
@@ -10,8 +14,17 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -1039,6 +1052,277 @@ func TestFuturesPositionsInfo(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetDerivativePositions(asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetDerivativePositions(asset.CoinMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetDerivativePositions(asset.USDTMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLatestFundingRate(currency.NewPair(currency.BTC, currency.USDT), asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.GetLatestFundingRate(currency.NewPair(currency.BTC, currency.USDT), asset.USDTMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetLatestFundingRate(currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"), asset.CoinMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateDerivativePrice(t *testing.T) {
+	t.Parallel()
+	_, err := b.UpdateDerivativePrice(currency.NewPair(currency.BTC, currency.USDT), asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.UpdateDerivativePrice(currency.NewPair(currency.BTC, currency.USDT), asset.USDTMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.UpdateDerivativePrice(currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"), asset.CoinMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLiquidations(currency.NewPair(currency.BTC, currency.USDT), asset.Spot, time.Time{}, time.Time{})
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.GetLiquidations(currency.NewPair(currency.BTC, currency.USDT), asset.USDTMarginedFutures, time.Time{}, time.Time{})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetLiquidations(currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"), asset.CoinMarginedFutures, time.Time{}, time.Time{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestForceOrderToLiquidation(t *testing.T) {
+	t.Parallel()
+	l, err := b.forceOrderToLiquidation(currency.NewPair(currency.BTC, currency.USDT),
+		asset.USDTMarginedFutures, "SELL", 9000, 8990, 2, 1.5, 1577836800000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Side != order.Sell || l.Price != 8990 || l.Amount != 1.5 ||
+		!l.Timestamp.Equal(time.Unix(1577836800, 0)) {
+		t.Errorf("unexpected liquidation %+v", l)
+	}
+	_, err = b.forceOrderToLiquidation(currency.NewPair(currency.BTC, currency.USDT),
+		asset.USDTMarginedFutures, "lol", 9000, 0, 2, 0, 1577836800000)
+	if err == nil {
+		t.Error("expected an error for an invalid side")
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	r := &fundingrate.HistoricalRatesRequest{
+		Pair:  currency.NewPair(currency.BTC, currency.USDT),
+		Asset: asset.USDTMarginedFutures,
+		Start: time.Unix(1577836800, 0),
+		End:   time.Unix(1580515200, 0),
+	}
+	_, err := b.GetFundingRateHistory(r)
+	if err != nil {
+		t.Error(err)
+	}
+	r.Asset = asset.Spot
+	_, err = b.GetFundingRateHistory(r)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLeverage(currency.NewPair(currency.BTC, currency.USDT), asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetLeverage(currency.NewPair(currency.BTC, currency.USDT), asset.USDTMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	err := b.SetLeverage(currency.NewPair(currency.BTC, currency.USDT), asset.USDTMarginedFutures, 2.5)
+	if err == nil {
+		t.Error("expected an error for fractional leverage")
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.SetLeverage(currency.NewPair(currency.BTC, currency.USDT), asset.USDTMarginedFutures, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	err := b.SetMarginType(currency.NewPair(currency.BTC, currency.USDT), asset.USDTMarginedFutures, position.UnknownMarginType)
+	if err == nil {
+		t.Error("expected an error for an unknown margin type")
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.SetMarginType(currency.NewPair(currency.BTC, currency.USDT), asset.USDTMarginedFutures, position.Isolated)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLoans(t *testing.T) {
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	t.Parallel()
+	_, err := b.GetLoans()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBorrow(t *testing.T) {
+	t.Parallel()
+	_, err := b.Borrow(&lending.Borrow{Exchange: b.Name, Currency: currency.USDT})
+	if err == nil {
+		t.Error("expected an error for a zero amount")
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	_, err = b.Borrow(&lending.Borrow{Exchange: b.Name, Currency: currency.USDT, Amount: 10})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepay(t *testing.T) {
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	t.Parallel()
+	_, err := b.Repay(&lending.Repay{Exchange: b.Name, Currency: currency.USDT})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOfferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := b.OfferFunds(&lending.Offer{Exchange: b.Name, Currency: currency.USDT})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+	err = b.CancelFundingOffer("1337")
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestGetSubAccounts(t *testing.T) {
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	t.Parallel()
+	_, err := b.GetSubAccounts()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := b.TransferFunds(&transfer.Request{
+		Exchange:  b.Name,
+		Currency:  currency.USDT,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.PerpetualSwap,
+	})
+	if !errors.Is(err, transfer.ErrUnsupportedTransfer) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrUnsupportedTransfer)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	_, err = b.TransferFunds(&transfer.Request{
+		Exchange:  b.Name,
+		Currency:  currency.USDT,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.USDTMarginedFutures,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPositionSide(t *testing.T) {
+	t.Parallel()
+	tester := []struct {
+		side   string
+		amount float64
+		expect order.Side
+		size   float64
+		hedged bool
+	}{
+		{"BOTH", 2, order.Long, 2, false},
+		{"BOTH", -2, order.Short, 2, false},
+		{"LONG", 3, order.Long, 3, true},
+		{"SHORT", -3, order.Short, 3, true},
+	}
+	for i := range tester {
+		side, size, hedged := positionSide(tester[i].side, tester[i].amount)
+		if side != tester[i].expect || size != tester[i].size || hedged != tester[i].hedged {
+			t.Errorf("test %d: expected %s %v %v, received %s %v %v",
+				i, tester[i].expect, tester[i].size, tester[i].hedged, side, size, hedged)
+		}
+	}
+}
+
+func TestHedgeModeParams(t *testing.T) {
+	t.Parallel()
+	posSide, reduceOnly := hedgeModeParams(&order.Submit{ReduceOnly: true})
+	if posSide != "" || !reduceOnly {
+		t.Errorf("expected one way reduce only order, received %s %v", posSide, reduceOnly)
+	}
+	posSide, reduceOnly = hedgeModeParams(&order.Submit{ReduceOnly: true, PositionSide: order.Short})
+	if posSide != "SHORT" || reduceOnly {
+		t.Errorf("expected hedged short leg order, received %s %v", posSide, reduceOnly)
+	}
+}
+
 func TestFuturesTradeHistory(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() {
@@ -1128,6 +1412,35 @@ func TestGetExchangeInfo(t *testing.T) {
 	}
 }
 
+func TestGetServerTime(t *testing.T) {
+	t.Parallel()
+	if mockTests {
+		t.Skip("server time is not recorded in the mock data")
+	}
+	serverTime, err := b.GetCurrentServerTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serverTime.IsZero() {
+		t.Error("expected a server time")
+	}
+}
+
+func TestUpdateExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := b.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := b.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 {
+		t.Errorf("expected price tick and amount step to be loaded, received %+v", l)
+	}
+}
+
 func TestFetchTradablePairs(t *testing.T) {
 	t.Parallel()
 	_, err := b.FetchTradablePairs(asset.Spot)
@@ -2473,3 +2786,166 @@ func TestUFuturesHistoricalTrades(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestReplayWebsocketCapture(t *testing.T) {
+	sharedtestvalues.ReplayWebsocketCapture(t, b.Name, b.Websocket, b.wsHandleData)
+}
+
+// TestWebsocketOrderbookRecordingReplay seeds the orderbook from a recorded
+// REST snapshot and replays recorded depth updates through the handler. The
+// final update follows a lost range of update IDs, which the buffer's sequence
+// verifier must reject
+func TestWebsocketOrderbookRecordingReplay(t *testing.T) {
+	contents, err := ioutil.ReadFile(filepath.Join(mock.WebsocketDefaultDirectory,
+		"binance",
+		"orderbook_snapshot.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var snapshot OrderBookData
+	err = json.Unmarshal(contents, &snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	book := OrderBook{LastUpdateID: snapshot.LastUpdateID}
+	book.Bids, err = replayOrderbookItems(snapshot.Bids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	book.Asks, err = replayOrderbookItems(snapshot.Asks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := currency.NewPairWithDelimiter("BTC", "USDT", "-")
+	err = b.SeedLocalCacheWithBook(p, &book)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := mock.LoadWebsocketRecording(mock.WebsocketRecordingPath(b.Name, "orderbook"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var updateIDs []int64
+	var replayErr error
+	for i := range rec.Frames {
+		if rec.Frames[i].Direction != mock.WebsocketInbound {
+			continue
+		}
+		replayErr = b.wsHandleData(rec.Frames[i].Payload())
+		if replayErr != nil {
+			if i != len(rec.Frames)-1 {
+				t.Fatalf("frame %d: %v", i, replayErr)
+			}
+			break
+		}
+		ob := b.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+		if ob == nil {
+			t.Fatalf("frame %d: orderbook not found", i)
+		}
+		if len(updateIDs) == 0 || updateIDs[len(updateIDs)-1] != ob.LastUpdateID {
+			updateIDs = append(updateIDs, ob.LastUpdateID)
+		}
+		if ob.LastUpdateID == 5098475941 {
+			if ob.Bids[0].Price != 11409.07 || ob.Bids[0].Amount != 0.3 {
+				t.Errorf("unexpected best bid %+v", ob.Bids[0])
+			}
+			if ob.Bids[1].Amount != 0.62265 || ob.Bids[3].Price != 11408.52 {
+				t.Errorf("unexpected bids %+v", ob.Bids)
+			}
+			if ob.Asks[0].Price != 11409.1 || ob.Asks[1].Amount != 0.75 {
+				t.Errorf("unexpected asks %+v", ob.Asks)
+			}
+		}
+	}
+	expected := []int64{5098475930, 5098475934, 5098475941}
+	if len(updateIDs) != len(expected) {
+		t.Fatalf("expected update IDs %v, received %v", expected, updateIDs)
+	}
+	for i := range expected {
+		if updateIDs[i] != expected[i] {
+			t.Fatalf("expected update IDs %v, received %v", expected, updateIDs)
+		}
+	}
+	if !errors.Is(replayErr, buffer.ErrSequenceGap) {
+		t.Errorf("received %v, expected %v", replayErr, buffer.ErrSequenceGap)
+	}
+}
+
+func replayOrderbookItems(levels [][2]string) ([]OrderbookItem, error) {
+	items := make([]OrderbookItem, len(levels))
+	for i := range levels {
+		price, err := strconv.ParseFloat(levels[i][0], 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := strconv.ParseFloat(levels[i][1], 64)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = OrderbookItem{Price: price, Quantity: amount}
+	}
+	return items, nil
+}
+
+func TestWebsocketRecordingReplay(t *testing.T) {
+	server, err := mock.NewWebsocketServer(mock.WebsocketRecordingPath(b.Name, "ticker"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	err = b.Websocket.SetWebsocketURL(server.URL, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		err = b.Websocket.SetWebsocketURL("", false, false)
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	err = b.wsConnectPooled(b.Websocket.Conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		err = b.Websocket.Conn.Shutdown()
+		if err != nil {
+			t.Error(err)
+		}
+		// release the read routine which reports the closure
+		select {
+		case <-b.Websocket.ReadMessageErrors:
+		case <-time.After(time.Second):
+		}
+	}()
+
+	err = b.SubscribeConnection(b.Websocket.Conn, []stream.ChannelSubscription{
+		{Channel: "btcusdt@ticker"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timer := time.NewTimer(5 * time.Second)
+	defer timer.Stop()
+	for {
+		select {
+		case d := <-b.Websocket.DataHandler:
+			p, ok := d.(*ticker.Price)
+			if !ok || p.LastUpdated.UnixNano()/int64(time.Millisecond) != 1603020471123 {
+				continue
+			}
+			if p.Last != 11409.07 || p.Bid != 11409.06 || p.Ask != 11409.07 {
+				t.Errorf("unexpected replayed ticker %+v", p)
+			}
+			if errs := server.Errors(); len(errs) != 0 {
+				t.Errorf("unexpected replay errors %v", errs)
+			}
+			return
+		case <-timer.C:
+			t.Fatal("timed out waiting for replayed ticker")
+		}
+	}
+}
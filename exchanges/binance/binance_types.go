@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"errors"
 	"sync"
 	"time"
 
@@ -402,6 +403,58 @@ type Account struct {
 	Balances         []Balance `json:"balances"`
 }
 
+// MarginTransaction holds the response from a margin borrow or repay
+type MarginTransaction struct {
+	TransactionID int64 `json:"tranId"`
+}
+
+// binanceWalletTypes maps assets to the wallets used by master account
+// transfers
+var binanceWalletTypes = map[asset.Item]string{
+	asset.Spot:                "MAIN",
+	asset.Margin:              "MARGIN",
+	asset.USDTMarginedFutures: "UMFUTURE",
+	asset.CoinMarginedFutures: "CMFUTURE",
+}
+
+// binanceSubAccountTypes maps assets to the account types used by
+// sub-account transfers
+var binanceSubAccountTypes = map[asset.Item]string{
+	asset.Spot:                "SPOT",
+	asset.Margin:              "MARGIN",
+	asset.USDTMarginedFutures: "USDT_FUTURE",
+	asset.CoinMarginedFutures: "COIN_FUTURE",
+}
+
+// SubAccount holds a sub-account registered under the master account
+type SubAccount struct {
+	Email      string `json:"email"`
+	IsFreeze   bool   `json:"isFreeze"`
+	CreateTime int64  `json:"createTime"`
+}
+
+// MarginAccountAsset holds a single asset of the cross margin account
+type MarginAccountAsset struct {
+	Asset    string  `json:"asset"`
+	Borrowed float64 `json:"borrowed,string"`
+	Free     float64 `json:"free,string"`
+	Interest float64 `json:"interest,string"`
+	Locked   float64 `json:"locked,string"`
+	NetAsset float64 `json:"netAsset,string"`
+}
+
+// MarginAccount holds the cross margin account details
+type MarginAccount struct {
+	BorrowEnabled       bool                 `json:"borrowEnabled"`
+	MarginLevel         float64              `json:"marginLevel,string"`
+	TotalAssetOfBTC     float64              `json:"totalAssetOfBtc,string"`
+	TotalLiabilityOfBTC float64              `json:"totalLiabilityOfBtc,string"`
+	TotalNetAssetOfBTC  float64              `json:"totalNetAssetOfBtc,string"`
+	TradeEnabled        bool                 `json:"tradeEnabled"`
+	TransferEnabled     bool                 `json:"transferEnabled"`
+	UserAssets          []MarginAccountAsset `json:"userAssets"`
+}
+
 // RequestParamsTimeForceType Time in force
 type RequestParamsTimeForceType string
 
@@ -792,3 +845,9 @@ type update struct {
 type job struct {
 	Pair currency.Pair
 }
+
+// fundingRateHistoryLimit is the maximum number of funding rates returned
+// per funding rate history request
+const fundingRateHistoryLimit = 1000
+
+var errNoFundingRate = errors.New("no funding rate returned, contract is not a perpetual")
@@ -24,6 +24,9 @@ import (
 const (
 	binanceDefaultWebsocketURL = "wss://stream.binance.com:9443/stream"
 	pingDelay                  = time.Minute * 9
+	// maxWSStreamsPerConnection is the number of streams a single connection
+	// can listen to, further subscriptions are made on pooled connections
+	maxWSStreamsPerConnection = 1024
 )
 
 var listenKey string
@@ -104,7 +107,7 @@ func (b *Binance) orderBookProcess() {
 		}
 	}
 
-	go b.wsReadData()
+	go b.wsReadData(b.Websocket.Conn)
 	b.setupOrderbookManager()
 }
 
@@ -122,6 +125,27 @@ func (b *Binance) setupOrderbookManager() {
 	}
 }
 
+// wsConnectPooled dials an additional connection for subscriptions which do
+// not fit on the standard connection. Pooled connections only carry market
+// data so the user data stream key is removed from the URL
+func (b *Binance) wsConnectPooled(conn stream.Connection) error {
+	conn.SetURL(strings.Split(b.Websocket.GetWebsocketURL(), "?streams=")[0])
+	var dialer websocket.Dialer
+	err := conn.Dial(&dialer, http.Header{})
+	if err != nil {
+		return fmt.Errorf("%v - Unable to connect to Websocket. Error: %s",
+			b.Name,
+			err)
+	}
+	conn.SetupPingHandler(stream.PingHandler{
+		UseGorillaHandler: true,
+		MessageType:       websocket.PongMessage,
+		Delay:             pingDelay,
+	})
+	go b.wsReadData(conn)
+	return nil
+}
+
 // KeepAuthKeyAlive will continuously send messages to
 // keep the WS auth key active
 func (b *Binance) KeepAuthKeyAlive() {
@@ -145,23 +169,31 @@ func (b *Binance) KeepAuthKeyAlive() {
 }
 
 // wsReadData receives and passes on websocket messages for processing
-func (b *Binance) wsReadData() {
+func (b *Binance) wsReadData(conn stream.Connection) {
 	b.Websocket.Wg.Add(1)
 	defer b.Websocket.Wg.Done()
 
 	for {
-		resp := b.Websocket.Conn.ReadMessage()
+		resp := conn.ReadMessage()
 		if resp.Raw == nil {
 			return
 		}
-		err := b.wsHandleData(resp.Raw)
+		err := b.wsHandleReceivedData(resp.Raw, resp.Received)
 		if err != nil {
 			b.Websocket.DataHandler <- err
 		}
 	}
 }
 
+// wsHandleData handles a message without a receipt time, such as a replayed
+// capture, so no websocket delay is reported
 func (b *Binance) wsHandleData(respRaw []byte) error {
+	return b.wsHandleReceivedData(respRaw, time.Time{})
+}
+
+// wsHandleReceivedData handles a message read from the connection at
+// received, reporting the delay of timestamped ticker and depth updates
+func (b *Binance) wsHandleReceivedData(respRaw []byte, received time.Time) error {
 	var multiStreamData map[string]interface{}
 	err := json.Unmarshal(respRaw, &multiStreamData)
 	if err != nil {
@@ -362,6 +394,7 @@ func (b *Binance) wsHandleData(respRaw []byte) error {
 						AssetType:    asset.Spot,
 						Pair:         pair,
 					}
+					b.Websocket.ReportDelay(t.EventTime, received)
 				case "kline_1m", "kline_3m", "kline_5m", "kline_15m", "kline_30m", "kline_1h", "kline_2h", "kline_4h",
 					"kline_6h", "kline_8h", "kline_12h", "kline_1d", "kline_3d", "kline_1w", "kline_1M":
 					var kline KlineStream
@@ -402,10 +435,11 @@ func (b *Binance) wsHandleData(respRaw []byte) error {
 
 					err = b.UpdateLocalBuffer(&depth)
 					if err != nil {
-						return fmt.Errorf("%v - UpdateLocalCache error: %s",
+						return fmt.Errorf("%v - UpdateLocalCache error: %w",
 							b.Name,
 							err)
 					}
+					b.Websocket.ReportDelay(depth.Timestamp, received)
 				default:
 					b.Websocket.DataHandler <- stream.UnhandledMessageWarning{
 						Message: b.Name + stream.UnhandledMessage + string(respRaw),
@@ -549,14 +583,7 @@ func (b *Binance) GenerateSubscriptions() ([]stream.ChannelSubscription, error)
 
 // Subscribe subscribes to a set of channels
 func (b *Binance) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
-	payload := WsPayload{
-		Method: "SUBSCRIBE",
-	}
-
-	for i := range channelsToSubscribe {
-		payload.Params = append(payload.Params, channelsToSubscribe[i].Channel)
-	}
-	err := b.Websocket.Conn.SendJSONMessage(payload)
+	err := b.SubscribeConnection(b.Websocket.Conn, channelsToSubscribe)
 	if err != nil {
 		return err
 	}
@@ -566,13 +593,7 @@ func (b *Binance) Subscribe(channelsToSubscribe []stream.ChannelSubscription) er
 
 // Unsubscribe unsubscribes from a set of channels
 func (b *Binance) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
-	payload := WsPayload{
-		Method: "UNSUBSCRIBE",
-	}
-	for i := range channelsToUnsubscribe {
-		payload.Params = append(payload.Params, channelsToUnsubscribe[i].Channel)
-	}
-	err := b.Websocket.Conn.SendJSONMessage(payload)
+	err := b.UnsubscribeConnection(b.Websocket.Conn, channelsToUnsubscribe)
 	if err != nil {
 		return err
 	}
@@ -580,6 +601,28 @@ func (b *Binance) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription
 	return nil
 }
 
+// SubscribeConnection subscribes to a set of channels on a specific
+// connection
+func (b *Binance) SubscribeConnection(conn stream.Connection, channelsToSubscribe []stream.ChannelSubscription) error {
+	return sendStreamMethod(conn, "SUBSCRIBE", channelsToSubscribe)
+}
+
+// UnsubscribeConnection unsubscribes from a set of channels on a specific
+// connection
+func (b *Binance) UnsubscribeConnection(conn stream.Connection, channelsToUnsubscribe []stream.ChannelSubscription) error {
+	return sendStreamMethod(conn, "UNSUBSCRIBE", channelsToUnsubscribe)
+}
+
+func sendStreamMethod(conn stream.Connection, method string, channels []stream.ChannelSubscription) error {
+	payload := WsPayload{
+		Method: method,
+	}
+	for i := range channels {
+		payload.Params = append(payload.Params, channels[i].Channel)
+	}
+	return conn.SendJSONMessage(payload)
+}
+
 // ProcessUpdate processes the websocket orderbook update
 func (b *Binance) ProcessUpdate(cp currency.Pair, a asset.Item, ws *WebsocketDepthStream) error {
 	var updateBid []orderbook.Item
@@ -625,11 +668,12 @@ func (b *Binance) ProcessUpdate(cp currency.Pair, a asset.Item, ws *WebsocketDep
 	}
 
 	return b.Websocket.Orderbook.Update(&buffer.Update{
-		Bids:     updateBid,
-		Asks:     updateAsk,
-		Pair:     cp,
-		UpdateID: ws.LastUpdateID,
-		Asset:    a,
+		Bids:          updateBid,
+		Asks:          updateAsk,
+		Pair:          cp,
+		UpdateID:      ws.LastUpdateID,
+		FirstUpdateID: ws.FirstUpdateID,
+		Asset:         a,
 	})
 }
 
@@ -877,13 +921,10 @@ func (u *update) validate(updt *WebsocketDepthStream, recent *orderbook.Base) (b
 				asset.Spot)
 		}
 		u.initialSync = false
-	} else if updt.FirstUpdateID != id {
-		// While listening to the stream, each new event's U should be
-		// equal to the previous event's u+1.
-		return false, fmt.Errorf("websocket orderbook synchronisation failure for pair %s and asset %s",
-			recent.Pair,
-			asset.Spot)
 	}
+	// While listening to the stream, each new event's U should be equal to
+	// the previous event's u+1, gaps are detected by the buffer's sequence
+	// verifier and the book is flushed and fetched again
 	return true, nil
 }
 
@@ -3,6 +3,7 @@ package binance
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,15 +17,22 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -172,7 +180,12 @@ func (b *Binance) SetDefaults() {
 
 	b.Requester = request.New(b.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(SetRateLimit()))
+		request.WithLimiter(SetRateLimit()),
+		request.WithRateLimitHeaders(request.RateLimitHeaders{
+			Used:       binanceUsedWeightHeader,
+			UsedLimit:  binanceGlobalRequestRate,
+			UsedWindow: binanceGlobalInterval,
+		}))
 	b.API.Endpoints = b.NewEndpoints()
 	err = b.API.Endpoints.SetDefaultEndpoints(map[exchange.URL]string{
 		exchange.RestSpot:              spotAPIURL,
@@ -223,6 +236,11 @@ func (b *Binance) Setup(exch *config.ExchangeConfig) error {
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
 		SortBuffer:                       true,
 		SortBufferByUpdateIDs:            true,
+		OrderbookVerifier:                buffer.SequenceVerifier{},
+		MaxSubscriptionsPerConnection:    maxWSStreamsPerConnection,
+		ConnectionDialer:                 b.wsConnectPooled,
+		ConnectionSubscriber:             b.SubscribeConnection,
+		ConnectionUnsubscriber:           b.UnsubscribeConnection,
 	})
 	if err != nil {
 		return err
@@ -389,7 +407,50 @@ func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
 			return err
 		}
 	}
-	return nil
+	return b.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the spot and margin pair execution limits from
+// the exchange info symbol filters
+func (b *Binance) updateExecutionLimits() error {
+	info, err := b.GetExchangeInfo()
+	if err != nil {
+		return err
+	}
+	var limits []order.Limits
+	for x := range info.Symbols {
+		if info.Symbols[x].Status != "TRADING" {
+			continue
+		}
+		l := order.Limits{
+			Pair: currency.NewPair(currency.NewCode(info.Symbols[x].BaseAsset),
+				currency.NewCode(info.Symbols[x].QuoteAsset)),
+		}
+		for y := range info.Symbols[x].Filters {
+			f := &info.Symbols[x].Filters[y]
+			switch f.FilterType {
+			case "PRICE_FILTER":
+				l.MinPrice = f.MinPrice
+				l.MaxPrice = f.MaxPrice
+				l.PriceTick = f.TickSize
+			case "LOT_SIZE":
+				l.MinAmount = f.MinQty
+				l.MaxAmount = f.MaxQty
+				l.AmountStep = f.StepSize
+			case "MIN_NOTIONAL":
+				l.MinNotional = f.MinNotional
+			}
+		}
+		if info.Symbols[x].IsSpotTradingAllowed {
+			l.Asset = asset.Spot
+			limits = append(limits, l)
+		}
+		if info.Symbols[x].IsMarginTradingAllowed {
+			l.Asset = asset.Margin
+			limits = append(limits, l)
+		}
+	}
+	return b.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -817,10 +878,11 @@ func (b *Binance) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		default:
 			return submitOrderResponse, errors.New("invalid type, check api docs for updates")
 		}
+		posSide, reduceOnly := hedgeModeParams(s)
 		order, err := b.FuturesNewOrder(s.Pair, reqSide,
-			"", oType, "GTC", "",
+			posSide, oType, "GTC", "",
 			s.ClientOrderID, "", "",
-			s.Amount, s.Price, 0, 0, 0, s.ReduceOnly)
+			s.Amount, s.Price, 0, 0, 0, reduceOnly)
 		if err != nil {
 			return submitOrderResponse, err
 		}
@@ -855,10 +917,11 @@ func (b *Binance) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		default:
 			return submitOrderResponse, errors.New("invalid type, check api docs for updates")
 		}
+		posSide, reduceOnly := hedgeModeParams(s)
 		order, err := b.UFuturesNewOrder(s.Pair, reqSide,
-			"", oType, "GTC", "",
+			posSide, oType, "GTC", "",
 			s.ClientOrderID, "", "",
-			s.Amount, s.Price, 0, 0, 0, s.ReduceOnly)
+			s.Amount, s.Price, 0, 0, 0, reduceOnly)
 		if err != nil {
 			return submitOrderResponse, err
 		}
@@ -1442,6 +1505,11 @@ func (b *Binance) FormatExchangeKlineInterval(in kline.Interval) string {
 	return in.Short()
 }
 
+// GetCurrentServerTime returns the current exchange server time
+func (b *Binance) GetCurrentServerTime() (time.Time, error) {
+	return b.GetServerTime()
+}
+
 // GetHistoricCandles returns candles between a time period for a set time interval
 func (b *Binance) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if err := b.ValidateKline(pair, a, interval); err != nil {
@@ -1568,3 +1636,560 @@ func compatibleOrderVars(side, status, orderType string) OrderVars {
 	}
 	return resp
 }
+
+// GetDerivativePositions returns the open positions for a derivatives asset type
+func (b *Binance) GetDerivativePositions(a asset.Item) ([]position.Position, error) {
+	var positions []position.Position
+	switch a {
+	case asset.CoinMarginedFutures:
+		resp, err := b.FuturesPositionsInfo("", "")
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp {
+			if resp[i].PositionAmount == 0 {
+				continue
+			}
+			p, err := b.MatchSymbolWithAvailablePairs(resp[i].Symbol, a)
+			if err != nil {
+				return nil, err
+			}
+			side, size, hedged := positionSide(resp[i].PositionSide, resp[i].PositionAmount)
+			positions = append(positions, position.Position{
+				Exchange:         b.Name,
+				Pair:             p,
+				Asset:            a,
+				Side:             side,
+				Size:             size,
+				Hedged:           hedged,
+				EntryPrice:       resp[i].EntryPrice,
+				MarkPrice:        resp[i].MarkPrice,
+				LiquidationPrice: resp[i].LiquidationPrice,
+				Leverage:         float64(resp[i].Leverage),
+				UnrealisedPNL:    resp[i].UnrealizedProfit,
+				MarginType:       position.StringToMarginType(resp[i].MarginType),
+				UpdatedAt:        time.Now(),
+			})
+		}
+	case asset.USDTMarginedFutures:
+		resp, err := b.UPositionsInfoV2(currency.Pair{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp {
+			if resp[i].PositionAmount == 0 {
+				continue
+			}
+			p, err := b.MatchSymbolWithAvailablePairs(resp[i].Symbol, a)
+			if err != nil {
+				return nil, err
+			}
+			side, size, hedged := positionSide(resp[i].PositionSide, resp[i].PositionAmount)
+			positions = append(positions, position.Position{
+				Exchange:         b.Name,
+				Pair:             p,
+				Asset:            a,
+				Side:             side,
+				Size:             size,
+				Hedged:           hedged,
+				EntryPrice:       resp[i].EntryPrice,
+				MarkPrice:        resp[i].MarkPrice,
+				LiquidationPrice: resp[i].LiquidationPrice,
+				Leverage:         resp[i].Leverage,
+				UnrealisedPNL:    resp[i].UnrealizedProfit,
+				MarginType:       position.StringToMarginType(resp[i].MarginType),
+				UpdatedAt:        time.Now(),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	return positions, nil
+}
+
+// CloseDerivativePosition closes all or part of an open derivatives position
+func (b *Binance) CloseDerivativePosition(c *position.Close) (order.SubmitResponse, error) {
+	positions, err := b.GetDerivativePositions(c.Asset)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	s, err := position.CloseOrder(positions, c)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return b.SubmitOrder(s)
+}
+
+// positionSide returns the side and absolute size of a position, one-way
+// mode positions are signed while hedge mode positions state their side
+func positionSide(side string, amount float64) (order.Side, float64, bool) {
+	switch {
+	case side == "SHORT":
+		return order.Short, math.Abs(amount), true
+	case side == "LONG":
+		return order.Long, amount, true
+	case amount > 0:
+		return order.Long, amount, false
+	}
+	return order.Short, -amount, false
+}
+
+// hedgeModeParams returns the position side and reduce only flag of a
+// futures order, hedge mode orders name the leg they reduce as Binance
+// rejects reduce only orders in hedge mode
+func hedgeModeParams(s *order.Submit) (string, bool) {
+	switch s.PositionSide {
+	case order.Long, order.Short:
+		return s.PositionSide.String(), false
+	}
+	return "", s.ReduceOnly
+}
+
+// GetLatestFundingRate returns the current funding rate of a perpetual
+// contract, Binance's last funding rate is the rate accruing for the next
+// funding time
+func (b *Binance) GetLatestFundingRate(p currency.Pair, a asset.Item) (*fundingrate.LatestRate, error) {
+	resp := &fundingrate.LatestRate{
+		Exchange: b.Name,
+		Pair:     p,
+		Asset:    a,
+	}
+	switch a {
+	case asset.CoinMarginedFutures:
+		fPair, err := b.FormatExchangeCurrency(p, a)
+		if err != nil {
+			return nil, err
+		}
+		marks, err := b.GetIndexAndMarkPrice(fPair.String(), "")
+		if err != nil {
+			return nil, err
+		}
+		if len(marks) != 1 || marks[0].LastFundingRate == "" {
+			return nil, fmt.Errorf("%s %s %w", a, p, errNoFundingRate)
+		}
+		resp.Rate, err = strconv.ParseFloat(marks[0].LastFundingRate, 64)
+		if err != nil {
+			return nil, err
+		}
+		resp.NextFundingTime = time.Unix(0, marks[0].NextFundingTime*int64(time.Millisecond))
+		resp.UpdatedAt = time.Unix(0, marks[0].Time*int64(time.Millisecond))
+	case asset.USDTMarginedFutures:
+		marks, err := b.UGetMarkPrice(p)
+		if err != nil {
+			return nil, err
+		}
+		if len(marks) != 1 || marks[0].NextFundingTime == 0 {
+			return nil, fmt.Errorf("%s %s %w", a, p, errNoFundingRate)
+		}
+		resp.Rate = marks[0].LastFundingRate
+		resp.NextFundingTime = time.Unix(0, marks[0].NextFundingTime*int64(time.Millisecond))
+		resp.UpdatedAt = time.Unix(0, marks[0].Time*int64(time.Millisecond))
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistory returns the funding rates applied to a perpetual
+// contract over a date range, paging forward from the start date
+func (b *Binance) GetFundingRateHistory(r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+	var fetch func(start time.Time) ([]FundingRateHistory, error)
+	switch r.Asset {
+	case asset.CoinMarginedFutures:
+		fetch = func(start time.Time) ([]FundingRateHistory, error) {
+			return b.FuturesGetFundingHistory(r.Pair, fundingRateHistoryLimit, start, r.End)
+		}
+	case asset.USDTMarginedFutures:
+		fetch = func(start time.Time) ([]FundingRateHistory, error) {
+			return b.UGetFundingHistory(r.Pair, fundingRateHistoryLimit, start, r.End)
+		}
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", r.Asset, b.Name)
+	}
+	var rates []fundingrate.Rate
+	for start := r.Start; start.Before(r.End); {
+		page, err := fetch(start)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page {
+			rates = append(rates, fundingrate.Rate{
+				Time: time.Unix(0, page[i].FundingTime*int64(time.Millisecond)),
+				Rate: page[i].FundingRate,
+			})
+		}
+		if len(page) < fundingRateHistoryLimit {
+			break
+		}
+		start = rates[len(rates)-1].Time.Add(time.Millisecond)
+	}
+	return r.Collate(b.Name, rates), nil
+}
+
+// GetLeverage returns the leverage and margin type applied to orders in a
+// futures contract
+func (b *Binance) GetLeverage(p currency.Pair, a asset.Item) (*position.Leverage, error) {
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	switch a {
+	case asset.CoinMarginedFutures:
+		resp, err := b.FuturesPositionsInfo("", "")
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp {
+			if resp[i].Symbol != fPair.String() {
+				continue
+			}
+			return &position.Leverage{
+				Exchange:   b.Name,
+				Pair:       p,
+				Asset:      a,
+				Leverage:   float64(resp[i].Leverage),
+				MarginType: position.StringToMarginType(resp[i].MarginType),
+			}, nil
+		}
+	case asset.USDTMarginedFutures:
+		resp, err := b.UPositionsInfoV2(p)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp {
+			if resp[i].Symbol != fPair.String() {
+				continue
+			}
+			return &position.Leverage{
+				Exchange:   b.Name,
+				Pair:       p,
+				Asset:      a,
+				Leverage:   resp[i].Leverage,
+				MarginType: position.StringToMarginType(resp[i].MarginType),
+			}, nil
+		}
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	return nil, fmt.Errorf("%s %s %s %w", b.Name, a, p, position.ErrLeverageNotFound)
+}
+
+// SetLeverage sets the initial leverage applied to orders in a futures
+// contract
+func (b *Binance) SetLeverage(p currency.Pair, a asset.Item, leverage float64) error {
+	l, err := position.WholeLeverage(leverage)
+	if err != nil {
+		return err
+	}
+	switch a {
+	case asset.CoinMarginedFutures:
+		_, err = b.FuturesChangeInitialLeverage(p, l)
+	case asset.USDTMarginedFutures:
+		_, err = b.UChangeInitialLeverageRequest(p, l)
+	default:
+		return fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	return err
+}
+
+// SetMarginType sets whether a futures contract uses isolated or cross
+// margin, Binance rejects the change while the contract has open positions
+// or orders
+func (b *Binance) SetMarginType(p currency.Pair, a asset.Item, m position.MarginType) error {
+	err := m.Validate()
+	if err != nil {
+		return err
+	}
+	marginType := "ISOLATED"
+	if m == position.Cross {
+		marginType = "CROSSED"
+	}
+	switch a {
+	case asset.CoinMarginedFutures:
+		_, err = b.FuturesChangeMarginType(p, marginType)
+	case asset.USDTMarginedFutures:
+		err = b.UChangeInitialMarginType(p, marginType)
+	default:
+		return fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	return err
+}
+
+// Borrow borrows funds on the cross margin account
+func (b *Binance) Borrow(r *lending.Borrow) (string, error) {
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+	id, err := b.MarginBorrow(r.Currency.Upper().String(), r.Amount)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// Repay repays borrowed funds on the cross margin account, a zero amount
+// repays the borrowed amount and accrued interest
+func (b *Binance) Repay(r *lending.Repay) (string, error) {
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+	amount := r.Amount
+	if amount == 0 {
+		acc, err := b.GetMarginAccount()
+		if err != nil {
+			return "", err
+		}
+		for i := range acc.UserAssets {
+			if r.Currency.Match(currency.NewCode(acc.UserAssets[i].Asset)) {
+				amount = acc.UserAssets[i].Borrowed + acc.UserAssets[i].Interest
+				break
+			}
+		}
+		if amount == 0 {
+			return "", fmt.Errorf("%s has no outstanding %s loan", b.Name, r.Currency)
+		}
+	}
+	id, err := b.MarginRepay(r.Currency.Upper().String(), amount)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// GetLoans returns the funds borrowed on the cross margin account
+func (b *Binance) GetLoans() ([]lending.Loan, error) {
+	acc, err := b.GetMarginAccount()
+	if err != nil {
+		return nil, err
+	}
+	var loans []lending.Loan
+	for i := range acc.UserAssets {
+		if acc.UserAssets[i].Borrowed == 0 && acc.UserAssets[i].Interest == 0 {
+			continue
+		}
+		loans = append(loans, lending.Loan{
+			Exchange: b.Name,
+			Currency: currency.NewCode(acc.UserAssets[i].Asset),
+			Side:     lending.Borrowed,
+			Amount:   acc.UserAssets[i].Borrowed,
+			Interest: acc.UserAssets[i].Interest,
+		})
+	}
+	return loans, nil
+}
+
+// OfferFunds is not supported, Binance lends margin funds itself and has no
+// market for accounts to offer funds to margin traders
+func (b *Binance) OfferFunds(_ *lending.Offer) (string, error) {
+	return "", fmt.Errorf("%w, %s has no margin lending market",
+		common.ErrFunctionNotSupported,
+		b.Name)
+}
+
+// CancelFundingOffer is not supported, Binance has no margin lending market
+func (b *Binance) CancelFundingOffer(_ string) error {
+	return fmt.Errorf("%w, %s has no margin lending market",
+		common.ErrFunctionNotSupported,
+		b.Name)
+}
+
+// UpdateDerivativePrice updates and returns the mark price, index price and
+// open interest of a futures contract
+func (b *Binance) UpdateDerivativePrice(p currency.Pair, a asset.Item) (*derivative.Price, error) {
+	resp := &derivative.Price{
+		ExchangeName: b.Name,
+		Pair:         p,
+		AssetType:    a,
+	}
+	switch a {
+	case asset.CoinMarginedFutures:
+		fPair, err := b.FormatExchangeCurrency(p, a)
+		if err != nil {
+			return nil, err
+		}
+		marks, err := b.GetIndexAndMarkPrice(fPair.String(), "")
+		if err != nil {
+			return nil, err
+		}
+		if len(marks) != 1 {
+			return nil, fmt.Errorf("%s %s %w", a, p, derivative.ErrNoPriceData)
+		}
+		interest, err := b.GetOpenInterest(p)
+		if err != nil {
+			return nil, err
+		}
+		resp.MarkPrice = marks[0].MarkPrice
+		resp.IndexPrice = marks[0].IndexPrice
+		resp.EstimatedSettlementPrice = marks[0].EstimatedSettlePrice
+		resp.OpenInterest = interest.OpenInterest
+		resp.LastUpdated = time.Unix(0, marks[0].Time*int64(time.Millisecond))
+	case asset.USDTMarginedFutures:
+		marks, err := b.UGetMarkPrice(p)
+		if err != nil {
+			return nil, err
+		}
+		if len(marks) != 1 {
+			return nil, fmt.Errorf("%s %s %w", a, p, derivative.ErrNoPriceData)
+		}
+		interest, err := b.UOpenInterest(p)
+		if err != nil {
+			return nil, err
+		}
+		resp.MarkPrice = marks[0].MarkPrice
+		resp.IndexPrice = marks[0].IndexPrice
+		resp.EstimatedSettlementPrice = marks[0].EstimatedSettlePrice
+		resp.OpenInterest = interest.OpenInterest
+		resp.LastUpdated = time.Unix(0, marks[0].Time*int64(time.Millisecond))
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	err := derivative.ProcessPrice(resp)
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetPrice(b.Name, p, a)
+}
+
+// GetLiquidations returns the public liquidation orders of a futures contract
+// between the start and end times
+func (b *Binance) GetLiquidations(p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]liquidation.Data, error) {
+	var resp []liquidation.Data
+	switch a {
+	case asset.CoinMarginedFutures:
+		orders, err := b.GetFuturesLiquidationOrders(p, "", 1000, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		for i := range orders {
+			l, err := b.forceOrderToLiquidation(p,
+				a,
+				orders[i].Side,
+				orders[i].Price,
+				orders[i].AveragePrice,
+				orders[i].OrigQty,
+				orders[i].ExecutedQty,
+				orders[i].Time)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, l)
+		}
+	case asset.USDTMarginedFutures:
+		orders, err := b.ULiquidationOrders(p, 999, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		for i := range orders {
+			l, err := b.forceOrderToLiquidation(p,
+				a,
+				orders[i].Side,
+				orders[i].Price,
+				orders[i].AveragePrice,
+				orders[i].OrigQty,
+				orders[i].ExecutedQty,
+				orders[i].Time)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, l)
+		}
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+
+	err := b.AddLiquidationsToBuffer(resp...)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(liquidation.ByDate(resp))
+	return resp, nil
+}
+
+// forceOrderToLiquidation converts a public forced liquidation order, using
+// the executed price and quantity once the order has filled
+func (b *Binance) forceOrderToLiquidation(p currency.Pair, a asset.Item, side string, price, avgPrice, origQty, executedQty float64, ts int64) (liquidation.Data, error) {
+	oSide, err := order.StringToOrderSide(side)
+	if err != nil {
+		return liquidation.Data{}, err
+	}
+	if avgPrice != 0 {
+		price = avgPrice
+	}
+	if executedQty != 0 {
+		origQty = executedQty
+	}
+	return liquidation.Data{
+		Exchange:     b.Name,
+		CurrencyPair: p,
+		AssetType:    a,
+		Side:         oSide,
+		Price:        price,
+		Amount:       origQty,
+		Timestamp:    time.Unix(0, ts*int64(time.Millisecond)),
+	}, nil
+}
+
+// GetSubAccounts returns the sub-accounts registered under the master account
+func (b *Binance) GetSubAccounts() ([]account.SubAccountDetails, error) {
+	subs, err := b.GetSubAccountList()
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]account.SubAccountDetails, len(subs))
+	for i := range subs {
+		resp[i] = account.SubAccountDetails{
+			ID:        subs[i].Email,
+			Name:      subs[i].Email,
+			Disabled:  subs[i].IsFreeze,
+			CreatedAt: time.Unix(0, subs[i].CreateTime*int64(time.Millisecond)),
+		}
+	}
+	return resp, nil
+}
+
+// TransferFunds moves funds between the spot, margin and futures wallets of
+// the master account or between the master account and its sub-accounts
+func (b *Binance) TransferFunds(r *transfer.Request) (*transfer.ExchangeResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	var id int64
+	var err error
+	if r.IsSubAccountTransfer() || r.FromAccount != "" {
+		from, ok := binanceSubAccountTypes[r.FromAsset]
+		if !ok {
+			return nil, fmt.Errorf("%w %s sub-account %s", transfer.ErrUnsupportedTransfer, b.Name, r.FromAsset)
+		}
+		to, ok := binanceSubAccountTypes[r.ToAsset]
+		if !ok {
+			return nil, fmt.Errorf("%w %s sub-account %s", transfer.ErrUnsupportedTransfer, b.Name, r.ToAsset)
+		}
+		id, err = b.SubAccountTransfer(r.FromAccount,
+			r.ToAccount,
+			from,
+			to,
+			r.Currency.Upper().String(),
+			r.Amount)
+	} else {
+		from, ok := binanceWalletTypes[r.FromAsset]
+		if !ok {
+			return nil, fmt.Errorf("%w %s wallet %s", transfer.ErrUnsupportedTransfer, b.Name, r.FromAsset)
+		}
+		to, ok := binanceWalletTypes[r.ToAsset]
+		if !ok {
+			return nil, fmt.Errorf("%w %s wallet %s", transfer.ErrUnsupportedTransfer, b.Name, r.ToAsset)
+		}
+		id, err = b.WalletTransfer(from+"_"+to, r.Currency.Upper().String(), r.Amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &transfer.ExchangeResponse{
+		Name: b.Name,
+		ID:   strconv.FormatInt(id, 10),
+	}, nil
+}
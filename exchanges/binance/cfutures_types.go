@@ -411,9 +411,9 @@ type FuturesPositionInformation struct {
 	EntryPrice       float64 `json:"entryPrice,string"`
 	MarkPrice        float64 `json:"markPrice,string"`
 	UnrealizedProfit float64 `json:"unRealizedProfit,string"`
-	LiquidationPrice float64 `json:"liquidation,string"`
-	Leverage         int64   `json:"leverage"`
-	MaxQty           float64 `json:"maxQty"`
+	LiquidationPrice float64 `json:"liquidationPrice,string"`
+	Leverage         int64   `json:"leverage,string"`
+	MaxQty           float64 `json:"maxQty,string"`
 	MarginType       string  `json:"marginType"`
 	IsolatedMargin   float64 `json:"isolatedMargin,string"`
 	IsAutoAddMargin  bool    `json:"isAutoAddMargin"`
@@ -19,6 +19,9 @@ const (
 	binanceOrderRequestRate      = 100
 	binanceOrderDailyInterval    = time.Hour * 24
 	binanceOrderDailyMaxRequests = 100000
+	// binanceUsedWeightHeader reports the request weight used in the current
+	// minute across all clients sharing an IP
+	binanceUsedWeightHeader = "X-Mbx-Used-Weight-1m"
 )
 
 const (
@@ -39,52 +42,72 @@ const (
 type RateLimit struct {
 	GlobalRate *rate.Limiter
 	Orders     *rate.Limiter
+
+	globalRate rate.Limit
 }
 
 // Limit executes rate limiting functionality for Binance
 func (r *RateLimit) Limit(f request.EndpointLimit) error {
-	var limiter *rate.Limiter
-	var tokens int
+	limiter, tokens := r.limiter(f)
+
+	var finalDelay time.Duration
+	for i := 0; i < tokens; i++ {
+		// Consume tokens 1 at a time as this avoids needing burst capacity in the limiter,
+		// which would otherwise allow the rate limit to be exceeded over short periods
+		finalDelay = limiter.Reserve().Delay()
+	}
+	time.Sleep(finalDelay)
+	return nil
+}
+
+// Weight returns the request weight of an endpoint
+func (r *RateLimit) Weight(f request.EndpointLimit) int {
+	_, tokens := r.limiter(f)
+	return tokens
+}
+
+// limiter returns the limiter of an endpoint and the number of tokens it
+// consumes
+func (r *RateLimit) limiter(f request.EndpointLimit) (*rate.Limiter, int) {
 	switch f {
 	case limitHistoricalTrades:
-		limiter, tokens = r.GlobalRate, 5
+		return r.GlobalRate, 5
 	case limitOrderbookDepth500:
-		limiter, tokens = r.GlobalRate, 5
+		return r.GlobalRate, 5
 	case limitOrderbookDepth1000:
-		limiter, tokens = r.GlobalRate, 10
+		return r.GlobalRate, 10
 	case limitOrderbookDepth5000:
-		limiter, tokens = r.GlobalRate, 50
+		return r.GlobalRate, 50
 	case limitOrderbookTickerAll:
-		limiter, tokens = r.GlobalRate, 2
+		return r.GlobalRate, 2
 	case limitPriceChangeAll:
-		limiter, tokens = r.GlobalRate, 40
+		return r.GlobalRate, 40
 	case limitSymbolPriceAll:
-		limiter, tokens = r.GlobalRate, 2
+		return r.GlobalRate, 2
 	case limitOpenOrdersAll:
-		limiter, tokens = r.Orders, 40
+		return r.Orders, 40
 	case limitOrder:
-		limiter, tokens = r.Orders, 1
+		return r.Orders, 1
 	case limitOrdersAll:
-		limiter, tokens = r.Orders, 5
+		return r.Orders, 5
 	default:
-		limiter, tokens = r.GlobalRate, 1
+		return r.GlobalRate, 1
 	}
+}
 
-	var finalDelay time.Duration
-	for i := 0; i < tokens; i++ {
-		// Consume tokens 1 at a time as this avoids needing burst capacity in the limiter,
-		// which would otherwise allow the rate limit to be exceeded over short periods
-		finalDelay = limiter.Reserve().Delay()
-	}
-	time.Sleep(finalDelay)
-	return nil
+// Loosen raises the global rate while Binance reports spare request weight,
+// order rates are not covered by the weight header and are left unchanged
+func (r *RateLimit) Loosen(spare rate.Limit) {
+	request.LoosenRateLimit(r.GlobalRate, r.globalRate, spare)
 }
 
 // SetRateLimit returns the rate limit for the exchange
 func SetRateLimit() *RateLimit {
+	global := request.NewRateLimit(binanceGlobalInterval, binanceGlobalRequestRate)
 	return &RateLimit{
-		GlobalRate: request.NewRateLimit(binanceGlobalInterval, binanceGlobalRequestRate),
+		GlobalRate: global,
 		Orders:     request.NewRateLimit(binanceOrderInterval, binanceOrderRequestRate),
+		globalRate: global.Limit(),
 	}
 }
 
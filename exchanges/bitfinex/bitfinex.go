@@ -60,6 +60,7 @@ const (
 	bitfinexLendbook           = "lendbook/"
 	bitfinexLends              = "lends/"
 	bitfinexLeaderboard        = "rankings"
+	bitfinexSymbolsDetails     = "symbols_details"
 
 	// Version 2 API endpoints
 	bitfinexAPIVersion2     = "/v2/"
@@ -88,6 +89,11 @@ const (
 
 	bitfinexChecksumFlag   = 131072
 	bitfinexWsSequenceFlag = 65536
+
+	// bitfinexMaxWSSubscriptions is the number of public channels a single
+	// connection can subscribe to, further subscriptions are made on pooled
+	// connections
+	bitfinexMaxWSSubscriptions = 25
 )
 
 // Bitfinex is the overarching type across the bitfinex package
@@ -280,6 +286,13 @@ func (b *Bitfinex) GetV2Balances() ([]WalletDataV2, error) {
 	return resp, nil
 }
 
+// GetSymbolsDetails returns the trading pair order size limits
+func (b *Bitfinex) GetSymbolsDetails() ([]SymbolDetails, error) {
+	var response []SymbolDetails
+	path := bitfinexAPIVersion + bitfinexSymbolsDetails
+	return response, b.SendHTTPRequest(exchange.RestSpot, path, &response, configs)
+}
+
 // GetMarginPairs gets pairs that allow margin trading
 func (b *Bitfinex) GetMarginPairs() ([]string, error) {
 	var resp [][]string
@@ -940,6 +953,9 @@ func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo
 		return WalletTransfer{}, err
 	}
 
+	if len(response) == 0 {
+		return WalletTransfer{}, errors.New("no wallet transfer response returned")
+	}
 	if response[0].Status == "error" {
 		return WalletTransfer{}, errors.New(response[0].Message)
 	}
@@ -1,6 +1,7 @@
 package bitfinex
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -8,15 +9,18 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -119,6 +123,21 @@ func TestGetMarginPairs(t *testing.T) {
 	}
 }
 
+func TestUpdateSpotExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := b.updateSpotExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := b.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.BTC, currency.USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.MinAmount == 0 || l.MaxAmount == 0 {
+		t.Errorf("expected minimum and maximum amount to be loaded, received %+v", l)
+	}
+}
+
 func TestAppendOptionalDelimiter(t *testing.T) {
 	t.Parallel()
 	curr1, err := currency.NewPairFromString("BTCUSD")
@@ -390,6 +409,52 @@ func TestWalletTransfer(t *testing.T) {
 	}
 }
 
+func TestGetSubAccounts(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetSubAccounts()
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := b.TransferFunds(&transfer.Request{
+		Exchange:  b.Name,
+		Currency:  currency.BTC,
+		Amount:    0.01,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Futures,
+	})
+	if !errors.Is(err, transfer.ErrUnsupportedTransfer) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrUnsupportedTransfer)
+	}
+	_, err = b.TransferFunds(&transfer.Request{
+		Exchange:  b.Name,
+		Currency:  currency.BTC,
+		Amount:    0.01,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Spot,
+		ToAccount: "sub",
+	})
+	if !errors.Is(err, transfer.ErrUnsupportedTransfer) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrUnsupportedTransfer)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
+	}
+	_, err = b.TransferFunds(&transfer.Request{
+		Exchange:  b.Name,
+		Currency:  currency.BTC,
+		Amount:    0.01,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Margin,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestNewOrder(t *testing.T) {
 	if !b.ValidateAPICredentials() {
 		t.SkipNow()
@@ -681,6 +746,73 @@ func TestCloseMarginFunding(t *testing.T) {
 	}
 }
 
+func TestGetLoans(t *testing.T) {
+	if !b.ValidateAPICredentials() {
+		t.SkipNow()
+	}
+	t.Parallel()
+
+	_, err := b.GetLoans()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBorrow(t *testing.T) {
+	t.Parallel()
+	_, err := b.Borrow(&lending.Borrow{Exchange: b.Name, Currency: currency.USD, Amount: 1})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestRepay(t *testing.T) {
+	t.Parallel()
+	r := &lending.Repay{Exchange: b.Name, Currency: currency.USD}
+	_, err := b.Repay(r)
+	if !errors.Is(err, lending.ErrLoanIDRequired) {
+		t.Errorf("expected %v, received %v", lending.ErrLoanIDRequired, err)
+	}
+	r.LoanID = "1337"
+	r.Amount = 1
+	_, err = b.Repay(r)
+	if !errors.Is(err, lending.ErrPartialRepayNotSupported) {
+		t.Errorf("expected %v, received %v", lending.ErrPartialRepayNotSupported, err)
+	}
+}
+
+func TestOfferFunds(t *testing.T) {
+	if !b.ValidateAPICredentials() || !canManipulateRealOrders {
+		t.SkipNow()
+	}
+	t.Parallel()
+
+	_, err := b.OfferFunds(&lending.Offer{
+		Exchange: b.Name,
+		Currency: currency.USD,
+		Amount:   50,
+		Rate:     0.0002,
+		Period:   2,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseLendingTimestamp(t *testing.T) {
+	t.Parallel()
+	ts := parseLendingTimestamp("1444141857.5")
+	if ts.Unix() != 1444141857 || ts.Nanosecond() != 5e8 {
+		t.Errorf("unexpected timestamp %v", ts)
+	}
+	if !parseLendingTimestamp("").IsZero() {
+		t.Error("expected zero time for an empty timestamp")
+	}
+	if r := yearlyPercentToDailyRate(36.5); r != 0.001 {
+		t.Errorf("expected 0.001, received %v", r)
+	}
+}
+
 func setFeeBuilder() *exchange.FeeBuilder {
 	return &exchange.FeeBuilder{
 		Amount:        1,
@@ -1561,9 +1693,8 @@ var testOb = orderbook.Base{
 }
 
 func TestChecksum(t *testing.T) {
-	err := validateCRC32(&testOb, 190468240)
-	if err != nil {
-		t.Fatal(err)
+	if check := calculateCRC32(&testOb); check != 190468240 {
+		t.Errorf("expected checksum %d, received %d", 190468240, check)
 	}
 }
 
@@ -3,6 +3,7 @@ package bitfinex
 import (
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -33,6 +34,13 @@ var AcceptedOrderType = []string{"market", "limit", "stop", "trailing-stop",
 var AcceptedWalletNames = []string{"trading", "exchange", "deposit", "margin",
 	"funding"}
 
+// transferWallets maps assets to the wallets used by wallet transfers
+var transferWallets = map[asset.Item]string{
+	asset.Spot:          "exchange",
+	asset.Margin:        "trading",
+	asset.MarginFunding: "deposit",
+}
+
 // AcceptableMethods defines a map of currency codes to methods
 var AcceptableMethods = make(map[string]string)
 
@@ -406,6 +414,9 @@ type Offer struct {
 	OriginalAmount  float64 `json:"original_amount,string"`
 	RemainingAmount float64 `json:"remaining_amount,string"`
 	ExecutedAmount  float64 `json:"executed_amount,string"`
+	// Amount and Status are only returned for active credits
+	Amount float64 `json:"amount,string"`
+	Status string  `json:"status"`
 }
 
 // MarginFunds holds active funding information used in a margin position
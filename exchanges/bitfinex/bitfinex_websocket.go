@@ -78,6 +78,21 @@ func (b *Bitfinex) WsConnect() error {
 	return nil
 }
 
+// wsConnectPooled dials an additional connection for public channels which
+// do not fit on the standard connection. Channel IDs on every connection are
+// funnelled through the same handler
+func (b *Bitfinex) wsConnectPooled(conn stream.Connection) error {
+	var dialer websocket.Dialer
+	err := conn.Dial(&dialer, http.Header{})
+	if err != nil {
+		return fmt.Errorf("%v unable to connect to Websocket. Error: %s",
+			b.Name,
+			err)
+	}
+	go b.wsReadData(conn)
+	return nil
+}
+
 // wsReadData receives and passes on websocket messages for processing
 func (b *Bitfinex) wsReadData(ws stream.Connection) {
 	b.Websocket.Wg.Add(1)
@@ -1026,29 +1041,14 @@ func (b *Bitfinex) WsUpdateOrderbook(p currency.Pair, assetType asset.Item, book
 
 	cMtx.Lock()
 	checkme := checksumStore[channelID]
-	if checkme == nil {
-		cMtx.Unlock()
-		return b.Websocket.Orderbook.Update(&orderbookUpdate)
-	}
 	checksumStore[channelID] = nil
 	cMtx.Unlock()
 
-	if checkme.Sequence+1 == sequenceNo {
-		// Sequence numbers get dropped, if checksum is not in line with
-		// sequence, do not check.
-		ob := b.Websocket.Orderbook.GetOrderbook(p, assetType)
-		if ob == nil {
-			return fmt.Errorf("cannot calculate websocket checksum: book not found for %s %s",
-				p,
-				assetType)
-		}
-
-		err := validateCRC32(ob, checkme.Token)
-		if err != nil {
-			return err
-		}
+	// Sequence numbers get dropped, if checksum is not in line with sequence,
+	// do not check. The checksum covers the orderbook before this update
+	if checkme != nil && checkme.Sequence+1 == sequenceNo {
+		orderbookUpdate.Checksum = uint32(checkme.Token)
 	}
-
 	return b.Websocket.Orderbook.Update(&orderbookUpdate)
 }
 
@@ -1105,55 +1105,58 @@ func (b *Bitfinex) GenerateDefaultSubscriptions() ([]stream.ChannelSubscription,
 
 // Subscribe sends a websocket message to receive data from the channel
 func (b *Bitfinex) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
-	var errs common.Errors
+	err := b.SubscribeConnection(b.Websocket.Conn, channelsToSubscribe)
+	if err != nil {
+		return err
+	}
+	b.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe...)
+	return nil
+}
+
+// Unsubscribe sends a websocket message to stop receiving data from the channel
+func (b *Bitfinex) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
+	err := b.UnsubscribeConnection(b.Websocket.Conn, channelsToUnsubscribe)
+	if err != nil {
+		return err
+	}
+	b.Websocket.RemoveSuccessfulUnsubscriptions(channelsToUnsubscribe...)
+	return nil
+}
+
+// SubscribeConnection subscribes to a set of channels on a specific
+// connection
+func (b *Bitfinex) SubscribeConnection(conn stream.Connection, channelsToSubscribe []stream.ChannelSubscription) error {
 	checksum := make(map[string]interface{})
 	checksum["event"] = "conf"
 	checksum["flags"] = bitfinexChecksumFlag + bitfinexWsSequenceFlag
-	err := b.Websocket.Conn.SendJSONMessage(checksum)
+	err := conn.SendJSONMessage(checksum)
 	if err != nil {
 		return err
 	}
+	return sendChannelEvent(conn, "subscribe", channelsToSubscribe)
+}
 
-	for i := range channelsToSubscribe {
-		req := make(map[string]interface{})
-		req["event"] = "subscribe"
-		req["channel"] = channelsToSubscribe[i].Channel
-
-		for k, v := range channelsToSubscribe[i].Params {
-			req[k] = v
-		}
-
-		err := b.Websocket.Conn.SendJSONMessage(req)
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-		b.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe[i])
-	}
-	if errs != nil {
-		return errs
-	}
-	return nil
+// UnsubscribeConnection unsubscribes from a set of channels on a specific
+// connection
+func (b *Bitfinex) UnsubscribeConnection(conn stream.Connection, channelsToUnsubscribe []stream.ChannelSubscription) error {
+	return sendChannelEvent(conn, "unsubscribe", channelsToUnsubscribe)
 }
 
-// Unsubscribe sends a websocket message to stop receiving data from the channel
-func (b *Bitfinex) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
+func sendChannelEvent(conn stream.Connection, event string, channels []stream.ChannelSubscription) error {
 	var errs common.Errors
-	for i := range channelsToUnsubscribe {
+	for i := range channels {
 		req := make(map[string]interface{})
-		req["event"] = "unsubscribe"
-		req["channel"] = channelsToUnsubscribe[i].Channel
+		req["event"] = event
+		req["channel"] = channels[i].Channel
 
-		for k, v := range channelsToUnsubscribe[i].Params {
+		for k, v := range channels[i].Params {
 			req[k] = v
 		}
 
-		err := b.Websocket.Conn.SendJSONMessage(req)
+		err := conn.SendJSONMessage(req)
 		if err != nil {
 			errs = append(errs, err)
-			continue
 		}
-		b.Websocket.RemoveSuccessfulUnsubscriptions(channelsToUnsubscribe[i])
 	}
 	if errs != nil {
 		return errs
@@ -1346,7 +1349,9 @@ func makeRequestInterface(channelName string, data interface{}) []interface{} {
 	return []interface{}{0, channelName, nil, data}
 }
 
-func validateCRC32(book *orderbook.Base, token int) error {
+// calculateCRC32 calculates the checksum of the top 25 bids and asks for
+// verification of websocket orderbook updates
+func calculateCRC32(book *orderbook.Base) uint32 {
 	// Order ID's need to be sub-sorted in ascending order, this needs to be
 	// done on the main book to ensure that we do not cut price levels out below
 	reOrderByID(book.Bids)
@@ -1393,15 +1398,7 @@ func validateCRC32(book *orderbook.Base, token int) error {
 	}
 
 	checksumStr := strings.TrimSuffix(check.String(), ":")
-	checksum := crc32.ChecksumIEEE([]byte(checksumStr))
-	if checksum == uint32(token) {
-		return nil
-	}
-	return fmt.Errorf("invalid checksum for %s %s: calculated [%d] does not match [%d]",
-		book.AssetType,
-		book.Pair,
-		checksum,
-		uint32(token))
+	return crc32.ChecksumIEEE([]byte(checksumStr))
 }
 
 // reOrderByID sub sorts orderbook items by its corresponding ID when price
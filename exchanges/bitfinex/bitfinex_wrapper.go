@@ -3,6 +3,7 @@ package bitfinex
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -17,14 +18,17 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -209,6 +213,15 @@ func (b *Bitfinex) Setup(exch *config.ExchangeConfig) error {
 		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
 		UpdateEntriesByID:                true,
+		OrderbookVerifier: buffer.ChecksumVerifier{
+			Checksum:  calculateCRC32,
+			PreUpdate: true,
+		},
+		OrderbookResync:               b.UpdateOrderbook,
+		MaxSubscriptionsPerConnection: bitfinexMaxWSSubscriptions,
+		ConnectionDialer:              b.wsConnectPooled,
+		ConnectionSubscriber:          b.SubscribeConnection,
+		ConnectionUnsubscriber:        b.UnsubscribeConnection,
 	})
 	if err != nil {
 		return err
@@ -320,7 +333,32 @@ func (b *Bitfinex) UpdateTradablePairs(forceUpdate bool) error {
 			return err
 		}
 	}
-	return nil
+	return b.updateSpotExecutionLimits()
+}
+
+// updateSpotExecutionLimits loads the spot pair order size limits, Bitfinex
+// prices are limited to significant digits rather than a tick size so only
+// amounts are enforced
+func (b *Bitfinex) updateSpotExecutionLimits() error {
+	details, err := b.GetSymbolsDetails()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(details))
+	for i := range details {
+		var p currency.Pair
+		p, err = currency.NewPairFromString(strings.ToUpper(details[i].Pair))
+		if err != nil {
+			return err
+		}
+		limits = append(limits, order.Limits{
+			Pair:      p,
+			Asset:     asset.Spot,
+			MinAmount: details[i].MinimumOrderSize,
+			MaxAmount: details[i].MaximumOrderSize,
+		})
+	}
+	return b.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -1089,3 +1127,148 @@ func (b *Bitfinex) fixCasing(in currency.Pair, a asset.Item) (string, error) {
 	runes[0] = unicode.ToLower(runes[0])
 	return string(runes), nil
 }
+
+// Borrow is not supported, Bitfinex takes margin funding automatically when a
+// margin order is placed and has no endpoint to borrow funds directly
+func (b *Bitfinex) Borrow(_ *lending.Borrow) (string, error) {
+	return "", fmt.Errorf("%w, %s borrows automatically when margin orders are placed",
+		common.ErrFunctionNotSupported,
+		b.Name)
+}
+
+// Repay closes a margin funding loan, Bitfinex only supports repaying the
+// whole loan
+func (b *Bitfinex) Repay(r *lending.Repay) (string, error) {
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+	if r.LoanID == "" {
+		return "", lending.ErrLoanIDRequired
+	}
+	if r.Amount != 0 {
+		return "", lending.ErrPartialRepayNotSupported
+	}
+	id, err := strconv.ParseInt(r.LoanID, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	resp, err := b.CloseMarginFunding(id)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(resp.ID, 10), nil
+}
+
+// GetLoans returns the funds lent out as active credits and the margin
+// funding borrowed by the account
+func (b *Bitfinex) GetLoans() ([]lending.Loan, error) {
+	credits, err := b.GetActiveCredits()
+	if err != nil {
+		return nil, err
+	}
+	funding, err := b.GetActiveMarginFunding()
+	if err != nil {
+		return nil, err
+	}
+	loans := make([]lending.Loan, 0, len(credits)+len(funding))
+	for i := range credits {
+		loans = append(loans, lending.Loan{
+			Exchange:  b.Name,
+			ID:        strconv.FormatInt(credits[i].ID, 10),
+			Currency:  currency.NewCode(credits[i].Currency),
+			Side:      lending.Lent,
+			Amount:    credits[i].Amount,
+			Rate:      yearlyPercentToDailyRate(credits[i].Rate),
+			Period:    credits[i].Period,
+			CreatedAt: parseLendingTimestamp(credits[i].Timestamp),
+		})
+	}
+	for i := range funding {
+		loans = append(loans, lending.Loan{
+			Exchange:  b.Name,
+			ID:        strconv.FormatInt(funding[i].ID, 10),
+			Currency:  currency.NewCode(funding[i].Currency),
+			Side:      lending.Borrowed,
+			Amount:    funding[i].Amount,
+			Rate:      yearlyPercentToDailyRate(funding[i].Rate),
+			Period:    int64(funding[i].Period),
+			CreatedAt: parseLendingTimestamp(funding[i].Timestamp),
+		})
+	}
+	return loans, nil
+}
+
+// OfferFunds offers funds to be lent to margin traders
+func (b *Bitfinex) OfferFunds(o *lending.Offer) (string, error) {
+	if err := o.Validate(); err != nil {
+		return "", err
+	}
+	resp, err := b.NewOffer(o.Currency.Upper().String(),
+		o.Amount,
+		o.Rate*100*365,
+		o.Period,
+		"lend")
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(resp.ID, 10), nil
+}
+
+// CancelFundingOffer cancels an open lending offer
+func (b *Bitfinex) CancelFundingOffer(id string) error {
+	offerID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = b.CancelOffer(offerID)
+	return err
+}
+
+// yearlyPercentToDailyRate converts the yearly percentage rate returned by the
+// v1 lending endpoints to a daily fraction
+func yearlyPercentToDailyRate(rate float64) float64 {
+	return rate / 365 / 100
+}
+
+// parseLendingTimestamp parses the fractional unix seconds timestamps returned
+// by the v1 lending endpoints
+func parseLendingTimestamp(ts string) time.Time {
+	f, err := strconv.ParseFloat(ts, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*1e9))
+}
+
+// GetSubAccounts is not supported, Bitfinex sub-accounts are managed through
+// the website and the API can neither list nor transfer to them
+func (b *Bitfinex) GetSubAccounts() ([]account.SubAccountDetails, error) {
+	return nil, fmt.Errorf("%w, %s cannot list sub-accounts", common.ErrFunctionNotSupported, b.Name)
+}
+
+// TransferFunds moves funds between the exchange, trading and deposit wallets
+func (b *Bitfinex) TransferFunds(r *transfer.Request) (*transfer.ExchangeResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if r.FromAccount != "" || r.ToAccount != "" {
+		return nil, fmt.Errorf("%w %s sub-account transfers", transfer.ErrUnsupportedTransfer, b.Name)
+	}
+	from, ok := transferWallets[r.FromAsset]
+	if !ok {
+		return nil, fmt.Errorf("%w %s wallet %s", transfer.ErrUnsupportedTransfer, b.Name, r.FromAsset)
+	}
+	to, ok := transferWallets[r.ToAsset]
+	if !ok {
+		return nil, fmt.Errorf("%w %s wallet %s", transfer.ErrUnsupportedTransfer, b.Name, r.ToAsset)
+	}
+	resp, err := b.WalletTransfer(r.Amount, r.Currency.String(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &transfer.ExchangeResponse{
+		Name:   b.Name,
+		Status: resp.Status,
+	}, nil
+}
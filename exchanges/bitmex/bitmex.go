@@ -956,3 +956,15 @@ func calculateTradingFee(purchasePrice, amount float64, isMaker bool) float64 {
 
 	return fee * purchasePrice * amount
 }
+
+// settlementAmount converts an amount in the minor units bitmex reports
+// margin and profit in to the settlement currency
+func settlementAmount(amount int64, settlementCurrency string) float64 {
+	switch settlementCurrency {
+	case "XBt":
+		return float64(amount) / 1e8
+	case "USDt":
+		return float64(amount) / 1e6
+	}
+	return float64(amount)
+}
@@ -628,7 +628,7 @@ func (p PositionGetParams) IsNil() bool {
 // endpoint
 type PositionIsolateMarginParams struct {
 	// Enabled - True for isolated margin, false for cross margin.
-	Enabled bool `json:"enabled,omitempty"`
+	Enabled bool `json:"enabled"`
 
 	// Symbol - Position symbol to isolate.
 	Symbol string `json:"symbol,omitempty"`
@@ -1,6 +1,7 @@
 package bitmex
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -15,7 +16,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -202,6 +205,25 @@ func TestGetActiveAndIndexInstruments(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := b.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := currency.NewPairFromString("XBTUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := b.GetOrderExecutionLimits(asset.PerpetualContract, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 {
+		t.Errorf("expected price tick and amount step to be loaded, received %+v", l)
+	}
+}
+
 func TestGetActiveIntervals(t *testing.T) {
 	t.Parallel()
 	_, err := b.GetActiveIntervals()
@@ -613,6 +635,149 @@ func TestSubmitOrder(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetDerivativePositions(asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.GetDerivativePositions(asset.PerpetualContract)
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
+func TestCloseDerivativePosition(t *testing.T) {
+	t.Parallel()
+	if areTestAPIKeysSet() && !canManipulateRealOrders {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	_, err := b.CloseDerivativePosition(&position.Close{
+		Exchange: b.Name,
+		Pair:     currency.NewPair(currency.XBT, currency.USD),
+		Asset:    asset.PerpetualContract,
+	})
+	if err == nil {
+		t.Error("expected an error without an open position")
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLeverage(currency.NewPair(currency.XBT, currency.USD), asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.GetLeverage(currency.NewPair(currency.XBT, currency.USD), asset.PerpetualContract)
+	if areTestAPIKeysSet() && err != nil && !errors.Is(err, position.ErrLeverageNotFound) {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	err := b.SetLeverage(currency.NewPair(currency.XBT, currency.USD), asset.PerpetualContract, 0)
+	if err == nil {
+		t.Error("expected an error for zero leverage")
+	}
+	if areTestAPIKeysSet() && !canManipulateRealOrders {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	err = b.SetLeverage(currency.NewPair(currency.XBT, currency.USD), asset.PerpetualContract, 2)
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	err := b.SetMarginType(currency.NewPair(currency.XBT, currency.USD), asset.PerpetualContract, position.UnknownMarginType)
+	if err == nil {
+		t.Error("expected an error for an unknown margin type")
+	}
+	if areTestAPIKeysSet() && !canManipulateRealOrders {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	err = b.SetMarginType(currency.NewPair(currency.XBT, currency.USD), asset.PerpetualContract, position.Cross)
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLatestFundingRate(currency.NewPair(currency.XBT, currency.USD), asset.Futures)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.GetLatestFundingRate(currency.NewPair(currency.XBT, currency.USD), asset.PerpetualContract)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateDerivativePrice(t *testing.T) {
+	t.Parallel()
+	_, err := b.UpdateDerivativePrice(currency.NewPair(currency.XBT, currency.USD), asset.Index)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.UpdateDerivativePrice(currency.NewPair(currency.XBT, currency.USD), asset.PerpetualContract)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateContracts(t *testing.T) {
+	t.Parallel()
+	_, err := b.UpdateContracts(asset.Index)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.UpdateContracts(asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFundingRateHistory(&fundingrate.HistoricalRatesRequest{
+		Pair:  currency.NewPair(currency.XBT, currency.USD),
+		Asset: asset.PerpetualContract,
+		Start: time.Now().Add(-time.Hour * 24 * 7),
+		End:   time.Now(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.XBT, currency.USD)
+	_, err := b.GetLiquidations(p, asset.Index, time.Now().Add(-time.Hour), time.Now())
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = b.GetLiquidations(p, asset.PerpetualContract, time.Now(), time.Now().Add(-time.Hour))
+	if err == nil {
+		t.Error("expected an error for an invalid time range")
+	}
+	_, err = b.GetLiquidations(p, asset.PerpetualContract, time.Now().Add(-time.Hour), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestCancelExchangeOrder(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
@@ -1031,6 +1196,29 @@ func TestWsTrades(t *testing.T) {
 	}
 }
 
+func TestWsInstrument(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"table":"instrument","action":"update","data":[{"symbol":"ETHUSD","markPrice":258.37,"indicativeSettlePrice":258.31,"openInterest":84525611,"timestamp":"2020-02-17T01:35:40.000Z"}]}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsLiquidation(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"table":"liquidation","action":"partial","data":[{"orderID":"f4d72fa2-6d7b-4c1e-9a7c-3c5e4e5b3c2a","symbol":"XBTUSD","side":"Sell","price":9000,"leavesQty":1000}]}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+	pressXToJSON = []byte(`{"table":"liquidation","action":"insert","data":[{"orderID":"e11df9b5-60f6-4b6d-a5f4-4e2c0b1a8d3f","symbol":"XBTUSD","side":"Buy","price":10000,"leavesQty":500}]}`)
+	err = b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetRecentTrades(t *testing.T) {
 	t.Parallel()
 	err := b.UpdateTradablePairs(false)
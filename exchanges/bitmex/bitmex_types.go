@@ -687,3 +687,7 @@ var orderSideMap = map[int64]order.Side{
 	1: order.Buy,
 	2: order.Sell,
 }
+
+// fundingRateHistoryLimit is the maximum number of funding rates returned
+// per funding history request
+const fundingRateHistoryLimit = 500
@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -253,6 +254,48 @@ func (b *Bitmex) wsHandleData(respRaw []byte) error {
 				})
 			}
 			return b.AddTradesToBuffer(trades...)
+		case bitmexWSLiquidation:
+			var liquidationHolder LiquidationData
+			err = json.Unmarshal(respRaw, &liquidationHolder)
+			if err != nil {
+				return err
+			}
+			// Only inserts are new liquidation events, partials, updates
+			// and deletes track the remaining quantity of active
+			// liquidation orders
+			if liquidationHolder.Action != bitmexActionInsertData {
+				return nil
+			}
+			liquidations := make([]liquidation.Data, len(liquidationHolder.Data))
+			for i := range liquidationHolder.Data {
+				var p currency.Pair
+				p, err = currency.NewPairFromString(liquidationHolder.Data[i].Symbol)
+				if err != nil {
+					return err
+				}
+
+				var a asset.Item
+				a, err = b.GetPairAssetType(p)
+				if err != nil {
+					return err
+				}
+				var oSide order.Side
+				oSide, err = order.StringToOrderSide(liquidationHolder.Data[i].Side)
+				if err != nil {
+					return err
+				}
+				liquidations[i] = liquidation.Data{
+					Exchange:     b.Name,
+					CurrencyPair: p,
+					AssetType:    a,
+					Side:         oSide,
+					Price:        liquidationHolder.Data[i].Price,
+					Amount:       float64(liquidationHolder.Data[i].LeavesQty),
+					Timestamp:    time.Now(),
+				}
+			}
+			b.Websocket.DataHandler <- liquidations
+			return b.AddLiquidationsToBuffer(liquidations...)
 		case bitmexWSAnnouncement:
 			var announcement AnnouncementData
 			err = json.Unmarshal(respRaw, &announcement)
@@ -273,7 +316,27 @@ func (b *Bitmex) wsHandleData(respRaw []byte) error {
 			}
 			b.Websocket.DataHandler <- response
 		case bitmexWSInstrument:
-			// ticker
+			var instruments InstrumentData
+			err = json.Unmarshal(respRaw, &instruments)
+			if err != nil {
+				return err
+			}
+			for i := range instruments.Data {
+				var a asset.Item
+				a, err = b.GetPairAssetType(instruments.Data[i].Symbol)
+				if err != nil {
+					return err
+				}
+				if !a.IsDerivative() {
+					continue
+				}
+				// instrument updates only contain the fields which have
+				// changed, zero values are retained by the derivative
+				// price service
+				b.Websocket.DataHandler <- b.instrumentToDerivativePrice(&instruments.Data[i],
+					instruments.Data[i].Symbol,
+					a)
+			}
 		case bitmexWSExecution:
 			// trades of an order
 			var response WsExecutionResponse
@@ -550,7 +613,7 @@ func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, p currency.
 
 // GenerateDefaultSubscriptions Adds default subscriptions to websocket to be handled by ManageSubscriptions()
 func (b *Bitmex) GenerateDefaultSubscriptions() ([]stream.ChannelSubscription, error) {
-	channels := []string{bitmexWSOrderbookL2, bitmexWSTrade}
+	channels := []string{bitmexWSOrderbookL2, bitmexWSTrade, bitmexWSInstrument, bitmexWSLiquidation}
 	subscriptions := []stream.ChannelSubscription{
 		{
 			Channel: bitmexWSAnnouncement,
@@ -565,8 +628,12 @@ func (b *Bitmex) GenerateDefaultSubscriptions() ([]stream.ChannelSubscription, e
 		}
 		for y := range contracts {
 			for z := range channels {
-				if assets[x] == asset.Index && channels[z] == bitmexWSOrderbookL2 {
-					// There are no L2 orderbook for index assets
+				if assets[x] == asset.Index &&
+					(channels[z] == bitmexWSOrderbookL2 ||
+						channels[z] == bitmexWSInstrument ||
+						channels[z] == bitmexWSLiquidation) {
+					// There are no L2 orderbook, derivative prices or
+					// liquidations for index assets
 					continue
 				}
 				subscriptions = append(subscriptions, stream.ChannelSubscription{
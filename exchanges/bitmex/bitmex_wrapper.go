@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -15,9 +16,14 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -271,7 +277,43 @@ func (b *Bitmex) UpdateTradablePairs(forceUpdate bool) error {
 		}
 	}
 
-	return nil
+	return b.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the contract execution limits from the
+// instrument tick and lot sizes, amounts are in contracts
+func (b *Bitmex) updateExecutionLimits() error {
+	instruments, err := b.GetActiveAndIndexInstruments()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(instruments))
+	for x := range instruments {
+		symbol := instruments[x].Symbol.String()
+		if strings.Contains(symbol, ".") {
+			// Index instruments cannot be traded
+			continue
+		}
+		a := asset.Futures
+		if strings.Contains(symbol, "USD") {
+			a = asset.PerpetualContract
+		}
+		var p currency.Pair
+		p, err = currency.NewPairFromString(symbol)
+		if err != nil {
+			return err
+		}
+		limits = append(limits, order.Limits{
+			Pair:       p,
+			Asset:      a,
+			MaxPrice:   instruments[x].MaxPrice,
+			PriceTick:  instruments[x].TickSize,
+			MinAmount:  float64(instruments[x].LotSize),
+			MaxAmount:  float64(instruments[x].MaxOrderQty),
+			AmountStep: float64(instruments[x].LotSize),
+		})
+	}
+	return b.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -552,6 +594,12 @@ func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	if s.Type == order.Limit {
 		orderNewParams.Price = s.Price
 	}
+	if s.ReduceOnly {
+		orderNewParams.ExecInst = "ReduceOnly"
+	}
+	if s.ClientOrderID != "" {
+		orderNewParams.ClientOrderID = s.ClientOrderID
+	}
 
 	response, err := b.CreateOrder(&orderNewParams)
 	if err != nil {
@@ -812,3 +860,337 @@ func (b *Bitmex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end
 func (b *Bitmex) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return kline.Item{}, common.ErrFunctionNotSupported
 }
+
+// GetDerivativePositions returns the open positions for a derivatives asset type
+func (b *Bitmex) GetDerivativePositions(a asset.Item) ([]position.Position, error) {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	resp, err := b.GetPositions(PositionGetParams{})
+	if err != nil {
+		return nil, err
+	}
+	var positions []position.Position
+	for i := range resp {
+		if !resp[i].IsOpen || resp[i].CurrentQty == 0 {
+			continue
+		}
+		p, err := b.MatchSymbolWithAvailablePairs(resp[i].Symbol, a)
+		if err != nil {
+			// position is held in a contract of another asset type
+			continue
+		}
+		side, size := order.Long, float64(resp[i].CurrentQty)
+		if resp[i].CurrentQty < 0 {
+			side, size = order.Short, -size
+		}
+		marginType := position.Isolated
+		if resp[i].CrossMargin {
+			marginType = position.Cross
+		}
+		positions = append(positions, position.Position{
+			Exchange:         b.Name,
+			Pair:             p,
+			Asset:            a,
+			Side:             side,
+			Size:             size,
+			EntryPrice:       resp[i].AvgEntryPrice,
+			MarkPrice:        resp[i].MarkPrice,
+			LiquidationPrice: resp[i].LiquidationPrice,
+			Leverage:         resp[i].Leverage,
+			UnrealisedPNL:    settlementAmount(resp[i].UnrealisedPnl, resp[i].Currency),
+			MarginType:       marginType,
+			UpdatedAt:        resp[i].Timestamp,
+		})
+	}
+	return positions, nil
+}
+
+// CloseDerivativePosition closes all or part of an open derivatives position
+func (b *Bitmex) CloseDerivativePosition(c *position.Close) (order.SubmitResponse, error) {
+	positions, err := b.GetDerivativePositions(c.Asset)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	s, err := position.CloseOrder(positions, c)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return b.SubmitOrder(s)
+}
+
+// GetLatestFundingRate returns the current funding rate of a perpetual
+// contract, BitMEX's indicative funding rate is the predicted rate for the
+// funding interval following the next funding time
+func (b *Bitmex) GetLatestFundingRate(p currency.Pair, a asset.Item) (*fundingrate.LatestRate, error) {
+	if a != asset.PerpetualContract {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	instruments, err := b.GetInstruments(&GenericRequestParams{Symbol: fPair.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(instruments) != 1 {
+		return nil, fmt.Errorf("%s %s instrument not found", b.Name, fPair)
+	}
+	return &fundingrate.LatestRate{
+		Exchange:        b.Name,
+		Pair:            p,
+		Asset:           a,
+		Rate:            instruments[0].FundingRate,
+		PredictedRate:   instruments[0].IndicativeFundingRate,
+		NextFundingTime: instruments[0].FundingTimestamp,
+		UpdatedAt:       instruments[0].Timestamp,
+	}, nil
+}
+
+// GetFundingRateHistory returns the funding rates applied to a perpetual
+// contract over a date range, paging through the range by offset
+func (b *Bitmex) GetFundingRateHistory(r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if r.Asset != asset.PerpetualContract {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", r.Asset, b.Name)
+	}
+	fPair, err := b.FormatExchangeCurrency(r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	var rates []fundingrate.Rate
+	for offset := 0; ; offset += fundingRateHistoryLimit {
+		page, err := b.GetFullFundingHistory(fPair.String(),
+			strconv.Itoa(fundingRateHistoryLimit),
+			"",
+			"",
+			strconv.Itoa(offset),
+			false,
+			r.Start,
+			r.End)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page {
+			rates = append(rates, fundingrate.Rate{
+				Time: page[i].Timestamp,
+				Rate: page[i].FundingRate,
+			})
+		}
+		if len(page) < fundingRateHistoryLimit {
+			break
+		}
+	}
+	return r.Collate(b.Name, rates), nil
+}
+
+// GetLiquidations returns the public liquidation orders of a contract between
+// the start and end times. Bitmex only lists liquidation orders which are
+// still active and does not timestamp them, so they are stamped with the time
+// they are retrieved. They are not added to the liquidation buffer as the
+// websocket feed already records each liquidation when it is inserted.
+func (b *Bitmex) GetLiquidations(p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]liquidation.Data, error) {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	if !startTime.Before(endTime) {
+		return nil, fmt.Errorf("invalid time range supplied. Start: %v End %v",
+			startTime,
+			endTime)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := b.GetLiquidationOrders(&GenericRequestParams{
+		Symbol:    fPair.String(),
+		Count:     1000,
+		StartTime: startTime.UTC().Format("2006-01-02T15:04:05.000Z"),
+		EndTime:   endTime.UTC().Format("2006-01-02T15:04:05.000Z"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	resp := make([]liquidation.Data, len(orders))
+	for i := range orders {
+		var side order.Side
+		side, err = order.StringToOrderSide(orders[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = liquidation.Data{
+			Exchange:     b.Name,
+			CurrencyPair: p,
+			AssetType:    a,
+			Side:         side,
+			Price:        orders[i].Price,
+			Amount:       float64(orders[i].LeavesQty),
+			Timestamp:    now,
+		}
+	}
+	return resp, nil
+}
+
+// GetLeverage returns the leverage and margin type applied to orders in a
+// contract, Bitmex only holds settings for contracts the account has traded
+func (b *Bitmex) GetLeverage(p currency.Pair, a asset.Item) (*position.Leverage, error) {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.GetPositions(PositionGetParams{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp {
+		if resp[i].Symbol != fPair.String() {
+			continue
+		}
+		marginType := position.Isolated
+		if resp[i].CrossMargin {
+			marginType = position.Cross
+		}
+		return &position.Leverage{
+			Exchange:   b.Name,
+			Pair:       p,
+			Asset:      a,
+			Leverage:   resp[i].Leverage,
+			MarginType: marginType,
+		}, nil
+	}
+	return nil, fmt.Errorf("%s %s %s %w", b.Name, a, p, position.ErrLeverageNotFound)
+}
+
+// SetLeverage sets the leverage applied to orders in a contract, Bitmex
+// switches the contract to isolated margin when leverage is set
+func (b *Bitmex) SetLeverage(p currency.Pair, a asset.Item, leverage float64) error {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	err := position.ValidateLeverage(leverage)
+	if err != nil {
+		return err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return err
+	}
+	_, err = b.LeveragePosition(PositionUpdateLeverageParams{
+		Leverage: leverage,
+		Symbol:   fPair.String(),
+	})
+	return err
+}
+
+// SetMarginType sets whether a contract uses isolated or cross margin
+func (b *Bitmex) SetMarginType(p currency.Pair, a asset.Item, m position.MarginType) error {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	err := m.Validate()
+	if err != nil {
+		return err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return err
+	}
+	_, err = b.IsolatePosition(PositionIsolateMarginParams{
+		Enabled: m == position.Isolated,
+		Symbol:  fPair.String(),
+	})
+	return err
+}
+
+// UpdateDerivativePrice updates and returns the mark price, index price and
+// open interest of a contract
+func (b *Bitmex) UpdateDerivativePrice(p currency.Pair, a asset.Item) (*derivative.Price, error) {
+	if !a.IsDerivative() {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	instruments, err := b.GetInstruments(&GenericRequestParams{Symbol: fPair.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(instruments) != 1 {
+		return nil, fmt.Errorf("%s %s instrument not found", b.Name, fPair)
+	}
+	err = derivative.ProcessPrice(b.instrumentToDerivativePrice(&instruments[0], p, a))
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetPrice(b.Name, p, a)
+}
+
+// instrumentToDerivativePrice converts instrument data to a derivative
+// price, BitMEX's indicative settle price tracks the underlying index and is
+// the estimated settlement price of dated contracts
+func (b *Bitmex) instrumentToDerivativePrice(i *Instrument, p currency.Pair, a asset.Item) *derivative.Price {
+	resp := &derivative.Price{
+		ExchangeName: b.Name,
+		Pair:         p,
+		AssetType:    a,
+		MarkPrice:    i.MarkPrice,
+		IndexPrice:   i.IndicativeSettlePrice,
+		OpenInterest: float64(i.OpenInterest),
+		LastUpdated:  i.Timestamp,
+	}
+	if a != asset.PerpetualContract {
+		resp.EstimatedSettlementPrice = i.IndicativeSettlePrice
+	}
+	return resp
+}
+
+// UpdateContracts refreshes the contract metadata of futures and perpetual
+// contracts. Bitmex does not list contracts under an alias so the two
+// earliest expiring contracts of each underlying are classified as this and
+// next quarter
+func (b *Bitmex) UpdateContracts(a asset.Item) ([]contract.Roll, error) {
+	if a != asset.Futures && a != asset.PerpetualContract {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
+	}
+	instruments, err := b.GetActiveAndIndexInstruments()
+	if err != nil {
+		return nil, err
+	}
+	var contracts []contract.Contract
+	for x := range instruments {
+		// matches the asset classification of UpdateTradablePairs
+		symbol := instruments[x].Symbol.String()
+		if strings.Contains(symbol, ".") ||
+			strings.Contains(symbol, "USD") != (a == asset.PerpetualContract) {
+			continue
+		}
+		c := contract.Contract{
+			Exchange: b.Name,
+			Pair:     instruments[x].Symbol,
+			Asset:    a,
+			Underlying: currency.NewPair(currency.NewCode(instruments[x].RootSymbol),
+				currency.NewCode(instruments[x].QuoteCurrency)),
+			Type:               contract.Perpetual,
+			SettlementCurrency: currency.NewCode(strings.ToUpper(instruments[x].SettlCurrency)),
+		}
+		if instruments[x].Expiry != "" {
+			c.Expiry, err = time.Parse(time.RFC3339, instruments[x].Expiry)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s expiry cannot be parsed: %w", b.Name, symbol, err)
+			}
+			c.Type = contract.Unknown
+		}
+		contracts = append(contracts, c)
+	}
+	contract.ClassifyQuarterly(contracts)
+	return b.LoadContracts(a, contracts)
+}
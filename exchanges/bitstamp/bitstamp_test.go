@@ -197,6 +197,21 @@ func TestGetTradingPairs(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := b.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := b.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.LTC, currency.USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick != 0.01 || l.AmountStep != 1e-8 || l.MinNotional != 5 {
+		t.Errorf("unexpected limits loaded %+v", l)
+	}
+}
+
 func TestGetTransactions(t *testing.T) {
 	t.Parallel()
 	_, err := b.GetTransactions(currency.BTC.String()+currency.USD.String(), "hour")
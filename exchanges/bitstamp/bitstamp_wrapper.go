@@ -2,6 +2,7 @@ package bitstamp
 
 import (
 	"errors"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -250,7 +251,47 @@ func (b *Bitstamp) UpdateTradablePairs(forceUpdate bool) error {
 		return err
 	}
 
-	return b.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	err = b.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	return b.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the pair execution limits, Bitstamp returns
+// the price and amount precision as decimal places and the minimum order as
+// a quote currency value e.g. "5.0 USD"
+func (b *Bitstamp) updateExecutionLimits() error {
+	pairs, err := b.GetTradingPairs()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(pairs))
+	for x := range pairs {
+		if pairs[x].Trading != "Enabled" {
+			continue
+		}
+		var p currency.Pair
+		p, err = currency.NewPairDelimiter(pairs[x].Name, "/")
+		if err != nil {
+			return err
+		}
+		var minNotional float64
+		if minOrder := strings.Fields(pairs[x].MinimumOrder); len(minOrder) > 0 {
+			minNotional, err = strconv.ParseFloat(minOrder[0], 64)
+			if err != nil {
+				return err
+			}
+		}
+		limits = append(limits, order.Limits{
+			Pair:        p,
+			Asset:       asset.Spot,
+			PriceTick:   math.Pow10(-pairs[x].CounterDecimals),
+			AmountStep:  math.Pow10(-pairs[x].BaseDecimals),
+			MinNotional: minNotional,
+		})
+	}
+	return b.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -891,6 +891,11 @@ func (b *BTCMarkets) FormatExchangeKlineInterval(in kline.Interval) string {
 	return in.Short()
 }
 
+// GetCurrentServerTime returns the current exchange server time
+func (b *BTCMarkets) GetCurrentServerTime() (time.Time, error) {
+	return b.GetServerTime()
+}
+
 // GetHistoricCandles returns candles between a time period for a set time interval
 func (b *BTCMarkets) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if err := b.ValidateKline(pair, a, interval); err != nil {
@@ -907,6 +907,15 @@ func (b *BTSE) FormatExchangeKlineInterval(in kline.Interval) string {
 	return strconv.FormatFloat(in.Duration().Minutes(), 'f', 0, 64)
 }
 
+// GetCurrentServerTime returns the current exchange server time
+func (b *BTSE) GetCurrentServerTime() (time.Time, error) {
+	s, err := b.GetServerTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return s.ISO, nil
+}
+
 // GetHistoricCandles returns candles between a time period for a set time interval
 func (b *BTSE) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if err := b.ValidateKline(pair, a, interval); err != nil {
@@ -65,6 +65,20 @@ func TestGetProducts(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	err := c.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := c.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.BTC, currency.USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 || l.MinAmount == 0 {
+		t.Errorf("expected price tick, amount step and minimum amount to be loaded, received %+v", l)
+	}
+}
+
 func TestGetTicker(t *testing.T) {
 	_, err := c.GetTicker(testPair)
 	if err != nil {
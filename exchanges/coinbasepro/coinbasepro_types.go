@@ -13,7 +13,9 @@ type Product struct {
 	QuoteCurrency  string      `json:"quote_currency"`
 	BaseMinSize    float64     `json:"base_min_size,string"`
 	BaseMaxSize    interface{} `json:"base_max_size"`
+	BaseIncrement  float64     `json:"base_increment,string"`
 	QuoteIncrement float64     `json:"quote_increment,string"`
+	MinMarketFunds float64     `json:"min_market_funds,string"`
 	DisplayName    string      `json:"string"`
 }
 
@@ -308,7 +308,42 @@ func (c *CoinbasePro) UpdateTradablePairs(forceUpdate bool) error {
 		return err
 	}
 
-	return c.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	err = c.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	return c.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the pair execution limits from the product
+// increments and sizes, the minimum market funds is the minimum notional
+// value
+func (c *CoinbasePro) updateExecutionLimits() error {
+	products, err := c.GetProducts()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(products))
+	for x := range products {
+		var maxAmount float64
+		if v, ok := products[x].BaseMaxSize.(string); ok && v != "" {
+			maxAmount, err = strconv.ParseFloat(v, 64)
+			if err != nil {
+				return err
+			}
+		}
+		limits = append(limits, order.Limits{
+			Pair: currency.NewPair(currency.NewCode(products[x].BaseCurrency),
+				currency.NewCode(products[x].QuoteCurrency)),
+			Asset:       asset.Spot,
+			PriceTick:   products[x].QuoteIncrement,
+			MinAmount:   products[x].BaseMinSize,
+			MaxAmount:   maxAmount,
+			AmountStep:  products[x].BaseIncrement,
+			MinNotional: products[x].MinMarketFunds,
+		})
+	}
+	return c.LoadExecutionLimits(limits)
 }
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
@@ -842,6 +877,15 @@ func checkInterval(i time.Duration) (int64, error) {
 	return 0, fmt.Errorf("interval not allowed %v", i.Seconds())
 }
 
+// GetCurrentServerTime returns the current exchange server time
+func (c *CoinbasePro) GetCurrentServerTime() (time.Time, error) {
+	s, err := c.GetServerTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return s.ISO, nil
+}
+
 // GetHistoricCandles returns a set of candle between two time periods for a
 // designated time period
 func (c *CoinbasePro) GetHistoricCandles(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
@@ -72,6 +72,8 @@ const (
 	buyDirection    = "1"
 	openLong        = "openLong"
 	openShort       = "openShort"
+	closeLong       = "closeLong"
+	closeShort      = "closeShort"
 	sellDirection   = "2"
 )
 
@@ -733,7 +735,9 @@ func (c *Coinbene) GetSwapAccountInfo() (SwapAccountInfo, error) {
 // GetSwapPositions returns a list of open swap positions
 func (c *Coinbene) GetSwapPositions(symbol string) (SwapPositions, error) {
 	v := url.Values{}
-	v.Set("symbol", symbol)
+	if symbol != "" {
+		v.Set("symbol", symbol)
+	}
 	type resp struct {
 		Data SwapPositions `json:"data"`
 	}
@@ -763,10 +767,12 @@ func (c *Coinbene) PlaceSwapOrder(symbol, direction, orderType, marginMode,
 		v.Set("direction", openLong)
 	case order.Sell.Lower():
 		v.Set("direction", openShort)
+	case closeLong, closeShort:
+		v.Set("direction", direction)
 	default:
 		return SwapPlaceOrderResponse{},
-			fmt.Errorf("invalid direction '%v', must be either 'buy' or 'sell'",
-				direction)
+			fmt.Errorf("invalid direction '%v', must be either 'buy', 'sell', '%s' or '%s'",
+				direction, closeLong, closeShort)
 	}
 
 	switch orderType {
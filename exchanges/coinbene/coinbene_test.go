@@ -61,6 +61,21 @@ func TestGetAllPairs(t *testing.T) {
 	}
 }
 
+func TestUpdateSpotExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := c.updateSpotExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := c.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 {
+		t.Errorf("expected price tick and amount step to be loaded, received %+v", l)
+	}
+}
+
 func TestGetPairInfo(t *testing.T) {
 	t.Parallel()
 	_, err := c.GetPairInfo(spotTestPair)
@@ -341,6 +356,21 @@ func TestGetSwapPositions(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	_, err := c.GetDerivativePositions(asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys required but not set, skipping test")
+	}
+	_, err = c.GetDerivativePositions(asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestPlaceSwapOrder(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
@@ -488,7 +518,7 @@ func TestWsLogin(t *testing.T) {
 	pressXToJSON := []byte(`{"event":"login","success":true}`)
 	err := c.wsHandleData(pressXToJSON)
 	if err == nil {
-		t.Error("error cannot be nil as this will initiate an auth subscription")
+		t.Error("error cannot be nil as no login request is pending")
 	}
 
 	pressXToJSON = []byte(`{"event":"login","success":false}`)
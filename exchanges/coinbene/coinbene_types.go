@@ -121,6 +121,14 @@ type WsSub struct {
 	Arguments []string `json:"args"`
 }
 
+// WsLoginResponse stores the response to a websocket login request
+type WsLoginResponse struct {
+	Event   string      `json:"event"`
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Code    interface{} `json:"code"`
+}
+
 // WsTickerData stores websocket ticker data
 type WsTickerData struct {
 	Symbol        string  `json:"symbol"`
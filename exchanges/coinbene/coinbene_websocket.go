@@ -1,6 +1,7 @@
 package coinbene
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -83,7 +84,14 @@ func (c *Coinbene) GenerateDefaultSubscriptions() ([]stream.ChannelSubscription,
 			})
 		}
 	}
-
+	if c.Websocket.CanUseAuthenticatedEndpoints() {
+		var authSubs []stream.ChannelSubscription
+		authSubs, err = c.GenerateAuthSubs()
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, authSubs...)
+	}
 	return subscriptions, nil
 }
 
@@ -143,17 +151,10 @@ func (c *Coinbene) wsHandleData(respRaw []byte) error {
 		return fmt.Errorf("message: %s. code: %v", result["message"], result["code"])
 	}
 	if ok && strings.Contains(result[event].(string), "login") {
-		if result["success"].(bool) {
-			c.Websocket.SetCanUseAuthenticatedEndpoints(true)
-			var authsubs []stream.ChannelSubscription
-			authsubs, err = c.GenerateAuthSubs()
-			if err != nil {
-				return err
-			}
-			return c.Websocket.SubscribeToChannels(authsubs)
+		if !c.Websocket.Match.IncomingWithData("login", respRaw) {
+			return fmt.Errorf("%v - unexpected login response: %s", c.Name, respRaw)
 		}
-		c.Websocket.SetCanUseAuthenticatedEndpoints(false)
-		return fmt.Errorf("message: %s. code: %v", result["message"], result["code"])
+		return nil
 	}
 	assetType := inferAssetFromTopic(result[topic].(string))
 	var newPair currency.Pair
@@ -499,6 +500,13 @@ func (c *Coinbene) Unsubscribe(channelToUnsubscribe []stream.ChannelSubscription
 
 // Login logs in
 func (c *Coinbene) Login() error {
+	ctx, cancel := c.Websocket.RequestContext()
+	defer cancel()
+	return c.LoginContext(ctx)
+}
+
+// LoginContext logs in, giving up when ctx is cancelled
+func (c *Coinbene) LoginContext(ctx context.Context) error {
 	var sub WsSub
 	expTime := time.Now().Add(time.Minute * 10).Format("2006-01-02T15:04:05Z")
 	signMsg := expTime + http.MethodGet + "/login"
@@ -508,5 +516,22 @@ func (c *Coinbene) Login() error {
 	sign := crypto.HexEncodeToString(tempSign)
 	sub.Operation = "login"
 	sub.Arguments = []string{c.API.Credentials.Key, expTime, sign}
-	return c.Websocket.Conn.SendJSONMessage(sub)
+	// A repeated login is harmless, so a lost response is retried once
+	resp, err := c.Websocket.Conn.SendMessageReturnResponses(ctx,
+		"login",
+		sub,
+		stream.RequestConfig{Retries: 1})
+	if err != nil {
+		return err
+	}
+	var result WsLoginResponse
+	err = json.Unmarshal(resp[0], &result)
+	if err != nil {
+		return err
+	}
+	if !result.Success {
+		return fmt.Errorf("message: %s. code: %v", result.Message, result.Code)
+	}
+	c.Websocket.SetCanUseAuthenticatedEndpoints(true)
+	return nil
 }
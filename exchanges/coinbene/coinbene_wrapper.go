@@ -3,6 +3,7 @@ package coinbene
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -18,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -298,10 +300,37 @@ func (c *Coinbene) UpdateTradablePairs(forceUpdate bool) error {
 		if err != nil {
 			return err
 		}
+		if assets[x] == asset.Spot {
+			err = c.updateSpotExecutionLimits()
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// updateSpotExecutionLimits loads the spot pair execution limits, Coinbene
+// returns the price and amount precision as decimal places
+func (c *Coinbene) updateSpotExecutionLimits() error {
+	pairs, err := c.GetAllPairs()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(pairs))
+	for x := range pairs {
+		limits = append(limits, order.Limits{
+			Pair: currency.NewPair(currency.NewCode(pairs[x].BaseAsset),
+				currency.NewCode(pairs[x].QuoteAsset)),
+			Asset:      asset.Spot,
+			PriceTick:  math.Pow10(-int(pairs[x].PricePrecision)),
+			MinAmount:  pairs[x].MinAmount,
+			AmountStep: math.Pow10(-int(pairs[x].AmountPrecision)),
+		})
+	}
+	return c.LoadExecutionLimits(limits)
+}
+
 // UpdateTicker updates and returns the ticker for a currency pair
 func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	if !c.SupportsAsset(assetType) {
@@ -946,3 +975,78 @@ func (c *Coinbene) GetHistoricCandles(pair currency.Pair, a asset.Item, start, e
 func (c *Coinbene) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return c.GetHistoricCandles(pair, a, start, end, interval)
 }
+
+// GetDerivativePositions returns the open positions for a derivatives asset type
+func (c *Coinbene) GetDerivativePositions(a asset.Item) ([]position.Position, error) {
+	if a != asset.PerpetualSwap {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, c.Name)
+	}
+	resp, err := c.GetSwapPositions("")
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]position.Position, 0, len(resp))
+	for i := range resp {
+		if resp[i].Quantity == 0 {
+			continue
+		}
+		p, err := c.MatchSymbolWithAvailablePairs(resp[i].Symbol, a)
+		if err != nil {
+			return nil, err
+		}
+		side, err := order.StringToOrderSide(resp[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position.Position{
+			Exchange:         c.Name,
+			Pair:             p,
+			Asset:            a,
+			Side:             side,
+			Size:             resp[i].Quantity,
+			EntryPrice:       resp[i].AveragePrice,
+			MarkPrice:        resp[i].MarkPrice,
+			LiquidationPrice: resp[i].LiquidationPrice,
+			Leverage:         float64(resp[i].Leverage),
+			UnrealisedPNL:    resp[i].UnrealisedProfitAndLoss,
+			MarginType:       position.UnknownMarginType,
+			UpdatedAt:        time.Now(),
+		})
+	}
+	return positions, nil
+}
+
+// CloseDerivativePosition closes all or part of an open derivatives position
+func (c *Coinbene) CloseDerivativePosition(cl *position.Close) (order.SubmitResponse, error) {
+	positions, err := c.GetDerivativePositions(cl.Asset)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	s, err := position.CloseOrder(positions, cl)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	fPair, err := c.FormatExchangeCurrency(s.Pair, s.AssetType)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	direction := closeShort
+	if s.Side == order.Sell {
+		direction = closeLong
+	}
+	resp, err := c.PlaceSwapOrder(fPair.String(),
+		direction,
+		s.Type.Lower(),
+		"",
+		s.ClientOrderID,
+		s.Price,
+		s.Amount,
+		int(s.Leverage))
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       resp.OrderID,
+	}, nil
+}
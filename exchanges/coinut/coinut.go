@@ -220,8 +220,8 @@ func (c *COINUT) GetDerivativeInstruments(secType string) (interface{}, error) {
 	return result, c.SendHTTPRequest(exchange.RestSpot, coinutInstruments, params, false, &result)
 }
 
-// GetOptionChain returns option chain
-func (c *COINUT) GetOptionChain(asset, secType string) (OptionChainResponse, error) {
+// GetOptionChainData returns option chain
+func (c *COINUT) GetOptionChainData(asset, secType string) (OptionChainResponse, error) {
 	var result OptionChainResponse
 	params := make(map[string]interface{})
 	params["asset"] = asset
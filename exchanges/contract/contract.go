@@ -0,0 +1,213 @@
+package contract
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// String implements the stringer interface
+func (t Type) String() string {
+	return string(t)
+}
+
+// IsRolling returns whether the type is an alias which refers to a different
+// contract after each expiry
+func (t Type) IsRolling() bool {
+	switch t {
+	case ThisWeek, NextWeek, ThisQuarter, NextQuarter:
+		return true
+	}
+	return false
+}
+
+// Validate checks that a contract is fully described, dated contracts require
+// an expiry and perpetual contracts must not have one
+func (c *Contract) Validate() error {
+	if c.Pair.IsEmpty() {
+		return errPairUnset
+	}
+	if !c.Asset.IsDerivative() {
+		return fmt.Errorf("%s %s %w", c.Pair, c.Asset, errAssetInvalid)
+	}
+	if c.Underlying.IsEmpty() {
+		return fmt.Errorf("%s %w", c.Pair, errUnderlyingUnset)
+	}
+	switch c.Type {
+	case Perpetual:
+		if !c.Expiry.IsZero() {
+			return fmt.Errorf("%s %w", c.Pair, errExpirySet)
+		}
+	case ThisWeek, NextWeek, ThisQuarter, NextQuarter, Unknown:
+		if c.Expiry.IsZero() {
+			return fmt.Errorf("%s %w", c.Pair, errExpiryUnset)
+		}
+	default:
+		return fmt.Errorf("%s %w %q", c.Pair, errTypeInvalid, c.Type)
+	}
+	return nil
+}
+
+// IsExpired returns whether a dated contract has expired at the supplied time
+func (c *Contract) IsExpired(t time.Time) bool {
+	return !c.Expiry.IsZero() && !t.Before(c.Expiry)
+}
+
+// ClassifyQuarterly sets the type of dated contracts which are not listed
+// under an alias to this quarter and next quarter by expiry order within each
+// underlying and settlement currency. It is intended for exchanges which only
+// list quarterly contracts
+func ClassifyQuarterly(contracts []Contract) {
+	groups := make(map[string][]*Contract)
+	var keys []string
+	for i := range contracts {
+		if contracts[i].Type != Unknown || contracts[i].Expiry.IsZero() {
+			continue
+		}
+		key := contracts[i].Underlying.Upper().String() + "/" +
+			contracts[i].SettlementCurrency.Upper().String()
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], &contracts[i])
+	}
+	for i := range keys {
+		group := groups[keys[i]]
+		sort.Slice(group, func(x, y int) bool {
+			return group[x].Expiry.Before(group[y].Expiry)
+		})
+		group[0].Type = ThisQuarter
+		if len(group) > 1 {
+			group[1].Type = NextQuarter
+		}
+	}
+}
+
+// Load replaces the stored contracts of an asset, contracts no longer listed
+// by the exchange are removed
+func (s *Store) Load(a asset.Item, contracts []Contract) error {
+	for i := range contracts {
+		if contracts[i].Asset != a {
+			return fmt.Errorf("%s %w %s", contracts[i].Pair, errAssetMismatch, a)
+		}
+		if err := contracts[i].Validate(); err != nil {
+			return err
+		}
+	}
+	m := make(map[*currency.Item]map[*currency.Item]Contract)
+	for i := range contracts {
+		base := contracts[i].Pair.Base.Item
+		if m[base] == nil {
+			m[base] = make(map[*currency.Item]Contract)
+		}
+		m[base][contracts[i].Pair.Quote.Item] = contracts[i]
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.m == nil {
+		s.m = make(map[asset.Item]map[*currency.Item]map[*currency.Item]Contract)
+	}
+	s.m[a] = m
+	return nil
+}
+
+// Get returns the contract of a pair
+func (s *Store) Get(a asset.Item, p currency.Pair) (Contract, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	c, ok := s.m[a][p.Base.Item][p.Quote.Item]
+	if !ok {
+		return Contract{}, fmt.Errorf("%w for %s %s", ErrContractNotFound, a, p)
+	}
+	return c, nil
+}
+
+// GetAll returns the stored contracts of an asset ordered by underlying and
+// expiry
+func (s *Store) GetAll(a asset.Item) []Contract {
+	s.mtx.RLock()
+	var contracts []Contract
+	for _, quotes := range s.m[a] {
+		for _, c := range quotes {
+			contracts = append(contracts, c)
+		}
+	}
+	s.mtx.RUnlock()
+	sort.Slice(contracts, func(i, j int) bool {
+		x, y := contracts[i].Underlying.String(), contracts[j].Underlying.String()
+		if x != y {
+			return x < y
+		}
+		if !contracts[i].Expiry.Equal(contracts[j].Expiry) {
+			return contracts[i].Expiry.Before(contracts[j].Expiry)
+		}
+		return contracts[i].Pair.String() < contracts[j].Pair.String()
+	})
+	return contracts
+}
+
+// GetRollover returns the earliest expiring contract of the same underlying
+// and settlement currency which expires after the contract of the pair
+func (s *Store) GetRollover(a asset.Item, p currency.Pair) (Contract, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	current, ok := s.m[a][p.Base.Item][p.Quote.Item]
+	if !ok {
+		return Contract{}, fmt.Errorf("%w for %s %s", ErrContractNotFound, a, p)
+	}
+	var next Contract
+	var found bool
+	for _, quotes := range s.m[a] {
+		for _, c := range quotes {
+			if c.Type == Perpetual ||
+				!c.Expiry.After(current.Expiry) ||
+				!c.Underlying.Equal(current.Underlying) ||
+				!c.SettlementCurrency.Match(current.SettlementCurrency) {
+				continue
+			}
+			if !found || c.Expiry.Before(next.Expiry) {
+				next = c
+				found = true
+			}
+		}
+	}
+	if current.Type == Perpetual || !found {
+		return Contract{}, fmt.Errorf("%w for %s %s", ErrNoRolloverContract, a, p)
+	}
+	return next, nil
+}
+
+// FindRolls compares the stored contracts of the enabled pairs against an
+// updated contract list and returns the enabled pairs whose alias now refers
+// to a different contract
+func (s *Store) FindRolls(a asset.Item, enabled currency.Pairs, updated []Contract) []Roll {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	var rolls []Roll
+	for i := range enabled {
+		previous, ok := s.m[a][enabled[i].Base.Item][enabled[i].Quote.Item]
+		if !ok || !previous.Type.IsRolling() {
+			continue
+		}
+		for j := range updated {
+			if updated[j].Type != previous.Type ||
+				!updated[j].Underlying.Equal(previous.Underlying) ||
+				!updated[j].SettlementCurrency.Match(previous.SettlementCurrency) {
+				continue
+			}
+			if !updated[j].Pair.Equal(enabled[i]) {
+				rolls = append(rolls, Roll{
+					Asset: a,
+					Type:  previous.Type,
+					From:  enabled[i],
+					To:    updated[j].Pair,
+				})
+			}
+			break
+		}
+	}
+	return rolls
+}
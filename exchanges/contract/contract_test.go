@@ -0,0 +1,225 @@
+package contract
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	underlying = currency.NewPair(currency.BTC, currency.USD)
+	june       = time.Date(2021, 6, 25, 8, 0, 0, 0, time.UTC)
+	september  = time.Date(2021, 9, 24, 8, 0, 0, 0, time.UTC)
+	december   = time.Date(2021, 12, 31, 8, 0, 0, 0, time.UTC)
+)
+
+func dated(quote string, t Type, expiry time.Time) Contract {
+	return Contract{
+		Exchange:           "test",
+		Pair:               currency.NewPairWithDelimiter("BTC-USD", quote, "_"),
+		Asset:              asset.Futures,
+		Underlying:         underlying,
+		Type:               t,
+		Expiry:             expiry,
+		SettlementCurrency: currency.BTC,
+	}
+}
+
+func TestIsRolling(t *testing.T) {
+	t.Parallel()
+	if !ThisQuarter.IsRolling() || !NextWeek.IsRolling() {
+		t.Error("expected aliases to roll")
+	}
+	if Perpetual.IsRolling() || Unknown.IsRolling() {
+		t.Error("expected perpetual and unknown types not to roll")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	c := dated("210625", ThisQuarter, june)
+	if err := c.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Asset = asset.Spot
+	if err := c.Validate(); !errors.Is(err, errAssetInvalid) {
+		t.Errorf("received %v, expected %v", err, errAssetInvalid)
+	}
+	c.Asset = asset.Futures
+
+	c.Underlying = currency.Pair{}
+	if err := c.Validate(); !errors.Is(err, errUnderlyingUnset) {
+		t.Errorf("received %v, expected %v", err, errUnderlyingUnset)
+	}
+	c.Underlying = underlying
+
+	c.Expiry = time.Time{}
+	if err := c.Validate(); !errors.Is(err, errExpiryUnset) {
+		t.Errorf("received %v, expected %v", err, errExpiryUnset)
+	}
+
+	c.Type = Perpetual
+	if err := c.Validate(); err != nil {
+		t.Error(err)
+	}
+	c.Expiry = june
+	if err := c.Validate(); !errors.Is(err, errExpirySet) {
+		t.Errorf("received %v, expected %v", err, errExpirySet)
+	}
+
+	c.Type = "MONTHLY"
+	if err := c.Validate(); !errors.Is(err, errTypeInvalid) {
+		t.Errorf("received %v, expected %v", err, errTypeInvalid)
+	}
+
+	c.Pair = currency.Pair{}
+	if err := c.Validate(); !errors.Is(err, errPairUnset) {
+		t.Errorf("received %v, expected %v", err, errPairUnset)
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	t.Parallel()
+	c := dated("210625", ThisQuarter, june)
+	if c.IsExpired(june.Add(-time.Second)) {
+		t.Error("expected contract to be live before expiry")
+	}
+	if !c.IsExpired(june) {
+		t.Error("expected contract to be expired at expiry")
+	}
+	c.Type = Perpetual
+	c.Expiry = time.Time{}
+	if c.IsExpired(december) {
+		t.Error("perpetual contracts do not expire")
+	}
+}
+
+func TestClassifyQuarterly(t *testing.T) {
+	t.Parallel()
+	contracts := []Contract{
+		dated("211231", Unknown, december),
+		dated("210625", Unknown, june),
+		dated("210924", Unknown, september),
+		{
+			Pair:       currency.NewPair(currency.XBT, currency.USD),
+			Asset:      asset.Futures,
+			Underlying: underlying,
+			Type:       Perpetual,
+		},
+	}
+	ClassifyQuarterly(contracts)
+	if contracts[1].Type != ThisQuarter {
+		t.Errorf("received %s, expected %s", contracts[1].Type, ThisQuarter)
+	}
+	if contracts[2].Type != NextQuarter {
+		t.Errorf("received %s, expected %s", contracts[2].Type, NextQuarter)
+	}
+	if contracts[0].Type != Unknown {
+		t.Errorf("received %s, expected %s", contracts[0].Type, Unknown)
+	}
+	if contracts[3].Type != Perpetual {
+		t.Errorf("received %s, expected %s", contracts[3].Type, Perpetual)
+	}
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+	var s Store
+	thisQuarter := dated("210625", ThisQuarter, june)
+	nextQuarter := dated("210924", NextQuarter, september)
+
+	_, err := s.Get(asset.Futures, thisQuarter.Pair)
+	if !errors.Is(err, ErrContractNotFound) {
+		t.Errorf("received %v, expected %v", err, ErrContractNotFound)
+	}
+
+	err = s.Load(asset.PerpetualSwap, []Contract{thisQuarter})
+	if !errors.Is(err, errAssetMismatch) {
+		t.Errorf("received %v, expected %v", err, errAssetMismatch)
+	}
+
+	err = s.Load(asset.Futures, []Contract{nextQuarter, thisQuarter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.Get(asset.Futures, thisQuarter.Pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Expiry.Equal(june) {
+		t.Errorf("received %v, expected %v", c.Expiry, june)
+	}
+
+	all := s.GetAll(asset.Futures)
+	if len(all) != 2 || !all[0].Pair.Equal(thisQuarter.Pair) {
+		t.Errorf("unexpected contracts %v", all)
+	}
+
+	next, err := s.GetRollover(asset.Futures, thisQuarter.Pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.Pair.Equal(nextQuarter.Pair) {
+		t.Errorf("received %s, expected %s", next.Pair, nextQuarter.Pair)
+	}
+	_, err = s.GetRollover(asset.Futures, nextQuarter.Pair)
+	if !errors.Is(err, ErrNoRolloverContract) {
+		t.Errorf("received %v, expected %v", err, ErrNoRolloverContract)
+	}
+
+	// expired contracts are removed when the listing is reloaded
+	err = s.Load(asset.Futures, []Contract{dated("210924", ThisQuarter, september)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.Get(asset.Futures, thisQuarter.Pair)
+	if !errors.Is(err, ErrContractNotFound) {
+		t.Errorf("received %v, expected %v", err, ErrContractNotFound)
+	}
+}
+
+func TestFindRolls(t *testing.T) {
+	t.Parallel()
+	var s Store
+	err := s.Load(asset.Futures, []Contract{
+		dated("210625", ThisQuarter, june),
+		dated("210924", NextQuarter, september),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled := currency.Pairs{
+		dated("210625", ThisQuarter, june).Pair,
+		dated("210924", NextQuarter, september).Pair,
+	}
+	if rolls := s.FindRolls(asset.Futures, enabled, []Contract{
+		dated("210625", ThisQuarter, june),
+		dated("210924", NextQuarter, september),
+	}); len(rolls) != 0 {
+		t.Errorf("expected no rolls, received %v", rolls)
+	}
+
+	// after the June expiry September becomes this quarter and December is
+	// listed as next quarter
+	rolls := s.FindRolls(asset.Futures, enabled, []Contract{
+		dated("210924", ThisQuarter, september),
+		dated("211231", NextQuarter, december),
+	})
+	if len(rolls) != 2 {
+		t.Fatalf("received %d rolls, expected 2", len(rolls))
+	}
+	if rolls[0].Type != ThisQuarter ||
+		!rolls[0].From.Equal(enabled[0]) ||
+		rolls[0].To.Quote.String() != "210924" {
+		t.Errorf("unexpected roll %+v", rolls[0])
+	}
+	if rolls[1].Type != NextQuarter ||
+		!rolls[1].From.Equal(enabled[1]) ||
+		rolls[1].To.Quote.String() != "211231" {
+		t.Errorf("unexpected roll %+v", rolls[1])
+	}
+}
@@ -0,0 +1,70 @@
+package contract
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Public errors
+var (
+	ErrContractNotFound   = errors.New("contract not found")
+	ErrNoRolloverContract = errors.New("no later contract to roll over to")
+)
+
+var (
+	errPairUnset       = errors.New("contract pair unset")
+	errAssetInvalid    = errors.New("asset type is not a derivative")
+	errAssetMismatch   = errors.New("contract asset does not match")
+	errUnderlyingUnset = errors.New("contract underlying unset")
+	errExpiryUnset     = errors.New("dated contract expiry unset")
+	errExpirySet       = errors.New("perpetual contract expiry set")
+	errTypeInvalid     = errors.New("contract type is invalid")
+)
+
+// Type is the delivery schedule of a futures contract relative to the other
+// listed contracts of its underlying
+type Type string
+
+// Contract types
+const (
+	Perpetual   Type = "PERPETUAL"
+	ThisWeek    Type = "THIS_WEEK"
+	NextWeek    Type = "NEXT_WEEK"
+	ThisQuarter Type = "THIS_QUARTER"
+	NextQuarter Type = "NEXT_QUARTER"
+	// Unknown is used for dated contracts which the exchange does not list
+	// under an alias
+	Unknown Type = "UNKNOWN"
+)
+
+// Contract holds the metadata of a perpetual or dated futures contract
+type Contract struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	// Underlying is the index or spot pair the contract tracks
+	Underlying currency.Pair
+	Type       Type
+	// Expiry is zero for perpetual contracts
+	Expiry             time.Time
+	SettlementCurrency currency.Code
+}
+
+// Roll records an enabled pair which has been replaced by the contract now
+// listed under the same alias
+type Roll struct {
+	Asset asset.Item
+	Type  Type
+	From  currency.Pair
+	To    currency.Pair
+}
+
+// Store holds the contracts of an exchange by asset and pair
+type Store struct {
+	m   map[asset.Item]map[*currency.Item]map[*currency.Item]Contract
+	mtx sync.RWMutex
+}
@@ -0,0 +1,183 @@
+package deribit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+// Deribit is the overarching type across this package
+type Deribit struct {
+	exchange.Base
+}
+
+const (
+	deribitAPIURL = "https://www.deribit.com/api/v2"
+
+	// Public endpoints
+	getCurrencies            = "/public/get_currencies"
+	getInstruments           = "/public/get_instruments"
+	getBookSummaryByCurrency = "/public/get_book_summary_by_currency"
+	getTicker                = "/public/ticker"
+	getOrderbook             = "/public/get_order_book"
+	getTradesByTime          = "/public/get_last_trades_by_instrument_and_time"
+	getTradingViewChartData  = "/public/get_tradingview_chart_data"
+
+	// Instrument kinds
+	kindFuture = "future"
+	kindOption = "option"
+
+	perpetualSettlement = "perpetual"
+
+	ratePeriod = time.Second
+	rateLimit  = 20
+
+	// tradesLimit is the maximum number of trades returned per request
+	tradesLimit = 1000
+)
+
+var (
+	errInstrumentNameUnset = errors.New("instrument name unset")
+	errCurrencyUnset       = errors.New("currency unset")
+	errInvalidTimeRange    = errors.New("start time must be before end time")
+)
+
+// GetCurrencies returns the currencies which have listed instruments
+func (d *Deribit) GetCurrencies() ([]CurrencyData, error) {
+	var resp []CurrencyData
+	return resp, d.SendHTTPRequest(exchange.RestSpot, getCurrencies, nil, &resp)
+}
+
+// GetInstruments returns the instruments of a kind which settle in the
+// supplied currency, kind is either future or option
+func (d *Deribit) GetInstruments(currency, kind string, expired bool) ([]Instrument, error) {
+	if currency == "" {
+		return nil, errCurrencyUnset
+	}
+	params := url.Values{}
+	params.Set("currency", currency)
+	if kind != "" {
+		params.Set("kind", kind)
+	}
+	params.Set("expired", strconv.FormatBool(expired))
+	var resp []Instrument
+	return resp, d.SendHTTPRequest(exchange.RestSpot, getInstruments, params, &resp)
+}
+
+// GetBookSummaryByCurrency returns the market summary of every instrument of
+// a kind which settles in the supplied currency
+func (d *Deribit) GetBookSummaryByCurrency(currency, kind string) ([]BookSummary, error) {
+	if currency == "" {
+		return nil, errCurrencyUnset
+	}
+	params := url.Values{}
+	params.Set("currency", currency)
+	if kind != "" {
+		params.Set("kind", kind)
+	}
+	var resp []BookSummary
+	return resp, d.SendHTTPRequest(exchange.RestSpot, getBookSummaryByCurrency, params, &resp)
+}
+
+// GetTicker returns the ticker of an instrument, option tickers include
+// implied volatilities and greeks
+func (d *Deribit) GetTicker(instrument string) (*TickerData, error) {
+	if instrument == "" {
+		return nil, errInstrumentNameUnset
+	}
+	params := url.Values{}
+	params.Set("instrument_name", instrument)
+	var resp TickerData
+	return &resp, d.SendHTTPRequest(exchange.RestSpot, getTicker, params, &resp)
+}
+
+// GetOrderbook returns the orderbook of an instrument to the supplied depth
+func (d *Deribit) GetOrderbook(instrument string, depth int64) (*Orderbook, error) {
+	if instrument == "" {
+		return nil, errInstrumentNameUnset
+	}
+	params := url.Values{}
+	params.Set("instrument_name", instrument)
+	if depth > 0 {
+		params.Set("depth", strconv.FormatInt(depth, 10))
+	}
+	var resp Orderbook
+	return &resp, d.SendHTTPRequest(exchange.RestSpot, getOrderbook, params, &resp)
+}
+
+// GetTradesByTime returns up to the count of an instrument's trades
+// between the start and end times in ascending order
+func (d *Deribit) GetTradesByTime(instrument string, start, end time.Time, count int64) (*TradesData, error) {
+	if instrument == "" {
+		return nil, errInstrumentNameUnset
+	}
+	if !start.Before(end) {
+		return nil, errInvalidTimeRange
+	}
+	params := url.Values{}
+	params.Set("instrument_name", instrument)
+	params.Set("start_timestamp", strconv.FormatInt(start.UnixNano()/int64(time.Millisecond), 10))
+	params.Set("end_timestamp", strconv.FormatInt(end.UnixNano()/int64(time.Millisecond), 10))
+	params.Set("sorting", "asc")
+	if count > 0 {
+		params.Set("count", strconv.FormatInt(count, 10))
+	}
+	var resp TradesData
+	return &resp, d.SendHTTPRequest(exchange.RestSpot, getTradesByTime, params, &resp)
+}
+
+// GetTradingViewChartData returns an instrument's candles between the start
+// and end times, resolution is in minutes or 1D
+func (d *Deribit) GetTradingViewChartData(instrument, resolution string, start, end time.Time) (*ChartData, error) {
+	if instrument == "" {
+		return nil, errInstrumentNameUnset
+	}
+	if !start.Before(end) {
+		return nil, errInvalidTimeRange
+	}
+	params := url.Values{}
+	params.Set("instrument_name", instrument)
+	params.Set("resolution", resolution)
+	params.Set("start_timestamp", strconv.FormatInt(start.UnixNano()/int64(time.Millisecond), 10))
+	params.Set("end_timestamp", strconv.FormatInt(end.UnixNano()/int64(time.Millisecond), 10))
+	var resp ChartData
+	return &resp, d.SendHTTPRequest(exchange.RestSpot, getTradingViewChartData, params, &resp)
+}
+
+// SendHTTPRequest sends an unauthenticated HTTP request and unwraps the
+// JSON-RPC result into the supplied result
+func (d *Deribit) SendHTTPRequest(ep exchange.URL, path string, params url.Values, result interface{}) error {
+	endpoint, err := d.API.Endpoints.GetURL(ep)
+	if err != nil {
+		return err
+	}
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+	resp := Response{Result: result}
+	err = d.SendPayload(context.Background(), &request.Item{
+		Method:        http.MethodGet,
+		Path:          endpoint + path,
+		Result:        &resp,
+		Verbose:       d.Verbose,
+		HTTPDebugging: d.HTTPDebugging,
+		HTTPRecording: d.HTTPRecording,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s error %d: %s",
+			d.Name,
+			resp.Error.Code,
+			resp.Error.Message)
+	}
+	return nil
+}
@@ -0,0 +1,367 @@
+package deribit
+
+import (
+	"errors"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/option"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+const (
+	perpetualPair = "BTC-PERPETUAL"
+)
+
+var d Deribit
+
+func TestMain(m *testing.M) {
+	d.SetDefaults()
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exchCfg, err := cfg.GetExchangeConfig("Deribit")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	d.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = d.Setup(exchCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	d.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	d.Websocket.TrafficAlert = sharedtestvalues.GetWebsocketStructChannelOverride()
+	os.Exit(m.Run())
+}
+
+// Implement tests for API endpoints below
+
+func TestGetCurrencies(t *testing.T) {
+	t.Parallel()
+	_, err := d.GetCurrencies()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetInstruments(t *testing.T) {
+	t.Parallel()
+	_, err := d.GetInstruments("", kindOption, false)
+	if !errors.Is(err, errCurrencyUnset) {
+		t.Errorf("received %v, expected %v", err, errCurrencyUnset)
+	}
+	_, err = d.GetInstruments("BTC", kindOption, false)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetBookSummaryByCurrency(t *testing.T) {
+	t.Parallel()
+	_, err := d.GetBookSummaryByCurrency("BTC", kindFuture)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetTicker(t *testing.T) {
+	t.Parallel()
+	_, err := d.GetTicker("")
+	if !errors.Is(err, errInstrumentNameUnset) {
+		t.Errorf("received %v, expected %v", err, errInstrumentNameUnset)
+	}
+	_, err = d.GetTicker(perpetualPair)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetOrderbook(t *testing.T) {
+	t.Parallel()
+	_, err := d.GetOrderbook(perpetualPair, 10)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetTradesByTime(t *testing.T) {
+	t.Parallel()
+	_, err := d.GetTradesByTime(perpetualPair, time.Now(), time.Now().Add(-time.Hour), 0)
+	if !errors.Is(err, errInvalidTimeRange) {
+		t.Errorf("received %v, expected %v", err, errInvalidTimeRange)
+	}
+	_, err = d.GetTradesByTime(perpetualPair, time.Now().Add(-time.Hour), time.Now(), 10)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetTradingViewChartData(t *testing.T) {
+	t.Parallel()
+	_, err := d.GetTradingViewChartData(perpetualPair, "60", time.Now().Add(-time.Hour*24), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFetchTradablePairs(t *testing.T) {
+	t.Parallel()
+	_, err := d.FetchTradablePairs(asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateTicker(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(perpetualPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = d.UpdateTicker(p, asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateOrderbook(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(perpetualPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = d.UpdateOrderbook(p, asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetRecentTrades(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(perpetualPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = d.GetRecentTrades(p, asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetHistoricCandles(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(perpetualPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end := time.Now().Truncate(time.Hour)
+	_, err = d.GetHistoricCandles(p, asset.PerpetualSwap, end.Add(-time.Hour*24), end, kline.OneHour)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateDerivativePrice(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(perpetualPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = d.UpdateDerivativePrice(p, asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetOptionChain(t *testing.T) {
+	t.Parallel()
+	chain, err := d.GetOptionChain(currency.BTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain.Quotes) == 0 {
+		t.Error("expected option quotes")
+	}
+}
+
+func TestFormatExchangeKlineInterval(t *testing.T) {
+	t.Parallel()
+	if r := d.FormatExchangeKlineInterval(kline.FifteenMin); r != "15" {
+		t.Errorf("received %s, expected 15", r)
+	}
+	if r := d.FormatExchangeKlineInterval(kline.TwelveHour); r != "720" {
+		t.Errorf("received %s, expected 720", r)
+	}
+	if r := d.FormatExchangeKlineInterval(kline.OneDay); r != "1D" {
+		t.Errorf("received %s, expected 1D", r)
+	}
+}
+
+func TestGetAssetFromInstrument(t *testing.T) {
+	t.Parallel()
+	if a := getAssetFromInstrument(&Instrument{Kind: kindOption}); a != asset.Options {
+		t.Errorf("received %v, expected %v", a, asset.Options)
+	}
+	if a := getAssetFromInstrument(&Instrument{Kind: kindFuture, SettlementPeriod: perpetualSettlement}); a != asset.PerpetualSwap {
+		t.Errorf("received %v, expected %v", a, asset.PerpetualSwap)
+	}
+	if a := getAssetFromInstrument(&Instrument{Kind: kindFuture, SettlementPeriod: "month"}); a != asset.Futures {
+		t.Errorf("received %v, expected %v", a, asset.Futures)
+	}
+}
+
+func TestInstrumentToContract(t *testing.T) {
+	t.Parallel()
+	c, err := instrumentToContract(&Instrument{
+		InstrumentName:      "BTC-25JUN21-40000-C",
+		Kind:                kindOption,
+		BaseCurrency:        "btc",
+		OptionType:          "call",
+		Strike:              40000,
+		ExpirationTimestamp: 1624608000000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Type != option.Call ||
+		c.Strike != 40000 ||
+		!c.Underlying.Match(currency.BTC) ||
+		!c.Expiry.Equal(time.Date(2021, 6, 25, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected contract %+v", c)
+	}
+	if c.Pair.String() != "BTC-25JUN21-40000-C" {
+		t.Errorf("received %s, expected BTC-25JUN21-40000-C", c.Pair)
+	}
+
+	_, err = instrumentToContract(&Instrument{
+		InstrumentName: "BTC-25JUN21-40000-C",
+		BaseCurrency:   "btc",
+		OptionType:     "straddle",
+	})
+	if !errors.Is(err, option.ErrInvalidType) {
+		t.Errorf("received %v, expected %v", err, option.ErrInvalidType)
+	}
+}
+
+func TestParsingWSTickerData(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+		"jsonrpc": "2.0",
+		"method": "subscription",
+		"params": {
+			"channel": "ticker.BTC-25JUN21-40000-C.100ms",
+			"data": {
+				"timestamp": 1623746893221,
+				"state": "open",
+				"instrument_name": "BTC-25JUN21-40000-C",
+				"best_bid_price": 0.0225,
+				"best_ask_price": 0.024,
+				"last_price": 0.0235,
+				"mark_price": 0.0231,
+				"index_price": 39825.17,
+				"open_interest": 1289.1,
+				"bid_iv": 78.12,
+				"ask_iv": 82.07,
+				"mark_iv": 80.01,
+				"underlying_price": 39851.3,
+				"underlying_index": "BTC-25JUN21",
+				"greeks": {"delta": 0.48, "gamma": 0.00011, "vega": 13.25, "theta": -189.4, "rho": 1.01},
+				"stats": {"high": 0.027, "low": 0.021, "volume": 312.4, "price_change": 4.1}
+			}
+		}
+	}`)
+	err := d.wsHandleData(data)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParsingWSOBData(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+		"jsonrpc": "2.0",
+		"method": "subscription",
+		"params": {
+			"channel": "book.BTC-PERPETUAL.none.10.100ms",
+			"data": {
+				"timestamp": 1623746893221,
+				"instrument_name": "BTC-PERPETUAL",
+				"change_id": 30987226491,
+				"bids": [[39820.5, 1520.0], [39820.0, 20.0]],
+				"asks": [[39821.0, 3540.0], [39822.5, 100.0]]
+			}
+		}
+	}`)
+	err := d.wsHandleData(data)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParsingWSTradesData(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+		"jsonrpc": "2.0",
+		"method": "subscription",
+		"params": {
+			"channel": "trades.BTC-PERPETUAL.100ms",
+			"data": [
+				{
+					"trade_seq": 55519101,
+					"trade_id": "87341265",
+					"timestamp": 1623746893221,
+					"tick_direction": 0,
+					"price": 39821.0,
+					"mark_price": 39820.9,
+					"instrument_name": "BTC-PERPETUAL",
+					"index_price": 39825.17,
+					"direction": "buy",
+					"amount": 100.0
+				},
+				{
+					"trade_seq": 55519102,
+					"trade_id": "87341266",
+					"timestamp": 1623746893230,
+					"tick_direction": 2,
+					"price": 39820.5,
+					"mark_price": 39820.9,
+					"instrument_name": "BTC-PERPETUAL",
+					"index_price": 39825.17,
+					"direction": "sell",
+					"amount": 2500.0,
+					"liquidation": "M"
+				}
+			]
+		}
+	}`)
+	err := d.wsHandleData(data)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParsingWSResponses(t *testing.T) {
+	t.Parallel()
+	err := d.wsHandleData([]byte(`{"jsonrpc": "2.0", "id": 4, "result": ["ticker.BTC-PERPETUAL.100ms"]}`))
+	if err != nil {
+		t.Error(err)
+	}
+	err = d.wsHandleData([]byte(`{"jsonrpc": "2.0", "id": 5, "error": {"code": 11050, "message": "bad_request"}}`))
+	if err == nil {
+		t.Error("expected websocket error")
+	}
+	err = d.wsHandleData([]byte(`{"jsonrpc": "2.0", "method": "subscription", "params": {"channel": "unknown"}}`))
+	if !errors.Is(err, errUnknownChannel) {
+		t.Errorf("received %v, expected %v", err, errUnknownChannel)
+	}
+}
@@ -0,0 +1,198 @@
+package deribit
+
+import "encoding/json"
+
+// Response is the JSON-RPC envelope of every REST and websocket response
+type Response struct {
+	JSONRPCVersion string      `json:"jsonrpc"`
+	ID             int64       `json:"id"`
+	Result         interface{} `json:"result"`
+	Error          *ErrorData  `json:"error"`
+	Testnet        bool        `json:"testnet"`
+}
+
+// ErrorData stores the error of a failed request
+type ErrorData struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+// CurrencyData stores a currency which has listed instruments
+type CurrencyData struct {
+	Currency             string  `json:"currency"`
+	CurrencyLong         string  `json:"currency_long"`
+	MinConfirmations     int64   `json:"min_confirmations"`
+	WithdrawalFee        float64 `json:"withdrawal_fee"`
+	FeePrecision         int64   `json:"fee_precision"`
+	CoinType             string  `json:"coin_type"`
+	DisabledDepositAddrs bool    `json:"disabled_deposit_address_creation"`
+}
+
+// Instrument stores the contract details of a future or option, strike and
+// option type are only set for options
+type Instrument struct {
+	InstrumentName      string  `json:"instrument_name"`
+	Kind                string  `json:"kind"`
+	BaseCurrency        string  `json:"base_currency"`
+	QuoteCurrency       string  `json:"quote_currency"`
+	SettlementPeriod    string  `json:"settlement_period"`
+	OptionType          string  `json:"option_type"`
+	Strike              float64 `json:"strike"`
+	ExpirationTimestamp int64   `json:"expiration_timestamp"`
+	CreationTimestamp   int64   `json:"creation_timestamp"`
+	IsActive            bool    `json:"is_active"`
+	TickSize            float64 `json:"tick_size"`
+	MinTradeAmount      float64 `json:"min_trade_amount"`
+	ContractSize        float64 `json:"contract_size"`
+}
+
+// BookSummary stores the market summary of an instrument
+type BookSummary struct {
+	InstrumentName    string  `json:"instrument_name"`
+	BaseCurrency      string  `json:"base_currency"`
+	QuoteCurrency     string  `json:"quote_currency"`
+	BidPrice          float64 `json:"bid_price"`
+	AskPrice          float64 `json:"ask_price"`
+	MidPrice          float64 `json:"mid_price"`
+	MarkPrice         float64 `json:"mark_price"`
+	Last              float64 `json:"last"`
+	High              float64 `json:"high"`
+	Low               float64 `json:"low"`
+	PriceChange       float64 `json:"price_change"`
+	Volume            float64 `json:"volume"`
+	OpenInterest      float64 `json:"open_interest"`
+	MarkIV            float64 `json:"mark_iv"`
+	UnderlyingPrice   float64 `json:"underlying_price"`
+	UnderlyingIndex   string  `json:"underlying_index"`
+	InterestRate      float64 `json:"interest_rate"`
+	CreationTimestamp int64   `json:"creation_timestamp"`
+}
+
+// Greeks stores the sensitivities of an option's price
+type Greeks struct {
+	Delta float64 `json:"delta"`
+	Gamma float64 `json:"gamma"`
+	Vega  float64 `json:"vega"`
+	Theta float64 `json:"theta"`
+	Rho   float64 `json:"rho"`
+}
+
+// TickerStats stores the rolling 24 hour statistics of an instrument
+type TickerStats struct {
+	High        float64 `json:"high"`
+	Low         float64 `json:"low"`
+	Volume      float64 `json:"volume"`
+	PriceChange float64 `json:"price_change"`
+}
+
+// TickerData stores the ticker of an instrument, implied volatilities,
+// greeks and the underlying are only set for options and funding only for
+// perpetuals
+type TickerData struct {
+	InstrumentName         string      `json:"instrument_name"`
+	State                  string      `json:"state"`
+	Timestamp              int64       `json:"timestamp"`
+	BestBidPrice           float64     `json:"best_bid_price"`
+	BestBidAmount          float64     `json:"best_bid_amount"`
+	BestAskPrice           float64     `json:"best_ask_price"`
+	BestAskAmount          float64     `json:"best_ask_amount"`
+	LastPrice              float64     `json:"last_price"`
+	MarkPrice              float64     `json:"mark_price"`
+	IndexPrice             float64     `json:"index_price"`
+	SettlementPrice        float64     `json:"settlement_price"`
+	EstimatedDeliveryPrice float64     `json:"estimated_delivery_price"`
+	OpenInterest           float64     `json:"open_interest"`
+	MinPrice               float64     `json:"min_price"`
+	MaxPrice               float64     `json:"max_price"`
+	CurrentFunding         float64     `json:"current_funding"`
+	Funding8H              float64     `json:"funding_8h"`
+	BidIV                  float64     `json:"bid_iv"`
+	AskIV                  float64     `json:"ask_iv"`
+	MarkIV                 float64     `json:"mark_iv"`
+	UnderlyingPrice        float64     `json:"underlying_price"`
+	UnderlyingIndex        string      `json:"underlying_index"`
+	InterestRate           float64     `json:"interest_rate"`
+	Greeks                 Greeks      `json:"greeks"`
+	Stats                  TickerStats `json:"stats"`
+}
+
+// Orderbook stores the price levels of an instrument as price and amount
+// pairs
+type Orderbook struct {
+	InstrumentName string       `json:"instrument_name"`
+	Timestamp      int64        `json:"timestamp"`
+	ChangeID       int64        `json:"change_id"`
+	Bids           [][2]float64 `json:"bids"`
+	Asks           [][2]float64 `json:"asks"`
+}
+
+// Trade stores a public trade, liquidation is set to M, T or MT when the
+// maker, taker or both sides were liquidated
+type Trade struct {
+	TradeID        string  `json:"trade_id"`
+	TradeSeq       int64   `json:"trade_seq"`
+	InstrumentName string  `json:"instrument_name"`
+	Timestamp      int64   `json:"timestamp"`
+	Price          float64 `json:"price"`
+	Amount         float64 `json:"amount"`
+	Direction      string  `json:"direction"`
+	TickDirection  int64   `json:"tick_direction"`
+	IndexPrice     float64 `json:"index_price"`
+	MarkPrice      float64 `json:"mark_price"`
+	IV             float64 `json:"iv"`
+	Liquidation    string  `json:"liquidation"`
+}
+
+// TradesData stores a page of trades
+type TradesData struct {
+	Trades  []Trade `json:"trades"`
+	HasMore bool    `json:"has_more"`
+}
+
+// ChartData stores candles as parallel arrays indexed by tick
+type ChartData struct {
+	Status string    `json:"status"`
+	Ticks  []int64   `json:"ticks"`
+	Open   []float64 `json:"open"`
+	High   []float64 `json:"high"`
+	Low    []float64 `json:"low"`
+	Close  []float64 `json:"close"`
+	Volume []float64 `json:"volume"`
+}
+
+// WsRequest is a JSON-RPC websocket request
+type WsRequest struct {
+	JSONRPCVersion string      `json:"jsonrpc"`
+	ID             int64       `json:"id"`
+	Method         string      `json:"method"`
+	Params         interface{} `json:"params"`
+}
+
+// WsSubscriptionParams are the channels of a subscribe or unsubscribe
+// request
+type WsSubscriptionParams struct {
+	Channels []string `json:"channels"`
+}
+
+// WsHeartbeatParams sets the interval in seconds of server heartbeats
+type WsHeartbeatParams struct {
+	Interval int64 `json:"interval"`
+}
+
+// WsResponse is a websocket request response or subscription notification
+type WsResponse struct {
+	JSONRPCVersion string          `json:"jsonrpc"`
+	ID             int64           `json:"id"`
+	Method         string          `json:"method"`
+	Params         WsParams        `json:"params"`
+	Result         json.RawMessage `json:"result"`
+	Error          *ErrorData      `json:"error"`
+}
+
+// WsParams holds the channel data of a notification or the type of a
+// heartbeat
+type WsParams struct {
+	Channel string          `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+	Type    string          `json:"type"`
+}
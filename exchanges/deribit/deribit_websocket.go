@@ -0,0 +1,337 @@
+package deribit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	deribitWSURL = "wss://www.deribit.com/ws/api/v2"
+
+	jsonRPCVersion = "2.0"
+
+	// heartbeatInterval is the number of seconds between server heartbeats,
+	// the connection is closed when a test request is left unanswered
+	heartbeatInterval = 30
+
+	wsSubscribe    = "public/subscribe"
+	wsUnsubscribe  = "public/unsubscribe"
+	wsSetHeartbeat = "public/set_heartbeat"
+	wsTest         = "public/test"
+	wsSubscription = "subscription"
+	wsHeartbeat    = "heartbeat"
+	wsTestRequest  = "test_request"
+
+	wsTicker    = "ticker"
+	wsOrderbook = "book"
+	wsTrades    = "trades"
+
+	// wsOrderbookDepth is the number of levels of each orderbook snapshot
+	wsOrderbookDepth = 10
+)
+
+var errUnknownChannel = errors.New("unknown channel")
+
+// WsConnect connects to a websocket feed
+func (d *Deribit) WsConnect() error {
+	if !d.Websocket.IsEnabled() || !d.IsEnabled() {
+		return errors.New(stream.WebsocketNotEnabled)
+	}
+	var dialer websocket.Dialer
+	err := d.Websocket.Conn.Dial(&dialer, http.Header{})
+	if err != nil {
+		return err
+	}
+	if d.Verbose {
+		log.Debugf(log.ExchangeSys, "%s Connected to Websocket.\n", d.Name)
+	}
+
+	go d.wsReadData()
+	return d.wsSend(wsSetHeartbeat, WsHeartbeatParams{Interval: heartbeatInterval})
+}
+
+// wsSend sends a JSON-RPC request over the websocket connection
+func (d *Deribit) wsSend(method string, params interface{}) error {
+	return d.Websocket.Conn.SendJSONMessage(WsRequest{
+		JSONRPCVersion: jsonRPCVersion,
+		ID:             d.Websocket.Conn.GenerateMessageID(false),
+		Method:         method,
+		Params:         params,
+	})
+}
+
+// channelName returns the Deribit channel name of a subscription
+func (d *Deribit) channelName(sub *stream.ChannelSubscription) (string, error) {
+	fPair, err := d.FormatExchangeCurrency(sub.Currency, sub.Asset)
+	if err != nil {
+		return "", err
+	}
+	switch sub.Channel {
+	case wsTicker, wsTrades:
+		return sub.Channel + "." + fPair.String() + ".100ms", nil
+	case wsOrderbook:
+		return fmt.Sprintf("%s.%s.none.%d.100ms", sub.Channel, fPair, wsOrderbookDepth), nil
+	}
+	return "", fmt.Errorf("%w %s", errUnknownChannel, sub.Channel)
+}
+
+// Subscribe sends a websocket message to receive data from the channel
+func (d *Deribit) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
+	var errs common.Errors
+	for i := range channelsToSubscribe {
+		name, err := d.channelName(&channelsToSubscribe[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = d.wsSend(wsSubscribe, WsSubscriptionParams{Channels: []string{name}})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		d.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe[i])
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
+
+// Unsubscribe sends a websocket message to stop receiving data from the channel
+func (d *Deribit) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
+	var errs common.Errors
+	for i := range channelsToUnsubscribe {
+		name, err := d.channelName(&channelsToUnsubscribe[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = d.wsSend(wsUnsubscribe, WsSubscriptionParams{Channels: []string{name}})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		d.Websocket.RemoveSuccessfulUnsubscriptions(channelsToUnsubscribe[i])
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
+
+// GenerateDefaultSubscriptions generates default subscription
+func (d *Deribit) GenerateDefaultSubscriptions() ([]stream.ChannelSubscription, error) {
+	var subscriptions []stream.ChannelSubscription
+	var channels = []string{wsTicker, wsTrades, wsOrderbook}
+	assets := d.GetAssetTypes()
+	for a := range assets {
+		pairs, err := d.GetEnabledPairs(assets[a])
+		if err != nil {
+			return nil, err
+		}
+		for z := range pairs {
+			for x := range channels {
+				subscriptions = append(subscriptions, stream.ChannelSubscription{
+					Channel:  channels[x],
+					Currency: pairs[z],
+					Asset:    assets[a],
+				})
+			}
+		}
+	}
+	return subscriptions, nil
+}
+
+// wsReadData gets and passes on websocket messages for processing
+func (d *Deribit) wsReadData() {
+	d.Websocket.Wg.Add(1)
+	defer d.Websocket.Wg.Done()
+
+	for {
+		select {
+		case <-d.Websocket.ShutdownC:
+			return
+		default:
+			resp := d.Websocket.Conn.ReadMessage()
+			if resp.Raw == nil {
+				return
+			}
+
+			err := d.wsHandleData(resp.Raw)
+			if err != nil {
+				d.Websocket.DataHandler <- err
+			}
+		}
+	}
+}
+
+func (d *Deribit) wsHandleData(respRaw []byte) error {
+	var resp WsResponse
+	err := json.Unmarshal(respRaw, &resp)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s websocket error %d: %s",
+			d.Name,
+			resp.Error.Code,
+			resp.Error.Message)
+	}
+
+	switch resp.Method {
+	case "":
+		// request acknowledgements carry no market data
+		return nil
+	case wsHeartbeat:
+		if resp.Params.Type == wsTestRequest {
+			return d.wsSend(wsTest, struct{}{})
+		}
+		return nil
+	case wsSubscription:
+	default:
+		d.Websocket.DataHandler <- stream.UnhandledMessageWarning{Message: d.Name + stream.UnhandledMessage + string(respRaw)}
+		return nil
+	}
+
+	// channels are named kind.instrument followed by their settings
+	parts := strings.Split(resp.Params.Channel, ".")
+	if len(parts) < 2 {
+		return fmt.Errorf("%s %w %s", d.Name, errUnknownChannel, resp.Params.Channel)
+	}
+	p, err := currency.NewPairFromString(parts[1])
+	if err != nil {
+		return err
+	}
+	a, err := d.GetPairAssetType(p)
+	if err != nil {
+		return err
+	}
+
+	switch parts[0] {
+	case wsTicker:
+		var tick TickerData
+		err = json.Unmarshal(resp.Params.Data, &tick)
+		if err != nil {
+			return err
+		}
+		d.Websocket.DataHandler <- d.tickerToPrice(&tick, p, a)
+		d.Websocket.DataHandler <- &derivative.Price{
+			ExchangeName:             d.Name,
+			Pair:                     p,
+			AssetType:                a,
+			MarkPrice:                tick.MarkPrice,
+			IndexPrice:               tick.IndexPrice,
+			OpenInterest:             tick.OpenInterest,
+			EstimatedSettlementPrice: tick.EstimatedDeliveryPrice,
+			LastUpdated:              time.Unix(0, tick.Timestamp*int64(time.Millisecond)),
+		}
+	case wsOrderbook:
+		var ob Orderbook
+		err = json.Unmarshal(resp.Params.Data, &ob)
+		if err != nil {
+			return err
+		}
+		return d.wsProcessOrderbook(&ob, p, a)
+	case wsTrades:
+		var trades []Trade
+		err = json.Unmarshal(resp.Params.Data, &trades)
+		if err != nil {
+			return err
+		}
+		return d.wsProcessTrades(trades, p, a)
+	default:
+		d.Websocket.DataHandler <- stream.UnhandledMessageWarning{Message: d.Name + stream.UnhandledMessage + string(respRaw)}
+	}
+	return nil
+}
+
+// wsProcessOrderbook loads an orderbook snapshot, the depth limited book
+// channel publishes the whole book on every change
+func (d *Deribit) wsProcessOrderbook(ob *Orderbook, p currency.Pair, a asset.Item) error {
+	book := orderbook.Base{
+		ExchangeName:       d.Name,
+		Pair:               p,
+		AssetType:          a,
+		LastUpdated:        time.Unix(0, ob.Timestamp*int64(time.Millisecond)),
+		LastUpdateID:       ob.ChangeID,
+		VerificationBypass: d.OrderbookVerificationBypass,
+	}
+	for x := range ob.Bids {
+		book.Bids = append(book.Bids, orderbook.Item{
+			Price:  ob.Bids[x][0],
+			Amount: ob.Bids[x][1],
+		})
+	}
+	for x := range ob.Asks {
+		book.Asks = append(book.Asks, orderbook.Item{
+			Price:  ob.Asks[x][0],
+			Amount: ob.Asks[x][1],
+		})
+	}
+	return d.Websocket.Orderbook.LoadSnapshot(&book)
+}
+
+// wsProcessTrades buffers trades for saving and sends those which
+// liquidated a position to the data handler
+func (d *Deribit) wsProcessTrades(trades []Trade, p currency.Pair, a asset.Item) error {
+	resp := make([]trade.Data, 0, len(trades))
+	var liquidations []liquidation.Data
+	for x := range trades {
+		side, err := order.StringToOrderSide(trades[x].Direction)
+		if err != nil {
+			d.Websocket.DataHandler <- order.ClassificationError{
+				Exchange: d.Name,
+				Err:      err,
+			}
+		}
+		ts := time.Unix(0, trades[x].Timestamp*int64(time.Millisecond))
+		if trades[x].Liquidation != "" {
+			liquidations = append(liquidations, liquidation.Data{
+				Exchange:     d.Name,
+				CurrencyPair: p,
+				AssetType:    a,
+				Side:         side,
+				Price:        trades[x].Price,
+				Amount:       trades[x].Amount,
+				Timestamp:    ts,
+			})
+		}
+		resp = append(resp, trade.Data{
+			Exchange:     d.Name,
+			TID:          trades[x].TradeID,
+			CurrencyPair: p,
+			AssetType:    a,
+			Side:         side,
+			Price:        trades[x].Price,
+			Amount:       trades[x].Amount,
+			Timestamp:    ts,
+		})
+	}
+	if len(liquidations) > 0 {
+		d.Websocket.DataHandler <- liquidations
+		err := d.AddLiquidationsToBuffer(liquidations...)
+		if err != nil {
+			return err
+		}
+	}
+	if !d.IsSaveTradeDataEnabled() {
+		return nil
+	}
+	return trade.AddTradesToBuffer(d.Name, resp...)
+}
@@ -0,0 +1,785 @@
+package deribit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/option"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// GetDefaultConfig returns a default exchange config
+func (d *Deribit) GetDefaultConfig() (*config.ExchangeConfig, error) {
+	d.SetDefaults()
+	exchCfg := new(config.ExchangeConfig)
+	exchCfg.Name = d.Name
+	exchCfg.HTTPTimeout = exchange.DefaultHTTPTimeout
+	exchCfg.BaseCurrencies = d.BaseCurrencies
+
+	err := d.SetupDefaults(exchCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Features.Supports.RESTCapabilities.AutoPairUpdates {
+		err = d.UpdateTradablePairs(true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return exchCfg, nil
+}
+
+// SetDefaults sets the basic defaults for Deribit
+func (d *Deribit) SetDefaults() {
+	d.Name = "Deribit"
+	d.Enabled = true
+	d.Verbose = true
+	d.API.CredentialsValidator.RequiresKey = true
+	d.API.CredentialsValidator.RequiresSecret = true
+
+	// Instruments are named BTC-PERPETUAL, BTC-25DEC20 and BTC-25DEC20-18000-C
+	// so the quote holds the contract details after the underlying
+	pairFmt := currency.PairStore{
+		RequestFormat: &currency.PairFormat{
+			Uppercase: true,
+			Delimiter: currency.DashDelimiter,
+		},
+		ConfigFormat: &currency.PairFormat{
+			Uppercase: true,
+			Delimiter: currency.DashDelimiter,
+		},
+	}
+	for _, a := range []asset.Item{asset.PerpetualSwap, asset.Futures, asset.Options} {
+		err := d.StoreAssetPairFormat(a, pairFmt)
+		if err != nil {
+			log.Errorln(log.ExchangeSys, err)
+		}
+	}
+
+	d.Features = exchange.Features{
+		Supports: exchange.FeaturesSupported{
+			REST:      true,
+			Websocket: true,
+			RESTCapabilities: protocol.Features{
+				TickerFetching:    true,
+				KlineFetching:     true,
+				TradeFetching:     true,
+				OrderbookFetching: true,
+				AutoPairUpdates:   true,
+			},
+			WebsocketCapabilities: protocol.Features{
+				TickerFetching:    true,
+				TradeFetching:     true,
+				OrderbookFetching: true,
+				Subscribe:         true,
+				Unsubscribe:       true,
+			},
+			WithdrawPermissions: exchange.NoAPIWithdrawalMethods,
+			Kline: kline.ExchangeCapabilitiesSupported{
+				DateRanges: true,
+				Intervals:  true,
+			},
+		},
+		Enabled: exchange.FeaturesEnabled{
+			AutoPairUpdates: true,
+			Kline: kline.ExchangeCapabilitiesEnabled{
+				Intervals: map[string]bool{
+					kline.OneMin.Word():     true,
+					kline.ThreeMin.Word():   true,
+					kline.FiveMin.Word():    true,
+					kline.TenMin.Word():     true,
+					kline.FifteenMin.Word(): true,
+					kline.ThirtyMin.Word():  true,
+					kline.OneHour.Word():    true,
+					kline.TwoHour.Word():    true,
+					kline.SixHour.Word():    true,
+					kline.TwelveHour.Word(): true,
+					kline.OneDay.Word():     true,
+				},
+				ResultLimit: 5000,
+			},
+		},
+	}
+
+	d.Requester = request.New(d.Name,
+		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
+		request.WithLimiter(request.NewBasicRateLimit(ratePeriod, rateLimit)))
+	d.API.Endpoints = d.NewEndpoints()
+	err := d.API.Endpoints.SetDefaultEndpoints(map[exchange.URL]string{
+		exchange.RestSpot:      deribitAPIURL,
+		exchange.WebsocketSpot: deribitWSURL,
+	})
+	if err != nil {
+		log.Errorln(log.ExchangeSys, err)
+	}
+	d.Websocket = stream.New()
+	d.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
+	d.WebsocketResponseCheckTimeout = exchange.DefaultWebsocketResponseCheckTimeout
+	d.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
+}
+
+// Setup takes in the supplied exchange configuration details and sets params
+func (d *Deribit) Setup(exch *config.ExchangeConfig) error {
+	if !exch.Enabled {
+		d.SetEnabled(false)
+		return nil
+	}
+
+	err := d.SetupDefaults(exch)
+	if err != nil {
+		return err
+	}
+
+	wsEndpoint, err := d.API.Endpoints.GetURL(exchange.WebsocketSpot)
+	if err != nil {
+		return err
+	}
+
+	err = d.Websocket.Setup(&stream.WebsocketSetup{
+		Enabled:                          exch.Features.Enabled.Websocket,
+		Verbose:                          exch.Verbose,
+		AuthenticatedWebsocketAPISupport: exch.API.AuthenticatedWebsocketSupport,
+		WebsocketTimeout:                 exch.WebsocketTrafficTimeout,
+		DefaultURL:                       deribitWSURL,
+		ExchangeName:                     exch.Name,
+		RunningURL:                       wsEndpoint,
+		Connector:                        d.WsConnect,
+		Subscriber:                       d.Subscribe,
+		UnSubscriber:                     d.Unsubscribe,
+		GenerateSubscriptions:            d.GenerateDefaultSubscriptions,
+		Features:                         &d.Features.Supports.WebsocketCapabilities,
+		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
+		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
+	})
+	if err != nil {
+		return err
+	}
+	return d.Websocket.SetupNewConnection(stream.ConnectionSetup{
+		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
+		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+	})
+}
+
+// Start starts the Deribit go routine
+func (d *Deribit) Start(wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		d.Run()
+		wg.Done()
+	}()
+}
+
+// Run implements the Deribit wrapper
+func (d *Deribit) Run() {
+	if d.Verbose {
+		log.Debugf(log.ExchangeSys,
+			"%s Websocket: %s.",
+			d.Name,
+			common.IsEnabled(d.Websocket.IsEnabled()))
+		d.PrintEnabledPairs()
+	}
+
+	if !d.GetEnabledFeatures().AutoPairUpdates {
+		return
+	}
+
+	err := d.UpdateTradablePairs(false)
+	if err != nil {
+		log.Errorf(log.ExchangeSys,
+			"%s failed to update tradable pairs. Err: %s",
+			d.Name,
+			err)
+	}
+}
+
+// getActiveInstruments returns the active instruments of an asset type
+// across every currency
+func (d *Deribit) getActiveInstruments(a asset.Item) ([]Instrument, error) {
+	var kind string
+	switch a {
+	case asset.PerpetualSwap, asset.Futures:
+		kind = kindFuture
+	case asset.Options:
+		kind = kindOption
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, d.Name)
+	}
+	currencies, err := d.GetCurrencies()
+	if err != nil {
+		return nil, err
+	}
+	var instruments []Instrument
+	for x := range currencies {
+		resp, err := d.GetInstruments(currencies[x].Currency, kind, false)
+		if err != nil {
+			return nil, err
+		}
+		for y := range resp {
+			if !resp[y].IsActive ||
+				getAssetFromInstrument(&resp[y]) != a {
+				continue
+			}
+			instruments = append(instruments, resp[y])
+		}
+	}
+	return instruments, nil
+}
+
+// getAssetFromInstrument returns the asset type an instrument trades as
+func getAssetFromInstrument(i *Instrument) asset.Item {
+	switch {
+	case i.Kind == kindOption:
+		return asset.Options
+	case i.SettlementPeriod == perpetualSettlement:
+		return asset.PerpetualSwap
+	default:
+		return asset.Futures
+	}
+}
+
+// FetchTradablePairs returns a list of the exchanges tradable pairs
+func (d *Deribit) FetchTradablePairs(a asset.Item) ([]string, error) {
+	instruments, err := d.getActiveInstruments(a)
+	if err != nil {
+		return nil, err
+	}
+	pairs := make([]string, len(instruments))
+	for x := range instruments {
+		pairs[x] = instruments[x].InstrumentName
+	}
+	return pairs, nil
+}
+
+// UpdateTradablePairs updates the exchanges available pairs and stores
+// them in the exchanges config, options expire daily so the execution
+// limits are loaded from the same instrument lists
+func (d *Deribit) UpdateTradablePairs(forceUpdate bool) error {
+	var limits []order.Limits
+	assets := d.GetAssetTypes()
+	for x := range assets {
+		instruments, err := d.getActiveInstruments(assets[x])
+		if err != nil {
+			return err
+		}
+		pairs := make(currency.Pairs, len(instruments))
+		for y := range instruments {
+			pairs[y], err = currency.NewPairFromString(instruments[y].InstrumentName)
+			if err != nil {
+				return err
+			}
+			limits = append(limits, order.Limits{
+				Pair:       pairs[y],
+				Asset:      assets[x],
+				PriceTick:  instruments[y].TickSize,
+				MinAmount:  instruments[y].MinTradeAmount,
+				AmountStep: instruments[y].MinTradeAmount,
+			})
+		}
+		err = d.UpdatePairs(pairs, assets[x], false, forceUpdate)
+		if err != nil {
+			return err
+		}
+	}
+	return d.LoadExecutionLimits(limits)
+}
+
+// UpdateTicker updates and returns the ticker for a currency pair
+func (d *Deribit) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	fPair, err := d.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+	tick, err := d.GetTicker(fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	err = ticker.ProcessTicker(d.tickerToPrice(tick, p, assetType))
+	if err != nil {
+		return nil, err
+	}
+	return ticker.GetTicker(d.Name, p, assetType)
+}
+
+// tickerToPrice converts an instrument ticker to a ticker price including
+// the option implied volatilities and greeks
+func (d *Deribit) tickerToPrice(tick *TickerData, p currency.Pair, a asset.Item) *ticker.Price {
+	return &ticker.Price{
+		ExchangeName:    d.Name,
+		Pair:            p,
+		AssetType:       a,
+		Last:            tick.LastPrice,
+		High:            tick.Stats.High,
+		Low:             tick.Stats.Low,
+		Bid:             tick.BestBidPrice,
+		Ask:             tick.BestAskPrice,
+		Volume:          tick.Stats.Volume,
+		MarkPrice:       tick.MarkPrice,
+		MarkIV:          tick.MarkIV,
+		BidIV:           tick.BidIV,
+		AskIV:           tick.AskIV,
+		UnderlyingPrice: tick.UnderlyingPrice,
+		Delta:           tick.Greeks.Delta,
+		Gamma:           tick.Greeks.Gamma,
+		Vega:            tick.Greeks.Vega,
+		Theta:           tick.Greeks.Theta,
+		Rho:             tick.Greeks.Rho,
+		LastUpdated:     time.Unix(0, tick.Timestamp*int64(time.Millisecond)),
+	}
+}
+
+// FetchTicker returns the ticker for a currency pair
+func (d *Deribit) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	tickerNew, err := ticker.GetTicker(d.Name, p, assetType)
+	if err != nil {
+		return d.UpdateTicker(p, assetType)
+	}
+	return tickerNew, nil
+}
+
+// FetchOrderbook returns orderbook base on the currency pair
+func (d *Deribit) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+	ob, err := orderbook.Get(d.Name, p, assetType)
+	if err != nil {
+		return d.UpdateOrderbook(p, assetType)
+	}
+	return ob, nil
+}
+
+// UpdateOrderbook updates and returns the orderbook for a currency pair
+func (d *Deribit) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+	book := &orderbook.Base{
+		ExchangeName:       d.Name,
+		Pair:               p,
+		AssetType:          assetType,
+		VerificationBypass: d.OrderbookVerificationBypass,
+	}
+	fPair, err := d.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return book, err
+	}
+	ob, err := d.GetOrderbook(fPair.String(), 1000)
+	if err != nil {
+		return book, err
+	}
+	for x := range ob.Bids {
+		book.Bids = append(book.Bids, orderbook.Item{
+			Price:  ob.Bids[x][0],
+			Amount: ob.Bids[x][1],
+		})
+	}
+	for x := range ob.Asks {
+		book.Asks = append(book.Asks, orderbook.Item{
+			Price:  ob.Asks[x][0],
+			Amount: ob.Asks[x][1],
+		})
+	}
+	err = book.Process()
+	if err != nil {
+		return book, err
+	}
+	return orderbook.Get(d.Name, p, assetType)
+}
+
+// UpdateAccountInfo retrieves balances for all enabled currencies
+func (d *Deribit) UpdateAccountInfo(assetType asset.Item) (account.Holdings, error) {
+	return account.Holdings{}, common.ErrNotYetImplemented
+}
+
+// FetchAccountInfo retrieves balances for all enabled currencies
+func (d *Deribit) FetchAccountInfo(assetType asset.Item) (account.Holdings, error) {
+	return account.Holdings{}, common.ErrNotYetImplemented
+}
+
+// GetFundingHistory returns funding history, deposits and
+// withdrawals
+func (d *Deribit) GetFundingHistory() ([]exchange.FundHistory, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetWithdrawalsHistory returns previous withdrawals data
+func (d *Deribit) GetWithdrawalsHistory(c currency.Code) ([]exchange.WithdrawalHistory, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetRecentTrades returns the most recent trades for a currency and asset
+func (d *Deribit) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
+	return d.GetHistoricTrades(p, assetType, time.Now().Add(-time.Hour), time.Now())
+}
+
+// GetHistoricTrades returns historic trade data within the timeframe
+// provided, paging forward from the start time
+func (d *Deribit) GetHistoricTrades(p currency.Pair, assetType asset.Item, timestampStart, timestampEnd time.Time) ([]trade.Data, error) {
+	if !timestampStart.Before(timestampEnd) ||
+		timestampEnd.After(time.Now()) {
+		return nil, fmt.Errorf("invalid time range supplied. Start: %v End %v",
+			timestampStart,
+			timestampEnd)
+	}
+	fPair, err := d.FormatExchangeCurrency(p, assetType)
+	if err != nil {
+		return nil, err
+	}
+	var resp []trade.Data
+	start := timestampStart
+	for {
+		trades, err := d.GetTradesByTime(fPair.String(), start, timestampEnd, tradesLimit)
+		if err != nil {
+			return nil, err
+		}
+		for x := range trades.Trades {
+			side, err := order.StringToOrderSide(trades.Trades[x].Direction)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, trade.Data{
+				Exchange:     d.Name,
+				TID:          trades.Trades[x].TradeID,
+				CurrencyPair: p,
+				AssetType:    assetType,
+				Side:         side,
+				Price:        trades.Trades[x].Price,
+				Amount:       trades.Trades[x].Amount,
+				Timestamp:    time.Unix(0, trades.Trades[x].Timestamp*int64(time.Millisecond)),
+			})
+		}
+		if !trades.HasMore || len(trades.Trades) == 0 {
+			break
+		}
+		// trades sharing the final millisecond are returned again and
+		// filtered below
+		last := trades.Trades[len(trades.Trades)-1].Timestamp
+		next := time.Unix(0, last*int64(time.Millisecond))
+		if !next.After(start) {
+			break
+		}
+		start = next
+	}
+
+	err = d.AddTradesToBuffer(resp...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = trade.FilterTradesByTime(resp, timestampStart, timestampEnd)
+	sort.Sort(trade.ByDate(resp))
+	return dedupeTrades(resp), nil
+}
+
+// dedupeTrades removes repeated trades from a list sorted by time
+func dedupeTrades(trades []trade.Data) []trade.Data {
+	seen := make(map[string]bool, len(trades))
+	resp := trades[:0]
+	for x := range trades {
+		if seen[trades[x].TID] {
+			continue
+		}
+		seen[trades[x].TID] = true
+		resp = append(resp, trades[x])
+	}
+	return resp
+}
+
+// SubmitOrder submits a new order
+func (d *Deribit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+	if err := s.Validate(); err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return order.SubmitResponse{}, common.ErrNotYetImplemented
+}
+
+// ModifyOrder will allow of changing orderbook placement and limit to
+// market conversion
+func (d *Deribit) ModifyOrder(action *order.Modify) (string, error) {
+	return "", common.ErrNotYetImplemented
+}
+
+// CancelOrder cancels an order by its corresponding ID number
+func (d *Deribit) CancelOrder(ord *order.Cancel) error {
+	return common.ErrNotYetImplemented
+}
+
+// CancelBatchOrders cancels orders by their corresponding ID numbers
+func (d *Deribit) CancelBatchOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return order.CancelBatchResponse{}, common.ErrNotYetImplemented
+}
+
+// CancelAllOrders cancels all orders associated with a currency pair
+func (d *Deribit) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrNotYetImplemented
+}
+
+// GetOrderInfo returns order information based on order ID
+func (d *Deribit) GetOrderInfo(orderID string, pair currency.Pair, assetType asset.Item) (order.Detail, error) {
+	return order.Detail{}, common.ErrNotYetImplemented
+}
+
+// GetDepositAddress returns a deposit address for a specified currency
+func (d *Deribit) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+	return "", common.ErrNotYetImplemented
+}
+
+// WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
+// submitted
+func (d *Deribit) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
+// submitted
+func (d *Deribit) WithdrawFiatFunds(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
+// submitted
+func (d *Deribit) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetWebsocket returns a pointer to the exchange websocket
+func (d *Deribit) GetWebsocket() (*stream.Websocket, error) {
+	return d.Websocket, nil
+}
+
+// GetActiveOrders retrieves any orders that are active/open
+func (d *Deribit) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (d *Deribit) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetFeeByType returns an estimate of fee based on the type of transaction
+func (d *Deribit) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+	return 0, common.ErrNotYetImplemented
+}
+
+// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
+// which lets websocket.manageSubscriptions handle subscribing
+func (d *Deribit) SubscribeToWebsocketChannels(channels []stream.ChannelSubscription) error {
+	return d.Websocket.SubscribeToChannels(channels)
+}
+
+// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
+// which lets websocket.manageSubscriptions handle unsubscribing
+func (d *Deribit) UnsubscribeToWebsocketChannels(channels []stream.ChannelSubscription) error {
+	return d.Websocket.UnsubscribeChannels(channels)
+}
+
+// AuthenticateWebsocket sends an authentication message to the websocket
+func (d *Deribit) AuthenticateWebsocket() error {
+	return common.ErrFunctionNotSupported
+}
+
+// ValidateCredentials validates current credentials used for wrapper
+func (d *Deribit) ValidateCredentials(assetType asset.Item) error {
+	_, err := d.UpdateAccountInfo(assetType)
+	return d.CheckTransientError(err)
+}
+
+// FormatExchangeKlineInterval returns the interval in minutes or 1D for a
+// day
+func (d *Deribit) FormatExchangeKlineInterval(in kline.Interval) string {
+	if in == kline.OneDay {
+		return "1D"
+	}
+	return fmt.Sprintf("%.0f", in.Duration().Minutes())
+}
+
+// GetHistoricCandles returns candles between a time period for a set time interval
+func (d *Deribit) GetHistoricCandles(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := d.ValidateKline(p, a, interval); err != nil {
+		return kline.Item{}, err
+	}
+	if kline.TotalCandlesPerInterval(start, end, interval) > d.Features.Enabled.Kline.ResultLimit {
+		return kline.Item{}, fmt.Errorf(kline.ErrRequestExceedsExchangeLimits)
+	}
+	fPair, err := d.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	ret := kline.Item{
+		Exchange: d.Name,
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+	}
+	err = d.appendCandles(&ret, fPair.String(), start, end)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	return ret, nil
+}
+
+// GetHistoricCandlesExtended returns candles between a time period for a set time interval
+func (d *Deribit) GetHistoricCandlesExtended(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := d.ValidateKline(p, a, interval); err != nil {
+		return kline.Item{}, err
+	}
+	fPair, err := d.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	ret := kline.Item{
+		Exchange: d.Name,
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+	}
+	dates := kline.CalcDateRanges(start, end, interval, d.Features.Enabled.Kline.ResultLimit)
+	for x := range dates {
+		err = d.appendCandles(&ret, fPair.String(), dates[x].Start, dates[x].End)
+		if err != nil {
+			return kline.Item{}, err
+		}
+	}
+	return ret, nil
+}
+
+// appendCandles fetches the chart data of an instrument between the start
+// and end times and appends it to the kline item
+func (d *Deribit) appendCandles(k *kline.Item, instrument string, start, end time.Time) error {
+	data, err := d.GetTradingViewChartData(instrument,
+		d.FormatExchangeKlineInterval(k.Interval),
+		start,
+		end)
+	if err != nil {
+		return err
+	}
+	if len(data.Open) != len(data.Ticks) ||
+		len(data.High) != len(data.Ticks) ||
+		len(data.Low) != len(data.Ticks) ||
+		len(data.Close) != len(data.Ticks) ||
+		len(data.Volume) != len(data.Ticks) {
+		return fmt.Errorf("%s %s chart data length mismatch", d.Name, instrument)
+	}
+	for x := range data.Ticks {
+		k.Candles = append(k.Candles, kline.Candle{
+			Time:   time.Unix(0, data.Ticks[x]*int64(time.Millisecond)),
+			Open:   data.Open[x],
+			High:   data.High[x],
+			Low:    data.Low[x],
+			Close:  data.Close[x],
+			Volume: data.Volume[x],
+		})
+	}
+	return nil
+}
+
+// UpdateDerivativePrice updates and returns the mark price, index price and
+// open interest of a perpetual, future or option
+func (d *Deribit) UpdateDerivativePrice(p currency.Pair, a asset.Item) (*derivative.Price, error) {
+	if !d.SupportsAsset(a) {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, d.Name)
+	}
+	fPair, err := d.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	tick, err := d.GetTicker(fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	err = derivative.ProcessPrice(&derivative.Price{
+		ExchangeName:             d.Name,
+		Pair:                     p,
+		AssetType:                a,
+		MarkPrice:                tick.MarkPrice,
+		IndexPrice:               tick.IndexPrice,
+		OpenInterest:             tick.OpenInterest,
+		EstimatedSettlementPrice: tick.EstimatedDeliveryPrice,
+		LastUpdated:              time.Unix(0, tick.Timestamp*int64(time.Millisecond)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetPrice(d.Name, p, a)
+}
+
+// GetOptionChain returns the listed option contracts of an underlying
+// currency across all expiries, greeks are only published per contract
+// through its ticker
+func (d *Deribit) GetOptionChain(underlying currency.Code) (*option.Chain, error) {
+	code := underlying.Upper().String()
+	instruments, err := d.GetInstruments(code, kindOption, false)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := d.GetBookSummaryByCurrency(code, kindOption)
+	if err != nil {
+		return nil, err
+	}
+	summaryByName := make(map[string]*BookSummary, len(summaries))
+	for x := range summaries {
+		summaryByName[summaries[x].InstrumentName] = &summaries[x]
+	}
+
+	chain := &option.Chain{
+		Exchange:    d.Name,
+		Underlying:  underlying.Upper(),
+		LastUpdated: time.Now(),
+	}
+	for x := range instruments {
+		contract, err := instrumentToContract(&instruments[x])
+		if err != nil {
+			return nil, err
+		}
+		q := option.Quote{Contract: contract}
+		if s, ok := summaryByName[instruments[x].InstrumentName]; ok {
+			q.Bid = s.BidPrice
+			q.Ask = s.AskPrice
+			q.Last = s.Last
+			q.Mark = s.MarkPrice
+			q.MarkIV = s.MarkIV
+			q.UnderlyingPrice = s.UnderlyingPrice
+			q.OpenInterest = s.OpenInterest
+			q.Volume = s.Volume
+		}
+		chain.Quotes = append(chain.Quotes, q)
+	}
+	return chain, nil
+}
+
+// instrumentToContract converts an option instrument to a contract
+// descriptor
+func instrumentToContract(i *Instrument) (option.Contract, error) {
+	p, err := currency.NewPairFromString(i.InstrumentName)
+	if err != nil {
+		return option.Contract{}, err
+	}
+	t, err := option.ParseType(i.OptionType)
+	if err != nil {
+		return option.Contract{}, err
+	}
+	c := option.Contract{
+		Pair:       p.Upper(),
+		Underlying: currency.NewCode(strings.ToUpper(i.BaseCurrency)),
+		Strike:     i.Strike,
+		Expiry:     time.Unix(0, i.ExpirationTimestamp*int64(time.Millisecond)).UTC(),
+		Type:       t,
+	}
+	return c, c.Validate()
+}
@@ -0,0 +1,185 @@
+package derivative
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func init() {
+	service = new(Service)
+	service.Prices = make(map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Item)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribePrice subcribes to a derivative contract and returns a
+// communication channel to stream new price updates
+func SubscribePrice(exchange string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+
+	item, ok := service.Prices[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("derivative price not found for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+	return service.mux.Subscribe(item.Main)
+}
+
+// SubscribeToExchangePrices subcribes to all derivative prices on an
+// exchange
+func SubscribeToExchangePrices(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	id, ok := service.Exchange[exchange]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("%s exchange derivative prices not found",
+			exchange)
+	}
+
+	return service.mux.Subscribe(id)
+}
+
+// GetPrice checks and returns a requested derivative price if it exists
+func GetPrice(exchange string, p currency.Pair, a asset.Item) (*Price, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	item, ok := service.Prices[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return nil, fmt.Errorf("%s %s %s %w", exchange, p, a, ErrNoPriceData)
+	}
+	price := item.Price
+	return &price, nil
+}
+
+// ProcessPrice processes an incoming derivative price, creating or updating
+// the stored price. Exchanges often stream each value on a separate channel
+// so fields left at zero retain their previously stored value.
+func ProcessPrice(p *Price) error {
+	if p == nil {
+		return errPriceIsNil
+	}
+
+	if p.ExchangeName == "" {
+		return errExchangeNameUnset
+	}
+
+	if p.Pair.IsEmpty() {
+		return fmt.Errorf("%s %w", p.ExchangeName, errPairNotSet)
+	}
+
+	if !p.AssetType.IsDerivative() {
+		return fmt.Errorf("%s %s %s %w",
+			p.ExchangeName,
+			p.Pair,
+			p.AssetType,
+			errAssetInvalid)
+	}
+
+	if p.LastUpdated.IsZero() {
+		p.LastUpdated = time.Now()
+	}
+
+	return service.Update(p)
+}
+
+// Update updates the stored derivative price and publishes the merged price
+// to subscribers
+func (s *Service) Update(p *Price) error {
+	name := strings.ToLower(p.ExchangeName)
+	s.Lock()
+
+	item, ok := s.Prices[name][p.Pair.Base.Item][p.Pair.Quote.Item][p.AssetType]
+	if ok {
+		if p.MarkPrice != 0 {
+			item.MarkPrice = p.MarkPrice
+		}
+		if p.IndexPrice != 0 {
+			item.IndexPrice = p.IndexPrice
+		}
+		if p.OpenInterest != 0 {
+			item.OpenInterest = p.OpenInterest
+		}
+		if p.EstimatedSettlementPrice != 0 {
+			item.EstimatedSettlementPrice = p.EstimatedSettlementPrice
+		}
+		item.LastUpdated = p.LastUpdated
+		price := item.Price
+		ids := append(item.Assoc, item.Main)
+		s.Unlock()
+		return s.mux.Publish(ids, &price)
+	}
+
+	switch {
+	case s.Prices[name] == nil:
+		s.Prices[name] = make(map[*currency.Item]map[*currency.Item]map[asset.Item]*Item)
+		fallthrough
+	case s.Prices[name][p.Pair.Base.Item] == nil:
+		s.Prices[name][p.Pair.Base.Item] = make(map[*currency.Item]map[asset.Item]*Item)
+		fallthrough
+	case s.Prices[name][p.Pair.Base.Item][p.Pair.Quote.Item] == nil:
+		s.Prices[name][p.Pair.Base.Item][p.Pair.Quote.Item] = make(map[asset.Item]*Item)
+	}
+
+	err := s.SetItemID(p, name)
+	if err != nil {
+		s.Unlock()
+		return err
+	}
+
+	s.Unlock()
+	return nil
+}
+
+// SetItemID retrieves and sets dispatch mux publish IDs
+func (s *Service) SetItemID(p *Price, fmtName string) error {
+	if p == nil {
+		return errPriceIsNil
+	}
+
+	ids, err := s.GetAssociations(p, fmtName)
+	if err != nil {
+		return err
+	}
+	singleID, err := s.mux.GetID()
+	if err != nil {
+		return err
+	}
+
+	s.Prices[fmtName][p.Pair.Base.Item][p.Pair.Quote.Item][p.AssetType] = &Item{Price: *p,
+		Main:  singleID,
+		Assoc: ids}
+	return nil
+}
+
+// GetAssociations links a singular derivative price with its dispatch
+// associations
+func (s *Service) GetAssociations(p *Price, fmtName string) ([]uuid.UUID, error) {
+	if p == nil || *p == (Price{}) {
+		return nil, errPriceIsNil
+	}
+	var ids []uuid.UUID
+	exchangeID, ok := s.Exchange[fmtName]
+	if !ok {
+		var err error
+		exchangeID, err = s.mux.GetID()
+		if err != nil {
+			return nil, err
+		}
+		s.Exchange[fmtName] = exchangeID
+	}
+
+	ids = append(ids, exchangeID)
+	return ids, nil
+}
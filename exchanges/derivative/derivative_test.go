@@ -0,0 +1,184 @@
+package derivative
+
+import (
+	"errors"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestSubscribePrice(t *testing.T) {
+	_, err := SubscribePrice("", currency.Pair{}, asset.Item(""))
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	err = ProcessPrice(&Price{
+		Pair:         p,
+		ExchangeName: "subscribetest",
+		AssetType:    asset.PerpetualContract,
+		MarkPrice:    1337,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = SubscribePrice("subscribetest", p, asset.PerpetualContract)
+	if err != nil {
+		t.Error("cannot subscribe to derivative price", err)
+	}
+}
+
+func TestSubscribeToExchangePrices(t *testing.T) {
+	_, err := SubscribeToExchangePrices("")
+	if err == nil {
+		t.Error("error cannot be nil")
+	}
+
+	err = ProcessPrice(&Price{
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		ExchangeName: "subscribeExchangeTest",
+		AssetType:    asset.Futures,
+		IndexPrice:   1337,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = SubscribeToExchangePrices("subscribeExchangeTest")
+	if err != nil {
+		t.Error("cannot subscribe to exchange derivative prices", err)
+	}
+}
+
+func TestProcessPrice(t *testing.T) {
+	err := ProcessPrice(nil)
+	if !errors.Is(err, errPriceIsNil) {
+		t.Errorf("received '%v' expected '%v'", err, errPriceIsNil)
+	}
+
+	err = ProcessPrice(&Price{})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeNameUnset)
+	}
+
+	err = ProcessPrice(&Price{ExchangeName: "processtest"})
+	if !errors.Is(err, errPairNotSet) {
+		t.Errorf("received '%v' expected '%v'", err, errPairNotSet)
+	}
+
+	p := currency.NewPair(currency.ETH, currency.USD)
+	err = ProcessPrice(&Price{
+		ExchangeName: "processtest",
+		Pair:         p,
+		AssetType:    asset.Spot,
+	})
+	if !errors.Is(err, errAssetInvalid) {
+		t.Errorf("received '%v' expected '%v'", err, errAssetInvalid)
+	}
+
+	err = ProcessPrice(&Price{
+		ExchangeName: "processtest",
+		Pair:         p,
+		AssetType:    asset.PerpetualSwap,
+		MarkPrice:    1000,
+		IndexPrice:   999,
+		OpenInterest: 50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated := time.Now().Add(time.Minute)
+	err = ProcessPrice(&Price{
+		ExchangeName: "processtest",
+		Pair:         p,
+		AssetType:    asset.PerpetualSwap,
+		MarkPrice:    1001,
+		LastUpdated:  updated,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	price, err := GetPrice("processtest", p, asset.PerpetualSwap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price.MarkPrice != 1001 {
+		t.Errorf("received mark price %v expected 1001", price.MarkPrice)
+	}
+	if price.IndexPrice != 999 || price.OpenInterest != 50 {
+		t.Error("partial update should retain previously stored values")
+	}
+	if !price.LastUpdated.Equal(updated) {
+		t.Error("last updated time should be set by the latest update")
+	}
+}
+
+func TestGetPrice(t *testing.T) {
+	// clear prices stored by a previous run so this test can be repeated
+	service.Lock()
+	delete(service.Prices, "gettest")
+	service.Unlock()
+
+	p := currency.NewPair(currency.LTC, currency.USD)
+	_, err := GetPrice("gettest", p, asset.Futures)
+	if !errors.Is(err, ErrNoPriceData) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoPriceData)
+	}
+
+	err = ProcessPrice(&Price{
+		ExchangeName:             "GetTest",
+		Pair:                     p,
+		AssetType:                asset.Futures,
+		EstimatedSettlementPrice: 150,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	price, err := GetPrice("gettest", p, asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price.EstimatedSettlementPrice != 150 {
+		t.Errorf("received estimated settlement price %v expected 150",
+			price.EstimatedSettlementPrice)
+	}
+
+	_, err = GetPrice("gettest", p, asset.PerpetualSwap)
+	if !errors.Is(err, ErrNoPriceData) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoPriceData)
+	}
+}
+
+func TestGetAssociations(t *testing.T) {
+	_, err := service.GetAssociations(nil, "")
+	if !errors.Is(err, errPriceIsNil) {
+		t.Errorf("received '%v' expected '%v'", err, errPriceIsNil)
+	}
+
+	ids, err := service.GetAssociations(&Price{ExchangeName: "assoctest"}, "assoctest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 {
+		t.Errorf("received %d associations expected 1", len(ids))
+	}
+}
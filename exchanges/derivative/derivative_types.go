@@ -0,0 +1,59 @@
+package derivative
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Public errors for the derivative package
+var (
+	ErrNoPriceData = errors.New("no derivative price data")
+)
+
+var (
+	errExchangeNameUnset = errors.New("derivative price exchange name not set")
+	errPairNotSet        = errors.New("derivative price currency pair not set")
+	errAssetInvalid      = errors.New("asset type is not a derivative")
+	errPriceIsNil        = errors.New("derivative price is nil")
+
+	service *Service
+)
+
+// Service holds derivative prices for each individual exchange
+type Service struct {
+	Prices   map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Item
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+// Price holds the market data of a derivative contract which is not covered
+// by its ticker
+type Price struct {
+	ExchangeName string        `json:"exchangeName"`
+	Pair         currency.Pair `json:"pair"`
+	AssetType    asset.Item    `json:"assetType"`
+	MarkPrice    float64       `json:"markPrice"`
+	IndexPrice   float64       `json:"indexPrice"`
+	// OpenInterest is denominated in contracts or the base currency,
+	// whichever the exchange reports
+	OpenInterest float64 `json:"openInterest"`
+	// EstimatedSettlementPrice is only published by some exchanges for
+	// dated futures approaching delivery
+	EstimatedSettlementPrice float64   `json:"estimatedSettlementPrice"`
+	LastUpdated              time.Time `json:"lastUpdated"`
+}
+
+// Item holds the latest derivative price for a contract and its dispatch
+// IDs
+type Item struct {
+	Price
+	Main  uuid.UUID
+	Assoc []uuid.UUID
+}
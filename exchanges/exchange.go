@@ -15,14 +15,24 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/option"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 )
 
 const (
@@ -39,6 +49,8 @@ const (
 	DefaultWebsocketOrderbookBufferLimit = 5
 )
 
+var errSymbolNotMatched = errors.New("symbol does not match an available pair")
+
 func (e *Base) checkAndInitRequester() {
 	if e.Requester == nil {
 		e.Requester = request.New(e.Name,
@@ -160,6 +172,10 @@ func (e *Base) SetFeatureDefaults() {
 			e.SetSaveTradeDataStatus(e.Config.Features.Enabled.SaveTradeData)
 		}
 
+		if e.IsSaveLiquidationDataEnabled() != e.Config.Features.Enabled.SaveLiquidationData {
+			e.SetSaveLiquidationDataStatus(e.Config.Features.Enabled.SaveLiquidationData)
+		}
+
 		e.Features.Enabled.AutoPairUpdates = e.Config.Features.Enabled.AutoPairUpdates
 	}
 }
@@ -597,6 +613,26 @@ func (e *Base) SetupDefaults(exch *config.ExchangeConfig) error {
 	}
 	e.BaseCurrencies = exch.BaseCurrencies
 	e.OrderbookVerificationBypass = exch.OrderbookConfig.VerificationBypass
+
+	if s := exch.SharedRateLimit; s != nil && s.Enabled {
+		e.checkAndInitRequester()
+		err = e.Requester.SetSharedLimit(s.Path, s.Interval, s.Requests)
+		if err != nil {
+			return err
+		}
+	}
+
+	if w := exch.WebsocketCapture; w != nil && w.Enabled && e.Websocket != nil {
+		c, err := stream.NewCapture(e.Name, stream.CaptureConfig{
+			Directory:   w.Directory,
+			MaxFileSize: w.MaxFileSize,
+			MaxFiles:    w.MaxFiles,
+		})
+		if err != nil {
+			return err
+		}
+		e.Websocket.SetCapture(c)
+	}
 	return nil
 }
 
@@ -1086,6 +1122,204 @@ func (e *Base) AuthenticateWebsocket() error {
 	return common.ErrFunctionNotSupported
 }
 
+// GetDerivativePositions returns the open positions for a derivatives asset type
+func (e *Base) GetDerivativePositions(_ asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CloseDerivativePosition closes all or part of an open derivatives position
+func (e *Base) CloseDerivativePosition(_ *position.Close) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// GetLatestFundingRate returns the current funding rate of a perpetual
+// contract
+func (e *Base) GetLatestFundingRate(_ currency.Pair, _ asset.Item) (*fundingrate.LatestRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFundingRateHistory returns the funding rates applied to a perpetual
+// contract over a date range
+func (e *Base) GetFundingRateHistory(_ *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativePrice updates and returns the mark price, index price and
+// open interest of a derivatives contract
+func (e *Base) UpdateDerivativePrice(_ currency.Pair, _ asset.Item) (*derivative.Price, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetLiquidations returns the public liquidation events of a derivatives
+// contract between the start and end times
+func (e *Base) GetLiquidations(_ currency.Pair, _ asset.Item, _, _ time.Time) ([]liquidation.Data, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetOptionChain returns the listed option contracts of an underlying
+// currency across all expiries
+func (e *Base) GetOptionChain(_ currency.Code) (*option.Chain, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetLeverage returns the leverage and margin type applied to orders in a
+// derivatives contract
+func (e *Base) GetLeverage(_ currency.Pair, _ asset.Item) (*position.Leverage, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage applied to orders in a derivatives contract
+func (e *Base) SetLeverage(_ currency.Pair, _ asset.Item, _ float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginType sets whether a derivatives contract uses isolated or cross
+// margin
+func (e *Base) SetMarginType(_ currency.Pair, _ asset.Item, _ position.MarginType) error {
+	return common.ErrFunctionNotSupported
+}
+
+// Borrow borrows funds on margin and returns the loan or transaction ID
+func (e *Base) Borrow(_ *lending.Borrow) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// Repay repays borrowed margin funds and returns the transaction ID
+func (e *Base) Repay(_ *lending.Repay) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// GetLoans returns all outstanding borrowed and lent funds
+func (e *Base) GetLoans() ([]lending.Loan, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// OfferFunds offers funds to be lent to margin traders and returns the offer
+// ID
+func (e *Base) OfferFunds(_ *lending.Offer) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// CancelFundingOffer cancels an open lending offer
+func (e *Base) CancelFundingOffer(_ string) error {
+	return common.ErrFunctionNotSupported
+}
+
+// LoadExecutionLimits stores the execution limits of pairs, replacing any
+// limits already stored for the same asset and pair
+func (e *Base) LoadExecutionLimits(limits []order.Limits) error {
+	return e.executionLimits.Load(limits)
+}
+
+// GetOrderExecutionLimits returns the execution limits of a pair loaded while
+// updating tradable pairs
+func (e *Base) GetOrderExecutionLimits(a asset.Item, p currency.Pair) (order.Limits, error) {
+	return e.executionLimits.GetLimits(a, p)
+}
+
+// GetCurrentServerTime returns the current exchange server time
+func (e *Base) GetCurrentServerTime() (time.Time, error) {
+	return time.Time{}, common.ErrFunctionNotSupported
+}
+
+// UpdateContracts refreshes the contract metadata of a derivatives asset and
+// returns the enabled pairs which were rolled to a new contract
+func (e *Base) UpdateContracts(_ asset.Item) ([]contract.Roll, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// LoadContracts replaces the contract metadata of an asset. Enabled pairs
+// whose alias, such as this quarter, now refers to a different contract are
+// replaced by that contract so subscriptions follow the alias across expiries
+func (e *Base) LoadContracts(a asset.Item, contracts []contract.Contract) ([]contract.Roll, error) {
+	// the pair store is read directly as an expired enabled pair may no longer
+	// be contained in the available pairs
+	ps, err := e.CurrencyPairs.Get(a)
+	if err != nil {
+		return nil, err
+	}
+	enabled := append(currency.Pairs(nil), ps.Enabled...)
+	available := append(currency.Pairs(nil), ps.Available...)
+	rolls := e.contracts.FindRolls(a, enabled, contracts)
+	err = e.contracts.Load(a, contracts)
+	if err != nil {
+		return nil, err
+	}
+	if len(rolls) == 0 {
+		return nil, nil
+	}
+
+	for i := range rolls {
+		enabled = enabled.Remove(rolls[i].From)
+		if !enabled.Contains(rolls[i].To, true) {
+			enabled = enabled.Add(rolls[i].To)
+		}
+		if !available.Contains(rolls[i].To, true) {
+			available = available.Add(rolls[i].To)
+		}
+		log.Infof(log.ExchangeSys,
+			"%s rolled enabled %s %s contract %s to %s",
+			e.Name,
+			a,
+			strings.ToLower(rolls[i].Type.String()),
+			rolls[i].From,
+			rolls[i].To)
+	}
+	e.Config.CurrencyPairs.StorePairs(a, available, false)
+	e.CurrencyPairs.StorePairs(a, available, false)
+	e.Config.CurrencyPairs.StorePairs(a, enabled, true)
+	e.CurrencyPairs.StorePairs(a, enabled, true)
+	return rolls, nil
+}
+
+// GetContract returns the metadata of a derivatives contract loaded by
+// UpdateContracts
+func (e *Base) GetContract(a asset.Item, p currency.Pair) (contract.Contract, error) {
+	return e.contracts.Get(a, p)
+}
+
+// GetContracts returns the metadata of all loaded contracts of an asset
+func (e *Base) GetContracts(a asset.Item) ([]contract.Contract, error) {
+	if !e.SupportsAsset(a) {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, e.Name)
+	}
+	return e.contracts.GetAll(a), nil
+}
+
+// GetRolloverContract returns the contract a position in the pair should be
+// rolled to before it expires
+func (e *Base) GetRolloverContract(a asset.Item, p currency.Pair) (contract.Contract, error) {
+	return e.contracts.GetRollover(a, p)
+}
+
+// GetSubAccounts returns the sub-accounts registered under the master account
+func (e *Base) GetSubAccounts() ([]account.SubAccountDetails, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// TransferFunds moves funds between the wallets of an account or between the
+// master account and its sub-accounts
+func (e *Base) TransferFunds(_ *transfer.Request) (*transfer.ExchangeResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// MatchSymbolWithAvailablePairs returns the available pair matching an
+// exchange symbol, ignoring case and delimiters
+func (e *Base) MatchSymbolWithAvailablePairs(symbol string, a asset.Item) (currency.Pair, error) {
+	pairs, err := e.GetAvailablePairs(a)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	r := strings.NewReplacer("-", "", "_", "", "/", "")
+	symbol = r.Replace(symbol)
+	for i := range pairs {
+		if strings.EqualFold(r.Replace(pairs[i].Base.String()+pairs[i].Quote.String()), symbol) {
+			return pairs[i], nil
+		}
+	}
+	return currency.Pair{}, fmt.Errorf("%s %s %w", symbol, a, errSymbolNotMatched)
+}
+
 // KlineIntervalEnabled returns if requested interval is enabled on exchange
 func (e *Base) klineIntervalEnabled(in kline.Interval) bool {
 	return e.Features.Enabled.Kline.Intervals[in.Word()]
@@ -1153,6 +1387,37 @@ func (e *Base) SetSaveTradeDataStatus(enabled bool) {
 	}
 }
 
+// AddLiquidationsToBuffer is a helper function that will only
+// add liquidations to the buffer if it is allowed
+func (e *Base) AddLiquidationsToBuffer(liquidations ...liquidation.Data) error {
+	if !e.IsSaveLiquidationDataEnabled() {
+		return nil
+	}
+
+	return liquidation.AddLiquidationsToBuffer(e.Name, liquidations...)
+}
+
+// IsSaveLiquidationDataEnabled checks the state of
+// SaveLiquidationData in a concurrent-friendly manner
+func (e *Base) IsSaveLiquidationDataEnabled() bool {
+	e.settingsMutex.RLock()
+	isEnabled := e.Features.Enabled.SaveLiquidationData
+	e.settingsMutex.RUnlock()
+	return isEnabled
+}
+
+// SetSaveLiquidationDataStatus locks and sets the status of
+// the config and the exchange's setting for SaveLiquidationData
+func (e *Base) SetSaveLiquidationDataStatus(enabled bool) {
+	e.settingsMutex.Lock()
+	defer e.settingsMutex.Unlock()
+	e.Features.Enabled.SaveLiquidationData = enabled
+	e.Config.Features.Enabled.SaveLiquidationData = enabled
+	if e.Verbose {
+		log.Debugf(log.Trade, "Set %v 'SaveLiquidationData' to %v", e.Name, enabled)
+	}
+}
+
 // NewEndpoints declares default and running URLs maps
 func (e *Base) NewEndpoints() *Endpoints {
 	return &Endpoints{
@@ -2,9 +2,11 @@ package exchange
 
 import (
 	"errors"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -14,12 +16,18 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 )
 
 const (
@@ -1264,6 +1272,32 @@ func TestSetupDefaults(t *testing.T) {
 		t.Error("HTTP timeout should be set to 30s")
 	}
 
+	// Test shared rate limit is applied to the requester
+	dir, err := ioutil.TempDir("", "gct-shared-limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg.SharedRateLimit = &config.SharedRateLimitConfig{
+		Enabled:  true,
+		Path:     filepath.Join(dir, "test.json"),
+		Interval: time.Second,
+		Requests: 10,
+	}
+	err = b.SetupDefaults(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Requester == nil {
+		t.Fatal("requester should be initialised for shared rate limit")
+	}
+	cfg.SharedRateLimit.Requests = 0
+	err = b.SetupDefaults(&cfg)
+	if err == nil {
+		t.Error("expected error for invalid shared rate limit")
+	}
+	cfg.SharedRateLimit = nil
+
 	// Test asset types
 	p, err := currency.NewPairDelimiter(defaultTestCurrencyPair, "-")
 	if err != nil {
@@ -1308,6 +1342,24 @@ func TestSetupDefaults(t *testing.T) {
 	if !b.IsWebsocketEnabled() {
 		t.Error("websocket should be enabled")
 	}
+
+	// Test websocket capture
+	b.Name = "test"
+	cfg.WebsocketCapture = &config.WebsocketCaptureConfig{
+		Enabled:   true,
+		Directory: filepath.Join(dir, "capture"),
+	}
+	err = b.SetupDefaults(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := stream.CaptureFiles(cfg.WebsocketCapture.Directory, b.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("expected a capture file to be started, received %v", files)
+	}
 }
 
 func TestAllowAuthenticatedRequest(t *testing.T) {
@@ -2105,6 +2157,235 @@ func TestAuthenticateWebsocket(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	b := Base{}
+	if _, err := b.GetDerivativePositions(asset.Futures); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if _, err := b.CloseDerivativePosition(nil); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetCurrentServerTime(t *testing.T) {
+	b := Base{}
+	if _, err := b.GetCurrentServerTime(); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetFundingRates(t *testing.T) {
+	b := Base{}
+	if _, err := b.GetLatestFundingRate(currency.Pair{}, asset.PerpetualSwap); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if _, err := b.GetFundingRateHistory(nil); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestUpdateDerivativePrice(t *testing.T) {
+	b := Base{}
+	if _, err := b.UpdateDerivativePrice(currency.Pair{}, asset.Futures); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	b := Base{}
+	if _, err := b.GetLiquidations(currency.Pair{}, asset.Futures, time.Time{}, time.Time{}); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestGetOptionChain(t *testing.T) {
+	b := Base{}
+	if _, err := b.GetOptionChain(currency.BTC); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestLeverage(t *testing.T) {
+	b := Base{}
+	if _, err := b.GetLeverage(currency.Pair{}, asset.Futures); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if err := b.SetLeverage(currency.Pair{}, asset.Futures, 1); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if err := b.SetMarginType(currency.Pair{}, asset.Futures, position.Cross); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestLending(t *testing.T) {
+	b := Base{}
+	if _, err := b.Borrow(&lending.Borrow{}); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if _, err := b.Repay(&lending.Repay{}); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if _, err := b.GetLoans(); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if _, err := b.OfferFunds(&lending.Offer{}); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if err := b.CancelFundingOffer(""); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestTransfers(t *testing.T) {
+	t.Parallel()
+	b := Base{}
+	if _, err := b.GetSubAccounts(); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	if _, err := b.TransferFunds(&transfer.Request{}); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+}
+
+func TestExecutionLimits(t *testing.T) {
+	t.Parallel()
+	b := Base{}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := b.GetOrderExecutionLimits(asset.Spot, p)
+	if !errors.Is(err, order.ErrExecutionLimitsNotLoaded) {
+		t.Fatalf("expected %v, received %v", order.ErrExecutionLimitsNotLoaded, err)
+	}
+	err = b.LoadExecutionLimits([]order.Limits{{Pair: p, Asset: asset.Spot, AmountStep: 0.001}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := b.GetOrderExecutionLimits(asset.Spot, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.AmountStep != 0.001 {
+		t.Errorf("expected 0.001, received %v", l.AmountStep)
+	}
+}
+
+func TestContracts(t *testing.T) {
+	t.Parallel()
+	b := Base{
+		Name:   "TESTNAME",
+		Config: &config.ExchangeConfig{CurrencyPairs: &currency.PairsManager{}},
+	}
+	thisQuarter := currency.NewPairWithDelimiter("BTC-USD", "210625", "_")
+	nextQuarter := currency.NewPairWithDelimiter("BTC-USD", "210924", "_")
+	newQuarter := currency.NewPairWithDelimiter("BTC-USD", "211231", "_")
+	b.CurrencyPairs.StorePairs(asset.Futures, currency.Pairs{thisQuarter, nextQuarter}, false)
+	b.CurrencyPairs.StorePairs(asset.Futures, currency.Pairs{thisQuarter}, true)
+
+	_, err := b.UpdateContracts(asset.Futures)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("expected %v, received %v", common.ErrFunctionNotSupported, err)
+	}
+	_, err = b.GetContract(asset.Futures, thisQuarter)
+	if !errors.Is(err, contract.ErrContractNotFound) {
+		t.Fatalf("expected %v, received %v", contract.ErrContractNotFound, err)
+	}
+	_, err = b.GetContracts(asset.Spot)
+	if err == nil {
+		t.Fatal("expected error for unsupported asset")
+	}
+
+	newContract := func(p currency.Pair, typ contract.Type, expiry time.Time) contract.Contract {
+		return contract.Contract{
+			Pair:               p,
+			Asset:              asset.Futures,
+			Underlying:         currency.NewPair(currency.BTC, currency.USD),
+			Type:               typ,
+			Expiry:             expiry,
+			SettlementCurrency: currency.BTC,
+		}
+	}
+	june := time.Date(2021, 6, 25, 8, 0, 0, 0, time.UTC)
+	rolls, err := b.LoadContracts(asset.Futures, []contract.Contract{
+		newContract(thisQuarter, contract.ThisQuarter, june),
+		newContract(nextQuarter, contract.NextQuarter, june.AddDate(0, 3, 0)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rolls) != 0 {
+		t.Fatalf("expected no rolls, received %v", rolls)
+	}
+	c, err := b.GetContract(asset.Futures, thisQuarter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Type != contract.ThisQuarter {
+		t.Errorf("expected %s, received %s", contract.ThisQuarter, c.Type)
+	}
+	next, err := b.GetRolloverContract(asset.Futures, thisQuarter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.Pair.Equal(nextQuarter) {
+		t.Errorf("expected %s, received %s", nextQuarter, next.Pair)
+	}
+
+	// the June contract expires and the enabled this quarter pair follows
+	// its alias to September
+	rolls, err = b.LoadContracts(asset.Futures, []contract.Contract{
+		newContract(nextQuarter, contract.ThisQuarter, june.AddDate(0, 3, 0)),
+		newContract(newQuarter, contract.NextQuarter, june.AddDate(0, 6, 0)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rolls) != 1 || !rolls[0].To.Equal(nextQuarter) {
+		t.Fatalf("unexpected rolls %v", rolls)
+	}
+	enabled, err := b.CurrencyPairs.GetPairs(asset.Futures, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enabled) != 1 || !enabled[0].Equal(nextQuarter) {
+		t.Errorf("expected enabled pairs to be rolled to %s, received %s", nextQuarter, enabled)
+	}
+	contracts, err := b.GetContracts(asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contracts) != 2 {
+		t.Errorf("expected 2 contracts, received %d", len(contracts))
+	}
+}
+
+func TestMatchSymbolWithAvailablePairs(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME"}
+	pairs, err := currency.NewPairsFromStrings([]string{"BTC-USD_210326", "ETH-USDT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.CurrencyPairs.StorePairs(asset.Futures, pairs, false)
+	b.CurrencyPairs.UseGlobalFormat = true
+	b.CurrencyPairs.ConfigFormat = &currency.PairFormat{Delimiter: "-", Uppercase: true}
+	b.CurrencyPairs.RequestFormat = b.CurrencyPairs.ConfigFormat
+
+	p, err := b.MatchSymbolWithAvailablePairs("btc-usd-210326", asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(pairs[0]) {
+		t.Errorf("expected %s, received %s", pairs[0], p)
+	}
+	if _, err = b.MatchSymbolWithAvailablePairs("ETHUSDT", asset.Futures); err != nil {
+		t.Fatal(err)
+	}
+	_, err = b.MatchSymbolWithAvailablePairs("LTCUSD", asset.Futures)
+	if !errors.Is(err, errSymbolNotMatched) {
+		t.Fatalf("expected %v, received %v", errSymbolNotMatched, err)
+	}
+}
+
 func TestKlineIntervalEnabled(t *testing.T) {
 	b := Base{}
 	if b.klineIntervalEnabled(kline.EightHour) {
@@ -2149,6 +2430,45 @@ func TestSetSaveTradeDataStatus(t *testing.T) {
 	go b.SetSaveTradeDataStatus(true)
 }
 
+func TestSetSaveLiquidationDataStatus(t *testing.T) {
+	b := Base{
+		Config: &config.ExchangeConfig{
+			Features: &config.FeaturesConfig{
+				Enabled: config.FeaturesEnabledConfig{},
+			},
+		},
+	}
+
+	if b.IsSaveLiquidationDataEnabled() {
+		t.Errorf("expected false")
+	}
+	b.SetSaveLiquidationDataStatus(true)
+	if !b.IsSaveLiquidationDataEnabled() {
+		t.Errorf("expected true")
+	}
+	if !b.Config.Features.Enabled.SaveLiquidationData {
+		t.Errorf("expected config to be updated")
+	}
+	b.SetSaveLiquidationDataStatus(false)
+	if b.IsSaveLiquidationDataEnabled() {
+		t.Errorf("expected false")
+	}
+}
+
+func TestAddLiquidationsToBuffer(t *testing.T) {
+	b := Base{
+		Config: &config.ExchangeConfig{
+			Features: &config.FeaturesConfig{
+				Enabled: config.FeaturesEnabledConfig{},
+			},
+		},
+	}
+	err := b.AddLiquidationsToBuffer(liquidation.Data{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestAddTradesToBuffer(t *testing.T) {
 	b := Base{
 		Features: Features{
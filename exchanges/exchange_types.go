@@ -6,7 +6,9 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -151,9 +153,10 @@ type Features struct {
 
 // FeaturesEnabled stores the exchange enabled features
 type FeaturesEnabled struct {
-	AutoPairUpdates bool
-	Kline           kline.ExchangeCapabilitiesEnabled
-	SaveTradeData   bool
+	AutoPairUpdates     bool
+	Kline               kline.ExchangeCapabilitiesEnabled
+	SaveTradeData       bool
+	SaveLiquidationData bool
 }
 
 // FeaturesSupported stores the exchanges supported features
@@ -222,6 +225,8 @@ type Base struct {
 	Config                      *config.ExchangeConfig
 	settingsMutex               sync.RWMutex
 	OrderbookVerificationBypass bool
+	executionLimits             order.ExecutionLimits
+	contracts                   contract.Store
 }
 
 // url lookup consts
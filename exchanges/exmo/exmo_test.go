@@ -82,6 +82,21 @@ func TestGetPairSettings(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := e.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := e.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.BTC, currency.USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.MinAmount == 0 || l.MaxAmount == 0 {
+		t.Errorf("expected minimum and maximum amount to be loaded, received %+v", l)
+	}
+}
+
 func TestGetCurrency(t *testing.T) {
 	t.Parallel()
 	_, err := e.GetCurrency()
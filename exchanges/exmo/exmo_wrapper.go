@@ -179,7 +179,38 @@ func (e *EXMO) UpdateTradablePairs(forceUpdate bool) error {
 		return err
 	}
 
-	return e.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	err = e.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	return e.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the pair execution limits from the pair
+// settings, the minimum amount is a quote currency value
+func (e *EXMO) updateExecutionLimits() error {
+	pairs, err := e.GetPairSettings()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(pairs))
+	for symbol, settings := range pairs {
+		var p currency.Pair
+		p, err = currency.NewPairFromString(symbol)
+		if err != nil {
+			return err
+		}
+		limits = append(limits, order.Limits{
+			Pair:        p,
+			Asset:       asset.Spot,
+			MinPrice:    settings.MinPrice,
+			MaxPrice:    settings.MaxPrice,
+			MinAmount:   settings.MinQuantity,
+			MaxAmount:   settings.MaxQuantity,
+			MinNotional: settings.MinAmount,
+		})
+	}
+	return e.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -90,6 +90,8 @@ const (
 	requestOTCQuote          = "/otc/quotes"
 	getOTCQuoteStatus        = "/otc/quotes/"
 	acceptOTCQuote           = "/otc/quotes/%s/accept"
+	getSubaccounts           = "/subaccounts"
+	transferSubaccounts      = "/subaccounts/transfer"
 
 	// Margin Endpoints
 	marginBorrowRates    = "/spot_margin/borrow_rates"
@@ -108,13 +110,21 @@ const (
 	closedStatus          = "closed"
 	spotString            = "spot"
 	futuresString         = "future"
+	mainAccount           = "main"
 
 	ratePeriod = time.Second
 	rateLimit  = 30
+
+	// fundingRateHistoryLimit is the maximum number of funding rates
+	// returned per funding rate request
+	fundingRateHistoryLimit = 500
+	// fundingRateLookback covers the most recent hourly funding payment
+	fundingRateLookback = time.Hour * 2
 )
 
 var (
 	errStartTimeCannotBeAfterEndTime = errors.New("start timestamp cannot be after end timestamp")
+	errNotPerpetual                  = errors.New("future is not a perpetual and has no funding rate")
 )
 
 // GetMarkets gets market data
@@ -463,6 +473,34 @@ func (f *FTX) Withdraw(coin, address, tag, password, code string, size float64)
 	return resp.Data, f.SendAuthHTTPRequest(exchange.RestSpot, http.MethodPost, withdrawRequest, req, &resp)
 }
 
+// GetSubaccounts returns the subaccounts registered under the main account
+func (f *FTX) GetSubaccounts() ([]Subaccount, error) {
+	resp := struct {
+		Data []Subaccount `json:"result"`
+	}{}
+	return resp.Data, f.SendAuthHTTPRequest(exchange.RestSpot, http.MethodGet, getSubaccounts, nil, &resp)
+}
+
+// TransferBetweenSubaccounts moves a coin between subaccounts, an empty
+// source or destination refers to the main account
+func (f *FTX) TransferBetweenSubaccounts(coin, source, destination string, size float64) (SubaccountTransfer, error) {
+	if source == "" {
+		source = mainAccount
+	}
+	if destination == "" {
+		destination = mainAccount
+	}
+	req := make(map[string]interface{})
+	req["coin"] = strings.ToUpper(coin)
+	req["size"] = size
+	req["source"] = source
+	req["destination"] = destination
+	resp := struct {
+		Data SubaccountTransfer `json:"result"`
+	}{}
+	return resp.Data, f.SendAuthHTTPRequest(exchange.RestSpot, http.MethodPost, transferSubaccounts, req, &resp)
+}
+
 // GetOpenOrders gets open orders
 func (f *FTX) GetOpenOrders(marketName string) ([]OrderData, error) {
 	params := url.Values{}
@@ -1,6 +1,7 @@
 package ftx
 
 import (
+	"errors"
 	"log"
 	"os"
 	"reflect"
@@ -12,9 +13,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -78,6 +82,25 @@ func TestGetMarkets(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := f.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := currency.NewPairFromString(spotPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := f.GetOrderExecutionLimits(asset.Spot, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 {
+		t.Errorf("expected price tick and amount step to be loaded, received %+v", l)
+	}
+}
+
 func TestGetMarket(t *testing.T) {
 	t.Parallel()
 	_, err := f.GetMarket(spotPair)
@@ -255,6 +278,45 @@ func TestChangeAccountLeverage(t *testing.T) {
 	}
 }
 
+func TestGetSubAccounts(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	_, err := f.GetSubAccounts()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := f.TransferFunds(&transfer.Request{
+		Exchange:  f.Name,
+		Currency:  currency.USD,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Futures,
+	})
+	if !errors.Is(err, transfer.ErrUnsupportedTransfer) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrUnsupportedTransfer)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
+	}
+	_, err = f.TransferFunds(&transfer.Request{
+		Exchange:  f.Name,
+		Currency:  currency.USD,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Spot,
+		ToAccount: "sub",
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetCoins(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() {
@@ -527,6 +589,139 @@ func TestSubmitOrder(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	_, err := f.GetDerivativePositions(asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCloseDerivativePosition(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys or canManipulateRealOrders isn't set correctly")
+	}
+	p, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.CloseDerivativePosition(&position.Close{
+		Exchange: f.Name,
+		Pair:     p,
+		Asset:    asset.Futures,
+	})
+	if err != nil && !errors.Is(err, position.ErrPositionNotFound) {
+		t.Error(err)
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	p, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetLeverage(p, asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys or canManipulateRealOrders isn't set correctly")
+	}
+	err := f.SetLeverage(currency.Pair{}, asset.Futures, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	err := f.SetMarginType(currency.Pair{}, asset.Futures, position.Isolated)
+	if !errors.Is(err, position.ErrMarginTypeNotSupported) {
+		t.Errorf("expected %v, received %v", position.ErrMarginTypeNotSupported, err)
+	}
+	err = f.SetMarginType(currency.Pair{}, asset.Futures, position.Cross)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetLatestFundingRate(p, asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = f.GetLatestFundingRate(p, asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateDerivativePrice(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.UpdateDerivativePrice(p, asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = f.UpdateDerivativePrice(p, asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetLiquidations(p, asset.Futures, time.Now(), time.Now().Add(-time.Hour))
+	if err == nil {
+		t.Error("expected an error for an invalid time range")
+	}
+	_, err = f.GetLiquidations(p, asset.Futures, time.Now().Add(-time.Hour), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetFundingRateHistory(&fundingrate.HistoricalRatesRequest{
+		Pair:  p,
+		Asset: asset.Futures,
+		Start: time.Now().Add(-time.Hour * 24 * 30),
+		End:   time.Now(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestTriggerOrder(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
@@ -1184,6 +1379,29 @@ func TestParsingWSTradesData(t *testing.T) {
 	}
 }
 
+func TestParsingWSLiquidationTradesData(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+		"channel": "trades",
+		"market": "BTC-PERP",
+		"type": "update",
+		"data": [
+			{
+				"id": 44200174,
+				"price": 9755.5,
+				"size": 1.25,
+				"side": "sell",
+				"liquidation": true,
+				"time": "2020-05-15T01:10:05.369194+00:00"
+			}
+		]
+	}`)
+	err := f.wsHandleData(data)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestParsingWSTickerData(t *testing.T) {
 	t.Parallel()
 	data := []byte(`{
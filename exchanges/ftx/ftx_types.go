@@ -176,6 +176,7 @@ type IndexWeights struct {
 type PositionData struct {
 	Cost                         float64 `json:"cost"`
 	EntryPrice                   float64 `json:"entryPrice"`
+	EstimatedLiquidationPrice    float64 `json:"estimatedLiquidationPrice"`
 	Future                       string  `json:"future"`
 	InitialMarginRequirement     float64 `json:"initialMarginRequirement"`
 	LongOrderSize                float64 `json:"longOrderSize"`
@@ -196,7 +197,7 @@ type AccountInfoData struct {
 	Collateral                   float64        `json:"collateral"`
 	FreeCollateral               float64        `json:"freeCollateral"`
 	InitialMarginRequirement     float64        `json:"initialMarginRequirement"`
-	Leverage                     float64        `json:"float64"`
+	Leverage                     float64        `json:"leverage"`
 	Liquidating                  bool           `json:"liquidating"`
 	MaintenanceMarginRequirement float64        `json:"maintenanceMarginRequirement"`
 	MakerFee                     float64        `json:"makerFee"`
@@ -267,6 +268,24 @@ type TransactionData struct {
 	TxID          string    `json:"txid"`
 }
 
+// Subaccount stores a subaccount registered under the main account
+type Subaccount struct {
+	Nickname    string `json:"nickname"`
+	Deletable   bool   `json:"deletable"`
+	Editable    bool   `json:"editable"`
+	Competition bool   `json:"competition"`
+}
+
+// SubaccountTransfer stores the result of a transfer between subaccounts
+type SubaccountTransfer struct {
+	ID     int64     `json:"id"`
+	Coin   string    `json:"coin"`
+	Size   float64   `json:"size"`
+	Time   time.Time `json:"time"`
+	Notes  string    `json:"notes"`
+	Status string    `json:"status"`
+}
+
 // OrderData stores open order data
 type OrderData struct {
 	CreatedAt     time.Time `json:"createdAt"`
@@ -16,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -282,6 +283,10 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 			}
 			err = f.WsProcessUpdateOB(&resultData.OBData, p, a)
 			if err != nil {
+				if errors.Is(err, buffer.ErrChecksumMismatch) {
+					// the buffer resyncs the orderbook via REST
+					return err
+				}
 				err2 := f.wsResubToOB(p)
 				if err2 != nil {
 					f.Websocket.DataHandler <- err2
@@ -289,15 +294,13 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 				return err
 			}
 		case wsTrades:
-			if !f.IsSaveTradeDataEnabled() {
-				return nil
-			}
 			var resultData WsTradeDataStore
 			err = json.Unmarshal(respRaw, &resultData)
 			if err != nil {
 				return err
 			}
 			var trades []trade.Data
+			var liquidations []liquidation.Data
 			for z := range resultData.TradeData {
 				var oSide order.Side
 				oSide, err = order.StringToOrderSide(resultData.TradeData[z].Side)
@@ -307,6 +310,17 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 						Err:      err,
 					}
 				}
+				if resultData.TradeData[z].Liquidation {
+					liquidations = append(liquidations, liquidation.Data{
+						Exchange:     f.Name,
+						CurrencyPair: p,
+						AssetType:    a,
+						Side:         oSide,
+						Price:        resultData.TradeData[z].Price,
+						Amount:       resultData.TradeData[z].Size,
+						Timestamp:    resultData.TradeData[z].Time,
+					})
+				}
 				trades = append(trades, trade.Data{
 					Timestamp:    resultData.TradeData[z].Time,
 					CurrencyPair: p,
@@ -318,6 +332,16 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 					TID:          strconv.FormatInt(resultData.TradeData[z].ID, 10),
 				})
 			}
+			if len(liquidations) > 0 {
+				f.Websocket.DataHandler <- liquidations
+				err = f.AddLiquidationsToBuffer(liquidations...)
+				if err != nil {
+					return err
+				}
+			}
+			if !f.IsSaveTradeDataEnabled() {
+				return nil
+			}
 			return trade.AddTradesToBuffer(f.Name, trades...)
 		case wsOrders:
 			var resultData WsOrderDataStore
@@ -431,9 +455,9 @@ func (f *FTX) WsProcessUpdateOB(data *WsOrderbookData, p currency.Pair, a asset.
 		Asset:      a,
 		Pair:       p,
 		UpdateTime: timestampFromFloat64(data.Time),
+		Checksum:   uint32(data.Checksum),
 	}
 
-	var err error
 	for x := range data.Bids {
 		update.Bids = append(update.Bids, orderbook.Item{
 			Price:  data.Bids[x][0],
@@ -447,21 +471,7 @@ func (f *FTX) WsProcessUpdateOB(data *WsOrderbookData, p currency.Pair, a asset.
 		})
 	}
 
-	err = f.Websocket.Orderbook.Update(&update)
-	if err != nil {
-		return err
-	}
-
-	updatedOb := f.Websocket.Orderbook.GetOrderbook(p, a)
-	checksum := f.CalcUpdateOBChecksum(updatedOb)
-
-	if checksum != data.Checksum {
-		log.Warnf(log.ExchangeSys, "%s checksum failure for item %s",
-			f.Name,
-			p)
-		return errors.New("checksum failed")
-	}
-	return nil
+	return f.Websocket.Orderbook.Update(&update)
 }
 
 func (f *FTX) wsResubToOB(p currency.Pair) error {
@@ -538,6 +548,12 @@ func (f *FTX) CalcPartialOBChecksum(data *WsOrderbookData) int64 {
 	return int64(crc32.ChecksumIEEE([]byte(checksumStr)))
 }
 
+// orderbookChecksum calculates the checksum of the websocket orderbook
+// buffer for verification of updates
+func (f *FTX) orderbookChecksum(b *orderbook.Base) uint32 {
+	return uint32(f.CalcUpdateOBChecksum(b))
+}
+
 // CalcUpdateOBChecksum calculates checksum of update OB data received from WS
 func (f *FTX) CalcUpdateOBChecksum(data *orderbook.Base) int64 {
 	var checksum strings.Builder
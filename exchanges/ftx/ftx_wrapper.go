@@ -14,15 +14,21 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -188,6 +194,10 @@ func (f *FTX) Setup(exch *config.ExchangeConfig) error {
 		Features:                         &f.Features.Supports.WebsocketCapabilities,
 		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
+		OrderbookVerifier: buffer.ChecksumVerifier{
+			Checksum: f.orderbookChecksum,
+		},
+		OrderbookResync: f.UpdateOrderbook,
 	})
 	if err != nil {
 		return err
@@ -275,7 +285,42 @@ func (f *FTX) UpdateTradablePairs(forceUpdate bool) error {
 			return err
 		}
 	}
-	return nil
+	return f.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the pair execution limits from the market
+// price and size increments, the size increment is also the minimum order
+// size
+func (f *FTX) updateExecutionLimits() error {
+	markets, err := f.GetMarkets()
+	if err != nil {
+		return err
+	}
+	var limits []order.Limits
+	for x := range markets {
+		var a asset.Item
+		switch markets[x].MarketType {
+		case spotString:
+			a = asset.Spot
+		case futuresString:
+			a = asset.Futures
+		default:
+			continue
+		}
+		var p currency.Pair
+		p, err = currency.NewPairFromString(markets[x].Name)
+		if err != nil {
+			return err
+		}
+		limits = append(limits, order.Limits{
+			Pair:       p,
+			Asset:      a,
+			PriceTick:  markets[x].PriceIncrement,
+			MinAmount:  markets[x].SizeIncrement,
+			AmountStep: markets[x].SizeIncrement,
+		})
+	}
+	return f.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -551,10 +596,14 @@ func (f *FTX) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		return resp, err
 	}
 
+	var reduceOnly string
+	if s.ReduceOnly {
+		reduceOnly = "true"
+	}
 	tempResp, err := f.Order(fPair.String(),
 		s.Side.Lower(),
 		s.Type.Lower(),
-		"",
+		reduceOnly,
 		"",
 		"",
 		s.ClientOrderID,
@@ -1091,3 +1140,329 @@ func (f *FTX) GetHistoricCandlesExtended(p currency.Pair, a asset.Item, start, e
 	}
 	return ret, nil
 }
+
+// GetDerivativePositions returns the open positions for a derivatives asset type
+func (f *FTX) GetDerivativePositions(a asset.Item) ([]position.Position, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, f.Name)
+	}
+	info, err := f.GetAccountInfo()
+	if err != nil {
+		return nil, err
+	}
+	futures, err := f.GetFutures()
+	if err != nil {
+		return nil, err
+	}
+	markPrices := make(map[string]float64, len(futures))
+	for i := range futures {
+		markPrices[futures[i].Name] = futures[i].Mark
+	}
+	var positions []position.Position
+	for i := range info.Positions {
+		if info.Positions[i].Size == 0 {
+			continue
+		}
+		p, err := f.MatchSymbolWithAvailablePairs(info.Positions[i].Future, a)
+		if err != nil {
+			return nil, err
+		}
+		side := order.Long
+		if strings.EqualFold(info.Positions[i].Side, order.Sell.String()) {
+			side = order.Short
+		}
+		positions = append(positions, position.Position{
+			Exchange:         f.Name,
+			Pair:             p,
+			Asset:            a,
+			Side:             side,
+			Size:             info.Positions[i].Size,
+			EntryPrice:       info.Positions[i].EntryPrice,
+			MarkPrice:        markPrices[info.Positions[i].Future],
+			LiquidationPrice: info.Positions[i].EstimatedLiquidationPrice,
+			Leverage:         info.Leverage,
+			UnrealisedPNL:    info.Positions[i].UnrealisedPnL,
+			// all positions share the account's collateral
+			MarginType: position.Cross,
+			UpdatedAt:  time.Now(),
+		})
+	}
+	return positions, nil
+}
+
+// CloseDerivativePosition closes all or part of an open derivatives position
+func (f *FTX) CloseDerivativePosition(c *position.Close) (order.SubmitResponse, error) {
+	positions, err := f.GetDerivativePositions(c.Asset)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	s, err := position.CloseOrder(positions, c)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return f.SubmitOrder(s)
+}
+
+// GetLatestFundingRate returns the current funding rate of a perpetual
+// future, FTX funds hourly and publishes a prediction of the next rate
+func (f *FTX) GetLatestFundingRate(p currency.Pair, a asset.Item) (*fundingrate.LatestRate, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, f.Name)
+	}
+	fPair, err := f.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := f.GetFutureStats(fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	if stats.NextFundingTime.IsZero() {
+		return nil, fmt.Errorf("%s %s %w", f.Name, fPair, errNotPerpetual)
+	}
+	now := time.Now()
+	rates, err := f.GetFundingRates(now.Add(-fundingRateLookback), now, fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	resp := &fundingrate.LatestRate{
+		Exchange:        f.Name,
+		Pair:            p,
+		Asset:           a,
+		PredictedRate:   stats.NextFundingRate,
+		NextFundingTime: stats.NextFundingTime,
+		UpdatedAt:       now,
+	}
+	// rates are returned newest first
+	if len(rates) > 0 {
+		resp.Rate = rates[0].Rate
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistory returns the funding rates applied to a perpetual
+// future over a date range, paging backward from the end date
+func (f *FTX) GetFundingRateHistory(r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if r.Asset != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", r.Asset, f.Name)
+	}
+	fPair, err := f.FormatExchangeCurrency(r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	var rates []fundingrate.Rate
+	for end := r.End; end.After(r.Start); {
+		page, err := f.GetFundingRates(r.Start, end, fPair.String())
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 {
+			break
+		}
+		for i := range page {
+			rates = append(rates, fundingrate.Rate{
+				Time: page[i].Time,
+				Rate: page[i].Rate,
+			})
+		}
+		if len(page) < fundingRateHistoryLimit {
+			break
+		}
+		end = page[len(page)-1].Time.Add(-time.Second)
+	}
+	return r.Collate(f.Name, rates), nil
+}
+
+// GetLeverage returns the leverage and margin type applied to futures
+// orders, FTX leverage applies across the whole account
+func (f *FTX) GetLeverage(p currency.Pair, a asset.Item) (*position.Leverage, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, f.Name)
+	}
+	info, err := f.GetAccountInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &position.Leverage{
+		Exchange:   f.Name,
+		Pair:       p,
+		Asset:      a,
+		Leverage:   info.Leverage,
+		MarginType: position.Cross,
+	}, nil
+}
+
+// SetLeverage sets the leverage applied to futures orders, FTX leverage
+// applies across the whole account
+func (f *FTX) SetLeverage(_ currency.Pair, a asset.Item, leverage float64) error {
+	if a != asset.Futures {
+		return fmt.Errorf("asset type of %s is not supported by %s", a, f.Name)
+	}
+	err := position.ValidateLeverage(leverage)
+	if err != nil {
+		return err
+	}
+	return f.ChangeAccountLeverage(leverage)
+}
+
+// SetMarginType sets whether futures use isolated or cross margin, FTX only
+// supports cross margin
+func (f *FTX) SetMarginType(_ currency.Pair, a asset.Item, m position.MarginType) error {
+	if a != asset.Futures {
+		return fmt.Errorf("asset type of %s is not supported by %s", a, f.Name)
+	}
+	err := m.Validate()
+	if err != nil {
+		return err
+	}
+	if m != position.Cross {
+		return fmt.Errorf("%s %s %w", f.Name, m, position.ErrMarginTypeNotSupported)
+	}
+	return nil
+}
+
+// UpdateDerivativePrice updates and returns the mark price, index price and
+// open interest of a future, the predicted expiration price is only set for
+// dated futures
+func (f *FTX) UpdateDerivativePrice(p currency.Pair, a asset.Item) (*derivative.Price, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, f.Name)
+	}
+	fPair, err := f.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	future, err := f.GetFuture(fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	stats, err := f.GetFutureStats(fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	err = derivative.ProcessPrice(&derivative.Price{
+		ExchangeName:             f.Name,
+		Pair:                     p,
+		AssetType:                a,
+		MarkPrice:                future.Mark,
+		IndexPrice:               future.Index,
+		OpenInterest:             stats.OpenInterest,
+		EstimatedSettlementPrice: stats.PredictedExpirationPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetPrice(f.Name, p, a)
+}
+
+// GetLiquidations returns the trades of a market which were liquidations
+// between the start and end times
+func (f *FTX) GetLiquidations(p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]liquidation.Data, error) {
+	if !startTime.Before(endTime) {
+		return nil, fmt.Errorf("invalid time range supplied. Start: %v End %v",
+			startTime,
+			endTime)
+	}
+	fPair, err := f.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+
+	const limit = 100
+	var resp []liquidation.Data
+	seen := make(map[int64]bool)
+	end := endTime
+	for end.Unix() > startTime.Unix() {
+		var trades []TradeData
+		trades, err = f.GetTrades(fPair.String(), startTime.Unix(), end.Unix(), limit)
+		if err != nil {
+			return nil, err
+		}
+		for i := range trades {
+			if !trades[i].Liquidation || seen[trades[i].ID] {
+				continue
+			}
+			seen[trades[i].ID] = true
+			var side order.Side
+			side, err = order.StringToOrderSide(trades[i].Side)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, liquidation.Data{
+				Exchange:     f.Name,
+				CurrencyPair: p,
+				AssetType:    a,
+				Side:         side,
+				Price:        trades[i].Price,
+				Amount:       trades[i].Size,
+				Timestamp:    trades[i].Time,
+			})
+		}
+		if len(trades) != limit {
+			break
+		}
+		// trades are returned newest first, page back from the oldest
+		oldest := trades[len(trades)-1].Time
+		if !oldest.Before(end) {
+			break
+		}
+		end = oldest
+	}
+
+	err = f.AddLiquidationsToBuffer(resp...)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(liquidation.ByDate(resp))
+	return resp, nil
+}
+
+// GetSubAccounts returns the sub-accounts registered under the master account
+func (f *FTX) GetSubAccounts() ([]account.SubAccountDetails, error) {
+	subs, err := f.GetSubaccounts()
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]account.SubAccountDetails, len(subs))
+	for i := range subs {
+		resp[i] = account.SubAccountDetails{
+			ID:   subs[i].Nickname,
+			Name: subs[i].Nickname,
+		}
+	}
+	return resp, nil
+}
+
+// TransferFunds moves funds between the master account and its sub-accounts,
+// spot and futures share a single wallet on FTX so assets only need to be
+// supported by the exchange
+func (f *FTX) TransferFunds(r *transfer.Request) (*transfer.ExchangeResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if !r.IsSubAccountTransfer() {
+		return nil, fmt.Errorf("%w %s spot and futures share a single wallet", transfer.ErrUnsupportedTransfer, f.Name)
+	}
+	if !f.SupportsAsset(r.FromAsset) {
+		return nil, fmt.Errorf("%w %s wallet %s", transfer.ErrUnsupportedTransfer, f.Name, r.FromAsset)
+	}
+	if !f.SupportsAsset(r.ToAsset) {
+		return nil, fmt.Errorf("%w %s wallet %s", transfer.ErrUnsupportedTransfer, f.Name, r.ToAsset)
+	}
+	resp, err := f.TransferBetweenSubaccounts(r.Currency.String(),
+		r.FromAccount,
+		r.ToAccount,
+		r.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &transfer.ExchangeResponse{
+		Name:   f.Name,
+		ID:     strconv.FormatInt(resp.ID, 10),
+		Status: resp.Status,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package fundingrate
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	fundingratesql "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Validate checks the request can be sent to an exchange
+func (r *HistoricalRatesRequest) Validate() error {
+	if r.Pair.IsEmpty() {
+		return errPairUnset
+	}
+	if !r.Asset.IsDerivative() {
+		return fmt.Errorf("%s %w", r.Asset, errAssetInvalid)
+	}
+	if r.Start.IsZero() || r.End.IsZero() {
+		return errStartEndTimesUnset
+	}
+	if !r.Start.Before(r.End) {
+		return errInvalidTimeRange
+	}
+	return nil
+}
+
+// Collate returns the historical rates for the request from the pages of
+// rates returned by an exchange, rates outside of the requested range are
+// dropped and rates repeated across page boundaries are removed
+func (r *HistoricalRatesRequest) Collate(exchangeName string, rates []Rate) *HistoricalRates {
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Time.Before(rates[j].Time)
+	})
+	resp := &HistoricalRates{
+		Exchange: exchangeName,
+		Pair:     r.Pair,
+		Asset:    r.Asset,
+		Start:    r.Start,
+		End:      r.End,
+	}
+	for i := range rates {
+		if rates[i].Time.Before(r.Start) || rates[i].Time.After(r.End) {
+			continue
+		}
+		if len(resp.Rates) > 0 &&
+			resp.Rates[len(resp.Rates)-1].Time.Equal(rates[i].Time) {
+			continue
+		}
+		resp.Rates = append(resp.Rates, rates[i])
+	}
+	return resp
+}
+
+// StoreInDatabase saves historical funding rates to the database, rates
+// already stored for the same funding time are ignored
+func StoreInDatabase(h *HistoricalRates) error {
+	if h.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if h.Pair.IsEmpty() {
+		return errPairUnset
+	}
+	if !h.Asset.IsDerivative() {
+		return fmt.Errorf("%s %w", h.Asset, errAssetInvalid)
+	}
+	if len(h.Rates) == 0 {
+		return errNoRates
+	}
+	rates := make([]fundingratesql.Data, len(h.Rates))
+	for i := range h.Rates {
+		rates[i] = fundingratesql.Data{
+			Exchange:  h.Exchange,
+			Base:      h.Pair.Base.Upper().String(),
+			Quote:     h.Pair.Quote.Upper().String(),
+			AssetType: h.Asset.String(),
+			Rate:      h.Rates[i].Rate,
+			Timestamp: h.Rates[i].Time,
+		}
+	}
+	return fundingratesql.Insert(rates...)
+}
+
+// LoadFromDatabase returns the funding rates stored for a contract between
+// the start and end dates
+func LoadFromDatabase(exchangeName string, p currency.Pair, a asset.Item, start, end time.Time) (*HistoricalRates, error) {
+	stored, err := fundingratesql.GetInRange(exchangeName,
+		a.String(),
+		p.Base.String(),
+		p.Quote.String(),
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &HistoricalRates{
+		Exchange: exchangeName,
+		Pair:     p,
+		Asset:    a,
+		Start:    start,
+		End:      end,
+		Rates:    make([]Rate, len(stored)),
+	}
+	for i := range stored {
+		resp.Rates[i] = Rate{
+			Time: stored[i].Timestamp,
+			Rate: stored[i].Rate,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package fundingrate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	tester := []struct {
+		Request     HistoricalRatesRequest
+		ExpectedErr error
+	}{
+		{HistoricalRatesRequest{}, errPairUnset},
+		{HistoricalRatesRequest{Pair: p, Asset: asset.Spot}, errAssetInvalid},
+		{HistoricalRatesRequest{Pair: p, Asset: asset.PerpetualSwap}, errStartEndTimesUnset},
+		{HistoricalRatesRequest{Pair: p, Asset: asset.PerpetualSwap, Start: testStart, End: testStart}, errInvalidTimeRange},
+		{HistoricalRatesRequest{Pair: p, Asset: asset.PerpetualSwap, Start: testStart, End: testStart.Add(time.Hour)}, nil},
+	}
+	for i := range tester {
+		err := tester[i].Request.Validate()
+		if !errors.Is(err, tester[i].ExpectedErr) {
+			t.Errorf("test %d: expected %v, received %v", i, tester[i].ExpectedErr, err)
+		}
+	}
+}
+
+func TestCollate(t *testing.T) {
+	t.Parallel()
+	r := HistoricalRatesRequest{
+		Pair:  currency.NewPair(currency.BTC, currency.USD),
+		Asset: asset.PerpetualSwap,
+		Start: testStart,
+		End:   testStart.Add(time.Hour * 16),
+	}
+	h := r.Collate("test", []Rate{
+		{Time: testStart.Add(time.Hour * 16), Rate: 3},
+		{Time: testStart.Add(time.Hour * 8), Rate: 2},
+		{Time: testStart.Add(time.Hour * 24), Rate: 4},
+		{Time: testStart, Rate: 1},
+		{Time: testStart.Add(time.Hour * 8), Rate: 2},
+		{Time: testStart.Add(-time.Hour * 8), Rate: 0},
+	})
+	if h.Exchange != "test" || h.Asset != asset.PerpetualSwap {
+		t.Errorf("unexpected historical rates %+v", h)
+	}
+	if len(h.Rates) != 3 {
+		t.Fatalf("expected 3 rates, received %v", len(h.Rates))
+	}
+	for i := range h.Rates {
+		if h.Rates[i].Rate != float64(i+1) {
+			t.Errorf("expected rate %v, received %v", i+1, h.Rates[i].Rate)
+		}
+	}
+}
+
+func TestStoreInDatabase(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	tester := []struct {
+		Rates       HistoricalRates
+		ExpectedErr error
+	}{
+		{HistoricalRates{}, errExchangeNameUnset},
+		{HistoricalRates{Exchange: "test"}, errPairUnset},
+		{HistoricalRates{Exchange: "test", Pair: p, Asset: asset.Spot}, errAssetInvalid},
+		{HistoricalRates{Exchange: "test", Pair: p, Asset: asset.PerpetualSwap}, errNoRates},
+	}
+	for i := range tester {
+		err := StoreInDatabase(&tester[i].Rates)
+		if !errors.Is(err, tester[i].ExpectedErr) {
+			t.Errorf("test %d: expected %v, received %v", i, tester[i].ExpectedErr, err)
+		}
+	}
+}
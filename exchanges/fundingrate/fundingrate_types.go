@@ -0,0 +1,62 @@
+package fundingrate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	errExchangeNameUnset  = errors.New("exchange name unset")
+	errPairUnset          = errors.New("currency pair unset")
+	errAssetInvalid       = errors.New("asset type is not a derivative")
+	errStartEndTimesUnset = errors.New("start and end dates must be set")
+	errInvalidTimeRange   = errors.New("start date must be before end date")
+	errNoRates            = errors.New("no funding rates to store")
+)
+
+// LatestRate is the current funding state of a perpetual contract
+type LatestRate struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	// Rate is the funding rate which applies at the next funding time, or
+	// the most recently applied rate when the exchange only publishes a
+	// prediction of the next one
+	Rate float64
+	// PredictedRate is the exchange's estimate of the following funding
+	// rate, zero when the exchange does not publish one
+	PredictedRate float64
+	// NextFundingTime is zero when the exchange does not publish it
+	NextFundingTime time.Time
+	UpdatedAt       time.Time
+}
+
+// Rate is a funding rate applied to a perpetual contract at a funding time
+type Rate struct {
+	Time time.Time
+	Rate float64
+}
+
+// HistoricalRates holds the funding rates applied to a perpetual contract
+// over a date range in ascending time order
+type HistoricalRates struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Start    time.Time
+	End      time.Time
+	Rates    []Rate
+}
+
+// HistoricalRatesRequest defines a request for the funding rates applied to
+// a perpetual contract between two dates, exchanges page through their
+// history endpoints until the whole range is covered
+type HistoricalRatesRequest struct {
+	Pair  currency.Pair
+	Asset asset.Item
+	Start time.Time
+	End   time.Time
+}
@@ -73,6 +73,21 @@ func TestGetMarketInfo(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := g.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := g.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.ETH, currency.BTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 {
+		t.Errorf("expected price tick to be loaded, received %+v", l)
+	}
+}
+
 func TestSpotNewOrder(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package gateio
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -227,7 +228,35 @@ func (g *Gateio) UpdateTradablePairs(forceUpdate bool) error {
 	if err != nil {
 		return err
 	}
-	return g.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	err = g.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	return g.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the pair price tick from the market info
+// decimal places, the minimum amount is not loaded as market info does not
+// state whether it is a base or quote currency value
+func (g *Gateio) updateExecutionLimits() error {
+	info, err := g.GetMarketInfo()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(info.Pairs))
+	for x := range info.Pairs {
+		var p currency.Pair
+		p, err = currency.NewPairFromString(info.Pairs[x].Symbol)
+		if err != nil {
+			return err
+		}
+		limits = append(limits, order.Limits{
+			Pair:      p,
+			Asset:     asset.Spot,
+			PriceTick: math.Pow10(-int(info.Pairs[x].DecimalPlaces)),
+		})
+	}
+	return g.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
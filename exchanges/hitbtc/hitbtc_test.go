@@ -118,6 +118,20 @@ func TestGetCurrencies(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	err := h.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := h.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.ETH, currency.BTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 {
+		t.Errorf("expected price tick and amount step to be loaded, received %+v", l)
+	}
+}
+
 func setFeeBuilder() *exchange.FeeBuilder {
 	return &exchange.FeeBuilder{
 		Amount:              1,
@@ -302,7 +302,32 @@ func (h *HitBTC) UpdateTradablePairs(forceUpdate bool) error {
 	if err != nil {
 		return err
 	}
-	return h.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	err = h.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	return h.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the pair execution limits from the symbol
+// increments, the quantity increment is also the minimum order quantity
+func (h *HitBTC) updateExecutionLimits() error {
+	symbols, err := h.GetSymbolsDetailed()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(symbols))
+	for x := range symbols {
+		limits = append(limits, order.Limits{
+			Pair: currency.NewPair(currency.NewCode(symbols[x].BaseCurrency),
+				currency.NewCode(symbols[x].QuoteCurrency)),
+			Asset:      asset.Spot,
+			PriceTick:  symbols[x].TickSize,
+			MinAmount:  symbols[x].QuantityIncrement,
+			AmountStep: symbols[x].QuantityIncrement,
+		})
+	}
+	return h.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
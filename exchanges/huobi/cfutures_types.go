@@ -534,13 +534,13 @@ type LiquidationOrdersData struct {
 		Orders []struct {
 			Symbol       string  `json:"symbol"`
 			ContractCode string  `json:"contract_code"`
-			Direction    string  `json:"buy"`
+			Direction    string  `json:"direction"`
 			Offset       string  `json:"offset"`
 			Volume       float64 `json:"volume"`
 			Price        float64 `json:"price"`
 			CreatedAt    int64   `json:"created_at"`
 		} `json:"orders"`
-		TotalPage   int64 `json:"totalPage"`
+		TotalPage   int64 `json:"total_page"`
 		CurrentPage int64 `json:"current_page"`
 		TotalSize   int64 `json:"total_size"`
 	} `json:"data"`
@@ -550,11 +550,11 @@ type LiquidationOrdersData struct {
 type FundingRatesData struct {
 	EstimatedRate   float64 `json:"estimated_rate,string"`
 	FundingRate     float64 `json:"funding_rate,string"`
-	ContractCode    string  `json:"contractCode"`
+	ContractCode    string  `json:"contract_code"`
 	Symbol          string  `json:"symbol"`
 	FeeAsset        string  `json:"fee_asset"`
-	FundingTime     string  `json:"fundingTime"`
-	NextFundingTime string  `json:"next_funding_time"`
+	FundingTime     int64   `json:"funding_time,string"`
+	NextFundingTime int64   `json:"next_funding_time,string"`
 }
 
 // HistoricalFundingRateData stores historical funding rates for perpetuals
@@ -571,7 +571,7 @@ type HistoricalFundingRateData struct {
 type HistoricalRateData struct {
 	FundingRate     float64 `json:"funding_rate,string"`
 	RealizedRate    float64 `json:"realized_rate,string"`
-	FundingTime     int64   `json:"fundingTime,string"`
+	FundingTime     int64   `json:"funding_time,string"`
 	ContractCode    string  `json:"contract_code"`
 	Symbol          string  `json:"symbol"`
 	FeeAsset        string  `json:"fee_asset"`
@@ -594,6 +594,22 @@ type PremiumIndexKlineData struct {
 	Timestamp int64 `json:"ts"`
 }
 
+// MarkPriceKlineData stores kline data for the mark price
+type MarkPriceKlineData struct {
+	Channel string `json:"ch"`
+	Data    []struct {
+		Volume float64 `json:"vol,string"`
+		Close  float64 `json:"close,string"`
+		Count  float64 `json:"count,string"`
+		High   float64 `json:"high,string"`
+		ID     int64   `json:"id"`
+		Low    float64 `json:"low,string"`
+		Open   float64 `json:"open,string"`
+		Amount float64 `json:"amount,string"`
+	} `json:"data"`
+	Timestamp int64 `json:"ts"`
+}
+
 // EstimatedFundingRateData stores estimated funding rate data
 type EstimatedFundingRateData struct {
 	Channel string `json:"ch"`
@@ -766,6 +782,15 @@ type AvailableLeverageData struct {
 	Timestamp int64 `json:"timestamp"`
 }
 
+// SwapLeverageData stores the leverage rate of a swap contract
+type SwapLeverageData struct {
+	Data struct {
+		ContractCode string `json:"contract_code"`
+		LeverageRate int64  `json:"lever_rate"`
+	} `json:"data"`
+	Timestamp int64 `json:"ts"`
+}
+
 // FinancialRecordData stores an accounts financial records
 type FinancialRecordData struct {
 	Data struct {
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -62,6 +63,9 @@ const (
 	huobiWithdrawCancel        = "dw/withdraw-virtual/%s/cancel"
 	huobiStatusError           = "error"
 	huobiMarginRates           = "margin/loan-info"
+	huobiSubUserList           = "sub-user/user-list"
+	huobiSubUserTransfer       = "subuser/transfer"
+	huobiProFuturesTransfer    = "futures/transfer"
 )
 
 // HUOBI is the overarching type across this package
@@ -584,6 +588,61 @@ func (h *HUOBI) GetOrdersMatch(symbol currency.Pair, types, start, end, from, di
 	return resp.Orders, err
 }
 
+// GetSubUserList returns the sub users registered under the master account
+func (h *HUOBI) GetSubUserList() ([]SubUser, error) {
+	resp := struct {
+		Data []SubUser `json:"data"`
+	}{}
+	err := h.SendAuthenticatedHTTPRequest(exchange.RestSpot, http.MethodGet, huobiSubUserList, nil, nil, &resp, true)
+	return resp.Data, err
+}
+
+// SubUserTransfer transfers spot assets between the master account and a
+// sub user, type is either master-transfer-in or master-transfer-out
+func (h *HUOBI) SubUserTransfer(subUID, currency, transferType string, amount float64) (int64, error) {
+	if !common.StringDataCompare(validSubUserTransferType, transferType) {
+		return 0, fmt.Errorf("invalid transferType received")
+	}
+	data := struct {
+		SubUID   string `json:"sub-uid"`
+		Currency string `json:"currency"`
+		Amount   string `json:"amount"`
+		Type     string `json:"type"`
+	}{
+		SubUID:   subUID,
+		Currency: strings.ToLower(currency),
+		Amount:   strconv.FormatFloat(amount, 'f', -1, 64),
+		Type:     transferType,
+	}
+	resp := struct {
+		TransferID int64 `json:"data"`
+	}{}
+	err := h.SendAuthenticatedHTTPRequest(exchange.RestSpot, http.MethodPost, huobiSubUserTransfer, nil, data, &resp, false)
+	return resp.TransferID, err
+}
+
+// FuturesTransfer transfers assets between the spot and futures accounts,
+// type is either pro-to-futures or futures-to-pro
+func (h *HUOBI) FuturesTransfer(currency, transferType string, amount float64) (int64, error) {
+	if !common.StringDataCompare(validFuturesTransferType, transferType) {
+		return 0, fmt.Errorf("invalid transferType received")
+	}
+	data := struct {
+		Currency string `json:"currency"`
+		Amount   string `json:"amount"`
+		Type     string `json:"type"`
+	}{
+		Currency: strings.ToLower(currency),
+		Amount:   strconv.FormatFloat(amount, 'f', -1, 64),
+		Type:     transferType,
+	}
+	resp := struct {
+		TransferID int64 `json:"data"`
+	}{}
+	err := h.SendAuthenticatedHTTPRequest(exchange.RestSpot, http.MethodPost, huobiProFuturesTransfer, nil, data, &resp, false)
+	return resp.TransferID, err
+}
+
 // MarginTransfer transfers assets into or out of the margin account
 func (h *HUOBI) MarginTransfer(symbol currency.Pair, currency string, amount float64, in bool) (int64, error) {
 	symbolValue, err := h.FormatSymbol(symbol, asset.Spot)
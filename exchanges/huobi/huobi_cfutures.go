@@ -37,6 +37,7 @@ const (
 	huobiSwapLiquidationOrders           = "swap-api/v1/swap_liquidation_orders?"
 	huobiSwapHistoricalFundingRate       = "swap-api/v1/swap_historical_funding_rate?"
 	huobiPremiumIndexKlineData           = "index/market/history/swap_premium_index_kline?"
+	huobiMarkPriceKlineData              = "index/market/history/swap_mark_price_kline?"
 	huobiPredictedFundingRateData        = "index/market/history/swap_estimated_rate_kline?"
 	huobiBasisData                       = "index/market/history/swap_basis?"
 	huobiSwapAccInfo                     = "swap-api/v1/swap_account_info"
@@ -48,6 +49,7 @@ const (
 	huobiSwapFinancialRecords            = "swap-api/v1/swap_financial_record"
 	huobiSwapSettlementRecords           = "swap-api/v1/swap_user_settlement_records"
 	huobiSwapAvailableLeverage           = "swap-api/v1/swap_available_level_rate"
+	huobiSwapSwitchLeverageRate          = "swap-api/v1/swap_switch_lever_rate"
 	huobiSwapOrderLimitInfo              = "swap-api/v1/swap_order_limit"
 	huobiSwapTradingFeeInfo              = "swap-api/v1/swap_fee"
 	huobiSwapTransferLimitInfo           = "swap-api/v1/swap_transfer_limit"
@@ -336,7 +338,7 @@ func (h *HUOBI) GetLiquidationOrders(code currency.Pair, tradeType string, pageI
 		params.Set("page_index", strconv.FormatInt(pageIndex, 10))
 	}
 	if pageSize != 0 {
-		params.Set("page_size", strconv.FormatInt(pageIndex, 10))
+		params.Set("page_size", strconv.FormatInt(pageSize, 10))
 	}
 	return resp, h.SendHTTPRequest(exchange.RestFutures, huobiSwapLiquidationOrders+params.Encode(), &resp)
 }
@@ -354,7 +356,7 @@ func (h *HUOBI) GetHistoricalFundingRates(code currency.Pair, pageSize, pageInde
 		params.Set("page_index", strconv.FormatInt(pageIndex, 10))
 	}
 	if pageSize != 0 {
-		params.Set("page_size", strconv.FormatInt(pageIndex, 10))
+		params.Set("page_size", strconv.FormatInt(pageSize, 10))
 	}
 	return resp, h.SendHTTPRequest(exchange.RestFutures, huobiSwapHistoricalFundingRate+params.Encode(), &resp)
 }
@@ -379,6 +381,27 @@ func (h *HUOBI) GetPremiumIndexKlineData(code currency.Pair, period string, size
 	return resp, h.SendHTTPRequest(exchange.RestFutures, huobiPremiumIndexKlineData+params.Encode(), &resp)
 }
 
+// GetMarkPriceKlineData gets kline data for the mark price of perpetual
+// futures
+func (h *HUOBI) GetMarkPriceKlineData(code currency.Pair, period string, size int64) (MarkPriceKlineData, error) {
+	var resp MarkPriceKlineData
+	params := url.Values{}
+	codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
+	if err != nil {
+		return resp, err
+	}
+	params.Set("contract_code", codeValue)
+	if !common.StringDataCompare(validPeriods, period) {
+		return resp, fmt.Errorf("invalid period value received")
+	}
+	params.Set("period", period)
+	if size <= 0 || size > 1200 {
+		return resp, fmt.Errorf("invalid size provided values from 1-1200 supported")
+	}
+	params.Set("size", strconv.FormatInt(size, 10))
+	return resp, h.SendHTTPRequest(exchange.RestFutures, huobiMarkPriceKlineData+params.Encode(), &resp)
+}
+
 // GetEstimatedFundingRates gets estimated funding rates for perpetual futures
 func (h *HUOBI) GetEstimatedFundingRates(code currency.Pair, period string, size int64) (EstimatedFundingRateData, error) {
 	var resp EstimatedFundingRateData
@@ -426,11 +449,13 @@ func (h *HUOBI) GetBasisData(code currency.Pair, period, basisPriceType string,
 func (h *HUOBI) GetSwapAccountInfo(code currency.Pair) (SwapAccountInformation, error) {
 	var resp SwapAccountInformation
 	req := make(map[string]interface{})
-	codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
-	if err != nil {
-		return resp, err
+	if code != (currency.Pair{}) {
+		codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
+		if err != nil {
+			return resp, err
+		}
+		req["contract_code"] = codeValue
 	}
-	req["contract_code"] = codeValue
 	return resp, h.FuturesAuthenticatedHTTPRequest(exchange.RestFutures, http.MethodPost, huobiSwapAccInfo, nil, req, &resp)
 }
 
@@ -438,11 +463,13 @@ func (h *HUOBI) GetSwapAccountInfo(code currency.Pair) (SwapAccountInformation,
 func (h *HUOBI) GetSwapPositionsInfo(code currency.Pair) (SwapPositionInfo, error) {
 	var resp SwapPositionInfo
 	req := make(map[string]interface{})
-	codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
-	if err != nil {
-		return resp, err
+	if code != (currency.Pair{}) {
+		codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
+		if err != nil {
+			return resp, err
+		}
+		req["contract_code"] = codeValue
 	}
-	req["contract_code"] = codeValue
 	return resp, h.FuturesAuthenticatedHTTPRequest(exchange.RestFutures, http.MethodPost, huobiSwapPosInfo, nil, req, &resp)
 }
 
@@ -561,6 +588,19 @@ func (h *HUOBI) GetAvailableLeverage(code currency.Pair) (AvailableLeverageData,
 	return resp, h.FuturesAuthenticatedHTTPRequest(exchange.RestFutures, http.MethodPost, huobiSwapAvailableLeverage, nil, req, &resp)
 }
 
+// SwitchSwapLeverageRate switches the leverage rate of a swap contract
+func (h *HUOBI) SwitchSwapLeverageRate(code currency.Pair, leverageRate int64) (SwapLeverageData, error) {
+	var resp SwapLeverageData
+	req := make(map[string]interface{})
+	codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
+	if err != nil {
+		return resp, err
+	}
+	req["contract_code"] = codeValue
+	req["lever_rate"] = leverageRate
+	return resp, h.FuturesAuthenticatedHTTPRequest(exchange.RestFutures, http.MethodPost, huobiSwapSwitchLeverageRate, nil, req, &resp)
+}
+
 // GetSwapOrderLimitInfo gets order limit info for swaps
 func (h *HUOBI) GetSwapOrderLimitInfo(code currency.Pair, orderType string) (SwapOrderLimitInfo, error) {
 	var resp SwapOrderLimitInfo
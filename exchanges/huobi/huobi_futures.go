@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
@@ -77,6 +78,17 @@ const (
 	fTriggerOrderHistory       = "api/v1/contract_trigger_hisorders"
 )
 
+// futuresDeliveryHour is the UTC hour futures contracts are delivered
+const futuresDeliveryHour = 8
+
+// futuresContractTypes maps futures contract types to their alias
+var futuresContractTypes = map[string]contract.Type{
+	"this_week":    contract.ThisWeek,
+	"next_week":    contract.NextWeek,
+	"quarter":      contract.ThisQuarter,
+	"next_quarter": contract.NextQuarter,
+}
+
 // FGetContractInfo gets contract info for futures
 func (h *HUOBI) FGetContractInfo(symbol, contractType string, code currency.Pair) (FContractInfoData, error) {
 	var resp FContractInfoData
@@ -414,7 +426,7 @@ func (h *HUOBI) FLiquidationOrders(symbol, tradeType string, pageIndex, pageSize
 		params.Set("page_index", strconv.FormatInt(pageIndex, 10))
 	}
 	if pageSize != 0 {
-		params.Set("page_size", strconv.FormatInt(pageIndex, 10))
+		params.Set("page_size", strconv.FormatInt(pageSize, 10))
 	}
 	path := fLiquidationOrders + params.Encode()
 	return resp, h.SendHTTPRequest(exchange.RestFutures, path, &resp)
@@ -481,8 +493,8 @@ func (h *HUOBI) FGetAccountInfo(symbol currency.Code) (FUserAccountData, error)
 }
 
 // FGetPositionsInfo gets positions info for futures account
-func (h *HUOBI) FGetPositionsInfo(symbol currency.Code) (FUserAccountData, error) {
-	var resp FUserAccountData
+func (h *HUOBI) FGetPositionsInfo(symbol currency.Code) (FUsersPositionsInfo, error) {
+	var resp FUsersPositionsInfo
 	req := make(map[string]interface{})
 	if symbol != (currency.Code{}) {
 		codeValue, err := h.formatFuturesCode(symbol)
@@ -1,6 +1,8 @@
 package huobi
 
 import (
+	"context"
+	"errors"
 	"log"
 	"os"
 	"strconv"
@@ -14,10 +16,13 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -69,7 +74,7 @@ func setupWsTests(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = h.wsLogin()
+	err = h.wsLogin(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -401,6 +406,56 @@ func TestFTransfer(t *testing.T) {
 	}
 }
 
+func TestGetSubAccounts(t *testing.T) {
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	t.Parallel()
+	_, err := h.GetSubAccounts()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := h.TransferFunds(&transfer.Request{
+		Exchange:    h.Name,
+		Currency:    currency.HT,
+		Amount:      1,
+		FromAccount: "154263566",
+		ToAccount:   "154263567",
+		FromAsset:   asset.Spot,
+		ToAsset:     asset.Spot,
+	})
+	if !errors.Is(err, transfer.ErrUnsupportedTransfer) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrUnsupportedTransfer)
+	}
+	_, err = h.TransferFunds(&transfer.Request{
+		Exchange:  h.Name,
+		Currency:  currency.HT,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.CoinMarginedFutures,
+	})
+	if !errors.Is(err, transfer.ErrUnsupportedTransfer) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrUnsupportedTransfer)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	_, err = h.TransferFunds(&transfer.Request{
+		Exchange:  h.Name,
+		Currency:  currency.HT,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Futures,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestFGetTransferRecords(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("skipping test: api keys not set")
@@ -970,6 +1025,18 @@ func TestGetHistoricalFundingRates(t *testing.T) {
 	}
 }
 
+func TestGetMarkPriceKlineData(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = h.GetMarkPriceKlineData(cp, "5min", 15)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetPremiumIndexKlineData(t *testing.T) {
 	t.Parallel()
 	cp, err := currency.NewPairFromString("BTC-USD")
@@ -1075,6 +1142,164 @@ func TestGetSwapPositionsInfo(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetDerivativePositions(asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = h.GetDerivativePositions(asset.CoinMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = h.GetDerivativePositions(asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetLeverage(cp, asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = h.GetLeverage(cp, asset.CoinMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.SetLeverage(cp, asset.CoinMarginedFutures, 2.5)
+	if err == nil {
+		t.Error("expected an error for fractional leverage")
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = h.SetLeverage(cp, asset.CoinMarginedFutures, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	err := h.SetMarginType(currency.Pair{}, asset.CoinMarginedFutures, position.Isolated)
+	if !errors.Is(err, position.ErrMarginTypeNotSupported) {
+		t.Errorf("expected %v, received %v", position.ErrMarginTypeNotSupported, err)
+	}
+	err = h.SetMarginType(currency.Pair{}, asset.CoinMarginedFutures, position.Cross)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetLatestFundingRate(cp, asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = h.GetLatestFundingRate(cp, asset.CoinMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateContracts(t *testing.T) {
+	t.Parallel()
+	_, err := h.UpdateContracts(asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = h.UpdateContracts(asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateDerivativePrice(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.UpdateDerivativePrice(cp, asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = h.UpdateDerivativePrice(cp, asset.CoinMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLiquidations(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetLiquidations(cp, asset.CoinMarginedFutures, time.Now(), time.Now().Add(-time.Hour))
+	if err == nil {
+		t.Error("expected an error for an invalid time range")
+	}
+	_, err = h.GetLiquidations(cp, asset.Spot, time.Now().Add(-time.Hour), time.Now())
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = h.GetLiquidations(cp, asset.CoinMarginedFutures, time.Now().Add(-time.Hour), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetFundingRateHistory(&fundingrate.HistoricalRatesRequest{
+		Pair:  cp,
+		Asset: asset.CoinMarginedFutures,
+		Start: time.Now().Add(-time.Hour * 24 * 7),
+		End:   time.Now(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDirectionToPositionSide(t *testing.T) {
+	t.Parallel()
+	if directionToPositionSide("buy") != order.Long ||
+		directionToPositionSide("sell") != order.Short {
+		t.Error("unexpected position side")
+	}
+}
+
 func TestGetSwapAssetsAndPositions(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("skipping test: api keys not set")
@@ -1165,6 +1390,21 @@ func TestGetAvailableLeverage(t *testing.T) {
 	}
 }
 
+func TestSwitchSwapLeverageRate(t *testing.T) {
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	t.Parallel()
+	cp, err := currency.NewPairFromString("ETH-USD")
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = h.SwitchSwapLeverageRate(cp, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetSwapOrderLimitInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("skipping test: api keys not set")
@@ -1630,6 +1870,21 @@ func TestGetSymbols(t *testing.T) {
 	}
 }
 
+func TestUpdateSpotExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := h.updateSpotExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := h.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 {
+		t.Errorf("expected price tick and amount step to be loaded, received %+v", l)
+	}
+}
+
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
 	_, err := h.GetCurrencies()
@@ -2154,7 +2409,7 @@ func TestWsSubResponse(t *testing.T) {
   "ts": 1489474081631,
   "topic": "accounts"
 }`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2175,7 +2430,7 @@ func TestWsKline(t *testing.T) {
     "vol": 0.0
   }
 }`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2188,7 +2443,7 @@ func TestWsUnsubscribe(t *testing.T) {
   "unsubbed": "market.btcusdt.trade.detail",
   "ts": 1494326028889
 }`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2221,7 +2476,7 @@ func TestWsKlineArray(t *testing.T) {
     }
   ]
 }`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2244,7 +2499,7 @@ func TestWsMarketDepth(t *testing.T) {
     "ts": 1572362902012
   }
 }`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2263,7 +2518,7 @@ func TestWsBestBidOffer(t *testing.T) {
 		"askSize": "0.3"
 	  }
 	}`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2288,7 +2543,7 @@ func TestWsTradeDetail(t *testing.T) {
 			]
 	  }
 	}`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2310,7 +2565,7 @@ func TestWsTicker(t *testing.T) {
 		"vol":    121906001.754751
 	  }
 }`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2333,7 +2588,7 @@ func TestWsAccountUpdate(t *testing.T) {
 		]
 	  }
 	}`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2363,7 +2618,7 @@ func TestWsOrderUpdate(t *testing.T) {
     "filled-fees": "8.000000000000000000"
   }
 }`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2375,7 +2630,7 @@ func TestWsSubsbOp(t *testing.T) {
 	  "topic": "accounts",
 	  "cid": "123"
 	}`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2386,7 +2641,7 @@ func TestWsSubsbOp(t *testing.T) {
 	  "ts": 1489474081631,
 	  "topic": "accounts"
 	}`)
-	err = h.wsHandleData(pressXToJSON)
+	err = h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2405,7 +2660,7 @@ func TestWsMarketByPrice(t *testing.T) {
 			]
 		}
 	}`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2431,7 +2686,7 @@ func TestWsMarketByPrice(t *testing.T) {
 			]
 		}
 	}`)
-	err = h.wsHandleData(pressXToJSON)
+	err = h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -2456,7 +2711,7 @@ func TestWsOrdersUpdate(t *testing.T) {
 			"order-type": "buy-limit"
 	}
 	}`)
-	err := h.wsHandleData(pressXToJSON)
+	err := h.wsHandleData(h.Websocket.Conn, pressXToJSON)
 	if err != nil {
 		t.Error(err)
 	}
@@ -3,6 +3,7 @@ package huobi
 import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 )
 
 type errorCapture struct {
@@ -441,6 +442,12 @@ type ResponseV2 struct {
 	Message string `json:"message"`
 }
 
+// SubUser stores a sub user registered under the master account
+type SubUser struct {
+	UID       int64  `json:"uid"`
+	UserState string `json:"userState"`
+}
+
 // SwapMarketsData stores market data for swaps
 type SwapMarketsData struct {
 	Symbol         string  `json:"symbol"`
@@ -856,8 +863,9 @@ type WsAuthenticationRequest struct {
 
 // WsMessage defines read data from the websocket connection
 type WsMessage struct {
-	Raw []byte
-	URL string
+	Raw  []byte
+	URL  string
+	Conn stream.Connection
 }
 
 // WsAuthenticatedSubscriptionRequest request for subscription on authenticated connection
@@ -1051,6 +1059,10 @@ type OrderVars struct {
 	Fee       float64
 }
 
+// fundingRateHistoryPageSize is the maximum number of funding rates returned
+// per historical funding rate page
+const fundingRateHistoryPageSize = 50
+
 // Variables below are used to check api requests being sent out
 
 var (
@@ -1067,6 +1079,14 @@ var (
 		"master_to_sub", "sub_to_master",
 	}
 
+	validSubUserTransferType = []string{
+		"master-transfer-in", "master-transfer-out",
+	}
+
+	validFuturesTransferType = []string{
+		"pro-to-futures", "futures-to-pro",
+	}
+
 	validTradeTypes = map[string]int64{
 		"filled": 0,
 		"closed": 5,
@@ -1,6 +1,7 @@
 package huobi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -50,8 +51,12 @@ const (
 	requestOp        = "req"
 	authOp           = "auth"
 
-	loginDelay = 50 * time.Millisecond
-	rateLimit  = 20
+	rateLimit = 20
+
+	// maxWSTopicsPerConnection is the number of market topics a single
+	// connection carries, further subscriptions are made on pooled
+	// connections
+	maxWSTopicsPerConnection = 100
 )
 
 // Instantiates a communications channel between websocket connections
@@ -67,6 +72,7 @@ func (h *HUOBI) WsConnect() error {
 	if err != nil {
 		return err
 	}
+	go h.wsReadData()
 	err = h.wsAuthenticatedDial(&dialer)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
@@ -74,7 +80,9 @@ func (h *HUOBI) WsConnect() error {
 			h.Name,
 			err)
 	}
-	err = h.wsLogin()
+	ctx, cancel := h.Websocket.RequestContext()
+	defer cancel()
+	err = h.wsLogin(ctx)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%v - authentication failed: %v\n",
@@ -82,8 +90,6 @@ func (h *HUOBI) WsConnect() error {
 			err)
 		h.Websocket.SetCanUseAuthenticatedEndpoints(false)
 	}
-
-	go h.wsReadData()
 	return nil
 }
 
@@ -96,6 +102,19 @@ func (h *HUOBI) wsDial(dialer *websocket.Dialer) error {
 	return nil
 }
 
+// wsConnectPooled dials an additional connection for market topics which do
+// not fit on the standard connection, pings are answered on the connection
+// they arrive on
+func (h *HUOBI) wsConnectPooled(conn stream.Connection) error {
+	var dialer websocket.Dialer
+	err := conn.Dial(&dialer, http.Header{})
+	if err != nil {
+		return err
+	}
+	go h.wsFunnelConnectionData(conn, wsMarketURL)
+	return nil
+}
+
 func (h *HUOBI) wsAuthenticatedDial(dialer *websocket.Dialer) error {
 	if !h.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
 		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled",
@@ -119,7 +138,7 @@ func (h *HUOBI) wsFunnelConnectionData(ws stream.Connection, url string) {
 		if resp.Raw == nil {
 			return
 		}
-		comms <- WsMessage{Raw: resp.Raw, URL: url}
+		comms <- WsMessage{Raw: resp.Raw, URL: url, Conn: ws}
 	}
 }
 
@@ -129,7 +148,7 @@ func (h *HUOBI) wsReadData() {
 	defer h.Websocket.Wg.Done()
 	for {
 		resp := <-comms
-		err := h.wsHandleData(resp.Raw)
+		err := h.wsHandleData(resp.Conn, resp.Raw)
 		if err != nil {
 			h.Websocket.DataHandler <- err
 		}
@@ -176,7 +195,7 @@ func stringToOrderType(oType string) (order.Type, error) {
 		errors.New(oType + " not recognised as order type")
 }
 
-func (h *HUOBI) wsHandleData(respRaw []byte) error {
+func (h *HUOBI) wsHandleData(conn stream.Connection, respRaw []byte) error {
 	var init WsResponse
 	err := json.Unmarshal(respRaw, &init)
 	if err != nil {
@@ -190,7 +209,7 @@ func (h *HUOBI) wsHandleData(respRaw []byte) error {
 		return nil
 	}
 	if init.Ping != 0 {
-		h.sendPingResponse(init.Ping)
+		h.sendPingResponse(conn, init.Ping)
 		return nil
 	}
 
@@ -206,6 +225,19 @@ func (h *HUOBI) wsHandleData(respRaw []byte) error {
 		return nil
 	}
 
+	// responses are matched before errors are handled so that requests
+	// receive their own errors
+	if strings.EqualFold(init.Op, authOp) &&
+		h.Websocket.Match.IncomingWithData(authOp, respRaw) {
+		return nil
+	}
+
+	if init.ClientID > 0 {
+		if h.Websocket.Match.IncomingWithData(init.ClientID, respRaw) {
+			return nil
+		}
+	}
+
 	if init.ErrorMessage != "" {
 		if init.ErrorMessage == "api-signature-not-valid" {
 			h.Websocket.SetCanUseAuthenticatedEndpoints(false)
@@ -217,16 +249,9 @@ func (h *HUOBI) wsHandleData(respRaw []byte) error {
 		return errors.New(h.Name + " Code:" + codes + " Message:" + init.ErrorMessage)
 	}
 
-	if init.ClientID > 0 {
-		if h.Websocket.Match.IncomingWithData(init.ClientID, respRaw) {
-			return nil
-		}
-	}
-
 	switch {
 	case strings.EqualFold(init.Op, authOp):
-		h.Websocket.SetCanUseAuthenticatedEndpoints(true)
-		// Auth captured
+		// Auth captured after its login request timed out
 		return nil
 	case strings.EqualFold(init.Topic, "accounts"):
 		var response WsAuthenticatedAccountsResponse
@@ -419,8 +444,8 @@ func (h *HUOBI) wsHandleData(respRaw []byte) error {
 	return nil
 }
 
-func (h *HUOBI) sendPingResponse(pong int64) {
-	err := h.Websocket.Conn.SendJSONMessage(WsPong{Pong: pong})
+func (h *HUOBI) sendPingResponse(conn stream.Connection, pong int64) {
+	err := conn.SendJSONMessage(WsPong{Pong: pong})
 	if err != nil {
 		log.Error(log.ExchangeSys, err)
 	}
@@ -504,6 +529,28 @@ func (h *HUOBI) GenerateDefaultSubscriptions() ([]stream.ChannelSubscription, er
 
 // Subscribe sends a websocket message to receive data from the channel
 func (h *HUOBI) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
+	err := h.SubscribeConnection(h.Websocket.Conn, channelsToSubscribe)
+	if err != nil {
+		return err
+	}
+	h.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe...)
+	return nil
+}
+
+// Unsubscribe sends a websocket message to stop receiving data from the channel
+func (h *HUOBI) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
+	err := h.UnsubscribeConnection(h.Websocket.Conn, channelsToUnsubscribe)
+	if err != nil {
+		return err
+	}
+	h.Websocket.RemoveSuccessfulUnsubscriptions(channelsToUnsubscribe...)
+	return nil
+}
+
+// SubscribeConnection subscribes to a set of channels on a specific
+// connection, account and order channels are always sent on the
+// authenticated connection
+func (h *HUOBI) SubscribeConnection(conn stream.Connection, channelsToSubscribe []stream.ChannelSubscription) error {
 	var errs common.Errors
 	for i := range channelsToSubscribe {
 		if strings.Contains(channelsToSubscribe[i].Channel, "orders.") ||
@@ -513,19 +560,15 @@ func (h *HUOBI) Subscribe(channelsToSubscribe []stream.ChannelSubscription) erro
 				channelsToSubscribe[i].Channel)
 			if err != nil {
 				errs = append(errs, err)
-				continue
 			}
-			h.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe[i])
 			continue
 		}
-		err := h.Websocket.Conn.SendJSONMessage(WsRequest{
+		err := conn.SendJSONMessage(WsRequest{
 			Subscribe: channelsToSubscribe[i].Channel,
 		})
 		if err != nil {
 			errs = append(errs, err)
-			continue
 		}
-		h.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe[i])
 	}
 	if errs != nil {
 		return errs
@@ -533,8 +576,10 @@ func (h *HUOBI) Subscribe(channelsToSubscribe []stream.ChannelSubscription) erro
 	return nil
 }
 
-// Unsubscribe sends a websocket message to stop receiving data from the channel
-func (h *HUOBI) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
+// UnsubscribeConnection unsubscribes from a set of channels on a specific
+// connection, account and order channels are always sent on the
+// authenticated connection
+func (h *HUOBI) UnsubscribeConnection(conn stream.Connection, channelsToUnsubscribe []stream.ChannelSubscription) error {
 	var errs common.Errors
 	for i := range channelsToUnsubscribe {
 		if strings.Contains(channelsToUnsubscribe[i].Channel, "orders.") ||
@@ -544,19 +589,15 @@ func (h *HUOBI) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription)
 				channelsToUnsubscribe[i].Channel)
 			if err != nil {
 				errs = append(errs, err)
-				continue
 			}
-			h.Websocket.RemoveSuccessfulUnsubscriptions(channelsToUnsubscribe[i])
 			continue
 		}
-		err := h.Websocket.Conn.SendJSONMessage(WsRequest{
+		err := conn.SendJSONMessage(WsRequest{
 			Unsubscribe: channelsToUnsubscribe[i].Channel,
 		})
 		if err != nil {
 			errs = append(errs, err)
-			continue
 		}
-		h.Websocket.RemoveSuccessfulUnsubscriptions(channelsToUnsubscribe[i])
 	}
 	if errs != nil {
 		return errs
@@ -576,7 +617,7 @@ func (h *HUOBI) wsGenerateSignature(timestamp, endpoint string) []byte {
 	return crypto.GetHMAC(crypto.HashSHA256, []byte(payload), []byte(h.API.Credentials.Secret))
 }
 
-func (h *HUOBI) wsLogin() error {
+func (h *HUOBI) wsLogin(ctx context.Context) error {
 	if !h.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
 		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", h.Name)
 	}
@@ -591,13 +632,26 @@ func (h *HUOBI) wsLogin() error {
 	}
 	hmac := h.wsGenerateSignature(timestamp, wsAccountsOrdersEndPoint)
 	request.Signature = crypto.Base64Encode(hmac)
-	err := h.Websocket.AuthConn.SendJSONMessage(request)
+	// A repeated login is harmless, so a lost response is retried once
+	resp, err := h.Websocket.AuthConn.SendMessageReturnResponses(ctx,
+		authOp,
+		request,
+		stream.RequestConfig{Retries: 1})
 	if err != nil {
 		h.Websocket.SetCanUseAuthenticatedEndpoints(false)
 		return err
 	}
-
-	time.Sleep(loginDelay)
+	var response WsResponse
+	err = json.Unmarshal(resp[0], &response)
+	if err != nil {
+		h.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		return err
+	}
+	if response.ErrorMessage != "" {
+		h.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		codes, _ := response.ErrorCode.(string)
+		return errors.New(h.Name + " Code:" + codes + " Message:" + response.ErrorMessage)
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package huobi
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,15 +16,21 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -206,6 +213,10 @@ func (h *HUOBI) Setup(exch *config.ExchangeConfig) error {
 		Features:                         &h.Features.Supports.WebsocketCapabilities,
 		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
+		MaxSubscriptionsPerConnection:    maxWSTopicsPerConnection,
+		ConnectionDialer:                 h.wsConnectPooled,
+		ConnectionSubscriber:             h.SubscribeConnection,
+		ConnectionUnsubscriber:           h.UnsubscribeConnection,
 	})
 	if err != nil {
 		return err
@@ -399,6 +410,10 @@ func (h *HUOBI) UpdateTradablePairs(forceUpdate bool) error {
 	if err != nil {
 		return err
 	}
+	err = h.updateSpotExecutionLimits()
+	if err != nil {
+		return err
+	}
 
 	futuresPairs, err := h.FetchTradablePairs(asset.Futures)
 	if err != nil {
@@ -424,6 +439,40 @@ func (h *HUOBI) UpdateTradablePairs(forceUpdate bool) error {
 	return h.UpdatePairs(cp, asset.CoinMarginedFutures, false, forceUpdate)
 }
 
+// updateSpotExecutionLimits loads the spot pair execution limits, Huobi
+// returns the price and amount precision as decimal places
+func (h *HUOBI) updateSpotExecutionLimits() error {
+	symbols, err := h.GetSymbols()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(symbols))
+	for x := range symbols {
+		if symbols[x].State != "online" {
+			continue
+		}
+		minAmount := symbols[x].LimitOrderMinOrderAmt
+		if minAmount == 0 {
+			minAmount = symbols[x].MinOrderAmt
+		}
+		maxAmount := symbols[x].LimitOrderMaxOrderAmt
+		if maxAmount == 0 {
+			maxAmount = symbols[x].MaxOrderAmt
+		}
+		limits = append(limits, order.Limits{
+			Pair: currency.NewPair(currency.NewCode(symbols[x].BaseCurrency),
+				currency.NewCode(symbols[x].QuoteCurrency)),
+			Asset:       asset.Spot,
+			PriceTick:   math.Pow10(-int(symbols[x].PricePrecision)),
+			MinAmount:   minAmount,
+			MaxAmount:   maxAmount,
+			AmountStep:  math.Pow10(-int(symbols[x].AmountPrecision)),
+			MinNotional: symbols[x].MinOrderValue,
+		})
+	}
+	return h.LoadExecutionLimits(limits)
+}
+
 // UpdateTicker updates and returns the ticker for a currency pair
 func (h *HUOBI) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	if !h.SupportsAsset(assetType) {
@@ -857,6 +906,8 @@ func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 			oType = "limit"
 		case order.PostOnly:
 			oType = "post_only"
+		case order.Market:
+			oType = "opponent"
 		}
 		order, err := h.PlaceSwapOrders(s.Pair, s.ClientOrderID, oDirection, s.Offset, oType, s.Price, s.Amount, s.Leverage)
 		if err != nil {
@@ -878,6 +929,8 @@ func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 			oType = "limit"
 		case order.PostOnly:
 			oType = "post_only"
+		case order.Market:
+			oType = "opponent"
 		}
 		order, err := h.FOrder(s.Pair, "", "", s.ClientOrderID, oDirection, s.Offset, oType, s.Price, s.Amount, s.Leverage)
 		if err != nil {
@@ -1551,7 +1604,9 @@ func setOrderSideAndType(requestType string, orderDetail *order.Detail) {
 
 // AuthenticateWebsocket sends an authentication message to the websocket
 func (h *HUOBI) AuthenticateWebsocket() error {
-	return h.wsLogin()
+	ctx, cancel := h.Websocket.RequestContext()
+	defer cancel()
+	return h.wsLogin(ctx)
 }
 
 // ValidateCredentials validates current credentials used for wrapper
@@ -1661,3 +1716,545 @@ func compatibleVars(side, orderPriceType string, status int64) (OrderVars, error
 	}
 	return resp, nil
 }
+
+// GetDerivativePositions returns the open positions for a derivatives asset type
+func (h *HUOBI) GetDerivativePositions(a asset.Item) ([]position.Position, error) {
+	var positions []position.Position
+	switch a {
+	case asset.CoinMarginedFutures:
+		accounts, err := h.GetSwapAccountInfo(currency.Pair{})
+		if err != nil {
+			return nil, err
+		}
+		liquidationPrices := make(map[string]float64, len(accounts.Data))
+		for i := range accounts.Data {
+			liquidationPrices[accounts.Data[i].ContractCode] = accounts.Data[i].LiquidationPrice
+		}
+		resp, err := h.GetSwapPositionsInfo(currency.Pair{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.Data {
+			if resp.Data[i].Volume == 0 {
+				continue
+			}
+			p, err := h.MatchSymbolWithAvailablePairs(resp.Data[i].ContractCode, a)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, position.Position{
+				Exchange:         h.Name,
+				Pair:             p,
+				Asset:            a,
+				Side:             directionToPositionSide(resp.Data[i].Direction),
+				Size:             resp.Data[i].Volume,
+				EntryPrice:       resp.Data[i].CostOpen,
+				MarkPrice:        resp.Data[i].LastPrice,
+				LiquidationPrice: liquidationPrices[resp.Data[i].ContractCode],
+				Leverage:         resp.Data[i].LeverRate,
+				UnrealisedPNL:    resp.Data[i].ProfitUnreal,
+				MarginType:       position.Cross,
+				UpdatedAt:        time.Now(),
+			})
+		}
+	case asset.Futures:
+		accounts, err := h.FGetAccountInfo(currency.Code{})
+		if err != nil {
+			return nil, err
+		}
+		// contracts of the same underlying share margin and liquidation price
+		liquidationPrices := make(map[string]float64, len(accounts.AccData))
+		for i := range accounts.AccData {
+			liquidationPrices[accounts.AccData[i].Symbol] = accounts.AccData[i].LiquidationPrice
+		}
+		resp, err := h.FGetPositionsInfo(currency.Code{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.PosInfo {
+			if resp.PosInfo[i].Volume == 0 {
+				continue
+			}
+			p, err := h.MatchSymbolWithAvailablePairs(resp.PosInfo[i].ContractCode, a)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, position.Position{
+				Exchange:         h.Name,
+				Pair:             p,
+				Asset:            a,
+				Side:             directionToPositionSide(resp.PosInfo[i].Direction),
+				Size:             resp.PosInfo[i].Volume,
+				EntryPrice:       resp.PosInfo[i].CostOpen,
+				MarkPrice:        resp.PosInfo[i].LastPrice,
+				LiquidationPrice: liquidationPrices[resp.PosInfo[i].Symbol],
+				Leverage:         resp.PosInfo[i].LeverageRate,
+				UnrealisedPNL:    resp.PosInfo[i].ProfitUnreal,
+				MarginType:       position.Cross,
+				UpdatedAt:        time.Unix(0, resp.Timestamp*int64(time.Millisecond)),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, h.Name)
+	}
+	return positions, nil
+}
+
+// CloseDerivativePosition closes all or part of an open derivatives position
+func (h *HUOBI) CloseDerivativePosition(c *position.Close) (order.SubmitResponse, error) {
+	positions, err := h.GetDerivativePositions(c.Asset)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	s, err := position.CloseOrder(positions, c)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return h.SubmitOrder(s)
+}
+
+// directionToPositionSide converts the direction of a position, buy for a long
+// position and sell for a short position
+func directionToPositionSide(direction string) order.Side {
+	if strings.EqualFold(direction, order.Sell.String()) {
+		return order.Short
+	}
+	return order.Long
+}
+
+// GetLatestFundingRate returns the current funding rate of a perpetual swap,
+// Huobi's funding rate applies at the coming funding time and the estimated
+// rate at the funding time after
+func (h *HUOBI) GetLatestFundingRate(p currency.Pair, a asset.Item) (*fundingrate.LatestRate, error) {
+	if a != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, h.Name)
+	}
+	resp, err := h.GetSwapFundingRates(p)
+	if err != nil {
+		return nil, err
+	}
+	return &fundingrate.LatestRate{
+		Exchange:        h.Name,
+		Pair:            p,
+		Asset:           a,
+		Rate:            resp.FundingRate,
+		PredictedRate:   resp.EstimatedRate,
+		NextFundingTime: time.Unix(0, resp.FundingTime*int64(time.Millisecond)),
+		UpdatedAt:       time.Now(),
+	}, nil
+}
+
+// GetFundingRateHistory returns the funding rates applied to a perpetual
+// swap over a date range, pages are returned newest first so paging stops
+// once the start date is reached
+func (h *HUOBI) GetFundingRateHistory(r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if r.Asset != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", r.Asset, h.Name)
+	}
+	var rates []fundingrate.Rate
+	for page := int64(1); ; page++ {
+		resp, err := h.GetHistoricalFundingRates(r.Pair, fundingRateHistoryPageSize, page)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.Data.Data {
+			rates = append(rates, fundingrate.Rate{
+				Time: time.Unix(0, resp.Data.Data[i].FundingTime*int64(time.Millisecond)),
+				Rate: resp.Data.Data[i].FundingRate,
+			})
+		}
+		if len(resp.Data.Data) == 0 ||
+			page >= resp.Data.TotalPage ||
+			rates[len(rates)-1].Time.Before(r.Start) {
+			break
+		}
+	}
+	return r.Collate(h.Name, rates), nil
+}
+
+// GetLeverage returns the leverage and margin type applied to orders in a
+// coin margined swap
+func (h *HUOBI) GetLeverage(p currency.Pair, a asset.Item) (*position.Leverage, error) {
+	if a != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, h.Name)
+	}
+	fPair, err := h.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := h.GetSwapAccountInfo(p)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.Data {
+		if !strings.EqualFold(resp.Data[i].ContractCode, fPair.String()) {
+			continue
+		}
+		return &position.Leverage{
+			Exchange:   h.Name,
+			Pair:       p,
+			Asset:      a,
+			Leverage:   resp.Data[i].LeverageRate,
+			MarginType: position.Cross,
+		}, nil
+	}
+	return nil, fmt.Errorf("%s %s %s %w", h.Name, a, p, position.ErrLeverageNotFound)
+}
+
+// SetLeverage sets the leverage applied to orders in a coin margined swap
+func (h *HUOBI) SetLeverage(p currency.Pair, a asset.Item, leverage float64) error {
+	if a != asset.CoinMarginedFutures {
+		return fmt.Errorf("asset type of %s is not supported by %s", a, h.Name)
+	}
+	l, err := position.WholeLeverage(leverage)
+	if err != nil {
+		return err
+	}
+	_, err = h.SwitchSwapLeverageRate(p, l)
+	return err
+}
+
+// SetMarginType sets whether a coin margined swap uses isolated or cross
+// margin, Huobi coin margined swaps only support cross margin
+func (h *HUOBI) SetMarginType(_ currency.Pair, a asset.Item, m position.MarginType) error {
+	if a != asset.CoinMarginedFutures {
+		return fmt.Errorf("asset type of %s is not supported by %s", a, h.Name)
+	}
+	err := m.Validate()
+	if err != nil {
+		return err
+	}
+	if m != position.Cross {
+		return fmt.Errorf("%s %s %w", h.Name, m, position.ErrMarginTypeNotSupported)
+	}
+	return nil
+}
+
+// UpdateDerivativePrice updates and returns the mark price, index price and
+// open interest of a contract. Huobi only publishes mark prices for
+// perpetual swaps and estimated delivery prices for dated futures.
+func (h *HUOBI) UpdateDerivativePrice(p currency.Pair, a asset.Item) (*derivative.Price, error) {
+	resp := &derivative.Price{
+		ExchangeName: h.Name,
+		Pair:         p,
+		AssetType:    a,
+	}
+	switch a {
+	case asset.CoinMarginedFutures:
+		index, err := h.QuerySwapIndexPriceInfo(p)
+		if err != nil {
+			return nil, err
+		}
+		if len(index.Data) != 1 {
+			return nil, fmt.Errorf("%s %s %w", a, p, derivative.ErrNoPriceData)
+		}
+		marks, err := h.GetMarkPriceKlineData(p, "5min", 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(marks.Data) != 1 {
+			return nil, fmt.Errorf("%s %s %w", a, p, derivative.ErrNoPriceData)
+		}
+		interest, err := h.SwapOpenInterestInformation(p)
+		if err != nil {
+			return nil, err
+		}
+		if len(interest.Data) != 1 {
+			return nil, fmt.Errorf("%s %s %w", a, p, derivative.ErrNoPriceData)
+		}
+		resp.IndexPrice = index.Data[0].IndexPrice
+		resp.MarkPrice = marks.Data[0].Close
+		resp.OpenInterest = interest.Data[0].Volume
+		resp.LastUpdated = time.Unix(0, index.Data[0].IndexTimestamp*int64(time.Millisecond))
+	case asset.Futures:
+		index, err := h.FIndexPriceInfo(p.Base)
+		if err != nil {
+			return nil, err
+		}
+		if len(index.Data) != 1 {
+			return nil, fmt.Errorf("%s %s %w", a, p, derivative.ErrNoPriceData)
+		}
+		interest, err := h.FContractOpenInterest("", "", p)
+		if err != nil {
+			return nil, err
+		}
+		if len(interest.Data) != 1 {
+			return nil, fmt.Errorf("%s %s %w", a, p, derivative.ErrNoPriceData)
+		}
+		delivery, err := h.FGetEstimatedDeliveryPrice(p.Base)
+		if err != nil {
+			return nil, err
+		}
+		resp.IndexPrice = index.Data[0].IndexPrice
+		resp.OpenInterest = interest.Data[0].Volume
+		resp.EstimatedSettlementPrice = delivery.Data.DeliveryPrice
+		resp.LastUpdated = time.Unix(0, index.Timestamp*int64(time.Millisecond))
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, h.Name)
+	}
+	err := derivative.ProcessPrice(resp)
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetPrice(h.Name, p, a)
+}
+
+// GetLiquidations returns the filled liquidation orders of a contract between
+// the start and end times. Huobi only retains liquidation orders for the last
+// 90 days.
+func (h *HUOBI) GetLiquidations(p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]liquidation.Data, error) {
+	if !startTime.Before(endTime) {
+		return nil, fmt.Errorf("invalid time range supplied. Start: %v End %v",
+			startTime,
+			endTime)
+	}
+	createDate := int64(7)
+	if time.Since(startTime) > time.Hour*24*7 {
+		createDate = 90
+	}
+
+	const pageSize = 50
+	var resp []liquidation.Data
+	// add appends an order within the time range and reports whether older
+	// orders may remain, orders are returned newest first
+	add := func(direction string, price, volume float64, createdAt int64) (bool, error) {
+		ts := time.Unix(0, createdAt*int64(time.Millisecond))
+		if ts.Before(startTime) {
+			return false, nil
+		}
+		if ts.After(endTime) {
+			return true, nil
+		}
+		side, err := order.StringToOrderSide(direction)
+		if err != nil {
+			return false, err
+		}
+		resp = append(resp, liquidation.Data{
+			Exchange:     h.Name,
+			CurrencyPair: p,
+			AssetType:    a,
+			Side:         side,
+			Price:        price,
+			Amount:       volume,
+			Timestamp:    ts,
+		})
+		return true, nil
+	}
+
+	switch a {
+	case asset.CoinMarginedFutures:
+		for page := int64(1); ; page++ {
+			orders, err := h.GetLiquidationOrders(p, "filled", page, pageSize, createDate)
+			if err != nil {
+				return nil, err
+			}
+			more := true
+			for i := range orders.Data.Orders {
+				more, err = add(orders.Data.Orders[i].Direction,
+					orders.Data.Orders[i].Price,
+					orders.Data.Orders[i].Volume,
+					orders.Data.Orders[i].CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				if !more {
+					break
+				}
+			}
+			if !more || page >= orders.Data.TotalPage {
+				break
+			}
+		}
+	case asset.Futures:
+		contractCode, err := h.FormatSymbol(p, a)
+		if err != nil {
+			return nil, err
+		}
+		for page := int64(1); ; page++ {
+			orders, err := h.FLiquidationOrders(p.Base.Upper().String(), "filled", page, pageSize, createDate)
+			if err != nil {
+				return nil, err
+			}
+			more := true
+			for i := range orders.Data.Orders {
+				// orders are returned for every contract of the symbol
+				if !strings.EqualFold(orders.Data.Orders[i].ContractCode, contractCode) {
+					continue
+				}
+				more, err = add(orders.Data.Orders[i].Direction,
+					orders.Data.Orders[i].Price,
+					orders.Data.Orders[i].Volume,
+					orders.Data.Orders[i].CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				if !more {
+					break
+				}
+			}
+			if !more || page >= orders.Data.TotalPage {
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, h.Name)
+	}
+
+	err := h.AddLiquidationsToBuffer(resp...)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(liquidation.ByDate(resp))
+	return resp, nil
+}
+
+// UpdateContracts refreshes the contract metadata of futures and coin
+// margined swaps and rolls enabled futures pairs to the contract now listed
+// under their alias
+func (h *HUOBI) UpdateContracts(a asset.Item) ([]contract.Roll, error) {
+	var contracts []contract.Contract
+	switch a {
+	case asset.Futures:
+		symbols, err := h.FGetContractInfo("", "", currency.Pair{})
+		if err != nil {
+			return nil, err
+		}
+		for x := range symbols.Data {
+			if symbols.Data[x].ContractStatus != 1 {
+				continue
+			}
+			t, ok := futuresContractTypes[symbols.Data[x].ContractType]
+			if !ok {
+				t = contract.Unknown
+			}
+			p, err := currency.NewPairFromString(symbols.Data[x].ContractCode)
+			if err != nil {
+				return nil, err
+			}
+			delivery, err := time.Parse("20060102", symbols.Data[x].DeliveryDate)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s delivery date cannot be parsed: %w",
+					h.Name,
+					symbols.Data[x].ContractCode,
+					err)
+			}
+			code := currency.NewCode(symbols.Data[x].Symbol)
+			contracts = append(contracts, contract.Contract{
+				Exchange:           h.Name,
+				Pair:               p,
+				Asset:              a,
+				Underlying:         currency.NewPair(code, currency.USD),
+				Type:               t,
+				Expiry:             delivery.Add(futuresDeliveryHour * time.Hour),
+				SettlementCurrency: code,
+			})
+		}
+	case asset.CoinMarginedFutures:
+		symbols, err := h.GetSwapMarkets(currency.Pair{})
+		if err != nil {
+			return nil, err
+		}
+		for x := range symbols {
+			if symbols[x].ContractStatus != 1 {
+				continue
+			}
+			p, err := currency.NewPairFromString(symbols[x].ContractCode)
+			if err != nil {
+				return nil, err
+			}
+			contracts = append(contracts, contract.Contract{
+				Exchange:           h.Name,
+				Pair:               p,
+				Asset:              a,
+				Underlying:         p,
+				Type:               contract.Perpetual,
+				SettlementCurrency: currency.NewCode(symbols[x].Symbol),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, h.Name)
+	}
+	return h.LoadContracts(a, contracts)
+}
+
+// GetSubAccounts returns the sub-accounts registered under the master account
+func (h *HUOBI) GetSubAccounts() ([]account.SubAccountDetails, error) {
+	users, err := h.GetSubUserList()
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]account.SubAccountDetails, len(users))
+	for i := range users {
+		uid := strconv.FormatInt(users[i].UID, 10)
+		resp[i] = account.SubAccountDetails{
+			ID:       uid,
+			Name:     uid,
+			Disabled: users[i].UserState == "lock",
+		}
+	}
+	return resp, nil
+}
+
+// TransferFunds moves funds between the spot and futures accounts of the
+// master account or between the master account and a sub user's spot or
+// futures account
+func (h *HUOBI) TransferFunds(r *transfer.Request) (*transfer.ExchangeResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	code := r.Currency.String()
+	resp := &transfer.ExchangeResponse{Name: h.Name}
+	switch {
+	case r.FromAccount != "" && r.ToAccount != "":
+		return nil, fmt.Errorf("%w %s only supports transfers between the master account and sub users",
+			transfer.ErrUnsupportedTransfer,
+			h.Name)
+	case r.IsSubAccountTransfer() && r.FromAsset != r.ToAsset:
+		return nil, fmt.Errorf("%w %s sub user transfers must use the same wallet",
+			transfer.ErrUnsupportedTransfer,
+			h.Name)
+	case r.IsSubAccountTransfer() && r.FromAsset == asset.Spot:
+		transferType, subUID := "master-transfer-out", r.ToAccount
+		if r.FromAccount != "" {
+			transferType, subUID = "master-transfer-in", r.FromAccount
+		}
+		id, err := h.SubUserTransfer(subUID, code, transferType, r.Amount)
+		if err != nil {
+			return nil, err
+		}
+		resp.ID = strconv.FormatInt(id, 10)
+	case r.IsSubAccountTransfer() && r.FromAsset == asset.Futures:
+		transferType, subUID := "master_to_sub", r.ToAccount
+		if r.FromAccount != "" {
+			transferType, subUID = "sub_to_master", r.FromAccount
+		}
+		result, err := h.FTransfer(subUID, code, transferType, r.Amount)
+		if err != nil {
+			return nil, err
+		}
+		resp.ID = result.Data.OrderID
+		resp.Status = result.Status
+	case !r.IsSubAccountTransfer() && r.FromAsset == asset.Spot && r.ToAsset == asset.Futures,
+		!r.IsSubAccountTransfer() && r.FromAsset == asset.Futures && r.ToAsset == asset.Spot:
+		transferType := "pro-to-futures"
+		if r.FromAsset == asset.Futures {
+			transferType = "futures-to-pro"
+		}
+		id, err := h.FuturesTransfer(code, transferType, r.Amount)
+		if err != nil {
+			return nil, err
+		}
+		resp.ID = strconv.FormatInt(id, 10)
+	default:
+		return nil, fmt.Errorf("%w %s %s to %s",
+			transfer.ErrUnsupportedTransfer,
+			h.Name,
+			r.FromAsset,
+			r.ToAsset)
+	}
+	return resp, nil
+}
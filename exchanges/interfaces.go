@@ -8,12 +8,20 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/option"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -73,6 +81,29 @@ type IBotExchange interface {
 	SupportsAsset(assetType asset.Item) bool
 	GetHistoricCandles(p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
 	GetHistoricCandlesExtended(p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
+	GetDerivativePositions(a asset.Item) ([]position.Position, error)
+	CloseDerivativePosition(c *position.Close) (order.SubmitResponse, error)
+	GetLatestFundingRate(p currency.Pair, a asset.Item) (*fundingrate.LatestRate, error)
+	GetFundingRateHistory(r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error)
+	UpdateDerivativePrice(p currency.Pair, a asset.Item) (*derivative.Price, error)
+	GetLiquidations(p currency.Pair, a asset.Item, startTime, endTime time.Time) ([]liquidation.Data, error)
+	GetOptionChain(underlying currency.Code) (*option.Chain, error)
+	GetLeverage(p currency.Pair, a asset.Item) (*position.Leverage, error)
+	SetLeverage(p currency.Pair, a asset.Item, leverage float64) error
+	SetMarginType(p currency.Pair, a asset.Item, m position.MarginType) error
+	Borrow(b *lending.Borrow) (string, error)
+	Repay(r *lending.Repay) (string, error)
+	GetLoans() ([]lending.Loan, error)
+	OfferFunds(o *lending.Offer) (string, error)
+	CancelFundingOffer(id string) error
+	GetOrderExecutionLimits(a asset.Item, p currency.Pair) (order.Limits, error)
+	GetCurrentServerTime() (time.Time, error)
+	UpdateContracts(a asset.Item) ([]contract.Roll, error)
+	GetContract(a asset.Item, p currency.Pair) (contract.Contract, error)
+	GetContracts(a asset.Item) ([]contract.Contract, error)
+	GetRolloverContract(a asset.Item, p currency.Pair) (contract.Contract, error)
+	GetSubAccounts() ([]account.SubAccountDetails, error)
+	TransferFunds(r *transfer.Request) (*transfer.ExchangeResponse, error)
 	DisableRateLimiter() error
 	EnableRateLimiter() error
 
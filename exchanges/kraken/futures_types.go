@@ -2,6 +2,7 @@ package kraken
 
 import (
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -10,6 +11,7 @@ var (
 	validOrderTypes = map[order.Type]string{
 		order.ImmediateOrCancel: "ioc",
 		order.Limit:             "lmt",
+		order.Market:            "mkt",
 		order.Stop:              "stp",
 		order.PostOnly:          "post",
 		order.TakeProfit:        "take_profit",
@@ -297,6 +299,16 @@ type FuturesTickerData struct {
 	ServerTime string `json:"serverTime"`
 }
 
+// FuturesHistoricFundingRates stores the funding rates applied to a
+// perpetual futures contract
+type FuturesHistoricFundingRates struct {
+	Rates []struct {
+		Timestamp           time.Time `json:"timestamp"`
+		FundingRate         float64   `json:"fundingRate"`
+		RelativeFundingRate float64   `json:"relativeFundingRate"`
+	} `json:"rates"`
+}
+
 // FuturesEditedOrderData stores an edited order's data
 type FuturesEditedOrderData struct {
 	ServerTime string `json:"serverTime"`
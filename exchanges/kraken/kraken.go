@@ -34,6 +34,9 @@ const (
 type Kraken struct {
 	exchange.Base
 	wsRequestMtx sync.Mutex
+
+	wsChecksumMtx      sync.Mutex
+	wsChecksumDecimals map[currency.Pair]checksumDecimals
 }
 
 // GetServerTime returns current server time
@@ -532,6 +535,28 @@ func (k *Kraken) Withdraw(asset, key string, amount float64) (string, error) {
 	return response.ReferenceID, GetError(response.Error)
 }
 
+// WalletTransfer transfers an asset from the spot wallet to the futures
+// wallet
+func (k *Kraken) WalletTransfer(asset string, amount float64) (string, error) {
+	var response struct {
+		Error  []string `json:"error"`
+		Result struct {
+			ReferenceID string `json:"refid"`
+		} `json:"result"`
+	}
+	params := url.Values{}
+	params.Set("asset", asset)
+	params.Set("from", "Spot Wallet")
+	params.Set("to", "Futures Wallet")
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+
+	if err := k.SendAuthenticatedHTTPRequest(exchange.RestSpot, krakenWalletTransfer, params, &response); err != nil {
+		return response.Result.ReferenceID, err
+	}
+
+	return response.Result.ReferenceID, GetError(response.Error)
+}
+
 // GetDepositMethods gets withdrawal fees
 func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
 	var response struct {
@@ -44,6 +44,19 @@ func (k *Kraken) GetFuturesTickers() (FuturesTickerData, error) {
 	return resp, k.SendHTTPRequest(exchange.RestFutures, futuresTickers, &resp)
 }
 
+// GetFuturesHistoricFundingRates gets the funding rates applied to a
+// perpetual futures contract
+func (k *Kraken) GetFuturesHistoricFundingRates(symbol currency.Pair) (FuturesHistoricFundingRates, error) {
+	var resp FuturesHistoricFundingRates
+	params := url.Values{}
+	symbolValue, err := k.FormatSymbol(symbol, asset.Futures)
+	if err != nil {
+		return resp, err
+	}
+	params.Set("symbol", symbolValue)
+	return resp, k.SendHTTPRequest(exchange.RestFutures, futuresHistoricFundingRates+"?"+params.Encode(), &resp)
+}
+
 // GetFuturesTradeHistory gets public trade history data for futures
 func (k *Kraken) GetFuturesTradeHistory(symbol currency.Pair, lastTime time.Time) (FuturesTradeHistoryData, error) {
 	var resp FuturesTradeHistoryData
@@ -167,7 +180,7 @@ func (k *Kraken) FuturesTransfer(fromAccount, toAccount, unit string, amount flo
 	req["toAccount"] = toAccount
 	req["unit"] = unit
 	req["amount"] = amount
-	return resp, k.SendFuturesAuthRequest(http.MethodPost, futuresTransfer, nil, nil, &resp)
+	return resp, k.SendFuturesAuthRequest(http.MethodPost, futuresTransfer, nil, req, &resp)
 }
 
 // FuturesGetOpenPositions gets futures platform's notifications
@@ -1,6 +1,8 @@
 package kraken
 
 import (
+	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -16,11 +18,13 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -225,6 +229,54 @@ func TestFuturesGetOpenPositions(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetDerivativePositions(asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = k.GetDerivativePositions(asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("PI_XBTUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = k.GetLatestFundingRate(cp, asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = k.GetLatestFundingRate(cp, asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("PI_XBTUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = k.GetFundingRateHistory(&fundingrate.HistoricalRatesRequest{
+		Pair:  cp,
+		Asset: asset.Futures,
+		Start: time.Now().Add(-time.Hour * 24 * 7),
+		End:   time.Now(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestFuturesNotifications(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("skipping test: api keys not set")
@@ -310,6 +362,41 @@ func TestFuturesWithdrawToSpotWallet(t *testing.T) {
 	}
 }
 
+func TestGetSubAccounts(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetSubAccounts()
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := k.TransferFunds(&transfer.Request{
+		Exchange:  k.Name,
+		Currency:  currency.XBT,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Margin,
+	})
+	if !errors.Is(err, transfer.ErrUnsupportedTransfer) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrUnsupportedTransfer)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders")
+	}
+	_, err = k.TransferFunds(&transfer.Request{
+		Exchange:  k.Name,
+		Currency:  currency.XBT,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Futures,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestFuturesGetTransfers(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("skipping test: api keys not set")
@@ -449,6 +536,27 @@ func TestGetAssetPairs(t *testing.T) {
 	}
 }
 
+func TestUpdateSpotExecutionLimits(t *testing.T) {
+	t.Parallel()
+	if !assetTranslator.Seeded() {
+		err := k.SeedAssets()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := k.updateSpotExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := k.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.XBT, currency.USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 || l.MinAmount == 0 {
+		t.Errorf("expected price tick, amount step and minimum amount to be loaded, received %+v", l)
+	}
+}
+
 // TestGetTicker API endpoint test
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
@@ -1147,7 +1255,7 @@ func TestGetWSToken(t *testing.T) {
 
 func TestWsAddOrder(t *testing.T) {
 	setupWsTests(t)
-	_, err := k.wsAddOrder(&WsAddOrderRequest{
+	_, err := k.wsAddOrder(context.Background(), &WsAddOrderRequest{
 		OrderType: order.Limit.Lower(),
 		OrderSide: order.Buy.Lower(),
 		Pair:      "XBT/USD",
@@ -1160,7 +1268,7 @@ func TestWsAddOrder(t *testing.T) {
 
 func TestWsCancelOrder(t *testing.T) {
 	setupWsTests(t)
-	err := k.wsCancelOrders([]string{"1337"})
+	err := k.wsCancelOrders(context.Background(), []string{"1337"})
 	if err != nil {
 		t.Error(err)
 	}
@@ -1168,7 +1276,7 @@ func TestWsCancelOrder(t *testing.T) {
 
 func TestWsCancelAllOrders(t *testing.T) {
 	setupWsTests(t)
-	_, err := k.wsCancelAllOrders()
+	_, err := k.wsCancelAllOrders(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -2002,8 +2110,15 @@ func TestChecksumCalculation(t *testing.T) {
 		t.Fatalf("expected %s but received %s", expected, v)
 	}
 
-	err := validateCRC32(&testOb, krakenAPIDocChecksum, 5, 8)
+	check, err := calculateCRC32(&testOb, 5, 8)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if check != krakenAPIDocChecksum {
+		t.Errorf("expected checksum %d, received %d", krakenAPIDocChecksum, check)
+	}
+}
+
+func TestReplayWebsocketCapture(t *testing.T) {
+	sharedtestvalues.ReplayWebsocketCapture(t, k.Name, k.Websocket, k.wsHandleData)
 }
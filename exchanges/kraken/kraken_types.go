@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"errors"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -38,6 +39,7 @@ const (
 	krakenWithdrawStatus   = "WithdrawStatus"
 	krakenWithdrawCancel   = "WithdrawCancel"
 	krakenWebsocketToken   = "GetWebSocketsToken"
+	krakenWalletTransfer   = "WalletTransfer"
 
 	// Futures
 	futuresTickers      = "/api/v3/tickers"
@@ -45,6 +47,8 @@ const (
 	futuresInstruments  = "/api/v3/instruments"
 	futuresTradeHistory = "/api/v3/history"
 
+	futuresHistoricFundingRates = "/api/v4/historicalfundingrates"
+
 	futuresSendOrder         = "/api/v3/sendorder"
 	futuresCancelOrder       = "/api/v3/cancelorder"
 	futuresOrderFills        = "/api/v3/fills"
@@ -73,6 +77,8 @@ const (
 
 var (
 	assetTranslator assetTranslatorStore
+
+	errTickerNotFound = errors.New("ticker not found")
 )
 
 // GenericResponse stores general response data for functions that only return success
@@ -707,3 +713,10 @@ type OrderVars struct {
 	OrderType order.Type
 	Fee       float64
 }
+
+// checksumDecimals holds the decimal places of a pair's prices and amounts
+// as last sent by the websocket, used to format orderbook checksums
+type checksumDecimals struct {
+	price  int
+	amount int
+}
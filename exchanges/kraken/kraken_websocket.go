@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,7 +9,6 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -72,14 +72,6 @@ var defaultSubscribedChannels = []string{
 	krakenWsSpread}
 var authenticatedChannels = []string{krakenWsOwnTrades, krakenWsOpenOrders}
 
-var cancelOrdersStatusMutex sync.Mutex
-var cancelOrdersStatus = make(map[int64]*struct {
-	Total        int    // total count of orders in wsCancelOrders request
-	Successful   int    // numbers of Successfully canceled orders in wsCancelOrders request
-	Unsuccessful int    // numbers of Unsuccessfully canceled orders in wsCancelOrders request
-	Error        string // if at least one of requested order return fail, store error here
-})
-
 // WsConnect initiates a websocket connection
 func (k *Kraken) WsConnect() error {
 	if !k.Websocket.IsEnabled() || !k.IsEnabled() {
@@ -170,26 +162,6 @@ func (k *Kraken) wsReadData(comms chan stream.Response) {
 	}
 }
 
-// awaitForCancelOrderResponses used to wait until all responses will received for appropriate CancelOrder request
-// success param = was the response from Kraken successful or not
-func isAwaitingCancelOrderResponses(requestID int64, success bool) bool {
-	cancelOrdersStatusMutex.Lock()
-	if stat, ok := cancelOrdersStatus[requestID]; ok {
-		if success {
-			cancelOrdersStatus[requestID].Successful++
-		} else {
-			cancelOrdersStatus[requestID].Unsuccessful++
-		}
-
-		if stat.Successful+stat.Unsuccessful != stat.Total {
-			cancelOrdersStatusMutex.Unlock()
-			return true
-		}
-	}
-	cancelOrdersStatusMutex.Unlock()
-	return false
-}
-
 func (k *Kraken) wsHandleData(respRaw []byte) error {
 	if strings.HasPrefix(string(respRaw), "[") {
 		var dataResponse WebsocketDataResponse
@@ -232,23 +204,8 @@ func (k *Kraken) wsHandleData(respRaw []byte) error {
 						respRaw)
 				}
 
-				success := true
-				if status.Status == "error" {
-					success = false
-					cancelOrdersStatusMutex.Lock()
-					if _, ok := cancelOrdersStatus[status.RequestID]; ok {
-						if cancelOrdersStatus[status.RequestID].Error == "" { // save the first error, if any
-							cancelOrdersStatus[status.RequestID].Error = status.ErrorMessage
-						}
-					}
-					cancelOrdersStatusMutex.Unlock()
-				}
-
-				if isAwaitingCancelOrderResponses(status.RequestID, success) {
-					return nil
-				}
-
-				// all responses handled, return results stored in cancelOrdersStatus
+				// a status is sent for each order cancelled, errors are
+				// collated by wsCancelOrders
 				if status.RequestID > 0 && !k.Websocket.Match.IncomingWithData(status.RequestID, respRaw) {
 					return fmt.Errorf("can't send ws incoming data to Matched channel with RequestID: %d",
 						status.RequestID)
@@ -341,16 +298,21 @@ func (k *Kraken) wsHandleData(respRaw []byte) error {
 						err,
 						respRaw)
 				}
-				if sub.Status != "subscribed" && sub.Status != "unsubscribed" {
+				failed := sub.Status != "subscribed" && sub.Status != "unsubscribed"
+				if !failed {
+					k.addNewSubscriptionChannelData(&sub)
+				}
+				if sub.RequestID > 0 {
+					// errors are matched too so the request completes and
+					// reports them
+					k.Websocket.Match.IncomingWithData(sub.RequestID, respRaw)
+				}
+				if failed {
 					return fmt.Errorf("%v %v %v",
 						k.Name,
 						sub.RequestID,
 						sub.ErrorMessage)
 				}
-				k.addNewSubscriptionChannelData(&sub)
-				if sub.RequestID > 0 {
-					k.Websocket.Match.IncomingWithData(sub.RequestID, respRaw)
-				}
 			default:
 				k.Websocket.DataHandler <- stream.UnhandledMessageWarning{
 					Message: k.Name + stream.UnhandledMessage + string(respRaw),
@@ -770,6 +732,10 @@ func (k *Kraken) wsProcessOrderBook(channelData *WebsocketChannelData, data map[
 			defer k.wsRequestMtx.Unlock()
 			err := k.wsProcessOrderBookUpdate(channelData, askData, bidData, checksum)
 			if err != nil {
+				if errors.Is(err, buffer.ErrChecksumMismatch) {
+					// the buffer resyncs the orderbook via REST
+					return err
+				}
 				go func(resub *stream.ChannelSubscription) {
 					// This was locking the main websocket reader routine and a
 					// backlog occurred. So put this into it's own go routine.
@@ -990,35 +956,50 @@ func (k *Kraken) wsProcessOrderBookUpdate(channelData *WebsocketChannelData, ask
 		}
 	}
 	update.UpdateTime = highestLastUpdate
-	err := k.Websocket.Orderbook.Update(&update)
+
+	token, err := strconv.ParseInt(checksum, 10, 64)
 	if err != nil {
 		return err
 	}
+	update.Checksum = uint32(token)
 
-	book := k.Websocket.Orderbook.GetOrderbook(channelData.Pair, asset.Spot)
-	if book == nil {
-		return fmt.Errorf("cannot calculate websocket checksum: book not found for %s %s",
-			channelData.Pair,
-			asset.Spot)
+	k.wsChecksumMtx.Lock()
+	if k.wsChecksumDecimals == nil {
+		k.wsChecksumDecimals = make(map[currency.Pair]checksumDecimals)
 	}
+	k.wsChecksumDecimals[channelData.Pair] = checksumDecimals{
+		price:  priceDP,
+		amount: amtDP,
+	}
+	k.wsChecksumMtx.Unlock()
+	return k.Websocket.Orderbook.Update(&update)
+}
 
-	token, err := strconv.ParseInt(checksum, 10, 64)
+// orderbookChecksum calculates the checksum of the websocket orderbook
+// buffer for verification of updates, using the decimal places of the last
+// update received for the pair
+func (k *Kraken) orderbookChecksum(b *orderbook.Base) uint32 {
+	k.wsChecksumMtx.Lock()
+	dp := k.wsChecksumDecimals[b.Pair]
+	k.wsChecksumMtx.Unlock()
+	check, err := calculateCRC32(b, dp.price, dp.amount)
 	if err != nil {
-		return err
+		log.Errorf(log.WebsocketMgr, "%s %v", k.Name, err)
+		return 0
 	}
-
-	return validateCRC32(book, uint32(token), priceDP, amtDP)
+	return check
 }
 
-func validateCRC32(b *orderbook.Base, token uint32, decPrice, decAmount int) error {
+// calculateCRC32 calculates the checksum of the top ten bids and asks
+func calculateCRC32(b *orderbook.Base, decPrice, decAmount int) (uint32, error) {
 	if len(b.Asks) < 10 || len(b.Bids) < 10 {
-		return fmt.Errorf("%s %s insufficient bid and asks to calculate checksum",
+		return 0, fmt.Errorf("%s %s insufficient bid and asks to calculate checksum",
 			b.Pair,
 			b.AssetType)
 	}
 
 	if decPrice == 0 || decAmount == 0 {
-		return fmt.Errorf("%s %s trailing decimal count not calculated", b.Pair,
+		return 0, fmt.Errorf("%s %s trailing decimal count not calculated", b.Pair,
 			b.AssetType)
 	}
 
@@ -1037,14 +1018,7 @@ func validateCRC32(b *orderbook.Base, token uint32, decPrice, decAmount int) err
 		checkStr.WriteString(amountStr)
 	}
 
-	if check := crc32.ChecksumIEEE([]byte(checkStr.String())); check != token {
-		return fmt.Errorf("%s %s invalid checksum %d, expected %d",
-			b.Pair,
-			b.AssetType,
-			check,
-			token)
-	}
-	return nil
+	return crc32.ChecksumIEEE([]byte(checkStr.String())), nil
 }
 
 // trim removes '.' and prefixed '0' from subsequent string
@@ -1186,11 +1160,13 @@ channels:
 		*s = append(*s, outbound)
 	}
 
+	ctx, cancel := k.Websocket.RequestContext()
+	defer cancel()
 	var errs common.Errors
 	for subType, subs := range subscriptions {
 		for i := range *subs {
 			if common.StringDataContains(authenticatedChannels, (*subs)[i].Subscription.Name) {
-				_, err := k.Websocket.AuthConn.SendMessageReturnResponse((*subs)[i].RequestID, (*subs)[i])
+				err := k.sendSubscriptionRequest(ctx, k.Websocket.AuthConn, &(*subs)[i])
 				if err != nil {
 					errs = append(errs, err)
 					continue
@@ -1205,7 +1181,7 @@ channels:
 				// imposed and requests are batched in allotments of 20 items.
 				time.Sleep(time.Second)
 			}
-			_, err := k.Websocket.Conn.SendMessageReturnResponse((*subs)[i].RequestID, (*subs)[i])
+			err := k.sendSubscriptionRequest(ctx, k.Websocket.Conn, &(*subs)[i])
 			if err != nil {
 				errs = append(errs, err)
 				continue
@@ -1258,10 +1234,12 @@ channels:
 		unsubs = append(unsubs, unsub)
 	}
 
+	ctx, cancel := k.Websocket.RequestContext()
+	defer cancel()
 	var errs common.Errors
 	for i := range unsubs {
 		if common.StringDataContains(authenticatedChannels, unsubs[i].Subscription.Name) {
-			_, err := k.Websocket.AuthConn.SendMessageReturnResponse(unsubs[i].RequestID, unsubs[i])
+			err := k.sendSubscriptionRequest(ctx, k.Websocket.AuthConn, &unsubs[i])
 			if err != nil {
 				errs = append(errs, err)
 				continue
@@ -1270,7 +1248,7 @@ channels:
 			continue
 		}
 
-		_, err := k.Websocket.Conn.SendMessageReturnResponse(unsubs[i].RequestID, unsubs[i])
+		err := k.sendSubscriptionRequest(ctx, k.Websocket.Conn, &unsubs[i])
 		if err != nil {
 			errs = append(errs, err)
 			continue
@@ -1283,18 +1261,52 @@ channels:
 	return nil
 }
 
+// sendSubscriptionRequest sends a subscription event and waits for the status
+// Kraken sends for each of its pairs, returning the first error status
+func (k *Kraken) sendSubscriptionRequest(ctx context.Context, conn stream.Connection, r *WebsocketSubscriptionEventRequest) error {
+	var responses int
+	for i := range r.Pairs {
+		if r.Pairs[i] != "" {
+			responses++
+		}
+	}
+	resp, err := conn.SendMessageReturnResponses(ctx,
+		r.RequestID,
+		r,
+		stream.RequestConfig{Responses: responses})
+	if err != nil {
+		return err
+	}
+	for i := range resp {
+		var sub wsSubscription
+		err = json.Unmarshal(resp[i], &sub)
+		if err != nil {
+			return err
+		}
+		if sub.Status != "subscribed" && sub.Status != "unsubscribed" {
+			return fmt.Errorf("%v %v %v", k.Name, sub.RequestID, sub.ErrorMessage)
+		}
+	}
+	return nil
+}
+
 // wsAddOrder creates an order, returned order ID if success
-func (k *Kraken) wsAddOrder(request *WsAddOrderRequest) (string, error) {
+func (k *Kraken) wsAddOrder(ctx context.Context, request *WsAddOrderRequest) (string, error) {
 	id := k.Websocket.AuthConn.GenerateMessageID(false)
 	request.RequestID = id
 	request.Event = krakenWsAddOrder
 	request.Token = authToken
-	jsonResp, err := k.Websocket.AuthConn.SendMessageReturnResponse(id, request)
+	// Resending an order whose response was lost could place it twice, so it
+	// is never retried
+	jsonResp, err := k.Websocket.AuthConn.SendMessageReturnResponses(ctx,
+		id,
+		request,
+		stream.RequestConfig{})
 	if err != nil {
 		return "", err
 	}
 	var resp WsAddOrderResponse
-	err = json.Unmarshal(jsonResp, &resp)
+	err = json.Unmarshal(jsonResp[0], &resp)
 	if err != nil {
 		return "", err
 	}
@@ -1305,7 +1317,7 @@ func (k *Kraken) wsAddOrder(request *WsAddOrderRequest) (string, error) {
 }
 
 // wsCancelOrders cancels one or more open orders passed in orderIDs param
-func (k *Kraken) wsCancelOrders(orderIDs []string) error {
+func (k *Kraken) wsCancelOrders(ctx context.Context, orderIDs []string) error {
 	id := k.Websocket.AuthConn.GenerateMessageID(false)
 	request := WsCancelOrderRequest{
 		Event:          krakenWsCancelOrder,
@@ -1314,29 +1326,34 @@ func (k *Kraken) wsCancelOrders(orderIDs []string) error {
 		RequestID:      id,
 	}
 
-	cancelOrdersStatus[id] = &struct {
-		Total        int
-		Successful   int
-		Unsuccessful int
-		Error        string
-	}{
-		Total: len(orderIDs),
-	}
-
-	defer delete(cancelOrdersStatus, id)
-
-	_, err := k.Websocket.AuthConn.SendMessageReturnResponse(id, request)
+	// A resent cancel reports the already cancelled orders as unknown, so it
+	// is not retried
+	resp, err := k.Websocket.AuthConn.SendMessageReturnResponses(ctx,
+		id,
+		request,
+		stream.RequestConfig{Responses: len(orderIDs)})
 	if err != nil {
 		return err
 	}
 
-	successful := cancelOrdersStatus[id].Successful
-
-	if cancelOrdersStatus[id].Error != "" || len(orderIDs) != successful { // strange Kraken logic ...
-		var reason string
-		if cancelOrdersStatus[id].Error != "" {
-			reason = fmt.Sprintf(" Reason: %s", cancelOrdersStatus[id].Error)
+	var successful int
+	var reason string
+	for i := range resp {
+		var status WsCancelOrderResponse
+		err = json.Unmarshal(resp[i], &status)
+		if err != nil {
+			return err
 		}
+		if status.Status == "error" {
+			if reason == "" { // save the first error, if any
+				reason = fmt.Sprintf(" Reason: %s", status.ErrorMessage)
+			}
+			continue
+		}
+		successful++
+	}
+
+	if len(orderIDs) != successful {
 		return fmt.Errorf("%s cancelled %d out of %d orders.%s",
 			k.Name, successful, len(orderIDs), reason)
 	}
@@ -1345,7 +1362,7 @@ func (k *Kraken) wsCancelOrders(orderIDs []string) error {
 
 // wsCancelAllOrders cancels all opened orders
 // Returns number (count param) of affected orders or 0 if no open orders found
-func (k *Kraken) wsCancelAllOrders() (*WsCancelOrderResponse, error) {
+func (k *Kraken) wsCancelAllOrders(ctx context.Context) (*WsCancelOrderResponse, error) {
 	id := k.Websocket.AuthConn.GenerateMessageID(false)
 	request := WsCancelOrderRequest{
 		Event:     krakenWsCancelAll,
@@ -1353,12 +1370,17 @@ func (k *Kraken) wsCancelAllOrders() (*WsCancelOrderResponse, error) {
 		RequestID: id,
 	}
 
-	jsonResp, err := k.Websocket.AuthConn.SendMessageReturnResponse(id, request)
+	// Cancelling all orders again is harmless, so a lost response is retried
+	// once
+	jsonResp, err := k.Websocket.AuthConn.SendMessageReturnResponses(ctx,
+		id,
+		request,
+		stream.RequestConfig{Retries: 1})
 	if err != nil {
 		return &WsCancelOrderResponse{}, err
 	}
 	var resp WsCancelOrderResponse
-	err = json.Unmarshal(jsonResp, &resp)
+	err = json.Unmarshal(jsonResp[0], &resp)
 	if err != nil {
 		return &WsCancelOrderResponse{}, err
 	}
@@ -3,6 +3,7 @@ package kraken
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,15 +17,19 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -217,6 +222,10 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) error {
 		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
 		SortBuffer:                       true,
+		OrderbookVerifier: buffer.ChecksumVerifier{
+			Checksum: k.orderbookChecksum,
+		},
+		OrderbookResync: k.UpdateOrderbook,
 	})
 	if err != nil {
 		return err
@@ -394,10 +403,51 @@ func (k *Kraken) UpdateTradablePairs(forceUpdate bool) error {
 		if err != nil {
 			return err
 		}
+		if assets[x] == asset.Spot {
+			err = k.updateSpotExecutionLimits()
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// updateSpotExecutionLimits loads the spot pair execution limits, Kraken
+// returns the price and volume precision as decimal places
+func (k *Kraken) updateSpotExecutionLimits() error {
+	pairs, err := k.GetAssetPairs([]string{}, "")
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(pairs))
+	for i := range pairs {
+		if strings.Contains(pairs[i].Altname, ".d") {
+			continue
+		}
+		base := assetTranslator.LookupAltname(pairs[i].Base)
+		quote := assetTranslator.LookupAltname(pairs[i].Quote)
+		if base == "" || quote == "" {
+			continue
+		}
+		var minAmount float64
+		if pairs[i].Ordermin != "" {
+			minAmount, err = strconv.ParseFloat(pairs[i].Ordermin, 64)
+			if err != nil {
+				return err
+			}
+		}
+		limits = append(limits, order.Limits{
+			Pair:       currency.NewPair(currency.NewCode(base), currency.NewCode(quote)),
+			Asset:      asset.Spot,
+			PriceTick:  math.Pow10(-pairs[i].PairDecimals),
+			MinAmount:  minAmount,
+			AmountStep: math.Pow10(-pairs[i].LotDecimals),
+		})
+	}
+	return k.LoadExecutionLimits(limits)
+}
+
 // UpdateTicker updates and returns the ticker for a currency pair
 func (k *Kraken) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	switch assetType {
@@ -689,13 +739,15 @@ func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
 			var resp string
 			s.Pair.Delimiter = "/" // required pair format: ISO 4217-A3
-			resp, err = k.wsAddOrder(&WsAddOrderRequest{
+			ctx, cancel := k.Websocket.RequestContext()
+			resp, err = k.wsAddOrder(ctx, &WsAddOrderRequest{
 				OrderType: s.Type.Lower(),
 				OrderSide: s.Side.Lower(),
 				Pair:      s.Pair.String(),
 				Price:     s.Price,
 				Volume:    s.Amount,
 			})
+			cancel()
 			if err != nil {
 				return submitOrderResponse, err
 			}
@@ -723,13 +775,17 @@ func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		}
 		submitOrderResponse.IsOrderPlaced = true
 	case asset.Futures:
+		var reduceOnly string
+		if s.ReduceOnly {
+			reduceOnly = "true"
+		}
 		order, err := k.FuturesSendOrder(
 			s.Type,
 			s.Pair,
 			s.Side.Lower(),
 			"",
 			s.ClientOrderID,
-			"",
+			reduceOnly,
 			s.Amount,
 			s.Price,
 			0,
@@ -759,7 +815,9 @@ func (k *Kraken) CancelOrder(o *order.Cancel) error {
 	switch o.AssetType {
 	case asset.Spot:
 		if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
-			return k.wsCancelOrders([]string{o.ID})
+			ctx, cancel := k.Websocket.RequestContext()
+			defer cancel()
+			return k.wsCancelOrders(ctx, []string{o.ID})
 		}
 		_, err := k.CancelExistingOrder(o.ID)
 		return err
@@ -783,7 +841,9 @@ func (k *Kraken) CancelBatchOrders(orders []order.Cancel) (order.CancelBatchResp
 	}
 
 	if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
-		err := k.wsCancelOrders(ordersList)
+		ctx, cancel := k.Websocket.RequestContext()
+		defer cancel()
+		err := k.wsCancelOrders(ctx, ordersList)
 		return order.CancelBatchResponse{}, err
 	}
 
@@ -797,8 +857,10 @@ func (k *Kraken) CancelAllOrders(req *order.Cancel) (order.CancelAllResponse, er
 	}
 	switch req.AssetType {
 	case asset.Spot:
+		ctx, cancel := k.Websocket.RequestContext()
+		defer cancel()
 		if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
-			resp, err := k.wsCancelAllOrders()
+			resp, err := k.wsCancelAllOrders(ctx)
 			if err != nil {
 				return cancelAllOrdersResponse, err
 			}
@@ -815,7 +877,7 @@ func (k *Kraken) CancelAllOrders(req *order.Cancel) (order.CancelAllResponse, er
 		for orderID := range openOrders.Open {
 			var err error
 			if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
-				err = k.wsCancelOrders([]string{orderID})
+				err = k.wsCancelOrders(ctx, []string{orderID})
 			} else {
 				_, err = k.CancelExistingOrder(orderID)
 			}
@@ -1472,3 +1534,164 @@ func compatibleFillOrderType(fillType string) (order.Type, error) {
 	}
 	return resp, nil
 }
+
+// GetDerivativePositions returns the open positions for a derivatives asset type
+func (k *Kraken) GetDerivativePositions(a asset.Item) ([]position.Position, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, k.Name)
+	}
+	resp, err := k.FuturesGetOpenPositions()
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.OpenPositions) == 0 {
+		return nil, nil
+	}
+	tickers, err := k.GetFuturesTickers()
+	if err != nil {
+		return nil, err
+	}
+	markPrices := make(map[string]float64, len(tickers.Tickers))
+	for i := range tickers.Tickers {
+		markPrices[strings.ToLower(tickers.Tickers[i].Symbol)] = tickers.Tickers[i].MarkPrice
+	}
+	positions := make([]position.Position, 0, len(resp.OpenPositions))
+	for i := range resp.OpenPositions {
+		p, err := k.MatchSymbolWithAvailablePairs(resp.OpenPositions[i].Symbol, a)
+		if err != nil {
+			return nil, err
+		}
+		side, err := order.StringToOrderSide(resp.OpenPositions[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		filled, err := time.Parse(time.RFC3339, resp.OpenPositions[i].FillTime)
+		if err != nil {
+			return nil, err
+		}
+		// the open positions endpoint does not report leverage, liquidation
+		// price or profit, margin is shared across the futures account
+		positions = append(positions, position.Position{
+			Exchange:   k.Name,
+			Pair:       p,
+			Asset:      a,
+			Side:       side,
+			Size:       resp.OpenPositions[i].Size,
+			EntryPrice: resp.OpenPositions[i].Price,
+			MarkPrice:  markPrices[strings.ToLower(resp.OpenPositions[i].Symbol)],
+			MarginType: position.Cross,
+			UpdatedAt:  filled,
+		})
+	}
+	return positions, nil
+}
+
+// CloseDerivativePosition closes all or part of an open derivatives position
+func (k *Kraken) CloseDerivativePosition(c *position.Close) (order.SubmitResponse, error) {
+	positions, err := k.GetDerivativePositions(c.Asset)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	s, err := position.CloseOrder(positions, c)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return k.SubmitOrder(s)
+}
+
+// GetLatestFundingRate returns the current funding rate of a perpetual
+// futures contract, Kraken reports absolute funding rates and does not
+// publish the next funding time over REST
+func (k *Kraken) GetLatestFundingRate(p currency.Pair, a asset.Item) (*fundingrate.LatestRate, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, k.Name)
+	}
+	fPair, err := k.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	tickers, err := k.GetFuturesTickers()
+	if err != nil {
+		return nil, err
+	}
+	for i := range tickers.Tickers {
+		if !strings.EqualFold(tickers.Tickers[i].Symbol, fPair.String()) {
+			continue
+		}
+		return &fundingrate.LatestRate{
+			Exchange:      k.Name,
+			Pair:          p,
+			Asset:         a,
+			Rate:          tickers.Tickers[i].FundingRate,
+			PredictedRate: tickers.Tickers[i].FundingRatePrediction,
+			UpdatedAt:     time.Now(),
+		}, nil
+	}
+	return nil, fmt.Errorf("%s %s %w", k.Name, fPair, errTickerNotFound)
+}
+
+// GetFundingRateHistory returns the absolute funding rates applied to a
+// perpetual futures contract over a date range, Kraken returns the full
+// history in a single response
+func (k *Kraken) GetFundingRateHistory(r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if r.Asset != asset.Futures {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", r.Asset, k.Name)
+	}
+	resp, err := k.GetFuturesHistoricFundingRates(r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]fundingrate.Rate, len(resp.Rates))
+	for i := range resp.Rates {
+		rates[i] = fundingrate.Rate{
+			Time: resp.Rates[i].Timestamp,
+			Rate: resp.Rates[i].FundingRate,
+		}
+	}
+	return r.Collate(k.Name, rates), nil
+}
+
+// GetSubAccounts is not supported, Kraken sub-accounts are managed through
+// the website and the API can neither list nor transfer to them
+func (k *Kraken) GetSubAccounts() ([]account.SubAccountDetails, error) {
+	return nil, fmt.Errorf("%w, %s cannot list sub-accounts", common.ErrFunctionNotSupported, k.Name)
+}
+
+// TransferFunds moves funds between the spot and futures wallets
+func (k *Kraken) TransferFunds(r *transfer.Request) (*transfer.ExchangeResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if r.FromAccount != "" || r.ToAccount != "" {
+		return nil, fmt.Errorf("%w %s sub-account transfers", transfer.ErrUnsupportedTransfer, k.Name)
+	}
+	switch {
+	case r.FromAsset == asset.Spot && r.ToAsset == asset.Futures:
+		id, err := k.WalletTransfer(r.Currency.Upper().String(), r.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return &transfer.ExchangeResponse{
+			Name: k.Name,
+			ID:   id,
+		}, nil
+	case r.FromAsset == asset.Futures && r.ToAsset == asset.Spot:
+		resp, err := k.FuturesWithdrawToSpotWallet(r.Currency.Lower().String(), r.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return &transfer.ExchangeResponse{
+			Name:   k.Name,
+			Status: resp.Result,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w %s %s to %s",
+		transfer.ErrUnsupportedTransfer,
+		k.Name,
+		r.FromAsset,
+		r.ToAsset)
+}
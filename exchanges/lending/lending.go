@@ -0,0 +1,56 @@
+package lending
+
+import "fmt"
+
+// Validate checks the borrow request can be sent to an exchange
+func (b *Borrow) Validate() error {
+	if b.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if b.Currency.IsEmpty() {
+		return errCurrencyUnset
+	}
+	if b.Amount <= 0 {
+		return fmt.Errorf("%w, received %v", errAmountInvalid, b.Amount)
+	}
+	return nil
+}
+
+// Validate checks the repay request can be sent to an exchange
+func (r *Repay) Validate() error {
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if r.Currency.IsEmpty() {
+		return errCurrencyUnset
+	}
+	if r.Amount < 0 {
+		return fmt.Errorf("%w, received %v", errRepayAmountInvalid, r.Amount)
+	}
+	return nil
+}
+
+// Validate checks the lending offer can be sent to an exchange
+func (o *Offer) Validate() error {
+	if o.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if o.Currency.IsEmpty() {
+		return errCurrencyUnset
+	}
+	if o.Amount <= 0 {
+		return fmt.Errorf("%w, received %v", errAmountInvalid, o.Amount)
+	}
+	if o.Rate <= 0 {
+		return fmt.Errorf("%w, received %v", errRateInvalid, o.Rate)
+	}
+	if o.Period <= 0 {
+		return fmt.Errorf("%w, received %v", errPeriodInvalid, o.Period)
+	}
+	return nil
+}
+
+// Outstanding returns the amount owed on a loan including accrued interest
+func (l *Loan) Outstanding() float64 {
+	return l.Amount + l.Interest
+}
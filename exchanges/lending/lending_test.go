@@ -0,0 +1,77 @@
+package lending
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func TestBorrowValidate(t *testing.T) {
+	t.Parallel()
+	b := &Borrow{}
+	if err := b.Validate(); !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("expected %v, received %v", errExchangeNameUnset, err)
+	}
+	b.Exchange = "test"
+	if err := b.Validate(); !errors.Is(err, errCurrencyUnset) {
+		t.Fatalf("expected %v, received %v", errCurrencyUnset, err)
+	}
+	b.Currency = currency.BTC
+	if err := b.Validate(); !errors.Is(err, errAmountInvalid) {
+		t.Fatalf("expected %v, received %v", errAmountInvalid, err)
+	}
+	b.Amount = 1
+	if err := b.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRepayValidate(t *testing.T) {
+	t.Parallel()
+	r := &Repay{}
+	if err := r.Validate(); !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("expected %v, received %v", errExchangeNameUnset, err)
+	}
+	r.Exchange = "test"
+	if err := r.Validate(); !errors.Is(err, errCurrencyUnset) {
+		t.Fatalf("expected %v, received %v", errCurrencyUnset, err)
+	}
+	r.Currency = currency.BTC
+	r.Amount = -1
+	if err := r.Validate(); !errors.Is(err, errRepayAmountInvalid) {
+		t.Fatalf("expected %v, received %v", errRepayAmountInvalid, err)
+	}
+	r.Amount = 0
+	if err := r.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOfferValidate(t *testing.T) {
+	t.Parallel()
+	o := &Offer{Exchange: "test", Currency: currency.USD}
+	if err := o.Validate(); !errors.Is(err, errAmountInvalid) {
+		t.Fatalf("expected %v, received %v", errAmountInvalid, err)
+	}
+	o.Amount = 100
+	if err := o.Validate(); !errors.Is(err, errRateInvalid) {
+		t.Fatalf("expected %v, received %v", errRateInvalid, err)
+	}
+	o.Rate = 0.0002
+	if err := o.Validate(); !errors.Is(err, errPeriodInvalid) {
+		t.Fatalf("expected %v, received %v", errPeriodInvalid, err)
+	}
+	o.Period = 2
+	if err := o.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOutstanding(t *testing.T) {
+	t.Parallel()
+	l := Loan{Amount: 1, Interest: 0.5}
+	if l.Outstanding() != 1.5 {
+		t.Errorf("expected 1.5, received %v", l.Outstanding())
+	}
+}
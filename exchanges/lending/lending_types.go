@@ -0,0 +1,81 @@
+package lending
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Public errors
+var (
+	ErrLoanIDRequired           = errors.New("loan ID required")
+	ErrPartialRepayNotSupported = errors.New("partial loan repayments are not supported")
+	ErrOfferPeriodNotSupported  = errors.New("offer period is not supported")
+)
+
+var (
+	errExchangeNameUnset  = errors.New("exchange name unset")
+	errCurrencyUnset      = errors.New("currency unset")
+	errAmountInvalid      = errors.New("amount must be greater than zero")
+	errRepayAmountInvalid = errors.New("repay amount must not be negative")
+	errRateInvalid        = errors.New("rate must be greater than zero")
+	errPeriodInvalid      = errors.New("period must be greater than zero")
+)
+
+// Side defines whether funds have been borrowed or lent out
+type Side string
+
+// Loan sides
+const (
+	Borrowed Side = "BORROWED"
+	Lent     Side = "LENT"
+)
+
+// Loan is an outstanding amount borrowed by the account or lent out by the
+// account
+type Loan struct {
+	Exchange string
+	// ID is empty when the exchange pools borrowings per currency
+	ID       string
+	Currency currency.Code
+	Side     Side
+	Amount   float64
+	// Interest is the accrued unpaid interest in the loan currency, zero when
+	// not reported by the exchange
+	Interest float64
+	// Rate is the daily interest rate as a fraction, zero when not reported
+	// by the exchange
+	Rate float64
+	// Period is the loan term in days, zero when open ended
+	Period    int64
+	CreatedAt time.Time
+}
+
+// Borrow defines a request to borrow funds against the margin account
+type Borrow struct {
+	Exchange string
+	Currency currency.Code
+	Amount   float64
+}
+
+// Repay defines a request to repay borrowed funds
+type Repay struct {
+	Exchange string
+	Currency currency.Code
+	// Amount to repay, zero repays the whole loan including interest
+	Amount float64
+	// LoanID is required by exchanges which track borrowings per loan
+	LoanID string
+}
+
+// Offer defines an offer to lend funds
+type Offer struct {
+	Exchange string
+	Currency currency.Code
+	Amount   float64
+	// Rate is the daily interest rate as a fraction
+	Rate float64
+	// Period is the loan term in days
+	Period int64
+}
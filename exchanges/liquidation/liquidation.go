@@ -0,0 +1,261 @@
+package liquidation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	liquidationsql "github.com/thrasher-corp/gocryptotrader/database/repository/liquidation"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func init() {
+	service = new(Service)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribeToExchangeLiquidations subscribes to all liquidation events on an
+// exchange. Liquidations are events rather than state so the subscription is
+// created even if no liquidation has been received yet.
+func SubscribeToExchangeLiquidations(exchange string) (dispatch.Pipe, error) {
+	if exchange == "" {
+		return dispatch.Pipe{}, errExchangeNameUnset
+	}
+	id, err := service.getExchangeID(strings.ToLower(exchange))
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return service.mux.Subscribe(id)
+}
+
+// Process validates liquidation events and publishes them to subscribers
+func Process(data ...Data) error {
+	var errs common.Errors
+	for i := range data {
+		err := validate(&data[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		id, err := service.getExchangeID(strings.ToLower(data[i].Exchange))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = service.mux.Publish([]uuid.UUID{id}, &data[i])
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// getExchangeID returns the dispatch ID for an exchange, creating one if it
+// does not exist
+func (s *Service) getExchangeID(fmtName string) (uuid.UUID, error) {
+	s.Lock()
+	defer s.Unlock()
+	id, ok := s.Exchange[fmtName]
+	if ok {
+		return id, nil
+	}
+	id, err := s.mux.GetID()
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	s.Exchange[fmtName] = id
+	return id, nil
+}
+
+// validate checks the liquidation event and normalises its side and amount
+func validate(d *Data) error {
+	if d.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if d.Price == 0 ||
+		d.Amount == 0 ||
+		d.CurrencyPair.IsEmpty() ||
+		!d.AssetType.IsValid() ||
+		d.Timestamp.IsZero() {
+		return fmt.Errorf("%s %w: %+v", d.Exchange, errInvalidData, *d)
+	}
+	if d.Amount < 0 {
+		d.Amount *= -1
+		d.Side = order.Sell
+	}
+	switch d.Side {
+	case order.Bid:
+		d.Side = order.Buy
+	case order.Ask:
+		d.Side = order.Sell
+	}
+	return nil
+}
+
+// Setup creates the liquidation processor
+func (p *Processor) setup(wg *sync.WaitGroup) {
+	p.mutex.Lock()
+	p.bufferProcessorInterval = BufferProcessorIntervalTime
+	p.mutex.Unlock()
+	go p.Run(wg)
+}
+
+// AddLiquidationsToBuffer will push liquidation data onto the buffer
+func AddLiquidationsToBuffer(exchangeName string, data ...Data) error {
+	if database.DB == nil || database.DB.Config == nil || !database.DB.Config.Enabled {
+		return nil
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	var errs common.Errors
+	if atomic.AddInt32(&processor.started, 0) == 0 {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		processor.setup(&wg)
+		wg.Wait()
+	}
+	var validDatas []Data
+	for i := range data {
+		err := validate(&data[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v received %w", exchangeName, err))
+			continue
+		}
+		uu, err := uuid.NewV4()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s uuid failed to generate for liquidation: %+v", exchangeName, data[i]))
+		}
+		data[i].ID = uu
+		validDatas = append(validDatas, data[i])
+	}
+	processor.mutex.Lock()
+	processor.buffer = append(processor.buffer, validDatas...)
+	processor.mutex.Unlock()
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Run will save liquidation data to the database in batches
+func (p *Processor) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	if !atomic.CompareAndSwapInt32(&p.started, 0, 1) {
+		log.Error(log.Trade, "liquidation processor already started")
+		return
+	}
+	defer func() {
+		atomic.CompareAndSwapInt32(&p.started, 1, 0)
+	}()
+	p.mutex.Lock()
+	ticker := time.NewTicker(p.bufferProcessorInterval)
+	p.mutex.Unlock()
+	for {
+		<-ticker.C
+		p.mutex.Lock()
+		bufferCopy := append(p.buffer[:0:0], p.buffer...)
+		p.buffer = nil
+		p.mutex.Unlock()
+		if len(bufferCopy) == 0 {
+			ticker.Stop()
+			return
+		}
+		err := SaveLiquidationsToDatabase(bufferCopy...)
+		if err != nil {
+			log.Error(log.Trade, err)
+		}
+	}
+}
+
+// SaveLiquidationsToDatabase converts liquidations and saves results to
+// database
+func SaveLiquidationsToDatabase(liquidations ...Data) error {
+	sqlLiquidations, err := liquidationToSQLData(liquidations...)
+	if err != nil {
+		return err
+	}
+	return liquidationsql.Insert(sqlLiquidations...)
+}
+
+// GetLiquidationsInRange calls db function to return liquidations in range
+// to minimise liquidationsql package usage
+func GetLiquidationsInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" || startDate.IsZero() || endDate.IsZero() {
+		return nil, errors.New("invalid arguments received")
+	}
+	results, err := liquidationsql.GetInRange(exchangeName, assetType, base, quote, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return SQLDataToLiquidation(results...)
+}
+
+func liquidationToSQLData(liquidations ...Data) ([]liquidationsql.Data, error) {
+	sort.Sort(ByDate(liquidations))
+	var results []liquidationsql.Data
+	for i := range liquidations {
+		liquidationID, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, liquidationsql.Data{
+			ID:        liquidationID.String(),
+			Timestamp: liquidations[i].Timestamp,
+			Exchange:  liquidations[i].Exchange,
+			Base:      liquidations[i].CurrencyPair.Base.String(),
+			Quote:     liquidations[i].CurrencyPair.Quote.String(),
+			AssetType: liquidations[i].AssetType.String(),
+			Side:      liquidations[i].Side.String(),
+			Price:     liquidations[i].Price,
+			Amount:    liquidations[i].Amount,
+		})
+	}
+	return results, nil
+}
+
+// SQLDataToLiquidation converts sql data to liquidation data
+func SQLDataToLiquidation(dbLiquidations ...liquidationsql.Data) (result []Data, err error) {
+	for i := range dbLiquidations {
+		var cp currency.Pair
+		cp, err = currency.NewPairFromStrings(dbLiquidations[i].Base, dbLiquidations[i].Quote)
+		if err != nil {
+			return nil, err
+		}
+		var a = asset.Item(dbLiquidations[i].AssetType)
+		if !a.IsValid() {
+			return nil, fmt.Errorf("invalid asset type %v", a)
+		}
+		var s order.Side
+		s, err = order.StringToOrderSide(dbLiquidations[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, Data{
+			ID:           uuid.FromStringOrNil(dbLiquidations[i].ID),
+			Timestamp:    dbLiquidations[i].Timestamp.UTC(),
+			Exchange:     dbLiquidations[i].Exchange,
+			CurrencyPair: cp.Upper(),
+			AssetType:    a,
+			Side:         s,
+			Price:        dbLiquidations[i].Price,
+			Amount:       dbLiquidations[i].Amount,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,213 @@
+package liquidation
+
+import (
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	liquidationsql "github.com/thrasher-corp/gocryptotrader/database/repository/liquidation"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func testLiquidation() Data {
+	return Data{
+		Exchange:     "test!",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.PerpetualContract,
+		Side:         order.Ask,
+		Price:        1337,
+		Amount:       -10,
+		Timestamp:    time.Now(),
+	}
+}
+
+func TestSubscribeToExchangeLiquidations(t *testing.T) {
+	_, err := SubscribeToExchangeLiquidations("")
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeNameUnset)
+	}
+
+	pipe, err := SubscribeToExchangeLiquidations("subscribetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		err = pipe.Release()
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	received := make(chan Data, 1)
+	go func() {
+		data := <-pipe.C
+		received <- (*data.(*interface{})).(Data)
+	}()
+
+	// the dispatcher drops events which a subscriber is not waiting on, so
+	// publish until the subscriber has received one
+	republish := time.NewTicker(10 * time.Millisecond)
+	defer republish.Stop()
+	timeout := time.NewTimer(5 * time.Second)
+	defer timeout.Stop()
+	for {
+		l := testLiquidation()
+		l.Exchange = "SubscribeTest"
+		err = Process(l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case d := <-received:
+			if d.Price != 1337 || d.Amount != 10 || d.Side != order.Sell {
+				t.Errorf("unexpected liquidation received %+v", d)
+			}
+			return
+		case <-republish.C:
+		case <-timeout.C:
+			t.Fatal("liquidation was not published")
+		}
+	}
+}
+
+func TestProcess(t *testing.T) {
+	err := Process(Data{})
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	l := testLiquidation()
+	l.Price = 0
+	err = Process(l)
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	err = Process(testLiquidation(), testLiquidation())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	l := testLiquidation()
+	err := validate(&l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Amount != 10 {
+		t.Errorf("received amount %v expected 10", l.Amount)
+	}
+	if l.Side != order.Sell {
+		t.Errorf("received side %v expected %v", l.Side, order.Sell)
+	}
+
+	l = testLiquidation()
+	l.Amount = 1
+	l.Side = order.Bid
+	err = validate(&l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Side != order.Buy {
+		t.Errorf("received side %v expected %v", l.Side, order.Buy)
+	}
+
+	l.AssetType = asset.Item("lol")
+	err = validate(&l)
+	if !errors.Is(err, errInvalidData) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidData)
+	}
+}
+
+func TestAddLiquidationsToBuffer(t *testing.T) {
+	processor.mutex.Lock()
+	processor.bufferProcessorInterval = BufferProcessorIntervalTime
+	processor.mutex.Unlock()
+	dbConf := database.Config{
+		Enabled: true,
+		Driver:  database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{
+			Host:     "localhost",
+			Database: "./rpctestdb",
+		},
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	processor.setup(&wg)
+	wg.Wait()
+	database.DB.Config = &dbConf
+	err := AddLiquidationsToBuffer("test!", testLiquidation())
+	if err != nil {
+		t.Error(err)
+	}
+	if atomic.AddInt32(&processor.started, 0) == 0 {
+		t.Error("expected the processor to have started")
+	}
+
+	l := testLiquidation()
+	l.Amount = 0
+	err = AddLiquidationsToBuffer("test!", l)
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	processor.mutex.Lock()
+	if len(processor.buffer) != 1 {
+		t.Errorf("expected 1 buffered liquidation, received %v", len(processor.buffer))
+	}
+	if processor.buffer[0].Side != order.Sell || processor.buffer[0].Amount != 10 {
+		t.Error("expected buffered liquidation to be normalised")
+	}
+	processor.buffer = nil
+	processor.mutex.Unlock()
+}
+
+func TestSQLDataToLiquidation(t *testing.T) {
+	l := testLiquidation()
+	err := validate(&l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlData, err := liquidationToSQLData(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := SQLDataToLiquidation(sqlData...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("expected 1 liquidation, received %v", len(resp))
+	}
+	if !resp[0].CurrencyPair.Equal(l.CurrencyPair) ||
+		resp[0].AssetType != l.AssetType ||
+		resp[0].Side != l.Side ||
+		resp[0].Price != l.Price ||
+		resp[0].Amount != l.Amount {
+		t.Errorf("unexpected conversion %+v", resp[0])
+	}
+
+	_, err = SQLDataToLiquidation(liquidationsql.Data{Base: "BTC", Quote: "USD", AssetType: "lol"})
+	if err == nil {
+		t.Error("expected error")
+	}
+}
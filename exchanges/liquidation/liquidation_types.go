@@ -0,0 +1,73 @@
+package liquidation
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// DefaultProcessorIntervalTime is the default interval to save liquidation
+// buffer data to the database
+const DefaultProcessorIntervalTime = time.Second * 15
+
+var (
+	processor Processor
+	service   *Service
+	// BufferProcessorIntervalTime is the interval to save liquidation buffer
+	// data to the database
+	BufferProcessorIntervalTime = DefaultProcessorIntervalTime
+
+	errExchangeNameUnset = errors.New("liquidation exchange name not set")
+	errInvalidData       = errors.New("invalid liquidation data")
+)
+
+// Data defines a normalised liquidation event. Side is the side of the
+// liquidation order placed by the exchange, a sell order closes a liquidated
+// long position and a buy order closes a liquidated short position.
+type Data struct {
+	ID           uuid.UUID `json:"ID,omitempty"`
+	Exchange     string
+	CurrencyPair currency.Pair
+	AssetType    asset.Item
+	Side         order.Side
+	Price        float64
+	Amount       float64
+	Timestamp    time.Time
+}
+
+// Processor used for processing liquidation data in batches and saving them
+// to the database
+type Processor struct {
+	mutex                   sync.Mutex
+	started                 int32
+	bufferProcessorInterval time.Duration
+	buffer                  []Data
+}
+
+// Service routes liquidation events to exchange subscribers
+type Service struct {
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.Mutex
+}
+
+// ByDate sorts liquidations by date ascending
+type ByDate []Data
+
+func (b ByDate) Len() int {
+	return len(b)
+}
+
+func (b ByDate) Less(i, j int) bool {
+	return b[i].Timestamp.Before(b[j].Timestamp)
+}
+
+func (b ByDate) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
@@ -0,0 +1,267 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+// WebsocketDefaultDirectory defines the websocket recording directory
+const WebsocketDefaultDirectory = "../../testdata/websocket_mock/"
+
+// Websocket frame directions
+const (
+	// WebsocketInbound is a frame received from the exchange
+	WebsocketInbound = "inbound"
+	// WebsocketOutbound is a frame sent to the exchange
+	WebsocketOutbound = "outbound"
+)
+
+// websocketDeltaKeys are request fields which change every session and are
+// ignored when matching outbound frames
+var websocketDeltaKeys = []string{"id", "reqid", "requestid", "cid", "nonce", "signature", "timestamp", "tonce", "key"}
+
+// WebsocketFrame defines a single recorded websocket message. JSON payloads
+// are stored in Data so recordings stay readable, anything else is stored in
+// Text
+type WebsocketFrame struct {
+	Direction string          `json:"direction"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	Text      string          `json:"text,omitempty"`
+}
+
+// Payload returns the frame's message as it is sent over the connection
+func (f *WebsocketFrame) Payload() []byte {
+	if len(f.Data) == 0 {
+		return []byte(f.Text)
+	}
+	var b bytes.Buffer
+	if json.Compact(&b, f.Data) != nil {
+		return f.Data
+	}
+	return b.Bytes()
+}
+
+// WebsocketRecording defines a recorded websocket session
+type WebsocketRecording struct {
+	Exchange string           `json:"exchange"`
+	Frames   []WebsocketFrame `json:"frames"`
+}
+
+// WebsocketRecorder captures the inbound and outbound frames of a websocket
+// session so it can be replayed by a WebsocketServer
+type WebsocketRecorder struct {
+	m         sync.Mutex
+	recording WebsocketRecording
+}
+
+// NewWebsocketRecorder returns a recorder for an exchange's websocket session
+func NewWebsocketRecorder(exchangeName string) *WebsocketRecorder {
+	return &WebsocketRecorder{
+		recording: WebsocketRecording{Exchange: strings.ToLower(exchangeName)},
+	}
+}
+
+// Record appends a frame to the recording
+func (r *WebsocketRecorder) Record(direction string, payload []byte) {
+	frame := WebsocketFrame{Direction: direction}
+	if json.Valid(payload) {
+		frame.Data = append(json.RawMessage(nil), payload...)
+	} else {
+		frame.Text = string(payload)
+	}
+	r.m.Lock()
+	r.recording.Frames = append(r.recording.Frames, frame)
+	r.m.Unlock()
+}
+
+// Recording returns a copy of the frames recorded so far
+func (r *WebsocketRecorder) Recording() WebsocketRecording {
+	r.m.Lock()
+	defer r.m.Unlock()
+	rec := r.recording
+	rec.Frames = append([]WebsocketFrame(nil), r.recording.Frames...)
+	return rec
+}
+
+// Save writes the recording to path as JSON, overwriting any existing file
+func (r *WebsocketRecorder) Save(path string) error {
+	rec := r.Recording()
+	data, err := json.MarshalIndent(rec, "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(path, data)
+}
+
+// WebsocketRecordingPath returns the default path of a named recording for
+// an exchange
+func WebsocketRecordingPath(exchangeName, name string) string {
+	exchangeName = strings.ToLower(exchangeName)
+	return filepath.Join(WebsocketDefaultDirectory, exchangeName, name+".json")
+}
+
+// LoadWebsocketRecording reads a websocket recording from path
+func LoadWebsocketRecording(path string) (*WebsocketRecording, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rec WebsocketRecording
+	err = json.Unmarshal(contents, &rec)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// WebsocketServer is a local websocket server which replays a recorded
+// session to every client that connects. Inbound frames are sent in order
+// and on reaching an outbound frame the server waits for the client to send a
+// matching message before continuing, unmatched client messages such as
+// pings are discarded
+type WebsocketServer struct {
+	// URL is the ws:// address of the server
+	URL string
+
+	server    *httptest.Server
+	recording *WebsocketRecording
+	m         sync.Mutex
+	errs      []error
+}
+
+// NewWebsocketServer starts a websocket server replaying the recording
+// stored at path
+func NewWebsocketServer(path string) (*WebsocketServer, error) {
+	if path == "" {
+		return nil, errors.New("no path to websocket recording found")
+	}
+	rec, err := LoadWebsocketRecording(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewWebsocketServerFromRecording(rec), nil
+}
+
+// NewWebsocketServerFromRecording starts a websocket server replaying the
+// supplied recording
+func NewWebsocketServerFromRecording(rec *WebsocketRecording) *WebsocketServer {
+	s := &WebsocketServer{recording: rec}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = "ws" + strings.TrimPrefix(s.server.URL, "http")
+	return s
+}
+
+// Close shuts down the server and closes all client connections
+func (s *WebsocketServer) Close() {
+	s.server.CloseClientConnections()
+	s.server.Close()
+}
+
+// Errors returns any errors encountered while replaying to clients
+func (s *WebsocketServer) Errors() []error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return append([]error(nil), s.errs...)
+}
+
+func (s *WebsocketServer) addError(err error) {
+	s.m.Lock()
+	s.errs = append(s.errs, err)
+	s.m.Unlock()
+}
+
+func (s *WebsocketServer) handle(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(*http.Request) bool { return true },
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.addError(err)
+		return
+	}
+	defer conn.Close()
+
+	for i := range s.recording.Frames {
+		frame := &s.recording.Frames[i]
+		switch frame.Direction {
+		case WebsocketInbound:
+			err = conn.WriteMessage(websocket.TextMessage, frame.Payload())
+			if err != nil {
+				s.addError(fmt.Errorf("frame %d: %w", i, err))
+				return
+			}
+		case WebsocketOutbound:
+			for {
+				_, msg, err := conn.ReadMessage()
+				if err != nil {
+					if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+						s.addError(fmt.Errorf("frame %d: waiting for %s: %w", i, frame.Payload(), err))
+					}
+					return
+				}
+				if MatchWebsocketPayload(frame.Payload(), msg) {
+					break
+				}
+			}
+		default:
+			s.addError(fmt.Errorf("frame %d: unknown direction %q", i, frame.Direction))
+			return
+		}
+	}
+
+	// hold the connection open until the client goes away so a completed
+	// replay is not mistaken for a disconnection
+	for {
+		if _, _, err = conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// MatchWebsocketPayload reports whether a sent message matches a recorded
+// one. JSON objects are compared by their top level fields, ignoring fields
+// which change every session such as request IDs and nonces
+func MatchWebsocketPayload(recorded, sent []byte) bool {
+	if bytes.Equal(recorded, sent) {
+		return true
+	}
+	v1, err := DeriveURLValsFromJSONMap(recorded)
+	if err != nil {
+		return false
+	}
+	v2, err := DeriveURLValsFromJSONMap(sent)
+	if err != nil {
+		return false
+	}
+	for k := range v1 {
+		if isWebsocketDeltaKey(k) {
+			v1.Del(k)
+		}
+	}
+	for k := range v2 {
+		if isWebsocketDeltaKey(k) {
+			v2.Del(k)
+		}
+	}
+	return MatchURLVals(v1, v2)
+}
+
+func isWebsocketDeltaKey(k string) bool {
+	for i := range websocketDeltaKeys {
+		if strings.EqualFold(k, websocketDeltaKeys[i]) {
+			return true
+		}
+	}
+	return false
+}
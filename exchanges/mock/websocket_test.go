@@ -0,0 +1,120 @@
+package mock
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestWebsocketRecorder(t *testing.T) {
+	r := NewWebsocketRecorder("TEST")
+	r.Record(WebsocketOutbound, []byte(`{"op":"subscribe","args":["trade"]}`))
+	r.Record(WebsocketInbound, []byte(`{"table":"trade",  "data":[]}`))
+	r.Record(WebsocketInbound, []byte("pong"))
+
+	rec := r.Recording()
+	if rec.Exchange != "test" || len(rec.Frames) != 3 {
+		t.Fatalf("unexpected recording %+v", rec)
+	}
+	if rec.Frames[2].Text != "pong" || rec.Frames[2].Data != nil {
+		t.Errorf("expected text frame, received %+v", rec.Frames[2])
+	}
+	if string(rec.Frames[1].Payload()) != `{"table":"trade","data":[]}` {
+		t.Errorf("unexpected payload %s", rec.Frames[1].Payload())
+	}
+
+	dir, err := ioutil.TempDir("", "gct-ws-mock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test", "session.json")
+	err = r.Save(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadWebsocketRecording(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Frames) != 3 || string(loaded.Frames[0].Payload()) != `{"op":"subscribe","args":["trade"]}` {
+		t.Errorf("unexpected loaded recording %+v", loaded)
+	}
+
+	if p := WebsocketRecordingPath("Bitmex", "trades"); p != filepath.Join(WebsocketDefaultDirectory, "bitmex", "trades.json") {
+		t.Errorf("unexpected recording path %s", p)
+	}
+}
+
+func TestMatchWebsocketPayload(t *testing.T) {
+	testCases := []struct {
+		recorded, sent string
+		match          bool
+	}{
+		{recorded: "ping", sent: "ping", match: true},
+		{recorded: "ping", sent: "pong"},
+		{recorded: `{"op":"subscribe","id":1}`, sent: `{"id":999,"op":"subscribe"}`, match: true},
+		{recorded: `{"op":"subscribe","args":["a"]}`, sent: `{"op":"subscribe","args":["b"]}`},
+		{recorded: `{"op":"subscribe"}`, sent: `{"op":"subscribe","args":["b"]}`},
+		{recorded: `{"op":"subscribe"}`, sent: "subscribe"},
+	}
+	for i := range testCases {
+		if m := MatchWebsocketPayload([]byte(testCases[i].recorded), []byte(testCases[i].sent)); m != testCases[i].match {
+			t.Errorf("case %d expected %v, received %v", i, testCases[i].match, m)
+		}
+	}
+}
+
+func TestWebsocketServer(t *testing.T) {
+	_, err := NewWebsocketServer("")
+	if err == nil {
+		t.Fatal("error cannot be nil")
+	}
+	_, err = NewWebsocketServer("nonexistent.json")
+	if err == nil {
+		t.Fatal("error cannot be nil")
+	}
+
+	r := NewWebsocketRecorder("test")
+	r.Record(WebsocketInbound, []byte(`{"event":"info"}`))
+	r.Record(WebsocketOutbound, []byte(`{"event":"subscribe","cid":1}`))
+	r.Record(WebsocketInbound, []byte(`{"event":"subscribed"}`))
+	rec := r.Recording()
+	s := NewWebsocketServerFromRecording(&rec)
+	defer s.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(s.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(msg) != `{"event":"info"}` {
+		t.Errorf("unexpected message %s", msg)
+	}
+	err = conn.WriteMessage(websocket.TextMessage, []byte(`{"event":"ping"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = conn.WriteMessage(websocket.TextMessage, []byte(`{"event":"subscribe","cid":42}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, msg, err = conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(msg) != `{"event":"subscribed"}` {
+		t.Errorf("unexpected message %s", msg)
+	}
+	if errs := s.Errors(); len(errs) != 0 {
+		t.Errorf("unexpected replay errors %v", errs)
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 )
 
@@ -45,6 +47,14 @@ const (
 	okGroupFundingTime           = "funding_time"
 	okGroupHistoricalFundingRate = "historical_funding_rate"
 	okGroupSwapInstruments       = "instruments"
+	// fundingRateHistoryLimit is the maximum number of funding rates
+	// returned by the historical funding rate endpoint
+	fundingRateHistoryLimit = 100
+	// estimatedDeliveryPriceWindow is how long before delivery the estimated
+	// delivery price of a futures contract is published
+	estimatedDeliveryPriceWindow = 3 * time.Hour
+	// futuresDeliveryHour is the UTC hour futures contracts are delivered
+	futuresDeliveryHour = 8
 	// ETT endpoints
 	okGroupConstituents    = "constituents"
 	okGroupDefinePrice     = "define-price"
@@ -55,6 +65,29 @@ const (
 	okGroupSpotPairs       = "instruments"
 )
 
+// futuresContractTypes maps futures contract aliases to their type
+var futuresContractTypes = map[string]contract.Type{
+	"this_week":  contract.ThisWeek,
+	"next_week":  contract.NextWeek,
+	"quarter":    contract.ThisQuarter,
+	"bi_quarter": contract.NextQuarter,
+}
+
+// OKEx account codes used by fund transfers
+const (
+	accountSubAccount = 0
+	accountSpot       = 1
+	accountFutures    = 3
+	accountSwap       = 9
+)
+
+// transferAccounts maps assets to the account codes used by fund transfers
+var transferAccounts = map[asset.Item]int64{
+	asset.Spot:          accountSpot,
+	asset.Futures:       accountFutures,
+	asset.PerpetualSwap: accountSwap,
+}
+
 // OKEX bases all account, spot and margin methods off okgroup implementation
 type OKEX struct {
 	okgroup.OKGroup
@@ -480,7 +513,7 @@ func (o *OKEX) GetSwapExchangeRates() (resp okgroup.GetSwapExchangeRatesResponse
 }
 
 // GetSwapOpenInterest Get the open interest of a contract.
-func (o *OKEX) GetSwapOpenInterest(instrumentID string) (resp okgroup.GetSwapExchangeRatesResponse, _ error) {
+func (o *OKEX) GetSwapOpenInterest(instrumentID string) (resp okgroup.GetSwapOpenInterestResponse, _ error) {
 	requestURL := fmt.Sprintf("%v/%v/%v", okgroup.OKGroupInstruments, instrumentID, okGroupOpenInterest)
 	return resp, o.SendHTTPRequest(exchange.RestSpot, http.MethodGet, okGroupSwapSubsection, requestURL, nil, &resp, false)
 }
@@ -509,7 +542,7 @@ func (o *OKEX) GetSwapNextSettlementTime(instrumentID string) (resp okgroup.GetS
 	return resp, o.SendHTTPRequest(exchange.RestSpot, http.MethodGet, okGroupSwapSubsection, requestURL, nil, &resp, false)
 }
 
-// GetSwapMarkPrice Get the time of next settlement.
+// GetSwapMarkPrice Get the mark price of a contract.
 func (o *OKEX) GetSwapMarkPrice(instrumentID string) (resp okgroup.GetSwapMarkPriceResponse, _ error) {
 	requestURL := fmt.Sprintf("%v/%v/%v", okgroup.OKGroupInstruments, instrumentID, okgroup.OKGroupMarkPrice)
 	return resp, o.SendHTTPRequest(exchange.RestSpot, http.MethodGet, okGroupSwapSubsection, requestURL, nil, &resp, false)
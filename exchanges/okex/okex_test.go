@@ -2,6 +2,7 @@ package okex
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -18,11 +19,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -580,6 +584,21 @@ func TestGetSpotTokenPairDetails(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := o.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := o.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.AmountStep == 0 || l.MinAmount == 0 {
+		t.Errorf("expected price tick, amount step and minimum amount to be loaded, received %+v", l)
+	}
+}
+
 // TestGetSpotAllTokenPairsInformation API endpoint test
 func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 	t.Parallel()
@@ -1214,6 +1233,222 @@ func TestGetSwapPostions(t *testing.T) {
 	testStandardErrorHandling(t, err)
 }
 
+// TestGetDerivativePositions wrapper test
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetDerivativePositions(asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = o.GetDerivativePositions(asset.PerpetualSwap)
+	testStandardErrorHandling(t, err)
+	_, err = o.GetDerivativePositions(asset.Futures)
+	testStandardErrorHandling(t, err)
+}
+
+// TestCloseDerivativePosition wrapper test
+func TestCloseDerivativePosition(t *testing.T) {
+	TestSetRealOrderDefaults(t)
+	t.Parallel()
+	_, err := o.CloseDerivativePosition(&position.Close{
+		Exchange: o.Name,
+		Pair:     currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter),
+		Asset:    asset.PerpetualSwap,
+	})
+	if err == nil {
+		t.Error("expected an error without an open position")
+	}
+}
+
+func TestDerivativeOrderType(t *testing.T) {
+	t.Parallel()
+	tester := []struct {
+		side   order.Side
+		reduce bool
+		offset string
+		expect int64
+	}{
+		{order.Buy, false, "", 1},
+		{order.Sell, false, "", 2},
+		{order.Sell, true, "", 3},
+		{order.Buy, false, "close", 4},
+	}
+	for i := range tester {
+		orderType, err := derivativeOrderType(&order.Submit{
+			Side:       tester[i].side,
+			ReduceOnly: tester[i].reduce,
+			Offset:     tester[i].offset,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if orderType != tester[i].expect {
+			t.Errorf("test %d: expected %v, received %v", i, tester[i].expect, orderType)
+		}
+	}
+	_, err := derivativeOrderType(&order.Submit{Side: order.Long})
+	if !errors.Is(err, order.ErrSideIsInvalid) {
+		t.Errorf("expected %v, received %v", order.ErrSideIsInvalid, err)
+	}
+}
+
+// TestGetLeverage wrapper test
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter)
+	_, err := o.GetLeverage(p, asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = o.GetLeverage(p, asset.PerpetualSwap)
+	testStandardErrorHandling(t, err)
+}
+
+// TestSetLeverage wrapper test
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter)
+	err := o.SetLeverage(p, asset.PerpetualSwap, 2.5)
+	if err == nil {
+		t.Error("expected an error for fractional leverage")
+	}
+	TestSetRealOrderDefaults(t)
+	err = o.SetLeverage(p, asset.PerpetualSwap, 5)
+	testStandardErrorHandling(t, err)
+}
+
+// TestSetMarginType wrapper test
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter)
+	err := o.SetMarginType(p, asset.PerpetualSwap, position.UnknownMarginType)
+	if err == nil {
+		t.Error("expected an error for an unknown margin type")
+	}
+	TestSetRealOrderDefaults(t)
+	err = o.SetMarginType(p, asset.PerpetualSwap, position.Cross)
+	testStandardErrorHandling(t, err)
+}
+
+// TestGetLatestFundingRate wrapper test
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter)
+	_, err := o.GetLatestFundingRate(p, asset.Futures)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = o.GetLatestFundingRate(p, asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// TestGetFundingRateHistory wrapper test
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetFundingRateHistory(&fundingrate.HistoricalRatesRequest{
+		Pair:  currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter),
+		Asset: asset.PerpetualSwap,
+		Start: time.Now().Add(-time.Hour * 24 * 7),
+		End:   time.Now(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// TestUpdateDerivativePrice wrapper test
+func TestUpdateDerivativePrice(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter)
+	_, err := o.UpdateDerivativePrice(p, asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = o.UpdateDerivativePrice(p, asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// TestFuturesDeliveryTime logic test
+func TestFuturesDeliveryTime(t *testing.T) {
+	t.Parallel()
+	delivery, err := futuresDeliveryTime(currency.NewPairWithDelimiter("BTC-USD", "201225", currency.UnderscoreDelimiter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !delivery.Equal(time.Date(2020, 12, 25, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected delivery time %v", delivery)
+	}
+	_, err = futuresDeliveryTime(currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter))
+	if err == nil {
+		t.Error("expected an error for a contract without a delivery date")
+	}
+}
+
+// TestUpdateContracts API endpoint test
+func TestGetSubAccounts(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetSubAccounts()
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := o.TransferFunds(&transfer.Request{
+		Exchange:  o.Name,
+		Currency:  currency.BTC,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Margin,
+	})
+	if !errors.Is(err, transfer.ErrUnsupportedTransfer) {
+		t.Errorf("received %v, expected %v", err, transfer.ErrUnsupportedTransfer)
+	}
+	TestSetRealOrderDefaults(t)
+	_, err = o.TransferFunds(&transfer.Request{
+		Exchange:  o.Name,
+		Currency:  currency.BTC,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Futures,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateContracts(t *testing.T) {
+	t.Parallel()
+	_, err := o.UpdateContracts(asset.Spot)
+	if err == nil {
+		t.Error("expected an error for an unsupported asset type")
+	}
+	_, err = o.UpdateContracts(asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// TestParsePositionFloats logic test
+func TestParsePositionFloats(t *testing.T) {
+	t.Parallel()
+	v, err := parsePositionFloats("1.5", "", "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v) != 3 || v[0] != 1.5 || v[1] != 0 || v[2] != 10 {
+		t.Errorf("unexpected values %v", v)
+	}
+	if _, err = parsePositionFloats("meow"); err == nil {
+		t.Error("expected an error for an invalid value")
+	}
+}
+
 // TestGetSwapPostionsForContract API endpoint test
 func TestGetSwapPostionsForContract(t *testing.T) {
 	t.Parallel()
@@ -1944,6 +2179,19 @@ func TestWsUnsubscribe(t *testing.T) {
 	}
 }
 
+func TestWsDerivativePrices(t *testing.T) {
+	pressXToJSON := []byte(`{"table":"swap/mark_price","data":[{"instrument_id":"BTC-USD-SWAP","mark_price":"19000.5","timestamp":"2020-12-01T08:00:00.000Z"}]}`)
+	err := o.WsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+	pressXToJSON = []byte(`{"table":"futures/estimated_price","data":[{"instrument_id":"BTC-USD-201225","settlement_price":"19001.2","timestamp":"2020-12-25T06:00:00.000Z"}]}`)
+	err = o.WsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestWsCandle(t *testing.T) {
 	pressXToJSON := []byte(`{
     "table":"spot/candle60s",
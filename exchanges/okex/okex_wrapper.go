@@ -3,7 +3,9 @@ package okex
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,16 +15,22 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/contract"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/transfer"
 )
 
 // GetDefaultConfig returns a default exchange config
@@ -423,7 +431,92 @@ func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
 			return err
 		}
 	}
-	return nil
+	return o.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the spot, futures and perpetual swap execution
+// limits from the instrument details, futures and swap amounts are in
+// contracts
+func (o *OKEX) updateExecutionLimits() error {
+	var limits []order.Limits
+	assets := o.CurrencyPairs.GetAssetTypes()
+	for x := range assets {
+		switch assets[x] {
+		case asset.Spot:
+			prods, err := o.GetSpotTokenPairDetails()
+			if err != nil {
+				return err
+			}
+			for i := range prods {
+				var l order.Limits
+				l.Pair = currency.NewPairWithDelimiter(prods[i].BaseCurrency,
+					prods[i].QuoteCurrency,
+					currency.DashDelimiter)
+				l.Asset = asset.Spot
+				l.PriceTick, err = parseLimit(prods[i].TickSize)
+				if err != nil {
+					return err
+				}
+				l.MinAmount, err = parseLimit(prods[i].MinSize)
+				if err != nil {
+					return err
+				}
+				l.AmountStep, err = parseLimit(prods[i].SizeIncrement)
+				if err != nil {
+					return err
+				}
+				limits = append(limits, l)
+			}
+		case asset.Futures:
+			prods, err := o.GetFuturesContractInformation()
+			if err != nil {
+				return err
+			}
+			for i := range prods {
+				p := strings.Split(prods[i].InstrumentID, currency.DashDelimiter)
+				if len(p) != 3 {
+					continue
+				}
+				limits = append(limits, order.Limits{
+					Pair: currency.NewPairWithDelimiter(p[0]+currency.DashDelimiter+p[1],
+						p[2],
+						currency.UnderscoreDelimiter),
+					Asset:      asset.Futures,
+					PriceTick:  prods[i].TickSize,
+					MinAmount:  float64(prods[i].TradeIncrement),
+					AmountStep: float64(prods[i].TradeIncrement),
+				})
+			}
+		case asset.PerpetualSwap:
+			prods, err := o.GetSwapContractInformation()
+			if err != nil {
+				return err
+			}
+			for i := range prods {
+				limits = append(limits, order.Limits{
+					Pair: currency.NewPairWithDelimiter(prods[i].UnderlyingIndex+
+						currency.DashDelimiter+
+						prods[i].QuoteCurrency,
+						"SWAP",
+						currency.UnderscoreDelimiter),
+					Asset:      asset.PerpetualSwap,
+					PriceTick:  prods[i].TickSize,
+					MinAmount:  prods[i].SizeIncrement,
+					AmountStep: prods[i].SizeIncrement,
+				})
+			}
+		}
+	}
+	return o.LoadExecutionLimits(limits)
+}
+
+// parseLimit parses an instrument detail value, an empty value is not
+// enforced
+func parseLimit(v string) (float64, error) {
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(v, 64)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -807,3 +900,575 @@ func (o *OKEX) GetRecentTrades(p currency.Pair, assetType asset.Item) ([]trade.D
 func (o *OKEX) CancelBatchOrders(ord []order.Cancel) (order.CancelBatchResponse, error) {
 	return order.CancelBatchResponse{}, common.ErrNotYetImplemented
 }
+
+// GetDerivativePositions returns the open positions for a derivatives asset type
+func (o *OKEX) GetDerivativePositions(a asset.Item) ([]position.Position, error) {
+	switch a {
+	case asset.Futures:
+		return o.getFuturesPositions()
+	case asset.PerpetualSwap:
+		return o.getSwapPositions()
+	}
+	return nil, fmt.Errorf("asset type of %s is not supported by %s", a, o.Name)
+}
+
+func (o *OKEX) getFuturesPositions() ([]position.Position, error) {
+	resp, err := o.GetFuturesPostions()
+	if err != nil {
+		return nil, err
+	}
+	var positions []position.Position
+	for i := range resp.Holding {
+		for j := range resp.Holding[i] {
+			h := &resp.Holding[i][j]
+			v, err := parsePositionFloats(h.LongQty, h.LongAvgCost, h.LongLiquiPrice,
+				h.LongLeverage, h.LongUnrealisedPnl, h.ShortQty, h.ShortAvgCost,
+				h.ShortLiquiPrice, h.ShortLeverage, h.ShortUnrealisedPnl)
+			if err != nil {
+				return nil, err
+			}
+			if v[0] == 0 && v[5] == 0 {
+				continue
+			}
+			p, err := o.MatchSymbolWithAvailablePairs(h.InstrumentID, asset.Futures)
+			if err != nil {
+				return nil, err
+			}
+			mark, err := o.GetFuturesCurrentMarkPrice(h.InstrumentID)
+			if err != nil {
+				return nil, err
+			}
+			updated, err := time.Parse(time.RFC3339, h.UpdatedAt)
+			if err != nil {
+				return nil, err
+			}
+			// futures accounts hold separate long and short positions
+			for _, leg := range []struct {
+				side   order.Side
+				values []float64
+			}{{order.Long, v[:5]}, {order.Short, v[5:]}} {
+				if leg.values[0] == 0 {
+					continue
+				}
+				positions = append(positions, position.Position{
+					Exchange:         o.Name,
+					Pair:             p,
+					Asset:            asset.Futures,
+					Side:             leg.side,
+					Size:             leg.values[0],
+					EntryPrice:       leg.values[1],
+					MarkPrice:        mark.MarkPrice,
+					LiquidationPrice: leg.values[2],
+					Leverage:         leg.values[3],
+					UnrealisedPNL:    leg.values[4],
+					MarginType:       position.StringToMarginType(h.MarginMode),
+					Hedged:           true,
+					UpdatedAt:        updated,
+				})
+			}
+		}
+	}
+	return positions, nil
+}
+
+func (o *OKEX) getSwapPositions() ([]position.Position, error) {
+	resp, err := o.GetSwapPostions()
+	if err != nil {
+		return nil, err
+	}
+	var positions []position.Position
+	for i := range resp {
+		for j := range resp[i].Holding {
+			h := &resp[i].Holding[j]
+			v, err := parsePositionFloats(h.Position, h.AvgCost, h.LiquidationPrice,
+				h.Leverage, h.UnrealizedPnl)
+			if err != nil {
+				return nil, err
+			}
+			if v[0] == 0 {
+				continue
+			}
+			p, err := o.MatchSymbolWithAvailablePairs(h.InstrumentID, asset.PerpetualSwap)
+			if err != nil {
+				return nil, err
+			}
+			mark, err := o.GetSwapMarkPrice(h.InstrumentID)
+			if err != nil {
+				return nil, err
+			}
+			side, err := order.StringToOrderSide(h.Side)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, position.Position{
+				Exchange:         o.Name,
+				Pair:             p,
+				Asset:            asset.PerpetualSwap,
+				Side:             side,
+				Size:             v[0],
+				EntryPrice:       v[1],
+				MarkPrice:        mark.MarkPrice,
+				LiquidationPrice: v[2],
+				Leverage:         v[3],
+				UnrealisedPNL:    v[4],
+				MarginType:       position.StringToMarginType(resp[i].MarginMode),
+				Hedged:           true,
+				UpdatedAt:        h.Timestamp,
+			})
+		}
+	}
+	return positions, nil
+}
+
+// CloseDerivativePosition closes all or part of an open derivatives position
+func (o *OKEX) CloseDerivativePosition(c *position.Close) (order.SubmitResponse, error) {
+	positions, err := o.GetDerivativePositions(c.Asset)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	s, err := position.CloseOrder(positions, c)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return o.SubmitOrder(s)
+}
+
+// SubmitOrder submits a new order, futures and perpetual swap orders open a
+// position unless they are reduce only or offset to close
+func (o *OKEX) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+	if s.AssetType != asset.Futures && s.AssetType != asset.PerpetualSwap {
+		return o.OKGroup.SubmitOrder(s)
+	}
+	var resp order.SubmitResponse
+	err := s.Validate()
+	if err != nil {
+		return resp, err
+	}
+	orderType, err := derivativeOrderType(s)
+	if err != nil {
+		return resp, err
+	}
+	fPair, err := o.FormatExchangeCurrency(s.Pair, s.AssetType)
+	if err != nil {
+		return resp, err
+	}
+	var matchPrice int64
+	if s.Type == order.Market {
+		matchPrice = 1
+	}
+
+	switch s.AssetType {
+	case asset.Futures:
+		if s.Amount != math.Trunc(s.Amount) {
+			return resp, errors.New("futures contract amount can not have decimals")
+		}
+		r, err := o.PlaceFuturesOrder(okgroup.PlaceFuturesOrderRequest{
+			ClientOid:    s.ClientOrderID,
+			InstrumentID: fPair.String(),
+			Type:         orderType,
+			Price:        s.Price,
+			Size:         int64(s.Amount),
+			MatchPrice:   matchPrice,
+			Leverage:     int64(s.Leverage),
+		})
+		if err != nil {
+			return resp, err
+		}
+		resp.IsOrderPlaced = r.Result
+		resp.OrderID = r.OrderID
+	case asset.PerpetualSwap:
+		r, err := o.PlaceSwapOrder(okgroup.PlaceSwapOrderRequest{
+			ClientOID:    s.ClientOrderID,
+			InstrumentID: fPair.String(),
+			Type:         orderType,
+			Price:        s.Price,
+			Size:         s.Amount,
+			MatchPrice:   matchPrice,
+		})
+		if err != nil {
+			return resp, err
+		}
+		resp.IsOrderPlaced = r.Result
+		resp.OrderID = r.OrderID
+	}
+	return resp, nil
+}
+
+// derivativeOrderType returns the futures and swap order type, buying opens a
+// long position and selling opens a short position unless the order closes
+// one, in which case selling closes a long position and buying a short one
+func derivativeOrderType(s *order.Submit) (int64, error) {
+	closing := s.ReduceOnly || s.Offset == "close"
+	switch {
+	case s.Side == order.Buy && !closing:
+		return 1, nil
+	case s.Side == order.Sell && !closing:
+		return 2, nil
+	case s.Side == order.Sell:
+		return 3, nil
+	case s.Side == order.Buy:
+		return 4, nil
+	}
+	return 0, fmt.Errorf("%w %s", order.ErrSideIsInvalid, s.Side)
+}
+
+// parsePositionFloats converts the string encoded values of a position, an
+// empty string is returned when a value does not apply to the position
+func parsePositionFloats(values ...string) ([]float64, error) {
+	resp := make([]float64, len(values))
+	for i := range values {
+		if values[i] == "" {
+			continue
+		}
+		var err error
+		resp[i], err = strconv.ParseFloat(values[i], 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// GetLatestFundingRate returns the current funding rate of a perpetual swap,
+// OKEx's funding rate applies at the coming funding time and the estimated
+// rate at the funding time after
+func (o *OKEX) GetLatestFundingRate(p currency.Pair, a asset.Item) (*fundingrate.LatestRate, error) {
+	if a != asset.PerpetualSwap {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, o.Name)
+	}
+	fPair, err := o.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.GetSwapNextSettlementTime(fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	return &fundingrate.LatestRate{
+		Exchange:        o.Name,
+		Pair:            p,
+		Asset:           a,
+		Rate:            resp.FundingRate,
+		PredictedRate:   resp.EstimatedRate,
+		NextFundingTime: resp.FundingTime,
+		UpdatedAt:       time.Now(),
+	}, nil
+}
+
+// GetFundingRateHistory returns the funding rates applied to a perpetual
+// swap over a date range, OKEx only serves its most recent funding rates so
+// older parts of the range are not returned
+func (o *OKEX) GetFundingRateHistory(r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if r.Asset != asset.PerpetualSwap {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", r.Asset, o.Name)
+	}
+	fPair, err := o.FormatExchangeCurrency(r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.GetSwapFundingRateHistory(okgroup.GetSwapFundingRateHistoryRequest{
+		InstrumentID: fPair.String(),
+		Limit:        fundingRateHistoryLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]fundingrate.Rate, len(resp))
+	for i := range resp {
+		rates[i] = fundingrate.Rate{
+			Time: resp[i].FundingTime,
+			Rate: resp[i].FundingRate,
+		}
+	}
+	return r.Collate(o.Name, rates), nil
+}
+
+// GetLeverage returns the leverage and margin type applied to orders in a
+// perpetual swap, fixed margin swaps report their long leverage
+func (o *OKEX) GetLeverage(p currency.Pair, a asset.Item) (*position.Leverage, error) {
+	if a != asset.PerpetualSwap {
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, o.Name)
+	}
+	fPair, err := o.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := o.GetSwapAccountSettingsOfAContract(fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	return &position.Leverage{
+		Exchange:   o.Name,
+		Pair:       p,
+		Asset:      a,
+		Leverage:   resp.LongLeverage,
+		MarginType: position.StringToMarginType(resp.MarginMode),
+	}, nil
+}
+
+// SetLeverage sets the leverage applied to orders in a perpetual swap, fixed
+// margin swaps have their long and short leverage set
+func (o *OKEX) SetLeverage(p currency.Pair, a asset.Item, leverage float64) error {
+	if a != asset.PerpetualSwap {
+		return fmt.Errorf("asset type of %s is not supported by %s", a, o.Name)
+	}
+	l, err := position.WholeLeverage(leverage)
+	if err != nil {
+		return err
+	}
+	fPair, err := o.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return err
+	}
+	settings, err := o.GetSwapAccountSettingsOfAContract(fPair.String())
+	if err != nil {
+		return err
+	}
+	return o.setSwapLeverage(fPair.String(), l, position.StringToMarginType(settings.MarginMode))
+}
+
+// SetMarginType sets whether a perpetual swap uses fixed or crossed margin,
+// the current leverage of the swap is kept
+func (o *OKEX) SetMarginType(p currency.Pair, a asset.Item, m position.MarginType) error {
+	if a != asset.PerpetualSwap {
+		return fmt.Errorf("asset type of %s is not supported by %s", a, o.Name)
+	}
+	err := m.Validate()
+	if err != nil {
+		return err
+	}
+	fPair, err := o.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return err
+	}
+	settings, err := o.GetSwapAccountSettingsOfAContract(fPair.String())
+	if err != nil {
+		return err
+	}
+	return o.setSwapLeverage(fPair.String(), int64(settings.LongLeverage), m)
+}
+
+// setSwapLeverage sets the leverage of a swap, OKEx selects the margin mode
+// by the side the leverage is set on
+func (o *OKEX) setSwapLeverage(instrumentID string, leverage int64, m position.MarginType) error {
+	sides := []int64{okgroup.SwapLeverageSideFixedLong, okgroup.SwapLeverageSideFixedShort}
+	if m == position.Cross {
+		sides = []int64{okgroup.SwapLeverageSideCrossed}
+	}
+	for i := range sides {
+		_, err := o.SetSwapLeverageLevelOfAContract(okgroup.SetSwapLeverageLevelOfAContractRequest{
+			InstrumentID: instrumentID,
+			Leverage:     leverage,
+			Side:         sides[i],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateDerivativePrice updates and returns the mark price, index price and
+// open interest of a contract, the estimated delivery price of futures is
+// only fetched once it has been published ahead of delivery
+func (o *OKEX) UpdateDerivativePrice(p currency.Pair, a asset.Item) (*derivative.Price, error) {
+	fPair, err := o.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	instrumentID := fPair.String()
+	resp := &derivative.Price{
+		ExchangeName: o.Name,
+		Pair:         p,
+		AssetType:    a,
+	}
+	switch a {
+	case asset.Futures:
+		mark, err := o.GetFuturesCurrentMarkPrice(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		index, err := o.GetFuturesIndices(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		interest, err := o.GetFuturesOpenInterests(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		delivery, err := futuresDeliveryTime(p)
+		if err != nil {
+			return nil, err
+		}
+		if time.Until(delivery) <= estimatedDeliveryPriceWindow {
+			estimate, err := o.GetFuturesEstimatedDeliveryPrice(instrumentID)
+			if err != nil {
+				return nil, err
+			}
+			resp.EstimatedSettlementPrice = estimate.SettlementPrice
+		}
+		resp.MarkPrice = mark.MarkPrice
+		resp.IndexPrice = index.Index
+		resp.OpenInterest = interest.Amount
+		resp.LastUpdated = mark.Timestamp
+	case asset.PerpetualSwap:
+		mark, err := o.GetSwapMarkPrice(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		index, err := o.GetSwapIndices(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		interest, err := o.GetSwapOpenInterest(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		resp.MarkPrice = mark.MarkPrice
+		resp.IndexPrice = index.Index
+		resp.OpenInterest = interest.Amount
+		resp.LastUpdated = mark.Timestamp
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, o.Name)
+	}
+	err = derivative.ProcessPrice(resp)
+	if err != nil {
+		return nil, err
+	}
+	return derivative.GetPrice(o.Name, p, a)
+}
+
+// futuresDeliveryTime returns the delivery time of a futures contract from
+// the delivery date in its quote currency e.g. BTC-USD_201225
+func futuresDeliveryTime(p currency.Pair) (time.Time, error) {
+	date, err := time.Parse("060102", p.Quote.String())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s delivery date cannot be parsed: %w", p, err)
+	}
+	return date.Add(futuresDeliveryHour * time.Hour), nil
+}
+
+// UpdateContracts refreshes the contract metadata of futures and perpetual
+// swaps and rolls enabled futures pairs to the contract now listed under
+// their alias
+func (o *OKEX) UpdateContracts(a asset.Item) ([]contract.Roll, error) {
+	var contracts []contract.Contract
+	switch a {
+	case asset.Futures:
+		prods, err := o.GetFuturesContractInformation()
+		if err != nil {
+			return nil, err
+		}
+		for x := range prods {
+			t, ok := futuresContractTypes[prods[x].Alias]
+			if !ok {
+				t = contract.Unknown
+			}
+			p := strings.Split(prods[x].InstrumentID, currency.DashDelimiter)
+			if len(p) != 3 {
+				return nil, fmt.Errorf("%s unexpected instrument ID %s", o.Name, prods[x].InstrumentID)
+			}
+			pair := currency.NewPairWithDelimiter(p[0]+currency.DashDelimiter+p[1],
+				p[2],
+				currency.UnderscoreDelimiter)
+			expiry, err := futuresDeliveryTime(pair)
+			if err != nil {
+				return nil, err
+			}
+			underlying, err := currency.NewPairFromString(prods[x].Underlying)
+			if err != nil {
+				return nil, err
+			}
+			contracts = append(contracts, contract.Contract{
+				Exchange:           o.Name,
+				Pair:               pair,
+				Asset:              a,
+				Underlying:         underlying,
+				Type:               t,
+				Expiry:             expiry,
+				SettlementCurrency: currency.NewCode(prods[x].SettlementCurrency),
+			})
+		}
+	case asset.PerpetualSwap:
+		prods, err := o.GetSwapContractInformation()
+		if err != nil {
+			return nil, err
+		}
+		for x := range prods {
+			contracts = append(contracts, contract.Contract{
+				Exchange: o.Name,
+				Pair: currency.NewPairWithDelimiter(prods[x].UnderlyingIndex+
+					currency.DashDelimiter+
+					prods[x].QuoteCurrency,
+					"SWAP",
+					currency.UnderscoreDelimiter),
+				Asset: a,
+				Underlying: currency.NewPairWithDelimiter(prods[x].UnderlyingIndex,
+					prods[x].QuoteCurrency,
+					currency.DashDelimiter),
+				Type:               contract.Perpetual,
+				SettlementCurrency: currency.NewCode(prods[x].Coin),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, o.Name)
+	}
+	return o.LoadContracts(a, contracts)
+}
+
+// GetSubAccounts is not supported, the OKEx v3 API only returns a sub-account
+// looked up by name and cannot list them. Transfers address sub-accounts by
+// the name set on the exchange
+func (o *OKEX) GetSubAccounts() ([]account.SubAccountDetails, error) {
+	return nil, fmt.Errorf("%w, %s cannot list sub-accounts", common.ErrFunctionNotSupported, o.Name)
+}
+
+// TransferFunds moves funds between the spot, futures and swap accounts of
+// the master account or between the master account and a sub-account
+func (o *OKEX) TransferFunds(r *transfer.Request) (*transfer.ExchangeResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if r.FromAccount != "" && r.ToAccount != "" {
+		return nil, fmt.Errorf("%w %s only supports transfers between the master account and sub-accounts",
+			transfer.ErrUnsupportedTransfer,
+			o.Name)
+	}
+	from, ok := transferAccounts[r.FromAsset]
+	if !ok {
+		return nil, fmt.Errorf("%w %s wallet %s", transfer.ErrUnsupportedTransfer, o.Name, r.FromAsset)
+	}
+	to, ok := transferAccounts[r.ToAsset]
+	if !ok {
+		return nil, fmt.Errorf("%w %s wallet %s", transfer.ErrUnsupportedTransfer, o.Name, r.ToAsset)
+	}
+	req := okgroup.TransferAccountFundsRequest{
+		Currency: r.Currency.String(),
+		Amount:   r.Amount,
+		From:     from,
+		To:       to,
+	}
+	switch {
+	case r.FromAccount != "":
+		req.From = accountSubAccount
+		req.SubAccountID = r.FromAccount
+	case r.ToAccount != "":
+		req.To = accountSubAccount
+		req.SubAccountID = r.ToAccount
+	}
+	resp, err := o.TransferAccountFunds(req)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, fmt.Errorf("%s transfer of %v %s was not accepted", o.Name, r.Amount, r.Currency)
+	}
+	return &transfer.ExchangeResponse{
+		Name: o.Name,
+		ID:   strconv.FormatInt(resp.TransferID, 10),
+	}, nil
+}
@@ -15,6 +15,14 @@ const (
 	ImmediateOrCancelOrder
 )
 
+// Swap leverage sides, the side a swap's leverage is set on selects its
+// margin mode
+const (
+	SwapLeverageSideFixedLong = iota + 1
+	SwapLeverageSideFixedShort
+	SwapLeverageSideCrossed
+)
+
 // TradingPairData stores data about a trading pair
 type TradingPairData struct {
 	BaseCurrency  string  `json:"base_currency"`
@@ -542,6 +550,7 @@ type GetFuturePostionsDetails struct {
 	LongPnlRatio         string `json:"long_pnl_ratio"`
 	LongQty              string `json:"long_qty"`
 	LongSettlementPrice  string `json:"long_settlement_price"`
+	LongUnrealisedPnl    string `json:"long_unrealised_pnl"`
 	MarginMode           string `json:"margin_mode"`
 	RealisedPnl          string `json:"realised_pnl"`
 	ShortAvailQty        string `json:"short_avail_qty"`
@@ -552,6 +561,7 @@ type GetFuturePostionsDetails struct {
 	ShortPnlRatio        string `json:"short_pnl_ratio"`
 	ShortQty             string `json:"short_qty"`
 	ShortSettlementPrice string `json:"short_settlement_price"`
+	ShortUnrealisedPnl   string `json:"short_unrealised_pnl"`
 	UpdatedAt            string `json:"updated_at"`
 }
 
@@ -907,6 +917,7 @@ type GetSwapPostionsResponseHolding struct {
 	SettlementPrice  string    `json:"settlement_price"`
 	Side             string    `json:"side"`
 	Timestamp        time.Time `json:"timestamp"`
+	UnrealizedPnl    string    `json:"unrealized_pnl"`
 }
 
 // GetSwapAccountOfAllCurrencyResponse response data for GetSwapAccountOfAllCurrency
@@ -1227,20 +1238,22 @@ type GetSwapOnHoldAmountForOpenOrdersResponse struct {
 
 // GetSwapNextSettlementTimeResponse response data for GetSwapNextSettlementTime
 type GetSwapNextSettlementTimeResponse struct {
-	InstrumentID string `json:"instrument_id"`
-	FundingTime  string `json:"funding_time"`
+	InstrumentID  string    `json:"instrument_id"`
+	FundingTime   time.Time `json:"funding_time"`
+	FundingRate   float64   `json:"funding_rate,string"`
+	EstimatedRate float64   `json:"estimated_rate,string"`
 }
 
 // GetSwapMarkPriceResponse response data for GetSwapMarkPrice
 type GetSwapMarkPriceResponse struct {
-	InstrumentID string `json:"instrument_id"`
-	MarkPrice    string `json:"mark_price"`
-	Timstamp     string `json:"timstamp"`
+	InstrumentID string    `json:"instrument_id"`
+	MarkPrice    float64   `json:"mark_price,string"`
+	Timestamp    time.Time `json:"timestamp"`
 }
 
 // GetSwapFundingRateHistoryRequest request data for GetSwapFundingRateHistory
 type GetSwapFundingRateHistoryRequest struct {
-	InstrumentID string `url:"ins-trument_id"`         // [required] Contract ID, e.g. "BTC-USD-SWAP
+	InstrumentID string `url:"-"`                      // [required] Contract ID, e.g. "BTC-USD-SWAP
 	From         int64  `url:"from,string,omitempty"`  // [optional] Request paging content for this page number.（Example: 1,2,3,4,5. From 4 we only have 4, to 4 we only have 3）
 	To           int64  `url:"to,string,omitempty"`    // [optional] Request page after (older) this pagination id. （Example: 1,2,3,4,5. From 4 we only have 4, to 4 we only have 3）
 	Limit        int64  `url:"limit,string,omitempty"` // [optional] Number of results per request. Maximum 100.
@@ -1248,12 +1261,12 @@ type GetSwapFundingRateHistoryRequest struct {
 
 // GetSwapFundingRateHistoryResponse response data for GetSwapFundingRateHistory
 type GetSwapFundingRateHistoryResponse struct {
-	InstrumentID string  `json:"instrument_id"`
-	FundingRate  float64 `json:"funding_rate,string,omitempty"`
-	RealizedRate float64 `json:"realized_rate,string"`
-	InterestRate float64 `json:"interest_rate,string"`
-	FundingTime  string  `json:"funding_time"`
-	FundingFee   float64 `json:"funding_fee,string,omitempty"`
+	InstrumentID string    `json:"instrument_id"`
+	FundingRate  float64   `json:"funding_rate,string,omitempty"`
+	RealizedRate float64   `json:"realized_rate,string"`
+	InterestRate float64   `json:"interest_rate,string"`
+	FundingTime  time.Time `json:"funding_time"`
+	FundingFee   float64   `json:"funding_fee,string,omitempty"`
 }
 
 // GetETTResponse response data for GetETT
@@ -1404,6 +1417,18 @@ type WebsocketTickerData struct {
 	} `json:"data"`
 }
 
+// WebsocketDerivativePriceData contains formatted data for mark price and
+// estimated settlement price websocket responses
+type WebsocketDerivativePriceData struct {
+	Table string `json:"table"`
+	Data  []struct {
+		InstrumentID    string    `json:"instrument_id"`
+		MarkPrice       float64   `json:"mark_price,string"`
+		SettlementPrice float64   `json:"settlement_price,string"`
+		Timestamp       time.Time `json:"timestamp"`
+	} `json:"data"`
+}
+
 // WebsocketTradeResponse contains formatted data for trade related websocket responses
 type WebsocketTradeResponse struct {
 	Table string `json:"table"`
@@ -1,6 +1,7 @@
 package okgroup
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivative"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -133,7 +135,7 @@ const (
 	okGroupWsFuturesCandle86400s   = okGroupWsFuturesSubsection + okGroupWsCandle86400s
 	okGroupWsFuturesCandle604900s  = okGroupWsFuturesSubsection + okGroupWsCandle604900s
 	okGroupWsFuturesTrade          = okGroupWsFuturesSubsection + okGroupWsTrade
-	okGroupWsFuturesEstimatedPrice = okGroupWsFuturesSubsection + okGroupWsTrade
+	okGroupWsFuturesEstimatedPrice = okGroupWsFuturesSubsection + okGroupWsEstimatedPrice
 	okGroupWsFuturesPriceRange     = okGroupWsFuturesSubsection + okGroupWsPriceRange
 	okGroupWsFuturesDepth          = okGroupWsFuturesSubsection + okGroupWsDepth
 	okGroupWsFuturesDepth5         = okGroupWsFuturesSubsection + okGroupWsDepth5
@@ -163,7 +165,9 @@ var defaultSpotSubscribedChannels = []string{okGroupWsSpotDepth,
 var defaultFuturesSubscribedChannels = []string{okGroupWsFuturesDepth,
 	okGroupWsFuturesCandle300s,
 	okGroupWsFuturesTicker,
-	okGroupWsFuturesTrade}
+	okGroupWsFuturesTrade,
+	okGroupWsFuturesMarkPrice,
+	okGroupWsFuturesEstimatedPrice}
 
 var defaultIndexSubscribedChannels = []string{okGroupWsIndexCandle300s,
 	okGroupWsIndexTicker}
@@ -208,6 +212,14 @@ func (o *OKGroup) WsConnect() error {
 
 // WsLogin sends a login request to websocket to enable access to authenticated endpoints
 func (o *OKGroup) WsLogin() error {
+	ctx, cancel := o.Websocket.RequestContext()
+	defer cancel()
+	return o.WsLoginContext(ctx)
+}
+
+// WsLoginContext sends a login request to websocket to enable access to
+// authenticated endpoints, giving up when ctx is cancelled
+func (o *OKGroup) WsLoginContext(ctx context.Context) error {
 	o.Websocket.SetCanUseAuthenticatedEndpoints(true)
 	unixTime := time.Now().UTC().Unix()
 	signPath := "/users/self/verify"
@@ -225,11 +237,34 @@ func (o *OKGroup) WsLogin() error {
 			base64,
 		},
 	}
-	_, err := o.Websocket.Conn.SendMessageReturnResponse("login", request)
+	// A repeated login is harmless, so a lost response is retried once
+	resp, err := o.Websocket.Conn.SendMessageReturnResponses(ctx,
+		"login",
+		request,
+		stream.RequestConfig{Retries: 1})
 	if err != nil {
 		o.Websocket.SetCanUseAuthenticatedEndpoints(false)
 		return err
 	}
+	var errorResponse WebsocketErrorResponse
+	err = json.Unmarshal(resp[0], &errorResponse)
+	if err == nil && errorResponse.ErrorCode > 0 {
+		o.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		return fmt.Errorf("%v login error - %v message: %s",
+			o.Name,
+			errorResponse.ErrorCode,
+			errorResponse.Message)
+	}
+	var eventResponse WebsocketEventResponse
+	err = json.Unmarshal(resp[0], &eventResponse)
+	if err != nil {
+		o.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		return err
+	}
+	if !eventResponse.Success {
+		o.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		return errors.New(o.Name + " - login was unsuccessful")
+	}
 	return nil
 }
 
@@ -272,6 +307,8 @@ func (o *OKGroup) WsHandleData(respRaw []byte) error {
 			return o.wsProcessTrades(respRaw)
 		case okGroupWsOrder:
 			return o.wsProcessOrder(respRaw)
+		case okGroupWsMarkPrice, okGroupWsEstimatedPrice:
+			return o.wsProcessDerivativePrices(respRaw)
 		}
 		o.Websocket.DataHandler <- stream.UnhandledMessageWarning{
 			Message: o.Name + stream.UnhandledMessage + string(respRaw),
@@ -282,6 +319,11 @@ func (o *OKGroup) WsHandleData(respRaw []byte) error {
 	var errorResponse WebsocketErrorResponse
 	err = json.Unmarshal(respRaw, &errorResponse)
 	if err == nil && errorResponse.ErrorCode > 0 {
+		// login failures are sent as errors without the login event so
+		// they are matched to a pending login request
+		if o.Websocket.Match.IncomingWithData("login", respRaw) {
+			return nil
+		}
 		return fmt.Errorf("%v error - %v message: %s ",
 			o.Name,
 			errorResponse.ErrorCode,
@@ -291,9 +333,7 @@ func (o *OKGroup) WsHandleData(respRaw []byte) error {
 	err = json.Unmarshal(respRaw, &eventResponse)
 	if err == nil && eventResponse.Event != "" {
 		if eventResponse.Event == "login" {
-			if o.Websocket.Match.Incoming("login") {
-				o.Websocket.SetCanUseAuthenticatedEndpoints(eventResponse.Success)
-			}
+			o.Websocket.Match.IncomingWithData("login", respRaw)
 		}
 		if o.Verbose {
 			log.Debug(log.ExchangeSys,
@@ -399,17 +439,7 @@ func (o *OKGroup) wsProcessTickers(respRaw []byte) error {
 	}
 	a := o.GetAssetTypeFromTableName(response.Table)
 	for i := range response.Data {
-		f := strings.Split(response.Data[i].InstrumentID, delimiterDash)
-
-		var c currency.Pair
-		switch a {
-		case asset.Futures, asset.PerpetualSwap:
-			c = currency.NewPairWithDelimiter(f[0]+delimiterDash+f[1],
-				f[2],
-				currency.UnderscoreDelimiter)
-		default:
-			c = currency.NewPairWithDelimiter(f[0], f[1], delimiterDash)
-		}
+		c := wsInstrumentToPair(response.Data[i].InstrumentID, a)
 
 		baseVolume := response.Data[i].BaseVolume24h
 		if response.Data[i].ContractVolume24h != 0 {
@@ -436,10 +466,58 @@ func (o *OKGroup) wsProcessTickers(respRaw []byte) error {
 			Pair:         c,
 			LastUpdated:  response.Data[i].Timestamp,
 		}
+
+		if response.Data[i].OpenInterest != 0 {
+			o.Websocket.DataHandler <- &derivative.Price{
+				ExchangeName: o.Name,
+				Pair:         c,
+				AssetType:    a,
+				OpenInterest: response.Data[i].OpenInterest,
+				LastUpdated:  response.Data[i].Timestamp,
+			}
+		}
 	}
 	return nil
 }
 
+// wsProcessDerivativePrices converts mark price and estimated settlement
+// price data and sends it to the datahandler, each channel only carries one
+// value so the derivative price service retains the others
+func (o *OKGroup) wsProcessDerivativePrices(respRaw []byte) error {
+	var response WebsocketDerivativePriceData
+	err := json.Unmarshal(respRaw, &response)
+	if err != nil {
+		return err
+	}
+	a := o.GetAssetTypeFromTableName(response.Table)
+	for i := range response.Data {
+		o.Websocket.DataHandler <- &derivative.Price{
+			ExchangeName:             o.Name,
+			Pair:                     wsInstrumentToPair(response.Data[i].InstrumentID, a),
+			AssetType:                a,
+			MarkPrice:                response.Data[i].MarkPrice,
+			EstimatedSettlementPrice: response.Data[i].SettlementPrice,
+			LastUpdated:              response.Data[i].Timestamp,
+		}
+	}
+	return nil
+}
+
+// wsInstrumentToPair converts a websocket instrument ID to a currency pair,
+// derivative instruments such as "BTC-USD-SWAP" keep their underlying as the
+// base currency
+func wsInstrumentToPair(instrumentID string, a asset.Item) currency.Pair {
+	f := strings.Split(instrumentID, delimiterDash)
+	switch a {
+	case asset.Futures, asset.PerpetualSwap:
+		return currency.NewPairWithDelimiter(f[0]+delimiterDash+f[1],
+			f[2],
+			currency.UnderscoreDelimiter)
+	default:
+		return currency.NewPairWithDelimiter(f[0], f[1], delimiterDash)
+	}
+}
+
 // wsProcessTrades converts trade data and sends it to the datahandler
 func (o *OKGroup) wsProcessTrades(respRaw []byte) error {
 	if !o.IsSaveTradeDataEnabled() {
@@ -595,6 +673,10 @@ func (o *OKGroup) WsProcessOrderBook(respRaw []byte) error {
 			}
 			err := o.WsProcessUpdateOrderbook(&response.Data[i], c, a)
 			if err != nil {
+				if errors.Is(err, buffer.ErrChecksumMismatch) {
+					// the buffer resyncs the orderbook via REST
+					return err
+				}
 				err2 := o.wsResubscribeToOrderbook(&response)
 				if err2 != nil {
 					o.Websocket.DataHandler <- err2
@@ -698,6 +780,7 @@ func (o *OKGroup) WsProcessUpdateOrderbook(wsEventData *WebsocketOrderBook, inst
 		Asset:      a,
 		Pair:       instrument,
 		UpdateTime: wsEventData.Timestamp,
+		Checksum:   uint32(wsEventData.Checksum),
 	}
 
 	var err error
@@ -710,22 +793,7 @@ func (o *OKGroup) WsProcessUpdateOrderbook(wsEventData *WebsocketOrderBook, inst
 		return err
 	}
 
-	err = o.Websocket.Orderbook.Update(&update)
-	if err != nil {
-		return err
-	}
-
-	updatedOb := o.Websocket.Orderbook.GetOrderbook(instrument, a)
-	checksum := o.CalculateUpdateOrderbookChecksum(updatedOb)
-
-	if checksum != wsEventData.Checksum {
-		// re-sub
-		log.Warnf(log.ExchangeSys, "%s checksum failure for item %s",
-			o.Name,
-			wsEventData.InstrumentID)
-		return errors.New("checksum failed")
-	}
-	return nil
+	return o.Websocket.Orderbook.Update(&update)
 }
 
 // CalculatePartialOrderbookChecksum alternates over the first 25 bid and ask
@@ -753,6 +821,12 @@ func (o *OKGroup) CalculatePartialOrderbookChecksum(orderbookData *WebsocketOrde
 	return int32(crc32.ChecksumIEEE([]byte(checksumStr)))
 }
 
+// orderbookChecksum calculates the checksum of the websocket orderbook
+// buffer for verification of updates
+func (o *OKGroup) orderbookChecksum(b *orderbook.Base) uint32 {
+	return uint32(o.CalculateUpdateOrderbookChecksum(b))
+}
+
 // CalculateUpdateOrderbookChecksum alternates over the first 25 bid and ask
 // entries of a merged orderbook. The checksum is made up of the price and the
 // quantity with a semicolon (:) deliminating them. This will also work when
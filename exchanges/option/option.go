@@ -0,0 +1,80 @@
+package option
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ParseType returns an option type from its full or single letter name
+func ParseType(s string) (Type, error) {
+	switch strings.ToUpper(s) {
+	case "CALL", "C":
+		return Call, nil
+	case "PUT", "P":
+		return Put, nil
+	}
+	return "", fmt.Errorf("%w %s", ErrInvalidType, s)
+}
+
+// String implements the stringer interface
+func (t Type) String() string {
+	return string(t)
+}
+
+// Validate checks the contract descriptor is complete
+func (c *Contract) Validate() error {
+	if c.Pair.IsEmpty() {
+		return errPairUnset
+	}
+	if c.Underlying.IsEmpty() {
+		return errUnderlyingUnset
+	}
+	if c.Strike <= 0 {
+		return errInvalidStrike
+	}
+	if c.Expiry.IsZero() {
+		return errExpiryUnset
+	}
+	if c.Type != Call && c.Type != Put {
+		return fmt.Errorf("%w %s", ErrInvalidType, c.Type)
+	}
+	return nil
+}
+
+// Expiries returns the distinct expiries of the chain in ascending order
+func (c *Chain) Expiries() []time.Time {
+	var expiries []time.Time
+	seen := make(map[int64]bool)
+	for i := range c.Quotes {
+		ts := c.Quotes[i].Expiry.UnixNano()
+		if seen[ts] {
+			continue
+		}
+		seen[ts] = true
+		expiries = append(expiries, c.Quotes[i].Expiry)
+	}
+	sort.Slice(expiries, func(i, j int) bool {
+		return expiries[i].Before(expiries[j])
+	})
+	return expiries
+}
+
+// GetByExpiry returns the quotes of the contracts expiring at the supplied
+// time ordered by strike, with calls before puts at the same strike
+func (c *Chain) GetByExpiry(expiry time.Time) []Quote {
+	var quotes []Quote
+	for i := range c.Quotes {
+		if c.Quotes[i].Expiry.Equal(expiry) {
+			quotes = append(quotes, c.Quotes[i])
+		}
+	}
+	sort.Slice(quotes, func(i, j int) bool {
+		if quotes[i].Strike == quotes[j].Strike {
+			return quotes[i].Type == Call && quotes[j].Type == Put
+		}
+		return quotes[i].Strike < quotes[j].Strike
+	})
+	return quotes
+}
@@ -0,0 +1,92 @@
+package option
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var testExpiry = time.Date(2020, 12, 25, 8, 0, 0, 0, time.UTC)
+
+func TestParseType(t *testing.T) {
+	t.Parallel()
+	tester := []struct {
+		Input       string
+		Expected    Type
+		ExpectedErr error
+	}{
+		{"call", Call, nil},
+		{"C", Call, nil},
+		{"PUT", Put, nil},
+		{"p", Put, nil},
+		{"straddle", "", ErrInvalidType},
+	}
+	for i := range tester {
+		o, err := ParseType(tester[i].Input)
+		if !errors.Is(err, tester[i].ExpectedErr) {
+			t.Errorf("test %d: expected %v, received %v", i, tester[i].ExpectedErr, err)
+		}
+		if o != tester[i].Expected {
+			t.Errorf("test %d: expected %v, received %v", i, tester[i].Expected, o)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairFromString("BTC-25DEC20-18000-C")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tester := []struct {
+		Contract    Contract
+		ExpectedErr error
+	}{
+		{Contract{}, errPairUnset},
+		{Contract{Pair: p}, errUnderlyingUnset},
+		{Contract{Pair: p, Underlying: currency.BTC}, errInvalidStrike},
+		{Contract{Pair: p, Underlying: currency.BTC, Strike: 18000}, errExpiryUnset},
+		{Contract{Pair: p, Underlying: currency.BTC, Strike: 18000, Expiry: testExpiry}, ErrInvalidType},
+		{Contract{Pair: p, Underlying: currency.BTC, Strike: 18000, Expiry: testExpiry, Type: Call}, nil},
+	}
+	for i := range tester {
+		err := tester[i].Contract.Validate()
+		if !errors.Is(err, tester[i].ExpectedErr) {
+			t.Errorf("test %d: expected %v, received %v", i, tester[i].ExpectedErr, err)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+	later := testExpiry.AddDate(0, 3, 0)
+	c := Chain{
+		Underlying: currency.BTC,
+		Quotes: []Quote{
+			{Contract: Contract{Strike: 20000, Expiry: later, Type: Call}},
+			{Contract: Contract{Strike: 20000, Expiry: testExpiry, Type: Put}},
+			{Contract: Contract{Strike: 18000, Expiry: testExpiry, Type: Put}},
+			{Contract: Contract{Strike: 20000, Expiry: testExpiry, Type: Call}},
+		},
+	}
+
+	expiries := c.Expiries()
+	if len(expiries) != 2 {
+		t.Fatalf("expected 2 expiries, received %v", len(expiries))
+	}
+	if !expiries[0].Equal(testExpiry) || !expiries[1].Equal(later) {
+		t.Errorf("unexpected expiry order %v", expiries)
+	}
+
+	quotes := c.GetByExpiry(testExpiry)
+	if len(quotes) != 3 {
+		t.Fatalf("expected 3 quotes, received %v", len(quotes))
+	}
+	if quotes[0].Strike != 18000 ||
+		quotes[1].Strike != 20000 || quotes[1].Type != Call ||
+		quotes[2].Strike != 20000 || quotes[2].Type != Put {
+		t.Errorf("unexpected quote order %+v", quotes)
+	}
+}
@@ -0,0 +1,60 @@
+package option
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Type defines whether an option contract is a call or a put
+type Type string
+
+// Option types
+const (
+	Call Type = "CALL"
+	Put  Type = "PUT"
+)
+
+var (
+	// ErrInvalidType is returned when an option type cannot be parsed
+	ErrInvalidType = errors.New("invalid option type")
+
+	errPairUnset       = errors.New("contract currency pair unset")
+	errUnderlyingUnset = errors.New("contract underlying currency unset")
+	errInvalidStrike   = errors.New("contract strike price must be greater than zero")
+	errExpiryUnset     = errors.New("contract expiry unset")
+)
+
+// Contract describes an option contract, the pair is the exchange
+// instrument the contract trades as
+type Contract struct {
+	Pair       currency.Pair
+	Underlying currency.Code
+	Strike     float64
+	Expiry     time.Time
+	Type       Type
+}
+
+// Quote is the market state of an option contract within a chain
+type Quote struct {
+	Contract
+	Bid  float64
+	Ask  float64
+	Last float64
+	Mark float64
+	// MarkIV is the implied volatility of the mark price as a percentage
+	MarkIV          float64
+	UnderlyingPrice float64
+	OpenInterest    float64
+	Volume          float64
+}
+
+// Chain holds the quotes of every listed option contract of an underlying
+// across all expiries
+type Chain struct {
+	Exchange    string
+	Underlying  currency.Code
+	Quotes      []Quote
+	LastUpdated time.Time
+}
@@ -0,0 +1,149 @@
+package order
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// stepTolerance allows for float imprecision when checking an increment
+const stepTolerance = 1e-9
+
+// Load stores execution limits, replacing any limits already stored for the
+// same asset and pair
+func (e *ExecutionLimits) Load(limits []Limits) error {
+	for i := range limits {
+		if err := limits[i].validate(); err != nil {
+			return err
+		}
+	}
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	if e.m == nil {
+		e.m = make(map[asset.Item]map[*currency.Item]map[*currency.Item]Limits)
+	}
+	for i := range limits {
+		a := limits[i].Asset
+		if e.m[a] == nil {
+			e.m[a] = make(map[*currency.Item]map[*currency.Item]Limits)
+		}
+		base := limits[i].Pair.Base.Item
+		if e.m[a][base] == nil {
+			e.m[a][base] = make(map[*currency.Item]Limits)
+		}
+		e.m[a][base][limits[i].Pair.Quote.Item] = limits[i]
+	}
+	return nil
+}
+
+// GetLimits returns the execution limits of a pair
+func (e *ExecutionLimits) GetLimits(a asset.Item, p currency.Pair) (Limits, error) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+	l, ok := e.m[a][p.Base.Item][p.Quote.Item]
+	if !ok {
+		return Limits{}, fmt.Errorf("%w for %s %s", ErrExecutionLimitsNotLoaded, a, p)
+	}
+	return l, nil
+}
+
+func (l *Limits) validate() error {
+	if l.Pair.IsEmpty() {
+		return fmt.Errorf("%w, %v", errLimitsInvalid, ErrPairIsEmpty)
+	}
+	if !l.Asset.IsValid() {
+		return fmt.Errorf("%w, %s asset type %s is invalid", errLimitsInvalid, l.Pair, l.Asset)
+	}
+	if l.MinPrice < 0 || l.MaxPrice < 0 || l.PriceTick < 0 ||
+		l.MinAmount < 0 || l.MaxAmount < 0 || l.AmountStep < 0 || l.MinNotional < 0 {
+		return fmt.Errorf("%w, %s %s values cannot be negative", errLimitsInvalid, l.Asset, l.Pair)
+	}
+	if l.MaxPrice > 0 && l.MinPrice > l.MaxPrice {
+		return fmt.Errorf("%w, %s %s minimum price %v exceeds maximum %v", errLimitsInvalid, l.Asset, l.Pair, l.MinPrice, l.MaxPrice)
+	}
+	if l.MaxAmount > 0 && l.MinAmount > l.MaxAmount {
+		return fmt.Errorf("%w, %s %s minimum amount %v exceeds maximum %v", errLimitsInvalid, l.Asset, l.Pair, l.MinAmount, l.MaxAmount)
+	}
+	return nil
+}
+
+// Conforms checks an order price and amount against the limits. For market
+// orders the price is a reference price, such as the last traded price, and
+// is only used to check the minimum notional value, which is skipped when the
+// reference price is zero
+func (l *Limits) Conforms(price, amount float64, orderType Type) error {
+	if l.MinAmount > 0 && amount < l.MinAmount {
+		return fmt.Errorf("%w, %v < %v", ErrAmountBelowMin, amount, l.MinAmount)
+	}
+	if l.MaxAmount > 0 && amount > l.MaxAmount {
+		return fmt.Errorf("%w, %v > %v", ErrAmountExceedsMax, amount, l.MaxAmount)
+	}
+	if !isMultiple(amount, l.AmountStep) {
+		return fmt.Errorf("%w, %v step %v", ErrAmountExceedsStep, amount, l.AmountStep)
+	}
+	if orderType == Market {
+		if l.MinNotional > 0 && price > 0 && price*amount < l.MinNotional {
+			return fmt.Errorf("%w, %v < %v at reference price %v", ErrNotionalValueBelowMin, price*amount, l.MinNotional, price)
+		}
+		return nil
+	}
+	if l.MinPrice > 0 && price < l.MinPrice {
+		return fmt.Errorf("%w, %v < %v", ErrPriceBelowMin, price, l.MinPrice)
+	}
+	if l.MaxPrice > 0 && price > l.MaxPrice {
+		return fmt.Errorf("%w, %v > %v", ErrPriceExceedsMax, price, l.MaxPrice)
+	}
+	if !isMultiple(price, l.PriceTick) {
+		return fmt.Errorf("%w, %v tick %v", ErrPriceExceedsStep, price, l.PriceTick)
+	}
+	if l.MinNotional > 0 && price*amount < l.MinNotional {
+		return fmt.Errorf("%w, %v < %v", ErrNotionalValueBelowMin, price*amount, l.MinNotional)
+	}
+	return nil
+}
+
+// RoundPrice rounds a price to the nearest price tick
+func (l *Limits) RoundPrice(price float64) float64 {
+	if l.PriceTick <= 0 {
+		return price
+	}
+	return truncate(math.Round(price/l.PriceTick)*l.PriceTick, l.PriceTick)
+}
+
+// RoundAmount rounds an amount down to the amount step so the order never
+// exceeds the requested amount
+func (l *Limits) RoundAmount(amount float64) float64 {
+	if l.AmountStep <= 0 {
+		return amount
+	}
+	return truncate(math.Floor(amount/l.AmountStep+stepTolerance)*l.AmountStep, l.AmountStep)
+}
+
+// isMultiple returns whether the value is a multiple of the step, a zero
+// step is always satisfied
+func isMultiple(value, step float64) bool {
+	if step <= 0 {
+		return true
+	}
+	q := value / step
+	return math.Abs(q-math.Round(q)) <= stepTolerance*math.Max(1, math.Abs(q))
+}
+
+// truncate removes float noise from a rounded value by formatting it to the
+// decimal places of the step
+func truncate(value, step float64) float64 {
+	s := strconv.FormatFloat(step, 'f', -1, 64)
+	var decimals int
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		decimals = len(s) - i - 1
+	}
+	v, err := strconv.ParseFloat(strconv.FormatFloat(value, 'f', decimals, 64), 64)
+	if err != nil {
+		return value
+	}
+	return v
+}
@@ -0,0 +1,121 @@
+package order
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestLoadLimits(t *testing.T) {
+	t.Parallel()
+	var e ExecutionLimits
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := e.GetLimits(asset.Spot, p)
+	if !errors.Is(err, ErrExecutionLimitsNotLoaded) {
+		t.Fatalf("expected %v, received %v", ErrExecutionLimitsNotLoaded, err)
+	}
+
+	err = e.Load([]Limits{{Asset: asset.Spot}})
+	if !errors.Is(err, errLimitsInvalid) {
+		t.Fatalf("expected %v, received %v", errLimitsInvalid, err)
+	}
+	err = e.Load([]Limits{{Pair: p, Asset: "bad"}})
+	if !errors.Is(err, errLimitsInvalid) {
+		t.Fatalf("expected %v, received %v", errLimitsInvalid, err)
+	}
+	err = e.Load([]Limits{{Pair: p, Asset: asset.Spot, MinAmount: 2, MaxAmount: 1}})
+	if !errors.Is(err, errLimitsInvalid) {
+		t.Fatalf("expected %v, received %v", errLimitsInvalid, err)
+	}
+	err = e.Load([]Limits{{Pair: p, Asset: asset.Spot, PriceTick: -1}})
+	if !errors.Is(err, errLimitsInvalid) {
+		t.Fatalf("expected %v, received %v", errLimitsInvalid, err)
+	}
+
+	err = e.Load([]Limits{{Pair: p, Asset: asset.Spot, PriceTick: 0.01}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = e.Load([]Limits{{Pair: p, Asset: asset.Spot, PriceTick: 0.1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := e.GetLimits(asset.Spot, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick != 0.1 {
+		t.Errorf("expected replaced price tick 0.1, received %v", l.PriceTick)
+	}
+	_, err = e.GetLimits(asset.Margin, p)
+	if !errors.Is(err, ErrExecutionLimitsNotLoaded) {
+		t.Fatalf("expected %v, received %v", ErrExecutionLimitsNotLoaded, err)
+	}
+}
+
+func TestConforms(t *testing.T) {
+	t.Parallel()
+	l := Limits{
+		MinPrice:    1,
+		MaxPrice:    100000,
+		PriceTick:   0.01,
+		MinAmount:   0.001,
+		MaxAmount:   100,
+		AmountStep:  0.001,
+		MinNotional: 10,
+	}
+	tester := []struct {
+		Price       float64
+		Amount      float64
+		Type        Type
+		ExpectedErr error
+	}{
+		{100, 0.0001, Limit, ErrAmountBelowMin},
+		{100, 101, Limit, ErrAmountExceedsMax},
+		{100, 0.1234, Limit, ErrAmountExceedsStep},
+		{0.5, 1, Limit, ErrPriceBelowMin},
+		{100001, 1, Limit, ErrPriceExceedsMax},
+		{100.001, 1, Limit, ErrPriceExceedsStep},
+		{2, 1, Limit, ErrNotionalValueBelowMin},
+		{0, 0.3, Market, nil},
+		{20, 0.3, Market, ErrNotionalValueBelowMin},
+		{35000, 0.3, Market, nil},
+		{100.01, 0.3, Limit, nil},
+		{35000.07, 0.017, Limit, nil},
+	}
+	for x := range tester {
+		err := l.Conforms(tester[x].Price, tester[x].Amount, tester[x].Type)
+		if !errors.Is(err, tester[x].ExpectedErr) {
+			t.Errorf("test %d expected %v, received %v", x, tester[x].ExpectedErr, err)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	t.Parallel()
+	l := Limits{PriceTick: 0.05, AmountStep: 0.001}
+	if p := l.RoundPrice(100.12); p != 100.1 {
+		t.Errorf("expected 100.1, received %v", p)
+	}
+	if p := l.RoundPrice(100.13); p != 100.15 {
+		t.Errorf("expected 100.15, received %v", p)
+	}
+	if a := l.RoundAmount(0.12345); a != 0.123 {
+		t.Errorf("expected 0.123, received %v", a)
+	}
+	if a := l.RoundAmount(0.3); a != 0.3 {
+		t.Errorf("expected 0.3, received %v", a)
+	}
+	if err := l.Conforms(l.RoundPrice(35000.123), l.RoundAmount(1.23456), Limit); err != nil {
+		t.Error(err)
+	}
+	var none Limits
+	if p := none.RoundPrice(1.23456); p != 1.23456 {
+		t.Errorf("expected unchanged price, received %v", p)
+	}
+	if a := none.RoundAmount(1.23456); a != 1.23456 {
+		t.Errorf("expected unchanged amount, received %v", a)
+	}
+}
@@ -453,6 +453,8 @@ var stringsToOrderSide = []struct {
 	{"ask", Ask, nil},
 	{"ASK", Ask, nil},
 	{"aSk", Ask, nil},
+	{"long", Long, nil},
+	{"SHORT", Short, nil},
 	{"any", AnySide, nil},
 	{"ANY", AnySide, nil},
 	{"aNy", AnySide, nil},
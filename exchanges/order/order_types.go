@@ -2,6 +2,7 @@ package order
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -21,8 +22,18 @@ var (
 	ErrAmountIsInvalid            = errors.New("order amount is invalid")
 	ErrPriceMustBeSetIfLimitOrder = errors.New("order price must be set if limit order type is desired")
 	ErrOrderIDNotSet              = errors.New("order id or client order id is not set")
+	ErrExecutionLimitsNotLoaded   = errors.New("execution limits not loaded")
+	ErrPriceBelowMin              = errors.New("order price below minimum")
+	ErrPriceExceedsMax            = errors.New("order price exceeds maximum")
+	ErrPriceExceedsStep           = errors.New("order price is not a multiple of the price tick")
+	ErrAmountBelowMin             = errors.New("order amount below minimum")
+	ErrAmountExceedsMax           = errors.New("order amount exceeds maximum")
+	ErrAmountExceedsStep          = errors.New("order amount is not a multiple of the amount step")
+	ErrNotionalValueBelowMin      = errors.New("order notional value below minimum")
 )
 
+var errLimitsInvalid = errors.New("execution limits invalid")
+
 // Submit contains all properties of an order that may be required
 // for an order to be created on an exchange
 // Each exchange has their own requirements, so not all fields
@@ -54,6 +65,7 @@ type Submit struct {
 	Offset            string
 	Type              Type
 	Side              Side
+	PositionSide      Side
 	Status            Status
 	AssetType         asset.Item
 	Date              time.Time
@@ -273,6 +285,8 @@ const (
 	Sell        Side = "SELL"
 	Bid         Side = "BID"
 	Ask         Side = "ASK"
+	Long        Side = "LONG"
+	Short       Side = "SHORT"
 	UnknownSide Side = "UNKNOWN"
 )
 
@@ -298,3 +312,27 @@ type ClassificationError struct {
 	OrderID  string
 	Err      error
 }
+
+// Limits defines the execution limits of a pair enforced by an exchange,
+// zero values are not enforced
+type Limits struct {
+	Pair     currency.Pair
+	Asset    asset.Item
+	MinPrice float64
+	MaxPrice float64
+	// PriceTick is the smallest price increment
+	PriceTick float64
+	MinAmount float64
+	MaxAmount float64
+	// AmountStep is the smallest amount increment
+	AmountStep float64
+	// MinNotional is the minimum price multiplied by amount of an order
+	MinNotional float64
+}
+
+// ExecutionLimits stores the execution limits of an exchange by asset and
+// pair
+type ExecutionLimits struct {
+	m   map[asset.Item]map[*currency.Item]map[*currency.Item]Limits
+	mtx sync.RWMutex
+}
@@ -1,6 +1,7 @@
 package poloniex
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -712,3 +714,44 @@ func TestGetHistoricTrades(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGetLoans(t *testing.T) {
+	if mockTests || !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	t.Parallel()
+	_, err := p.GetLoans()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBorrow(t *testing.T) {
+	t.Parallel()
+	_, err := p.Borrow(&lending.Borrow{Exchange: p.Name, Currency: currency.BTC, Amount: 1})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestRepay(t *testing.T) {
+	t.Parallel()
+	_, err := p.Repay(&lending.Repay{Exchange: p.Name, Currency: currency.BTC})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received %v, expected %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestOfferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := p.OfferFunds(&lending.Offer{
+		Exchange: p.Name,
+		Currency: currency.BTC,
+		Amount:   1,
+		Rate:     0.0002,
+		Period:   61,
+	})
+	if !errors.Is(err, lending.ErrOfferPeriodNotSupported) {
+		t.Errorf("expected %v, received %v", lending.ErrOfferPeriodNotSupported, err)
+	}
+}
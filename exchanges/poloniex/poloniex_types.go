@@ -294,11 +294,14 @@ type MarginPosition struct {
 // LoanOffer holds loan offer information
 type LoanOffer struct {
 	ID        int64   `json:"id"`
+	Currency  string  `json:"currency"`
 	Rate      float64 `json:"rate,string"`
 	Amount    float64 `json:"amount,string"`
 	Duration  int64   `json:"duration"`
-	AutoRenew bool    `json:"autoRenew"`
+	Range     int64   `json:"range"`
+	AutoRenew int64   `json:"autoRenew"`
 	Date      string  `json:"date"`
+	Fees      float64 `json:"fees,string"`
 }
 
 // ActiveLoans shows the full active loans on the exchange
@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -886,3 +887,87 @@ func (p *Poloniex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, e
 func (p *Poloniex) GetHistoricCandlesExtended(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return p.GetHistoricCandles(pair, a, start, end, interval)
 }
+
+// Borrow is not supported, Poloniex borrows from the lending market
+// automatically when a margin order is placed
+func (p *Poloniex) Borrow(_ *lending.Borrow) (string, error) {
+	return "", fmt.Errorf("%w, %s borrows automatically when margin orders are placed",
+		common.ErrFunctionNotSupported,
+		p.Name)
+}
+
+// Repay is not supported, Poloniex repays borrowed funds when the margin
+// position is closed
+func (p *Poloniex) Repay(_ *lending.Repay) (string, error) {
+	return "", fmt.Errorf("%w, %s repays loans when the margin position is closed",
+		common.ErrFunctionNotSupported,
+		p.Name)
+}
+
+// GetLoans returns the funds lent out and borrowed on the lending market
+func (p *Poloniex) GetLoans() ([]lending.Loan, error) {
+	resp, err := p.GetActiveLoans()
+	if err != nil {
+		return nil, err
+	}
+	loans := make([]lending.Loan, 0, len(resp.Provided)+len(resp.Used))
+	loans = p.appendLoans(loans, resp.Provided, lending.Lent)
+	return p.appendLoans(loans, resp.Used, lending.Borrowed), nil
+}
+
+func (p *Poloniex) appendLoans(loans []lending.Loan, offers []LoanOffer, side lending.Side) []lending.Loan {
+	for i := range offers {
+		createdAt, err := time.Parse(common.SimpleTimeFormat, offers[i].Date)
+		if err != nil {
+			log.Errorf(log.ExchangeSys,
+				"%s could not parse loan %v date %v",
+				p.Name,
+				offers[i].ID,
+				offers[i].Date)
+		}
+		loans = append(loans, lending.Loan{
+			Exchange:  p.Name,
+			ID:        strconv.FormatInt(offers[i].ID, 10),
+			Currency:  currency.NewCode(offers[i].Currency),
+			Side:      side,
+			Amount:    offers[i].Amount,
+			Rate:      offers[i].Rate,
+			Period:    offers[i].Range,
+			CreatedAt: createdAt,
+		})
+	}
+	return loans
+}
+
+// OfferFunds offers funds to be lent to margin traders, Poloniex supports
+// loan periods of 2 to 60 days
+func (p *Poloniex) OfferFunds(o *lending.Offer) (string, error) {
+	if err := o.Validate(); err != nil {
+		return "", err
+	}
+	if o.Period < 2 || o.Period > 60 {
+		return "", fmt.Errorf("%w, %s requires 2 to 60 days, received %d",
+			lending.ErrOfferPeriodNotSupported,
+			p.Name,
+			o.Period)
+	}
+	id, err := p.CreateLoanOffer(o.Currency.Upper().String(),
+		o.Amount,
+		o.Rate,
+		int(o.Period),
+		false)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// CancelFundingOffer cancels an open lending offer
+func (p *Poloniex) CancelFundingOffer(id string) error {
+	offerID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = p.CancelLoanOffer(offerID)
+	return err
+}
@@ -0,0 +1,155 @@
+package position
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Validate checks the close request can be converted to an order
+func (c *Close) Validate() error {
+	if c.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if c.Pair.IsEmpty() {
+		return errPairUnset
+	}
+	if !c.Asset.IsDerivative() {
+		return fmt.Errorf("%s %w", c.Asset, errAssetInvalid)
+	}
+	if c.Amount < 0 {
+		return errAmountInvalid
+	}
+	if c.Price < 0 {
+		return errPriceInvalid
+	}
+	switch c.Side {
+	case "", order.Long, order.Short:
+	default:
+		return fmt.Errorf("%w, received %s", errSideInvalid, c.Side)
+	}
+	return nil
+}
+
+// Match returns the open position the close request applies to. When the
+// exchange holds separate long and short legs for the pair the request must
+// set the side of the leg to close
+func (c *Close) Match(positions []Position) (*Position, error) {
+	var match *Position
+	for i := range positions {
+		if positions[i].Size <= 0 ||
+			positions[i].Asset != c.Asset ||
+			!positions[i].Pair.Equal(c.Pair) ||
+			(c.Side != "" && positions[i].Side != c.Side) {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("%s %s %s %w", c.Exchange, c.Asset, c.Pair, errSideRequired)
+		}
+		match = &positions[i]
+	}
+	if match == nil {
+		return nil, fmt.Errorf("%s %s %s %s %w", c.Exchange, c.Asset, c.Pair, c.Side, ErrPositionNotFound)
+	}
+	return match, nil
+}
+
+// CloseOrder returns a reduce only order which closes all or part of the
+// matching open position
+func CloseOrder(positions []Position, c *Close) (*order.Submit, error) {
+	err := c.Validate()
+	if err != nil {
+		return nil, err
+	}
+	p, err := c.Match(positions)
+	if err != nil {
+		return nil, err
+	}
+	side, err := p.CloseSide()
+	if err != nil {
+		return nil, err
+	}
+	amount := p.Size
+	if c.Amount > 0 {
+		if c.Amount > p.Size {
+			return nil, fmt.Errorf("%w: amount %v size %v", errAmountExceedsSize, c.Amount, p.Size)
+		}
+		amount = c.Amount
+	}
+	s := &order.Submit{
+		Exchange:      c.Exchange,
+		Pair:          p.Pair,
+		AssetType:     p.Asset,
+		Side:          side,
+		Type:          order.Market,
+		Amount:        amount,
+		Price:         c.Price,
+		ReduceOnly:    true,
+		Offset:        "close",
+		Leverage:      p.Leverage,
+		ClientOrderID: c.ClientOrderID,
+	}
+	if p.Hedged {
+		s.PositionSide = p.Side
+	}
+	if c.Price > 0 {
+		s.Type = order.Limit
+	}
+	return s, nil
+}
+
+// CloseSide returns the order side which reduces the position
+func (p *Position) CloseSide() (order.Side, error) {
+	switch p.Side {
+	case order.Long:
+		return order.Sell, nil
+	case order.Short:
+		return order.Buy, nil
+	}
+	return order.UnknownSide, fmt.Errorf("%w, received %s", errSideInvalid, p.Side)
+}
+
+// StringToMarginType converts a case insensitive margin type as returned by
+// an exchange to a MarginType
+func StringToMarginType(m string) MarginType {
+	switch {
+	case strings.EqualFold(m, string(Isolated)),
+		strings.EqualFold(m, "fixed"):
+		return Isolated
+	case strings.EqualFold(m, string(Cross)),
+		strings.EqualFold(m, "crossed"):
+		return Cross
+	}
+	return UnknownMarginType
+}
+
+// Validate checks the margin type can be set on an exchange
+func (m MarginType) Validate() error {
+	if m != Isolated && m != Cross {
+		return fmt.Errorf("%w, received %s", errMarginTypeInvalid, m)
+	}
+	return nil
+}
+
+// ValidateLeverage checks the leverage can be set on an exchange
+func ValidateLeverage(leverage float64) error {
+	if leverage <= 0 {
+		return fmt.Errorf("%w, received %v", errLeverageInvalid, leverage)
+	}
+	return nil
+}
+
+// WholeLeverage validates and converts leverage for exchanges which only
+// accept whole number leverage
+func WholeLeverage(leverage float64) (int64, error) {
+	err := ValidateLeverage(leverage)
+	if err != nil {
+		return 0, err
+	}
+	if leverage != math.Trunc(leverage) {
+		return 0, fmt.Errorf("%w, received %v", errLeverageNotWhole, leverage)
+	}
+	return int64(leverage), nil
+}
@@ -0,0 +1,162 @@
+package position
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	tester := []struct {
+		Close       Close
+		ExpectedErr error
+	}{
+		{Close{}, errExchangeNameUnset},
+		{Close{Exchange: "test"}, errPairUnset},
+		{Close{Exchange: "test", Pair: p, Asset: asset.Spot}, errAssetInvalid},
+		{Close{Exchange: "test", Pair: p, Asset: asset.Futures, Amount: -1}, errAmountInvalid},
+		{Close{Exchange: "test", Pair: p, Asset: asset.Futures, Price: -1}, errPriceInvalid},
+		{Close{Exchange: "test", Pair: p, Asset: asset.Futures, Side: order.Buy}, errSideInvalid},
+		{Close{Exchange: "test", Pair: p, Asset: asset.Futures, Side: order.Short}, nil},
+		{Close{Exchange: "test", Pair: p, Asset: asset.Futures}, nil},
+	}
+	for i := range tester {
+		err := tester[i].Close.Validate()
+		if !errors.Is(err, tester[i].ExpectedErr) {
+			t.Errorf("test %d: expected %v, received %v", i, tester[i].ExpectedErr, err)
+		}
+	}
+}
+
+func TestCloseOrder(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	positions := []Position{
+		{Pair: p, Asset: asset.PerpetualSwap, Side: order.Long, Size: 5},
+		{Pair: p, Asset: asset.Futures, Side: order.Short, Size: 2, Leverage: 10},
+	}
+
+	_, err := CloseOrder(positions, &Close{Exchange: "test", Pair: currency.NewPair(currency.ETH, currency.USD), Asset: asset.Futures})
+	if !errors.Is(err, ErrPositionNotFound) {
+		t.Fatalf("expected %v, received %v", ErrPositionNotFound, err)
+	}
+
+	_, err = CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.Futures, Amount: 3})
+	if !errors.Is(err, errAmountExceedsSize) {
+		t.Fatalf("expected %v, received %v", errAmountExceedsSize, err)
+	}
+
+	s, err := CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.Futures})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Side != order.Buy || s.Type != order.Market || s.Amount != 2 ||
+		!s.ReduceOnly || s.Leverage != 10 {
+		t.Errorf("unexpected close order %+v", s)
+	}
+
+	s, err = CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.PerpetualSwap, Amount: 1, Price: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Side != order.Sell || s.Type != order.Limit || s.Amount != 1 || s.Price != 100 {
+		t.Errorf("unexpected close order %+v", s)
+	}
+
+	positions[0].Side = order.Buy
+	_, err = CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.PerpetualSwap})
+	if !errors.Is(err, errSideInvalid) {
+		t.Fatalf("expected %v, received %v", errSideInvalid, err)
+	}
+}
+
+func TestCloseOrderHedged(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	positions := []Position{
+		{Pair: p, Asset: asset.Futures, Side: order.Long, Size: 5, Hedged: true},
+		{Pair: p, Asset: asset.Futures, Side: order.Short, Size: 2, Hedged: true},
+	}
+
+	_, err := CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.Futures})
+	if !errors.Is(err, errSideRequired) {
+		t.Fatalf("expected %v, received %v", errSideRequired, err)
+	}
+
+	s, err := CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.Futures, Side: order.Short})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Side != order.Buy || s.PositionSide != order.Short || s.Amount != 2 {
+		t.Errorf("unexpected close order %+v", s)
+	}
+
+	s, err = CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.Futures, Side: order.Long})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Side != order.Sell || s.PositionSide != order.Long || s.Amount != 5 {
+		t.Errorf("unexpected close order %+v", s)
+	}
+
+	positions[1].Size = 0
+	s, err = CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.Futures})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Side != order.Sell || s.PositionSide != order.Long {
+		t.Errorf("unexpected close order %+v", s)
+	}
+
+	_, err = CloseOrder(positions, &Close{Exchange: "test", Pair: p, Asset: asset.Futures, Side: order.Short})
+	if !errors.Is(err, ErrPositionNotFound) {
+		t.Fatalf("expected %v, received %v", ErrPositionNotFound, err)
+	}
+}
+
+func TestStringToMarginType(t *testing.T) {
+	t.Parallel()
+	if StringToMarginType("crossed") != Cross ||
+		StringToMarginType("Isolated") != Isolated ||
+		StringToMarginType("fixed") != Isolated ||
+		StringToMarginType("") != UnknownMarginType {
+		t.Error("unexpected margin type conversion")
+	}
+}
+
+func TestMarginTypeValidate(t *testing.T) {
+	t.Parallel()
+	if err := Isolated.Validate(); err != nil {
+		t.Error(err)
+	}
+	if err := Cross.Validate(); err != nil {
+		t.Error(err)
+	}
+	if err := UnknownMarginType.Validate(); !errors.Is(err, errMarginTypeInvalid) {
+		t.Errorf("expected %v, received %v", errMarginTypeInvalid, err)
+	}
+}
+
+func TestWholeLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := WholeLeverage(0)
+	if !errors.Is(err, errLeverageInvalid) {
+		t.Errorf("expected %v, received %v", errLeverageInvalid, err)
+	}
+	_, err = WholeLeverage(2.5)
+	if !errors.Is(err, errLeverageNotWhole) {
+		t.Errorf("expected %v, received %v", errLeverageNotWhole, err)
+	}
+	l, err := WholeLeverage(20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l != 20 {
+		t.Errorf("expected 20, received %v", l)
+	}
+}
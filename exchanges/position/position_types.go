@@ -0,0 +1,94 @@
+package position
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Public errors
+var (
+	ErrPositionNotFound       = errors.New("no open position found")
+	ErrLeverageNotFound       = errors.New("no leverage settings found")
+	ErrMarginTypeNotSupported = errors.New("margin type is not supported")
+)
+
+var (
+	errExchangeNameUnset = errors.New("exchange name unset")
+	errPairUnset         = errors.New("currency pair unset")
+	errAssetInvalid      = errors.New("asset type is not a derivative")
+	errAmountInvalid     = errors.New("close amount must not be negative")
+	errPriceInvalid      = errors.New("close price must not be negative")
+	errAmountExceedsSize = errors.New("close amount exceeds position size")
+	errSideInvalid       = errors.New("position side must be long or short")
+	errSideRequired      = errors.New("position is hedged, close side must be long or short")
+	errLeverageInvalid   = errors.New("leverage must be greater than zero")
+	errLeverageNotWhole  = errors.New("leverage must be a whole number")
+	errMarginTypeInvalid = errors.New("margin type must be isolated or cross")
+)
+
+// MarginType defines how margin is allocated to a position
+type MarginType string
+
+// Margin types
+const (
+	// Isolated positions are margined separately, a liquidation only
+	// loses the margin allocated to the position
+	Isolated MarginType = "ISOLATED"
+	// Cross positions share the account balance as margin
+	Cross MarginType = "CROSS"
+	// UnknownMarginType is used when an exchange does not report the margin
+	// type of a position
+	UnknownMarginType MarginType = "UNKNOWN"
+)
+
+// Position is an open position in a derivatives contract
+type Position struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	// Side is order.Long or order.Short
+	Side order.Side
+	// Size is the absolute position size in the exchange's contract or
+	// amount units
+	Size             float64
+	EntryPrice       float64
+	MarkPrice        float64
+	LiquidationPrice float64
+	Leverage         float64
+	// UnrealisedPNL is in the margin currency of the contract
+	UnrealisedPNL float64
+	MarginType    MarginType
+	// Hedged is set when the exchange holds the long and short legs of the
+	// pair as separate positions, orders must then name the leg they reduce
+	Hedged    bool
+	UpdatedAt time.Time
+}
+
+// Close defines a request to close all or part of an open position
+type Close struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	// Side selects the long or short leg of a hedged position, it can be
+	// left unset when only one leg is open
+	Side order.Side
+	// Amount to close, zero closes the whole position
+	Amount float64
+	// Price closes with a limit order, zero closes with a market order
+	Price         float64
+	ClientOrderID string
+}
+
+// Leverage is the leverage and margin type an exchange applies to orders in a
+// derivatives contract
+type Leverage struct {
+	Exchange   string
+	Pair       currency.Pair
+	Asset      asset.Item
+	Leverage   float64
+	MarginType MarginType
+}
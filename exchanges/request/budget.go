@@ -0,0 +1,229 @@
+package request
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// budgetLowWatermark is the fraction of the remaining budget at which
+	// requests are spread over the time left until the budget resets
+	budgetLowWatermark = 0.1
+	// defaultRateLimitPause is the initial pause after being rate limited
+	// without a Retry-After header, doubling with each consecutive response up
+	// to maxRateLimitPause
+	defaultRateLimitPause = time.Second
+	maxRateLimitPause     = time.Minute
+	// statusIPBanned is returned by some exchanges, i.e. Binance, after
+	// continuing to send requests once rate limited
+	statusIPBanned = 418
+	// unixSecondsThreshold distinguishes a reset header sent as a unix
+	// timestamp from one sent as seconds until reset
+	unixSecondsThreshold = 1000000000
+	unixMilliThreshold   = 1000000000000
+)
+
+// DefaultRateLimitHeaders are the de facto X-RateLimit headers reported by
+// many exchanges
+var DefaultRateLimitHeaders = RateLimitHeaders{
+	Limit:     "X-RateLimit-Limit",
+	Remaining: "X-RateLimit-Remaining",
+	Reset:     "X-RateLimit-Reset",
+}
+
+// RateLimitHeaders defines the response headers an exchange reports its rate
+// limit budget with
+type RateLimitHeaders struct {
+	// Limit, Remaining and Reset report the budget directly. Reset is either
+	// the seconds until the budget resets or a unix timestamp
+	Limit     string
+	Remaining string
+	Reset     string
+	// Used reports the budget consumed in the current window for exchanges
+	// which do not report a limit, i.e. Binance's X-MBX-USED-WEIGHT-1M. The
+	// limit is set by UsedLimit and the window resets every UsedWindow
+	Used       string
+	UsedLimit  int64
+	UsedWindow time.Duration
+}
+
+// RateLimitBudget is a snapshot of an exchange's rate limit budget as last
+// reported by its responses
+type RateLimitBudget struct {
+	// Enabled is false when the exchange's rate limiter has been disabled
+	// and the budget is tracked but not enforced
+	Enabled   bool
+	Limit     int64
+	Remaining int64
+	ResetAt   time.Time
+	// BlockedUntil is set after a rate limited response, no requests are sent
+	// until it has passed
+	BlockedUntil time.Time
+	// RateLimited is the number of rate limited responses received
+	RateLimited int64
+	LastUpdated time.Time
+}
+
+// budget learns an exchange's rate limit budget from its responses, delaying
+// requests to avoid being rate limited and reporting the spare rate limiters
+// can be loosened to
+type budget struct {
+	headers RateLimitHeaders
+	m       sync.Mutex
+	state   RateLimitBudget
+	pause   time.Duration
+}
+
+func newBudget(h RateLimitHeaders) *budget {
+	return &budget{headers: h}
+}
+
+// delay returns how long to wait before sending a request and reserves part
+// of the remaining budget for it
+func (b *budget) delay(now time.Time) time.Duration {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if now.Before(b.state.BlockedUntil) {
+		return b.state.BlockedUntil.Sub(now)
+	}
+	if b.state.Limit <= 0 || !now.Before(b.state.ResetAt) {
+		return 0
+	}
+	if float64(b.state.Remaining) >= float64(b.state.Limit)*budgetLowWatermark {
+		b.state.Remaining--
+		return 0
+	}
+	untilReset := b.state.ResetAt.Sub(now)
+	if b.state.Remaining <= 0 {
+		return untilReset
+	}
+	d := untilReset / time.Duration(b.state.Remaining)
+	b.state.Remaining--
+	return d
+}
+
+// spareRate returns the rate at which the budget above the low watermark can
+// be spent until it resets, zero when the budget is unknown, running low or
+// blocked after a rate limited response
+func (b *budget) spareRate(now time.Time) rate.Limit {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if now.Before(b.state.BlockedUntil) ||
+		b.state.Limit <= 0 ||
+		!now.Before(b.state.ResetAt) {
+		return 0
+	}
+	spare := float64(b.state.Remaining) - float64(b.state.Limit)*budgetLowWatermark
+	if spare <= 0 {
+		return 0
+	}
+	return rate.Limit(spare / b.state.ResetAt.Sub(now).Seconds())
+}
+
+// observe updates the budget from a response
+func (b *budget) observe(resp *http.Response, now time.Time) {
+	if resp == nil {
+		return
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	if resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == statusIPBanned {
+		b.state.RateLimited++
+		b.state.Remaining = 0
+		b.state.LastUpdated = now
+		after := RetryAfter(resp, now)
+		if resp.Header.Get(headerRetryAfter) == "" {
+			b.pause *= 2
+			if b.pause < defaultRateLimitPause {
+				b.pause = defaultRateLimitPause
+			}
+			if b.pause > maxRateLimitPause {
+				b.pause = maxRateLimitPause
+			}
+			after = b.pause
+		}
+		b.state.BlockedUntil = now.Add(after)
+		return
+	}
+	b.pause = 0
+
+	if b.headers.Used != "" && b.headers.UsedLimit > 0 {
+		if used, ok := parseHeaderInt(resp.Header, b.headers.Used); ok {
+			b.state.Limit = b.headers.UsedLimit
+			b.state.Remaining = b.headers.UsedLimit - used
+			if b.headers.UsedWindow > 0 {
+				b.state.ResetAt = now.Truncate(b.headers.UsedWindow).Add(b.headers.UsedWindow)
+			}
+			b.state.LastUpdated = now
+			return
+		}
+	}
+
+	remaining, ok := parseHeaderInt(resp.Header, b.headers.Remaining)
+	if !ok {
+		return
+	}
+	b.state.Remaining = remaining
+	if limit, ok := parseHeaderInt(resp.Header, b.headers.Limit); ok {
+		b.state.Limit = limit
+	}
+	if reset, ok := parseHeaderInt(resp.Header, b.headers.Reset); ok {
+		b.state.ResetAt = resetTime(reset, now)
+	}
+	b.state.LastUpdated = now
+}
+
+// snapshot returns the current budget
+func (b *budget) snapshot() RateLimitBudget {
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.state
+}
+
+// parseHeaderInt returns a header's value as an integer
+func parseHeaderInt(h http.Header, key string) (int64, bool) {
+	if key == "" {
+		return 0, false
+	}
+	v := h.Get(key)
+	if v == "" {
+		return 0, false
+	}
+	i, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, false
+	}
+	return int64(i), true
+}
+
+// resetTime converts a reset header value to a time, values large enough to
+// be unix timestamps in seconds or milliseconds are treated as such and
+// anything smaller as seconds from now
+func resetTime(v int64, now time.Time) time.Time {
+	switch {
+	case v >= unixMilliThreshold:
+		return time.Unix(0, v*int64(time.Millisecond))
+	case v >= unixSecondsThreshold:
+		return time.Unix(v, 0)
+	default:
+		return now.Add(time.Duration(v) * time.Second)
+	}
+}
+
+// GetRateLimitBudget returns the exchange's rate limit budget as last
+// reported by its responses
+func (r *Requester) GetRateLimitBudget() RateLimitBudget {
+	enabled := atomic.LoadInt32(&r.disableRateLimiter) == 0
+	if r.budget == nil {
+		return RateLimitBudget{Enabled: enabled}
+	}
+	b := r.budget.snapshot()
+	b.Enabled = enabled
+	return b
+}
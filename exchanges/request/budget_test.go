@@ -0,0 +1,215 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func budgetResponse(status int, headers map[string]string) *http.Response {
+	resp := &http.Response{StatusCode: status, Header: http.Header{}}
+	for k, v := range headers {
+		resp.Header.Set(k, v)
+	}
+	return resp
+}
+
+func TestBudgetObserveHeaders(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	b := newBudget(DefaultRateLimitHeaders)
+	b.observe(budgetResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Limit":     "60",
+		"X-RateLimit-Remaining": "30",
+		"X-RateLimit-Reset":     "10",
+	}), now)
+	s := b.snapshot()
+	if s.Limit != 60 || s.Remaining != 30 || !s.ResetAt.Equal(now.Add(10*time.Second)) {
+		t.Fatalf("unexpected budget %+v", s)
+	}
+	if d := b.delay(now); d != 0 {
+		t.Errorf("expected no delay with budget to spare, received %s", d)
+	}
+	if s = b.snapshot(); s.Remaining != 29 {
+		t.Errorf("expected request to be reserved, remaining %d", s.Remaining)
+	}
+
+	// running low spreads the remaining budget until reset
+	b.observe(budgetResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Remaining": "4",
+		"X-RateLimit-Reset":     "10",
+	}), now)
+	if d := b.delay(now); d != 2500*time.Millisecond {
+		t.Errorf("expected 2.5s delay, received %s", d)
+	}
+
+	b.observe(budgetResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Remaining": "0",
+		"X-RateLimit-Reset":     "10",
+	}), now)
+	if d := b.delay(now); d != 10*time.Second {
+		t.Errorf("expected delay until reset, received %s", d)
+	}
+	if d := b.delay(now.Add(11 * time.Second)); d != 0 {
+		t.Errorf("expected no delay after reset, received %s", d)
+	}
+}
+
+func TestBudgetObserveUsedWeight(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, 1, 1, 0, 0, 30, 0, time.UTC)
+	b := newBudget(RateLimitHeaders{
+		Used:       "X-Mbx-Used-Weight-1m",
+		UsedLimit:  1200,
+		UsedWindow: time.Minute,
+	})
+	b.observe(budgetResponse(http.StatusOK, map[string]string{
+		"X-MBX-USED-WEIGHT-1M": "1150",
+	}), now)
+	s := b.snapshot()
+	if s.Limit != 1200 || s.Remaining != 50 || !s.ResetAt.Equal(now.Add(30*time.Second)) {
+		t.Fatalf("unexpected budget %+v", s)
+	}
+	if d := b.delay(now); d != 600*time.Millisecond {
+		t.Errorf("expected 600ms delay, received %s", d)
+	}
+}
+
+func TestBudgetRateLimited(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	b := newBudget(DefaultRateLimitHeaders)
+	b.observe(budgetResponse(http.StatusTooManyRequests, map[string]string{
+		"Retry-After": "5",
+	}), now)
+	if d := b.delay(now); d != 5*time.Second {
+		t.Errorf("expected Retry-After delay, received %s", d)
+	}
+
+	b.observe(budgetResponse(statusIPBanned, nil), now)
+	if d := b.delay(now); d != defaultRateLimitPause {
+		t.Errorf("expected %s pause, received %s", defaultRateLimitPause, d)
+	}
+	b.observe(budgetResponse(http.StatusTooManyRequests, nil), now)
+	if d := b.delay(now); d != 2*defaultRateLimitPause {
+		t.Errorf("expected pause to double, received %s", d)
+	}
+	if s := b.snapshot(); s.RateLimited != 3 {
+		t.Errorf("expected 3 rate limited responses, received %d", s.RateLimited)
+	}
+
+	// a successful response resets the pause
+	b.observe(budgetResponse(http.StatusOK, nil), now)
+	b.observe(budgetResponse(http.StatusTooManyRequests, nil), now)
+	if d := b.delay(now); d != defaultRateLimitPause {
+		t.Errorf("expected pause to reset, received %s", d)
+	}
+}
+
+func TestBudgetSpareRate(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	b := newBudget(DefaultRateLimitHeaders)
+	if r := b.spareRate(now); r != 0 {
+		t.Errorf("expected no spare rate for an unknown budget, received %v", r)
+	}
+	b.observe(budgetResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Limit":     "100",
+		"X-RateLimit-Remaining": "60",
+		"X-RateLimit-Reset":     "10",
+	}), now)
+	// the low watermark is kept in reserve
+	if r := b.spareRate(now); r != 5 {
+		t.Errorf("expected spare rate of 5, received %v", r)
+	}
+	b.observe(budgetResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Remaining": "8",
+		"X-RateLimit-Reset":     "10",
+	}), now)
+	if r := b.spareRate(now); r != 0 {
+		t.Errorf("expected no spare rate when running low, received %v", r)
+	}
+	b.observe(budgetResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Remaining": "60",
+		"X-RateLimit-Reset":     "10",
+	}), now)
+	b.observe(budgetResponse(http.StatusTooManyRequests, nil), now)
+	if r := b.spareRate(now); r != 0 {
+		t.Errorf("expected no spare rate when rate limited, received %v", r)
+	}
+}
+
+func TestSpareBudgetLoosensLimiter(t *testing.T) {
+	t.Parallel()
+	remaining := "100"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", remaining)
+		w.Header().Set("X-RateLimit-Reset", "10")
+	}))
+	defer server.Close()
+
+	l := NewBasicRateLimit(time.Minute, 60).(*BasicLimit)
+	r := New("test", new(http.Client), WithLimiter(l))
+	item := &Item{Method: http.MethodGet, Path: server.URL}
+	err := r.SendPayload(context.Background(), item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate := l.r.Limit(); rate <= l.static {
+		t.Errorf("expected limiter to be loosened above %v, received %v", l.static, rate)
+	}
+
+	remaining = "5"
+	err = r.SendPayload(context.Background(), item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate := l.r.Limit(); rate != l.static {
+		t.Errorf("expected static rate %v to be restored, received %v", l.static, rate)
+	}
+}
+
+func TestResetTime(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1600000000, 0)
+	if r := resetTime(30, now); !r.Equal(now.Add(30 * time.Second)) {
+		t.Errorf("unexpected relative reset %s", r)
+	}
+	if r := resetTime(1600000060, now); !r.Equal(time.Unix(1600000060, 0)) {
+		t.Errorf("unexpected unix reset %s", r)
+	}
+	if r := resetTime(1600000060000, now); !r.Equal(time.Unix(1600000060, 0)) {
+		t.Errorf("unexpected unix milli reset %s", r)
+	}
+}
+
+func TestGetRateLimitBudget(t *testing.T) {
+	t.Parallel()
+	if b := (&Requester{}).GetRateLimitBudget(); b.Limit != 0 {
+		t.Errorf("unexpected budget %+v", b)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "99")
+		w.Header().Set("RateLimit-Limit", "100")
+	}))
+	defer server.Close()
+
+	r := New("test", new(http.Client), WithRateLimitHeaders(RateLimitHeaders{
+		Limit:     "RateLimit-Limit",
+		Remaining: "RateLimit-Remaining",
+	}))
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   server.URL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := r.GetRateLimitBudget(); b.Limit != 100 || b.Remaining != 99 || b.LastUpdated.IsZero() {
+		t.Errorf("unexpected budget %+v", b)
+	}
+}
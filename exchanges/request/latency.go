@@ -0,0 +1,56 @@
+package request
+
+import (
+	"sync"
+	"time"
+)
+
+// latencyWindow is the number of recent requests kept for latency statistics
+const latencyWindow = 256
+
+// LatencySample is the timing of a single request
+type LatencySample struct {
+	Time      time.Time
+	RoundTrip time.Duration
+}
+
+// latency holds a ring of the most recent request timings
+type latency struct {
+	m       sync.Mutex
+	samples [latencyWindow]LatencySample
+	next    int
+	full    bool
+}
+
+// observe records the timing of a request which received a response
+func (l *latency) observe(sent, received time.Time) {
+	s := LatencySample{Time: received, RoundTrip: received.Sub(sent)}
+	l.m.Lock()
+	l.samples[l.next] = s
+	l.next = (l.next + 1) % latencyWindow
+	if l.next == 0 {
+		l.full = true
+	}
+	l.m.Unlock()
+}
+
+// get returns the recorded samples oldest first
+func (l *latency) get() []LatencySample {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if !l.full {
+		return append([]LatencySample(nil), l.samples[:l.next]...)
+	}
+	samples := make([]LatencySample, 0, latencyWindow)
+	samples = append(samples, l.samples[l.next:]...)
+	return append(samples, l.samples[:l.next]...)
+}
+
+// GetLatencySamples returns the timings of the most recent requests which
+// received a response, oldest first
+func (r *Requester) GetLatencySamples() []LatencySample {
+	if r == nil {
+		return nil
+	}
+	return r.latency.get()
+}
@@ -0,0 +1,32 @@
+package request
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLatencyObserve(t *testing.T) {
+	t.Parallel()
+	var l latency
+	sent := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.observe(sent, sent.Add(time.Second))
+
+	samples := l.get()
+	if len(samples) != 1 {
+		t.Fatalf("expected 1 sample, received %d", len(samples))
+	}
+	if samples[0].RoundTrip != time.Second {
+		t.Errorf("expected round trip of 1s, received %s", samples[0].RoundTrip)
+	}
+
+	for i := 0; i < latencyWindow; i++ {
+		l.observe(sent, sent.Add(time.Duration(i)))
+	}
+	samples = l.get()
+	if len(samples) != latencyWindow {
+		t.Fatalf("expected %d samples, received %d", latencyWindow, len(samples))
+	}
+	if samples[0].RoundTrip != 0 || samples[latencyWindow-1].RoundTrip != latencyWindow-1 {
+		t.Error("expected samples oldest first")
+	}
+}
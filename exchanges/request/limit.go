@@ -18,7 +18,8 @@ const (
 // BasicLimit denotes basic rate limit that implements the Limiter interface
 // does not need to set endpoint functionality.
 type BasicLimit struct {
-	r *rate.Limiter
+	r      *rate.Limiter
+	static rate.Limit
 }
 
 // Limit executes a single rate limit set by NewRateLimit
@@ -27,6 +28,11 @@ func (b *BasicLimit) Limit(_ EndpointLimit) error {
 	return nil
 }
 
+// Loosen raises the rate limit to the spare budget reported by the exchange
+func (b *BasicLimit) Loosen(spare rate.Limit) {
+	LoosenRateLimit(b.r, b.static, spare)
+}
+
 // EndpointLimit defines individual endpoint rate limits that are set when
 // New is called.
 type EndpointLimit int
@@ -38,6 +44,31 @@ type Limiter interface {
 	Limit(EndpointLimit) error
 }
 
+// BudgetLimiter is implemented by limiters which raise their rates while the
+// exchange reports spare budget. Only the rates covered by the reported
+// budget should be raised
+type BudgetLimiter interface {
+	Limiter
+	Loosen(spare rate.Limit)
+}
+
+// WeightedLimiter is implemented by limiters whose endpoints consume more
+// than one request from the exchange's rate limit
+type WeightedLimiter interface {
+	Limiter
+	Weight(EndpointLimit) int
+}
+
+// LoosenRateLimit sets a limiter to the spare rate when it is faster than the
+// static rate, otherwise the static rate is restored
+func LoosenRateLimit(l *rate.Limiter, static, spare rate.Limit) {
+	if spare > static {
+		l.SetLimit(spare)
+		return
+	}
+	l.SetLimit(static)
+}
+
 // NewRateLimit creates a new RateLimit based of time interval and how many
 // actions allowed and breaks it down to an actions-per-second basis -- Burst
 // rate is kept as one as this is not supported for out-bound requests.
@@ -55,20 +86,48 @@ func NewRateLimit(interval time.Duration, actions int) *rate.Limiter {
 // NewBasicRateLimit returns an object that implements the limiter interface
 // for basic rate limit
 func NewBasicRateLimit(interval time.Duration, actions int) Limiter {
-	return &BasicLimit{NewRateLimit(interval, actions)}
+	l := NewRateLimit(interval, actions)
+	return &BasicLimit{r: l, static: l.Limit()}
 }
 
 // InitiateRateLimit sleeps for designated end point rate limits
 func (r *Requester) InitiateRateLimit(e EndpointLimit) error {
-	if atomic.LoadInt32(&r.disableRateLimiter) == 1 {
+	err := r.limit(e)
+	if err != nil {
+		return err
+	}
+	if d := r.budgetDelay(); d > 0 {
+		time.Sleep(d)
+	}
+	return nil
+}
+
+// limit sleeps on the endpoint's rate limiter
+func (r *Requester) limit(e EndpointLimit) error {
+	if atomic.LoadInt32(&r.disableRateLimiter) == 1 || r.limiter == nil {
 		return nil
 	}
+	return r.limiter.Limit(e)
+}
 
-	if r.limiter != nil {
-		return r.limiter.Limit(e)
+// budgetDelay reserves part of the budget reported by the exchange and returns
+// how long to delay further when it is running low or a rate limited response
+// has been received
+func (r *Requester) budgetDelay() time.Duration {
+	if atomic.LoadInt32(&r.disableRateLimiter) == 1 || r.budget == nil {
+		return 0
 	}
+	return r.budget.delay(time.Now())
+}
 
-	return nil
+// loosenLimiter raises the limiter's rates to the spare budget reported by
+// the exchange, restoring them once the budget runs low
+func (r *Requester) loosenLimiter() {
+	l, ok := r.limiter.(BudgetLimiter)
+	if !ok || r.budget == nil {
+		return
+	}
+	l.Loosen(r.budget.spareRate(time.Now()))
 }
 
 // DisableRateLimiter disables the rate limiting system for the exchange
@@ -0,0 +1,46 @@
+package request
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+var (
+	requestDuration = metrics.NewHistogram("gct_request_duration_seconds",
+		"Round trip time of exchange HTTP requests excluding rate limit waits",
+		metrics.DefaultLatencyBuckets, "exchange", "endpoint")
+	requestTotal = metrics.NewCounter("gct_requests_total",
+		"Exchange HTTP request attempts by response status code, transport errors have a code of error",
+		"exchange", "endpoint", "code")
+	requestErrors = metrics.NewCounter("gct_request_errors_total",
+		"Exchange HTTP requests which returned an error to the caller",
+		"exchange", "endpoint")
+)
+
+func init() {
+	err := metrics.Register(requestDuration, requestTotal, requestErrors)
+	if err != nil {
+		log.Errorf(log.RequestSys, "Failed to register request metrics: %v", err)
+	}
+}
+
+// endpointLabel returns the request path without the host or query, which
+// holds the nonces and signatures of authenticated requests
+func endpointLabel(req *http.Request) string {
+	if req.URL == nil {
+		return ""
+	}
+	return req.URL.Path
+}
+
+// statusLabel returns the response status code or error when the request
+// failed before a response was received
+func statusLabel(resp *http.Response, err error) string {
+	if err != nil || resp == nil {
+		return "error"
+	}
+	return strconv.Itoa(resp.StatusCode)
+}
@@ -0,0 +1,63 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+// scrapeMetrics returns the current value of each exported series
+func scrapeMetrics(t *testing.T) map[string]float64 {
+	t.Helper()
+	resp := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	values := make(map[string]float64)
+	for _, line := range strings.Split(resp.Body.String(), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.Contains(line, "secret") {
+			t.Error("query parameters should not be exported")
+		}
+		i := strings.LastIndex(line, " ")
+		if i == -1 {
+			continue
+		}
+		v, err := strconv.ParseFloat(line[i+1:], 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values[line[:i]] = v
+	}
+	return values
+}
+
+func TestRequestMetrics(t *testing.T) {
+	metrics.Enable()
+	defer metrics.Disable()
+	before := scrapeMetrics(t)
+	r := New("metrics", new(http.Client))
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   testURL + "/error?signature=secret",
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	after := scrapeMetrics(t)
+	for _, series := range []string{
+		`gct_requests_total{exchange="metrics",endpoint="/error",code="400"}`,
+		`gct_request_errors_total{exchange="metrics",endpoint="/error"}`,
+		`gct_request_duration_seconds_count{exchange="metrics",endpoint="/error"}`,
+	} {
+		if delta := after[series] - before[series]; delta != 1 {
+			t.Errorf("expected %s to increase by 1, received %v", series, delta)
+		}
+	}
+}
@@ -14,6 +14,14 @@ func WithLimiter(l Limiter) RequesterOption {
 	}
 }
 
+// WithRateLimitHeaders configures the response headers a Requester learns
+// the exchange's rate limit budget from.
+func WithRateLimitHeaders(h RateLimitHeaders) RequesterOption {
+	return func(r *Requester) {
+		r.budget = newBudget(h)
+	}
+}
+
 // WithRetryPolicy configures the retry policy for a Requester.
 func WithRetryPolicy(p RetryPolicy) RequesterOption {
 	return func(r *Requester) {
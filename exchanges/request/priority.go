@@ -0,0 +1,185 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Priority classes for requests, higher priorities are served by the rate
+// limiter first
+const (
+	// UnsetPriority takes the priority from the context or an active
+	// Prioritise scope, otherwise it is derived from the request
+	UnsetPriority Priority = iota
+	MarketDataPriority
+	AccountPriority
+	CriticalPriority
+
+	priorityCount = int(CriticalPriority) + 1
+)
+
+// Priority defines the order in which requests waiting on the rate limiter
+// are served
+type Priority uint8
+
+// String returns the priority class name
+func (p Priority) String() string {
+	switch p {
+	case MarketDataPriority:
+		return "market data"
+	case AccountPriority:
+		return "account"
+	case CriticalPriority:
+		return "critical"
+	default:
+		return "unset"
+	}
+}
+
+// priorityKey is the context key a request priority is stored under
+type priorityKey struct{}
+
+// WithPriority returns a context which serves requests sent with it at p,
+// unless the request item sets its own priority
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// scheduler hands out turns at an endpoint's rate limiter, queued requests
+// are served highest priority first and in arrival order within a priority
+type scheduler struct {
+	m       sync.Mutex
+	busy    bool
+	waiting [priorityCount][]chan struct{}
+}
+
+// acquire waits for a turn at the rate limiter
+func (s *scheduler) acquire(p Priority) {
+	s.m.Lock()
+	if !s.busy {
+		s.busy = true
+		s.m.Unlock()
+		return
+	}
+	ch := make(chan struct{})
+	s.waiting[p] = append(s.waiting[p], ch)
+	s.m.Unlock()
+	<-ch
+}
+
+// release hands the turn to the highest priority waiting request
+func (s *scheduler) release() {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for p := priorityCount - 1; p >= 0; p-- {
+		if len(s.waiting[p]) == 0 {
+			continue
+		}
+		ch := s.waiting[p][0]
+		s.waiting[p][0] = nil
+		s.waiting[p] = s.waiting[p][1:]
+		close(ch)
+		return
+	}
+	s.busy = false
+}
+
+// scopes counts the active Prioritise calls for each priority
+type scopes struct {
+	m     sync.Mutex
+	count [priorityCount]int
+}
+
+// Prioritise tags the requests sent without a priority of their own at the
+// priority of ctx, set with WithPriority, until the returned function is
+// called. The exchange wrappers do not take a context, so callers such as the
+// order manager and syncer use this to tag the requests sent by their wrapper
+// calls. Market data scopes apply to unauthenticated requests and higher
+// scopes to authenticated requests, the highest active scope is used
+func (r *Requester) Prioritise(ctx context.Context) func() {
+	p, ok := ctx.Value(priorityKey{}).(Priority)
+	if !ok || p == UnsetPriority || int(p) >= priorityCount {
+		return func() {}
+	}
+	r.scopes.m.Lock()
+	r.scopes.count[p]++
+	r.scopes.m.Unlock()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.scopes.m.Lock()
+			r.scopes.count[p]--
+			r.scopes.m.Unlock()
+		})
+	}
+}
+
+// scoped returns the highest active scope which applies to a request
+func (s *scopes) scoped(authenticated bool) Priority {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if !authenticated {
+		if s.count[MarketDataPriority] > 0 {
+			return MarketDataPriority
+		}
+		return UnsetPriority
+	}
+	for p := priorityCount - 1; p > int(MarketDataPriority); p-- {
+		if s.count[p] > 0 {
+			return Priority(p)
+		}
+	}
+	return UnsetPriority
+}
+
+// lanes holds a scheduler for each rate limited endpoint, so requests waiting
+// on one endpoint's limiter do not hold up requests to another
+type lanes struct {
+	m    sync.Mutex
+	lane map[EndpointLimit]*scheduler
+}
+
+// get returns the scheduler for an endpoint
+func (l *lanes) get(e EndpointLimit) *scheduler {
+	l.m.Lock()
+	defer l.m.Unlock()
+	s, ok := l.lane[e]
+	if !ok {
+		if l.lane == nil {
+			l.lane = make(map[EndpointLimit]*scheduler)
+		}
+		s = new(scheduler)
+		l.lane[e] = s
+	}
+	return s
+}
+
+// priority returns the priority a request is served at. Unset priorities are
+// taken from the context, then from the active Prioritise scopes and are
+// otherwise derived from the request. Authenticated DELETE requests are
+// cancellations and are CriticalPriority, other authenticated requests are
+// AccountPriority as POST is also used for private reads such as balances,
+// and everything else is MarketDataPriority
+func (r *Requester) priority(ctx context.Context, i *Item) Priority {
+	if i.Priority != UnsetPriority && int(i.Priority) < priorityCount {
+		return i.Priority
+	}
+	if ctx != nil {
+		if p, ok := ctx.Value(priorityKey{}).(Priority); ok &&
+			p != UnsetPriority && int(p) < priorityCount {
+			return p
+		}
+	}
+	if p := r.scopes.scoped(i.AuthRequest); p != UnsetPriority {
+		return p
+	}
+	if !i.AuthRequest {
+		return MarketDataPriority
+	}
+	if strings.EqualFold(i.Method, http.MethodDelete) {
+		return CriticalPriority
+	}
+	return AccountPriority
+}
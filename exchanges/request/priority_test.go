@@ -0,0 +1,191 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSchedulerOrder(t *testing.T) {
+	t.Parallel()
+	var s scheduler
+	s.acquire(MarketDataPriority)
+
+	var m sync.Mutex
+	var served []Priority
+	var wg sync.WaitGroup
+	queue := func(p Priority) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.acquire(p)
+			m.Lock()
+			served = append(served, p)
+			m.Unlock()
+			s.release()
+		}()
+		// wait for the request to be queued so arrival order is known
+		for {
+			s.m.Lock()
+			queued := len(s.waiting[p])
+			s.m.Unlock()
+			if queued > 0 {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	queue(MarketDataPriority)
+	queue(AccountPriority)
+	queue(CriticalPriority)
+	s.release()
+	wg.Wait()
+
+	expected := []Priority{CriticalPriority, AccountPriority, MarketDataPriority}
+	for i := range expected {
+		if served[i] != expected[i] {
+			t.Fatalf("expected %v served in order, received %v", expected, served)
+		}
+	}
+	if s.busy {
+		t.Error("scheduler should be idle once every request is served")
+	}
+}
+
+func TestPriority(t *testing.T) {
+	t.Parallel()
+	r := new(Requester)
+	priority := r.priority
+	ctx := context.Background()
+	if p := priority(ctx, &Item{}); p != MarketDataPriority {
+		t.Errorf("expected %v, received %v", MarketDataPriority, p)
+	}
+	if p := priority(ctx, &Item{AuthRequest: true, Method: http.MethodGet}); p != AccountPriority {
+		t.Errorf("expected %v, received %v", AccountPriority, p)
+	}
+	if p := priority(ctx, &Item{AuthRequest: true, Method: http.MethodDelete}); p != CriticalPriority {
+		t.Errorf("expected cancellations at %v, received %v", CriticalPriority, p)
+	}
+	// Kraken, Bitfinex and Poloniex send private reads such as balances as POST
+	if p := priority(ctx, &Item{AuthRequest: true, Method: http.MethodPost, Path: "https://api.kraken.com/0/private/Balance"}); p != AccountPriority {
+		t.Errorf("expected POST balance reads at %v, received %v", AccountPriority, p)
+	}
+	if p := priority(ctx, &Item{Method: http.MethodPost}); p != MarketDataPriority {
+		t.Errorf("unauthenticated requests should not be raised, received %v", p)
+	}
+	if p := priority(ctx, &Item{Priority: CriticalPriority}); p != CriticalPriority {
+		t.Errorf("expected %v, received %v", CriticalPriority, p)
+	}
+
+	ctx = WithPriority(ctx, AccountPriority)
+	if p := priority(ctx, &Item{}); p != AccountPriority {
+		t.Errorf("expected context %v, received %v", AccountPriority, p)
+	}
+	if p := priority(ctx, &Item{AuthRequest: true, Priority: MarketDataPriority}); p != MarketDataPriority {
+		t.Errorf("explicit priority should override the context, received %v", p)
+	}
+	if p := priority(WithPriority(context.Background(), UnsetPriority), &Item{AuthRequest: true, Method: http.MethodDelete}); p != CriticalPriority {
+		t.Errorf("unset context priority should be derived, received %v", p)
+	}
+}
+
+func TestPrioritise(t *testing.T) {
+	t.Parallel()
+	r := new(Requester)
+	ctx := context.Background()
+	order := &Item{AuthRequest: true, Method: http.MethodPost}
+	poll := &Item{Method: http.MethodGet}
+
+	release := r.Prioritise(WithPriority(ctx, CriticalPriority))
+	if p := r.priority(ctx, order); p != CriticalPriority {
+		t.Errorf("expected tagged %v, received %v", CriticalPriority, p)
+	}
+	if p := r.priority(ctx, poll); p != MarketDataPriority {
+		t.Errorf("critical scope should not raise public requests, received %v", p)
+	}
+	if p := r.priority(ctx, &Item{AuthRequest: true, Priority: AccountPriority}); p != AccountPriority {
+		t.Errorf("explicit priority should override the scope, received %v", p)
+	}
+
+	syncing := r.Prioritise(WithPriority(ctx, MarketDataPriority))
+	if p := r.priority(ctx, order); p != CriticalPriority {
+		t.Errorf("market data scope should not lower authenticated requests, received %v", p)
+	}
+	syncing()
+	release()
+	release()
+	if p := r.priority(ctx, order); p != AccountPriority {
+		t.Errorf("expected %v once released, received %v", AccountPriority, p)
+	}
+	if r.scopes.count[CriticalPriority] != 0 {
+		t.Error("release should only be applied once")
+	}
+	if done := r.Prioritise(ctx); done == nil {
+		t.Error("untagged context should return a release function")
+	}
+}
+
+func TestLanes(t *testing.T) {
+	t.Parallel()
+	var l lanes
+	a := l.get(Auth)
+	if l.get(Auth) != a {
+		t.Error("expected the same lane for an endpoint")
+	}
+	if l.get(UnAuth) == a {
+		t.Error("expected a separate lane for each endpoint")
+	}
+
+	// a request holding one endpoint's turn does not block another endpoint
+	a.acquire(MarketDataPriority)
+	done := make(chan struct{})
+	go func() {
+		u := l.get(UnAuth)
+		u.acquire(CriticalPriority)
+		u.release()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("request blocked by another endpoint's turn")
+	}
+	a.release()
+}
+
+func TestBudgetDelaySleptAfterTurn(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	r := New("test", new(http.Client), WithRateLimitHeaders(DefaultRateLimitHeaders))
+	r.budget.state.BlockedUntil = time.Now().Add(time.Second)
+	done := make(chan error, 1)
+	go func() {
+		done <- r.SendPayload(context.Background(), &Item{
+			Method: http.MethodGet,
+			Path:   server.URL,
+		})
+	}()
+
+	// the pending request sleeps on the budget without holding the turn
+	lane := r.lanes.get(Unset)
+	acquired := make(chan struct{})
+	go func() {
+		time.Sleep(time.Millisecond * 100)
+		lane.acquire(CriticalPriority)
+		lane.release()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+	case <-done:
+		t.Fatal("request should still be delayed by the budget")
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -29,6 +29,7 @@ func New(name string, httpRequester *http.Client, opts ...RequesterOption) *Requ
 		retryPolicy: DefaultRetryPolicy,
 		maxRetries:  MaxRetryAttempts,
 		timedLock:   timedmutex.NewTimedMutex(DefaultMutexLockTimeout),
+		budget:      newBudget(DefaultRateLimitHeaders),
 	}
 
 	for _, o := range opts {
@@ -65,6 +66,9 @@ func (r *Requester) SendPayload(ctx context.Context, i *Item) error {
 	err = r.doRequest(req, i)
 	atomic.AddInt32(&r.jobs, -1)
 	r.timedLock.UnlockIfLocked()
+	if err != nil {
+		requestErrors.Inc(r.Name, endpointLabel(req))
+	}
 
 	return err
 }
@@ -137,14 +141,39 @@ func (r *Requester) doRequest(req *http.Request, p *Item) error {
 		}
 	}
 
+	lane := r.lanes.get(p.Endpoint)
+	pri := r.priority(req.Context(), p)
 	for attempt := 1; ; attempt++ {
-		// Initiate a rate limit reservation and sleep on requested endpoint
-		err := r.InitiateRateLimit(p.Endpoint)
+		// Initiate a rate limit reservation and sleep on requested endpoint,
+		// higher priority requests are given their turn first. The budget
+		// delay is reserved in turn but slept after the turn is handed on
+		lane.acquire(pri)
+		err := r.limit(p.Endpoint)
+		var budgetDelay time.Duration
+		if err == nil {
+			budgetDelay = r.budgetDelay()
+		}
+		lane.release()
 		if err != nil {
 			return err
 		}
+		if budgetDelay > 0 {
+			time.Sleep(budgetDelay)
+		}
 
+		start := time.Now()
 		resp, err := r.HTTPClient.Do(req)
+		received := time.Now()
+		endpoint := endpointLabel(req)
+		requestDuration.Observe(received.Sub(start).Seconds(), r.Name, endpoint)
+		requestTotal.Inc(r.Name, endpoint, statusLabel(resp, err))
+		if err == nil {
+			r.latency.observe(start, received)
+		}
+		if err == nil && r.budget != nil {
+			r.budget.observe(resp, time.Now())
+			r.loosenLimiter()
+		}
 		if retry, checkErr := r.retryPolicy(resp, err); checkErr != nil {
 			return checkErr
 		} else if retry {
@@ -38,6 +38,10 @@ type Requester struct {
 	backoff            Backoff
 	retryPolicy        RetryPolicy
 	timedLock          *timedmutex.TimedMutex
+	budget             *budget
+	lanes              lanes
+	scopes             scopes
+	latency            latency
 }
 
 // Item is a temp item for requests
@@ -57,6 +61,10 @@ type Item struct {
 	// pagination
 	HeaderResponse *http.Header
 	Endpoint       EndpointLimit
+	// Priority determines the order requests waiting on the rate limiter are
+	// served, when unset it is taken from the context or derived from
+	// AuthRequest and Method
+	Priority Priority
 }
 
 // Backoff determines how long to wait between request attempts.
@@ -0,0 +1,203 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// sharedLockRetry is how often a held lock file is retried
+	sharedLockRetry = time.Millisecond
+	// sharedLockTimeout is how long to wait for the lock file before failing
+	// the request
+	sharedLockTimeout = 5 * time.Second
+)
+
+var (
+	errSharedPathUnset   = errors.New("shared rate limit path unset")
+	errSharedInvalidRate = errors.New("shared rate limit interval and requests must be greater than zero")
+	errSharedLockTimeout = errors.New("timed out waiting for shared rate limit lock")
+	errSharedLockHeld    = errors.New("shared rate limit lock held")
+	errRequesterUnset    = errors.New("requester not set")
+)
+
+// SharedLimit is a Limiter which coordinates a token bucket across every
+// process on the same host using the same state file. Each request takes its
+// endpoint weight in tokens after the process local Limiter, if any, has been
+// satisfied
+type SharedLimit struct {
+	local Limiter
+	path  string
+	// rate is the number of tokens added per second
+	rate float64
+}
+
+// sharedState is the token bucket persisted to the state file
+type sharedState struct {
+	Tokens  float64 `json:"tokens"`
+	Updated int64   `json:"updated"`
+}
+
+// NewSharedLimit returns a Limiter allowing requests per interval across all
+// processes sharing the state file at path. local is applied first to pace
+// requests within the process and may be nil
+func NewSharedLimit(path string, interval time.Duration, requests int, local Limiter) (*SharedLimit, error) {
+	if path == "" {
+		return nil, errSharedPathUnset
+	}
+	if interval <= 0 || requests <= 0 {
+		return nil, errSharedInvalidRate
+	}
+	err := os.MkdirAll(filepath.Dir(path), 0770)
+	if err != nil {
+		return nil, err
+	}
+	return &SharedLimit{
+		local: local,
+		path:  path,
+		rate:  float64(requests) / interval.Seconds(),
+	}, nil
+}
+
+// Limit waits for the process local limit and then reserves the endpoint
+// weight from the shared bucket, sleeping until it is available
+func (s *SharedLimit) Limit(e EndpointLimit) error {
+	weight := 1
+	if s.local != nil {
+		err := s.local.Limit(e)
+		if err != nil {
+			return err
+		}
+		if w, ok := s.local.(WeightedLimiter); ok {
+			weight = w.Weight(e)
+		}
+	}
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	d, err := s.reserve(time.Now(), weight)
+	unlockErr := unlock()
+	if err != nil {
+		return err
+	}
+	if unlockErr != nil {
+		return unlockErr
+	}
+	time.Sleep(d)
+	return nil
+}
+
+// Loosen forwards the spare budget reported by the exchange to the process
+// local limiter. The shared bucket keeps its configured rate as it caps every
+// process sharing the state file
+func (s *SharedLimit) Loosen(spare rate.Limit) {
+	if l, ok := s.local.(BudgetLimiter); ok {
+		l.Loosen(spare)
+	}
+}
+
+// reserve takes weight tokens from the shared bucket and returns how long to
+// wait before they may be used. Tokens are reserved ahead so concurrent callers
+// queue behind each other rather than all waking at once. The lock must be
+// held
+func (s *SharedLimit) reserve(now time.Time, weight int) (time.Duration, error) {
+	state := sharedState{Tokens: 1, Updated: now.UnixNano()}
+	contents, err := ioutil.ReadFile(s.path)
+	switch {
+	case err == nil:
+		if err = json.Unmarshal(contents, &state); err != nil {
+			// a corrupt state file is reset rather than blocking every
+			// process sharing it
+			state = sharedState{Tokens: 1, Updated: now.UnixNano()}
+		}
+	case !os.IsNotExist(err):
+		return 0, err
+	}
+
+	if elapsed := now.UnixNano() - state.Updated; elapsed > 0 {
+		state.Tokens += time.Duration(elapsed).Seconds() * s.rate
+		// burst is kept as one as it is for process local limits
+		if state.Tokens > 1 {
+			state.Tokens = 1
+		}
+		state.Updated = now.UnixNano()
+	}
+	state.Tokens -= float64(weight)
+
+	contents, err = json.Marshal(state)
+	if err != nil {
+		return 0, err
+	}
+	err = ioutil.WriteFile(s.path, contents, 0600)
+	if err != nil {
+		return 0, err
+	}
+	if state.Tokens >= 0 {
+		return 0, nil
+	}
+	return time.Duration(-state.Tokens / s.rate * float64(time.Second)), nil
+}
+
+// lock acquires exclusive access to the state file by locking a file
+// alongside it. The operating system releases the lock if the process exits
+// while holding it so the lock file is left in place rather than removed
+func (s *SharedLimit) lock() (func() error, error) {
+	lockPath := s.path + ".lock"
+	f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(sharedLockTimeout)
+	for {
+		err = tryLockFile(f)
+		if !errors.Is(err, errSharedLockHeld) {
+			break
+		}
+		if time.Now().After(deadline) {
+			err = fmt.Errorf("%w %s", errSharedLockTimeout, lockPath)
+			break
+		}
+		time.Sleep(sharedLockRetry)
+	}
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			return nil, fmt.Errorf("%w, %v", err, closeErr)
+		}
+		return nil, err
+	}
+	return func() error {
+		err := unlockFile(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}, nil
+}
+
+// SetSharedLimit coordinates the requester's rate limit with other processes
+// on the same host using the state file at path. The existing limiter
+// continues to pace requests within the process
+func (r *Requester) SetSharedLimit(path string, interval time.Duration, requests int) error {
+	if r == nil {
+		return errRequesterUnset
+	}
+	local := r.limiter
+	if existing, ok := local.(*SharedLimit); ok {
+		// replace rather than nest a previously shared limit
+		local = existing.local
+	}
+	s, err := NewSharedLimit(path, interval, requests, local)
+	if err != nil {
+		return err
+	}
+	r.limiter = s
+	return nil
+}
@@ -0,0 +1,23 @@
+// +build !windows
+
+package request
+
+import (
+	"os"
+	"syscall"
+)
+
+// tryLockFile attempts to take an exclusive lock on the file without
+// blocking, errSharedLockHeld is returned when another handle holds it
+func tryLockFile(f *os.File) error {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err == syscall.EWOULDBLOCK {
+		return errSharedLockHeld
+	}
+	return err
+}
+
+// unlockFile releases a lock taken by tryLockFile
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
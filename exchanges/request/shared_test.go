@@ -0,0 +1,212 @@
+package request
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestNewSharedLimit(t *testing.T) {
+	t.Parallel()
+	_, err := NewSharedLimit("", time.Second, 1, nil)
+	if !errors.Is(err, errSharedPathUnset) {
+		t.Fatalf("expected %v, received %v", errSharedPathUnset, err)
+	}
+	_, err = NewSharedLimit("test", 0, 1, nil)
+	if !errors.Is(err, errSharedInvalidRate) {
+		t.Fatalf("expected %v, received %v", errSharedInvalidRate, err)
+	}
+}
+
+func TestSharedLimitReserve(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-shared-limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.json")
+
+	// two limiters sharing a file behave as one bucket of 10 requests a
+	// second
+	a, err := NewSharedLimit(path, time.Second, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewSharedLimit(path, time.Second, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	if d, err := a.reserve(now, 1); err != nil || d != 0 {
+		t.Fatalf("expected first request to be immediate, received %s %v", d, err)
+	}
+	if d, err := b.reserve(now, 1); err != nil || d != 100*time.Millisecond {
+		t.Fatalf("expected 100ms wait, received %s %v", d, err)
+	}
+	if d, err := a.reserve(now, 1); err != nil || d != 200*time.Millisecond {
+		t.Fatalf("expected 200ms wait, received %s %v", d, err)
+	}
+	// tokens refill over time but are capped at one
+	if d, err := b.reserve(now.Add(time.Minute), 1); err != nil || d != 0 {
+		t.Fatalf("expected refilled bucket, received %s %v", d, err)
+	}
+	if d, err := a.reserve(now.Add(time.Minute), 1); err != nil || d != 100*time.Millisecond {
+		t.Fatalf("expected burst of one, received %s %v", d, err)
+	}
+
+	err = ioutil.WriteFile(path, []byte("corrupt"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, err := a.reserve(now, 1); err != nil || d != 0 {
+		t.Fatalf("expected corrupt state to be reset, received %s %v", d, err)
+	}
+}
+
+func TestSharedLimitLock(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-shared-limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s, err := NewSharedLimit(filepath.Join(dir, "test.json"), time.Millisecond, 1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.Limit(Unset); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the lock file is left in place but a lock is only held through an open
+	// handle, so one left behind by an exited process does not block
+	if _, err = os.Stat(s.path + ".lock"); err != nil {
+		t.Fatal(err)
+	}
+	unlock, err := s.lock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a held lock blocks other handles until it is released
+	released := make(chan struct{})
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(released)
+		if err := unlock(); err != nil {
+			t.Error(err)
+		}
+	}()
+	unlock2, err := s.lock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-released:
+	default:
+		t.Error("expected lock to be held until released")
+	}
+	if err = unlock2(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSharedLimitWeight(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-shared-limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s, err := NewSharedLimit(filepath.Join(dir, "test.json"), time.Second, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	// a weight of five drains the full bucket and four further tokens
+	if d, err := s.reserve(now, 5); err != nil || d != 400*time.Millisecond {
+		t.Fatalf("expected 400ms wait, received %s %v", d, err)
+	}
+	if d, err := s.reserve(now, 1); err != nil || d != 500*time.Millisecond {
+		t.Fatalf("expected 500ms wait, received %s %v", d, err)
+	}
+}
+
+// weightedLimit is a local limiter with endpoint weights and spare budget
+type weightedLimit struct {
+	weight int
+	spare  rate.Limit
+}
+
+func (w *weightedLimit) Limit(EndpointLimit) error { return nil }
+func (w *weightedLimit) Weight(EndpointLimit) int  { return w.weight }
+func (w *weightedLimit) Loosen(spare rate.Limit)   { w.spare = spare }
+
+func TestSharedLimitLocalLimiter(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-shared-limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	local := &weightedLimit{weight: 101}
+	s, err := NewSharedLimit(filepath.Join(dir, "test.json"), time.Second, 1000, local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Loosen(50)
+	if local.spare != 50 {
+		t.Errorf("expected spare budget to be forwarded, received %v", local.spare)
+	}
+	// the local limiter's weight of 101 drains the full bucket and waits for
+	// 100 further tokens
+	start := time.Now()
+	if err = s.Limit(Unset); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected endpoint weight to be reserved, waited %s", elapsed)
+	}
+}
+
+func TestSetSharedLimit(t *testing.T) {
+	t.Parallel()
+	var r *Requester
+	if err := r.SetSharedLimit("test", time.Second, 1); !errors.Is(err, errRequesterUnset) {
+		t.Fatalf("expected %v, received %v", errRequesterUnset, err)
+	}
+	dir, err := ioutil.TempDir("", "gct-shared-limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	local := NewBasicRateLimit(time.Second, 100)
+	r = New("test", nil, WithLimiter(local))
+	path := filepath.Join(dir, "nested", "test.json")
+	for i := 0; i < 2; i++ {
+		if err = r.SetSharedLimit(path, time.Second, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s, ok := r.limiter.(*SharedLimit)
+	if !ok || s.local != local {
+		t.Errorf("expected shared limit wrapping the local limiter, received %T", r.limiter)
+	}
+}
@@ -1,6 +1,8 @@
 package sharedtestvalues
 
 import (
+	"os"
+	"testing"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -18,6 +20,10 @@ const (
 	// Defines channel capacity as defaults size can block tests
 	WebsocketChannelOverrideCapacity = 75
 
+	// WebsocketCaptureEnvironment names the environment variable holding a
+	// websocket capture file or directory to replay in exchange tests
+	WebsocketCaptureEnvironment = "GCT_WS_CAPTURE"
+
 	MockTesting = "Mock testing framework in use for %s exchange on REST endpoints only"
 	LiveTesting = "Mock testing bypassed; live testing of REST endpoints in use for %s exchange"
 )
@@ -47,3 +53,44 @@ func NewTestWebsocket() *stream.Websocket {
 		Match:             stream.NewMatch(),
 	}
 }
+
+// ReplayWebsocketCapture feeds the websocket capture named by the
+// WebsocketCaptureEnvironment variable through an exchange's websocket
+// handler, skipping the test when it is unset. Data handler output is
+// discarded so long captures do not block
+func ReplayWebsocketCapture(t *testing.T, exchName string, ws *stream.Websocket, handler func([]byte) error) {
+	t.Helper()
+	path := os.Getenv(WebsocketCaptureEnvironment)
+	if path == "" {
+		t.Skipf("%s unset, skipping websocket capture replay", WebsocketCaptureEnvironment)
+	}
+	files := []string{path}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.IsDir() {
+		files, err = stream.CaptureFiles(path, exchName)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-ws.DataHandler:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	n, err := stream.ReplayCapture(handler, files...)
+	if err != nil {
+		t.Error(err)
+	}
+	t.Logf("%s replayed %d websocket frames from %d capture files", exchName, n, len(files))
+}
@@ -18,6 +18,20 @@ var (
 	errIssueBufferEnabledButNoLimit = errors.New("buffer enabled but no limit set")
 	errUpdateIsNil                  = errors.New("update is nil")
 	errUpdateNoTargets              = errors.New("update bid/ask targets cannot be nil")
+	errChecksumFuncUnset            = errors.New("checksum function unset")
+
+	// ErrOrderbookInvalid is returned when an update is received for an
+	// orderbook which has failed verification and has no resync function
+	ErrOrderbookInvalid = errors.New("orderbook invalid, awaiting snapshot")
+	// ErrSequenceGap is returned when an update does not follow on from the
+	// last update applied to the orderbook
+	ErrSequenceGap = errors.New("orderbook update sequence gap")
+	// ErrChecksumMismatch is returned when an orderbook does not match the
+	// checksum supplied with an update
+	ErrChecksumMismatch = errors.New("orderbook checksum mismatch")
+	// ErrStaleUpdate is returned by a Verifier when an update has already
+	// been applied, the update is dropped without invalidating the orderbook
+	ErrStaleUpdate = errors.New("orderbook update already applied")
 )
 
 // Setup sets private variables
@@ -46,6 +60,16 @@ func (w *Orderbook) Setup(obBufferLimit int,
 	return nil
 }
 
+// SetVerifier sets the verifier checked as each update is applied and the
+// function used to fetch a replacement snapshot when verification fails. A
+// nil resync leaves invalid orderbooks in place until a snapshot is loaded
+func (w *Orderbook) SetVerifier(v Verifier, resync ResyncFunc) {
+	w.m.Lock()
+	w.verifier = v
+	w.resync = resync
+	w.m.Unlock()
+}
+
 // validate validates update against setup values
 func (w *Orderbook) validate(u *Update) error {
 	if u == nil {
@@ -76,6 +100,10 @@ func (w *Orderbook) Update(u *Update) error {
 			u.Asset)
 	}
 
+	if obLookup.invalid {
+		return w.awaitResync(obLookup)
+	}
+
 	if w.bufferEnabled {
 		processed, err := w.processBufferUpdate(obLookup, u)
 		if err != nil {
@@ -86,8 +114,11 @@ func (w *Orderbook) Update(u *Update) error {
 			return nil
 		}
 	} else {
-		err := w.processObUpdate(obLookup, u)
+		err := w.verifyAndProcess(obLookup, u)
 		if err != nil {
+			if errors.Is(err, ErrStaleUpdate) {
+				return nil
+			}
 			return err
 		}
 	}
@@ -126,8 +157,11 @@ func (w *Orderbook) processBufferUpdate(o *orderbookHolder, u *Update) (bool, er
 		}
 	}
 	for i := range *o.buffer {
-		err := w.processObUpdate(o, &(*o.buffer)[i])
+		err := w.verifyAndProcess(o, &(*o.buffer)[i])
 		if err != nil {
+			if errors.Is(err, ErrStaleUpdate) {
+				continue
+			}
 			return false, err
 		}
 	}
@@ -136,6 +170,89 @@ func (w *Orderbook) processBufferUpdate(o *orderbookHolder, u *Update) (bool, er
 	return true, nil
 }
 
+// verifyAndProcess applies an update, checking the orderbook with the
+// verifier before and after. A failed check invalidates the orderbook
+func (w *Orderbook) verifyAndProcess(o *orderbookHolder, u *Update) error {
+	if w.verifier == nil {
+		return w.processObUpdate(o, u)
+	}
+	err := w.verifier.VerifyUpdate(o.ob, u)
+	if err != nil {
+		if errors.Is(err, ErrStaleUpdate) {
+			return err
+		}
+		return w.invalidate(o, err)
+	}
+	err = w.processObUpdate(o, u)
+	if err != nil {
+		return err
+	}
+	err = w.verifier.VerifyBook(o.ob, u)
+	if err != nil {
+		return w.invalidate(o, err)
+	}
+	return nil
+}
+
+// invalidate flags an orderbook as invalid, discards any buffered updates and
+// starts a resync
+func (w *Orderbook) invalidate(o *orderbookHolder, reason error) error {
+	o.invalid = true
+	*o.buffer = nil
+	w.startResync(o)
+	return fmt.Errorf("%s %s %s orderbook invalidated: %w",
+		w.exchangeName,
+		o.ob.Pair,
+		o.ob.AssetType,
+		reason)
+}
+
+// awaitResync drops an update for an invalid orderbook, retrying a resync if
+// the previous attempt failed
+func (w *Orderbook) awaitResync(o *orderbookHolder) error {
+	if w.resync == nil {
+		return fmt.Errorf("%s %s %s %w",
+			w.exchangeName,
+			o.ob.Pair,
+			o.ob.AssetType,
+			ErrOrderbookInvalid)
+	}
+	w.startResync(o)
+	return nil
+}
+
+// startResync fetches a replacement snapshot in the background if a resync
+// function is set and one is not already in progress
+func (w *Orderbook) startResync(o *orderbookHolder) {
+	if w.resync == nil || o.resyncing {
+		return
+	}
+	o.resyncing = true
+	go w.resyncOrderbook(o, o.ob.Pair, o.ob.AssetType)
+}
+
+// resyncOrderbook loads a snapshot from the resync function, on failure the
+// next update received retries
+func (w *Orderbook) resyncOrderbook(o *orderbookHolder, p currency.Pair, a asset.Item) {
+	book, err := w.resync(p, a)
+	if err == nil {
+		cpy := *book
+		cpy.Bids = append(book.Bids[:0:0], book.Bids...)
+		cpy.Asks = append(book.Asks[:0:0], book.Asks...)
+		err = w.LoadSnapshot(&cpy)
+	}
+	if err != nil {
+		w.m.Lock()
+		o.resyncing = false
+		w.m.Unlock()
+		w.dataHandler <- fmt.Errorf("%s %s %s orderbook resync failed: %w",
+			w.exchangeName,
+			p,
+			a,
+			err)
+	}
+}
+
 // processObUpdate processes updates either by its corresponding id or by
 // price level
 func (w *Orderbook) processObUpdate(o *orderbookHolder, u *Update) error {
@@ -380,6 +497,9 @@ func (w *Orderbook) LoadSnapshot(book *orderbook.Base) error {
 	} else {
 		m3.ob.Bids = book.Bids
 		m3.ob.Asks = book.Asks
+		m3.ob.LastUpdateID = book.LastUpdateID
+		m3.invalid = false
+		m3.resyncing = false
 	}
 	w.dataHandler <- book
 	return nil
@@ -1105,3 +1105,160 @@ func TestFlushOrderbook(t *testing.T) {
 		t.Fatal("orderbook items not flushed")
 	}
 }
+
+func TestSequenceVerifier(t *testing.T) {
+	var v SequenceVerifier
+	book := &orderbook.Base{}
+	if err := v.VerifyUpdate(book, &Update{UpdateID: 5}); err != nil {
+		t.Fatal(err)
+	}
+	book.LastUpdateID = 5
+	err := v.VerifyUpdate(book, &Update{UpdateID: 5})
+	if !errors.Is(err, ErrStaleUpdate) {
+		t.Fatalf("expected error %v but received %v", ErrStaleUpdate, err)
+	}
+	if err = v.VerifyUpdate(book, &Update{UpdateID: 6}); err != nil {
+		t.Fatal(err)
+	}
+	err = v.VerifyUpdate(book, &Update{UpdateID: 7})
+	if !errors.Is(err, ErrSequenceGap) {
+		t.Fatalf("expected error %v but received %v", ErrSequenceGap, err)
+	}
+	// ranged updates may overlap the last update ID
+	if err = v.VerifyUpdate(book, &Update{FirstUpdateID: 3, UpdateID: 9}); err != nil {
+		t.Fatal(err)
+	}
+	err = v.VerifyUpdate(book, &Update{FirstUpdateID: 7, UpdateID: 9})
+	if !errors.Is(err, ErrSequenceGap) {
+		t.Fatalf("expected error %v but received %v", ErrSequenceGap, err)
+	}
+}
+
+func TestChecksumVerifier(t *testing.T) {
+	book := &orderbook.Base{Bids: []orderbook.Item{{Price: 1}}}
+	err := ChecksumVerifier{}.VerifyBook(book, &Update{Checksum: 1})
+	if !errors.Is(err, errChecksumFuncUnset) {
+		t.Fatalf("expected error %v but received %v", errChecksumFuncUnset, err)
+	}
+	v := ChecksumVerifier{Checksum: func(b *orderbook.Base) uint32 {
+		return uint32(len(b.Bids))
+	}}
+	if err = v.VerifyBook(book, &Update{}); err != nil {
+		t.Fatal(err)
+	}
+	if err = v.VerifyBook(book, &Update{Checksum: 1}); err != nil {
+		t.Fatal(err)
+	}
+	err = v.VerifyBook(book, &Update{Checksum: 2})
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("expected error %v but received %v", ErrChecksumMismatch, err)
+	}
+	if err = v.VerifyUpdate(book, &Update{Checksum: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	v.PreUpdate = true
+	err = v.VerifyUpdate(book, &Update{Checksum: 2})
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("expected error %v but received %v", ErrChecksumMismatch, err)
+	}
+	if err = v.VerifyBook(book, &Update{Checksum: 2}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifierResync(t *testing.T) {
+	obl, _, _, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resynced := make(chan struct{}, 1)
+	obl.SetVerifier(SequenceVerifier{}, func(p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+		resynced <- struct{}{}
+		return &orderbook.Base{
+			ExchangeName:  exchangeName,
+			Pair:          p,
+			AssetType:     a,
+			Bids:          []orderbook.Item{{Price: 3000, Amount: 1}},
+			Asks:          []orderbook.Item{{Price: 5000, Amount: 1}},
+			LastUpdateID:  100,
+			NotAggregated: true,
+		}, nil
+	})
+	holder := obl.ob[cp.Base][cp.Quote][asset.Spot]
+	holder.ob.LastUpdateID = 1
+
+	err = obl.Update(&Update{UpdateID: 2, Bids: itemArray[0], Pair: cp, Asset: asset.Spot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = obl.Update(&Update{UpdateID: 4, Bids: itemArray[1], Pair: cp, Asset: asset.Spot})
+	if !errors.Is(err, ErrSequenceGap) {
+		t.Fatalf("expected error %v but received %v", ErrSequenceGap, err)
+	}
+
+	select {
+	case <-resynced:
+	case <-time.After(time.Second * 5):
+		t.Fatal("orderbook was not resynced")
+	}
+	for i := 0; i < 100; i++ {
+		obl.m.Lock()
+		invalid := holder.invalid
+		obl.m.Unlock()
+		if !invalid {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	ob := obl.GetOrderbook(cp, asset.Spot)
+	if ob.LastUpdateID != 100 || len(ob.Bids) != 1 || ob.Bids[0].Price != 3000 {
+		t.Fatalf("unexpected resynced orderbook %+v", ob)
+	}
+	err = obl.Update(&Update{UpdateID: 90, Bids: itemArray[1], Pair: cp, Asset: asset.Spot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = obl.Update(&Update{UpdateID: 101, Bids: itemArray[1], Pair: cp, Asset: asset.Spot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ob = obl.GetOrderbook(cp, asset.Spot); ob.LastUpdateID != 101 || len(ob.Bids) != 2 {
+		t.Fatalf("unexpected orderbook after resync %+v", ob)
+	}
+}
+
+func TestInvalidOrderbookWithoutResync(t *testing.T) {
+	obl, _, _, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	obl.SetVerifier(ChecksumVerifier{Checksum: func(*orderbook.Base) uint32 {
+		return 1
+	}}, nil)
+
+	err = obl.Update(&Update{Checksum: 2, Bids: itemArray[0], Pair: cp, Asset: asset.Spot})
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("expected error %v but received %v", ErrChecksumMismatch, err)
+	}
+	err = obl.Update(&Update{Checksum: 1, Bids: itemArray[1], Pair: cp, Asset: asset.Spot})
+	if !errors.Is(err, ErrOrderbookInvalid) {
+		t.Fatalf("expected error %v but received %v", ErrOrderbookInvalid, err)
+	}
+
+	err = obl.LoadSnapshot(&orderbook.Base{
+		ExchangeName:  exchangeName,
+		Pair:          cp,
+		AssetType:     asset.Spot,
+		Bids:          []orderbook.Item{{Price: 3000, Amount: 1}},
+		NotAggregated: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = obl.Update(&Update{Checksum: 1, Bids: itemArray[1], Pair: cp, Asset: asset.Spot})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -20,12 +20,48 @@ type Orderbook struct {
 	updateEntriesByID     bool // Use the update IDs to match ob entries
 	exchangeName          string
 	dataHandler           chan interface{}
+	verifier              Verifier
+	resync                ResyncFunc
 	m                     sync.Mutex
 }
 
 type orderbookHolder struct {
 	ob     *orderbook.Base
 	buffer *[]Update
+	// invalid is set when verification fails, updates are dropped until a
+	// new snapshot is loaded
+	invalid   bool
+	resyncing bool
+}
+
+// Verifier checks the integrity of an orderbook as updates are applied. Any
+// error returned flags the orderbook as invalid except for ErrStaleUpdate
+// which drops the update
+type Verifier interface {
+	// VerifyUpdate is called before an update is applied to the orderbook
+	VerifyUpdate(book *orderbook.Base, u *Update) error
+	// VerifyBook is called after an update is applied to the orderbook and
+	// before it is processed
+	VerifyBook(book *orderbook.Base, u *Update) error
+}
+
+// ResyncFunc fetches a full orderbook snapshot, usually via REST, to replace
+// an orderbook which has failed verification
+type ResyncFunc func(p currency.Pair, a asset.Item) (*orderbook.Base, error)
+
+// SequenceVerifier detects gaps in update IDs. An update must follow on from
+// the orderbook's last update ID, when FirstUpdateID is set the update may
+// span a range of IDs which overlaps the last update ID
+type SequenceVerifier struct{}
+
+// ChecksumVerifier compares the checksum supplied with an update to one
+// calculated from the updated orderbook
+type ChecksumVerifier struct {
+	Checksum func(book *orderbook.Base) uint32
+	// PreUpdate compares the checksum with the orderbook before the update
+	// is applied, for exchanges such as Bitfinex which send the checksum of
+	// the orderbook as of the previous update
+	PreUpdate bool
 }
 
 // Update stores orderbook updates and dictates what features to use when processing
@@ -42,6 +78,13 @@ type Update struct {
 	// should remove any items that are outside of this scope. Kraken is the
 	// only exchange utilising this field.
 	MaxDepth int
+
+	// FirstUpdateID is the first ID covered by an update which spans a range
+	// of IDs ending at UpdateID, used by SequenceVerifier
+	FirstUpdateID int64
+	// Checksum is the exchange supplied checksum of the orderbook after the
+	// update is applied, used by ChecksumVerifier. Zero skips verification
+	Checksum uint32
 }
 
 // Action defines a set of differing states required to implement an incoming
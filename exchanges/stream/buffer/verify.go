@@ -0,0 +1,72 @@
+package buffer
+
+import (
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// VerifyUpdate checks the update follows on from the orderbook's last update
+// ID. Updates which have already been applied return ErrStaleUpdate
+func (s SequenceVerifier) VerifyUpdate(book *orderbook.Base, u *Update) error {
+	if book.LastUpdateID == 0 {
+		// snapshot has no ID to sequence from
+		return nil
+	}
+	if u.UpdateID <= book.LastUpdateID {
+		return fmt.Errorf("%w: update ID %d last update ID %d",
+			ErrStaleUpdate,
+			u.UpdateID,
+			book.LastUpdateID)
+	}
+	first := u.FirstUpdateID
+	if first == 0 {
+		first = u.UpdateID
+	}
+	if first > book.LastUpdateID+1 {
+		return fmt.Errorf("%w: expected update ID %d received %d",
+			ErrSequenceGap,
+			book.LastUpdateID+1,
+			first)
+	}
+	return nil
+}
+
+// VerifyBook satisfies the Verifier interface, sequences are only checked
+// before an update is applied
+func (s SequenceVerifier) VerifyBook(*orderbook.Base, *Update) error {
+	return nil
+}
+
+// VerifyUpdate compares the update's checksum against the orderbook before
+// the update is applied when PreUpdate is set
+func (c ChecksumVerifier) VerifyUpdate(book *orderbook.Base, u *Update) error {
+	if !c.PreUpdate {
+		return nil
+	}
+	return c.verify(book, u)
+}
+
+// VerifyBook compares the update's checksum against the updated orderbook
+func (c ChecksumVerifier) VerifyBook(book *orderbook.Base, u *Update) error {
+	if c.PreUpdate {
+		return nil
+	}
+	return c.verify(book, u)
+}
+
+func (c ChecksumVerifier) verify(book *orderbook.Base, u *Update) error {
+	if u.Checksum == 0 {
+		return nil
+	}
+	if c.Checksum == nil {
+		return errChecksumFuncUnset
+	}
+	if calculated := c.Checksum(book); calculated != u.Checksum {
+		return fmt.Errorf("%w: expected %d calculated %d",
+			ErrChecksumMismatch,
+			u.Checksum,
+			calculated)
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package stream
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+)
+
+var (
+	errCaptureDirectoryUnset = errors.New("capture directory unset")
+	errCaptureExchangeUnset  = errors.New("capture exchange name unset")
+	errCaptureClosed         = errors.New("capture closed")
+)
+
+// NewCapture returns a capture writing an exchange's inbound frames to the
+// configured directory, a new file is started on each run
+func NewCapture(exchangeName string, cfg CaptureConfig) (*Capture, error) {
+	if exchangeName == "" {
+		return nil, errCaptureExchangeUnset
+	}
+	if cfg.Directory == "" {
+		return nil, errCaptureDirectoryUnset
+	}
+	if cfg.MaxFileSize <= 0 {
+		cfg.MaxFileSize = DefaultCaptureMaxFileSize
+	}
+	if cfg.MaxFiles <= 0 {
+		cfg.MaxFiles = DefaultCaptureMaxFiles
+	}
+	c := &Capture{exchangeName: strings.ToLower(exchangeName), cfg: cfg}
+	err := c.rotate(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Write appends a frame to the current capture file, rotating it once it
+// exceeds the maximum file size
+func (c *Capture) Write(received time.Time, payload []byte) error {
+	frame := CaptureFrame{Received: received}
+	if json.Valid(payload) {
+		frame.Data = payload
+	} else {
+		frame.Text = string(payload)
+	}
+	line, err := json.Marshal(&frame)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.gz == nil {
+		return errCaptureClosed
+	}
+	if c.size > 0 && c.size+int64(len(line)) > c.cfg.MaxFileSize {
+		err = c.rotate(received)
+		if err != nil {
+			return err
+		}
+	}
+	n, err := c.gz.Write(line)
+	c.size += int64(n)
+	if err != nil {
+		return err
+	}
+	if time.Since(c.lastFlush) >= captureFlushInterval {
+		c.lastFlush = time.Now()
+		return c.gz.Flush()
+	}
+	return nil
+}
+
+// Close flushes and closes the current capture file
+func (c *Capture) Close() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.close()
+}
+
+func (c *Capture) close() error {
+	if c.gz == nil {
+		return nil
+	}
+	err := c.gz.Close()
+	if closeErr := c.file.Close(); err == nil {
+		err = closeErr
+	}
+	c.gz = nil
+	c.file = nil
+	return err
+}
+
+// rotate closes the current capture file, removes the oldest files beyond
+// the maximum kept and starts a new file
+func (c *Capture) rotate(now time.Time) error {
+	err := c.close()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s%s", c.exchangeName, now.UTC().Format(captureTimeFormat), captureFileExt)
+	f, err := file.Writer(filepath.Join(c.cfg.Directory, name))
+	if err != nil {
+		return err
+	}
+	c.file = f
+	c.gz = gzip.NewWriter(f)
+	c.size = 0
+	c.lastFlush = time.Now()
+	return c.prune()
+}
+
+// prune removes the oldest capture files so no more than the maximum are
+// kept, including the current file
+func (c *Capture) prune() error {
+	files, err := CaptureFiles(c.cfg.Directory, c.exchangeName)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(files)-c.cfg.MaxFiles; i++ {
+		err = os.Remove(files[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CaptureFiles returns the paths of an exchange's capture files in a
+// directory, oldest first
+func CaptureFiles(directory, exchangeName string) ([]string, error) {
+	entries, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.ToLower(exchangeName) + "-"
+	var files []string
+	for i := range entries {
+		name := entries[i].Name()
+		if entries[i].IsDir() ||
+			!strings.HasPrefix(name, prefix) ||
+			!strings.HasSuffix(name, captureFileExt) {
+			continue
+		}
+		files = append(files, filepath.Join(directory, name))
+	}
+	// file names embed a fixed width UTC timestamp so sort chronologically
+	sort.Strings(files)
+	return files, nil
+}
+
+// ReadCapture calls fn with each frame of a capture file in the order they
+// were received. Files left open by a process which exited are read up to
+// their last flush
+func ReadCapture(path string, fn func(*CaptureFrame) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	r := bufio.NewReader(gz)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+				// a trailing partial line was cut off mid write
+				return nil
+			}
+			return err
+		}
+		var frame CaptureFrame
+		err = json.Unmarshal(line, &frame)
+		if err != nil {
+			return err
+		}
+		err = fn(&frame)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ReplayCapture feeds the payload of each frame in the capture files to
+// handler, such as an exchange's wsHandleData in a test, and returns the
+// number of frames replayed. Replay stops at the first handler error
+func ReplayCapture(handler func([]byte) error, paths ...string) (int, error) {
+	var replayed int
+	for i := range paths {
+		err := ReadCapture(paths[i], func(f *CaptureFrame) error {
+			err := handler(f.Payload())
+			if err != nil {
+				return fmt.Errorf("%s frame %d received %s: %w",
+					paths[i], replayed, f.Received.Format(time.RFC3339Nano), err)
+			}
+			replayed++
+			return nil
+		})
+		if err != nil {
+			return replayed, err
+		}
+	}
+	return replayed, nil
+}
+
+// CaptureToRecording converts capture files to a websocket recording of
+// inbound frames, which a mock.WebsocketServer replays through the full
+// websocket stack
+func CaptureToRecording(exchangeName string, paths ...string) (*mock.WebsocketRecording, error) {
+	rec := &mock.WebsocketRecording{Exchange: strings.ToLower(exchangeName)}
+	for i := range paths {
+		err := ReadCapture(paths[i], func(f *CaptureFrame) error {
+			rec.Frames = append(rec.Frames, mock.WebsocketFrame{
+				Direction: mock.WebsocketInbound,
+				Data:      f.Data,
+				Text:      f.Text,
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rec, nil
+}
+
+// Payload returns the frame's message as it was received
+func (f *CaptureFrame) Payload() []byte {
+	if len(f.Data) == 0 {
+		return []byte(f.Text)
+	}
+	return f.Data
+}
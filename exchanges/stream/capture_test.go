@@ -0,0 +1,186 @@
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+)
+
+func TestNewCapture(t *testing.T) {
+	t.Parallel()
+	_, err := NewCapture("", CaptureConfig{Directory: "test"})
+	if !errors.Is(err, errCaptureExchangeUnset) {
+		t.Fatalf("expected %v, received %v", errCaptureExchangeUnset, err)
+	}
+	_, err = NewCapture("test", CaptureConfig{})
+	if !errors.Is(err, errCaptureDirectoryUnset) {
+		t.Fatalf("expected %v, received %v", errCaptureDirectoryUnset, err)
+	}
+}
+
+func TestCaptureRotateAndReplay(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := NewCapture("Test", CaptureConfig{Directory: dir, MaxFileSize: 100, MaxFiles: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	payloads := []string{`{"seq":1}`, "heartbeat", `{"seq":2}`, `{"seq":3}`}
+	for i := range payloads {
+		// each frame is larger than half the maximum size so every file
+		// holds a single frame
+		err = c.Write(start.Add(time.Duration(i)*time.Millisecond), []byte(payloads[i]))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Write(start, []byte("closed")); !errors.Is(err, errCaptureClosed) {
+		t.Fatalf("expected %v, received %v", errCaptureClosed, err)
+	}
+
+	files, err := CaptureFiles(dir, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected the oldest files to be pruned, received %v", files)
+	}
+
+	var replayed []string
+	n, err := ReplayCapture(func(b []byte) error {
+		replayed = append(replayed, string(b))
+		return nil
+	}, files...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 || replayed[0] != payloads[2] || replayed[1] != payloads[3] {
+		t.Errorf("expected the latest frames %v to be replayed, received %v", payloads[2:], replayed)
+	}
+
+	errHandler := errors.New("handler error")
+	n, err = ReplayCapture(func([]byte) error { return errHandler }, files...)
+	if !errors.Is(err, errHandler) || n != 0 {
+		t.Errorf("expected replay to stop on %v, received %d %v", errHandler, n, err)
+	}
+}
+
+func TestReadUnclosedCapture(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gct-capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := NewCapture("test", CaptureConfig{Directory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	// force a flush as a process which has been running for a while would
+	c.lastFlush = time.Time{}
+	received := time.Now()
+	if err = c.Write(received, []byte(`{"event":"book"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := CaptureFiles(dir, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var frames []CaptureFrame
+	err = ReadCapture(files[0], func(f *CaptureFrame) error {
+		frames = append(frames, *f)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 1 || !frames[0].Received.Equal(received) ||
+		string(frames[0].Data) != `{"event":"book"}` {
+		t.Errorf("unexpected frames %+v", frames)
+	}
+}
+
+func TestWebsocketCapture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	session := &mock.WebsocketRecording{
+		Exchange: "test",
+		Frames: []mock.WebsocketFrame{
+			{Direction: mock.WebsocketInbound, Data: json.RawMessage(`{"event":"systemStatus","status":"online"}`)},
+			{Direction: mock.WebsocketInbound, Text: "heartbeat"},
+		},
+	}
+	server := mock.NewWebsocketServerFromRecording(session)
+	defer server.Close()
+
+	web := Websocket{
+		Wg:                new(sync.WaitGroup),
+		ShutdownC:         make(chan struct{}),
+		TrafficAlert:      make(chan struct{}),
+		ReadMessageErrors: make(chan error, 1),
+		exchangeName:      "test",
+	}
+	err = web.SetupNewConnection(ConnectionSetup{URL: server.URL, ResponseMaxLimit: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCapture("test", CaptureConfig{Directory: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	web.SetCapture(c)
+
+	err = web.Conn.Dial(&websocket.Dialer{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer web.Conn.Shutdown()
+	for range session.Frames {
+		web.Conn.ReadMessage()
+	}
+	if err = c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := CaptureFiles(dir, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, err := CaptureToRecording("test", files...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Frames) != len(session.Frames) {
+		t.Fatalf("expected %d captured frames, received %d", len(session.Frames), len(rec.Frames))
+	}
+	for i := range rec.Frames {
+		if string(rec.Frames[i].Payload()) != string(session.Frames[i].Payload()) ||
+			rec.Frames[i].Direction != mock.WebsocketInbound {
+			t.Errorf("frame %d: expected %s, received %s",
+				i, session.Frames[i].Payload(), rec.Frames[i].Payload())
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package stream
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCaptureMaxFileSize is the uncompressed size at which capture
+	// files are rotated
+	DefaultCaptureMaxFileSize = 64 << 20
+	// DefaultCaptureMaxFiles is the number of capture files kept for each
+	// exchange
+	DefaultCaptureMaxFiles = 10
+	// captureFlushInterval bounds how many frames are lost if the process
+	// exits without closing the capture
+	captureFlushInterval = time.Second
+	captureFileExt       = ".jsonl.gz"
+	captureTimeFormat    = "20060102T150405.000000000"
+)
+
+// CaptureFrame is a raw inbound websocket frame and the time it was
+// received. JSON payloads are stored in Data so captures stay readable,
+// anything else is stored in Text
+type CaptureFrame struct {
+	Received time.Time       `json:"received"`
+	Data     json.RawMessage `json:"data,omitempty"`
+	Text     string          `json:"text,omitempty"`
+}
+
+// CaptureConfig defines where websocket frames are captured and how the
+// capture files are rotated
+type CaptureConfig struct {
+	Directory string
+	// MaxFileSize is the uncompressed size in bytes at which a capture file
+	// is rotated
+	MaxFileSize int64
+	// MaxFiles is the number of capture files kept, the oldest are removed
+	MaxFiles int
+}
+
+// Capture writes inbound websocket frames to rotating gzip compressed files
+// of JSON lines, one CaptureFrame per line
+type Capture struct {
+	exchangeName string
+	cfg          CaptureConfig
+
+	m         sync.Mutex
+	file      *os.File
+	gz        *gzip.Writer
+	size      int64
+	lastFlush time.Time
+}
@@ -0,0 +1,43 @@
+package stream
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+var (
+	websocketMessages = metrics.NewCounter("gct_websocket_messages_total",
+		"Websocket messages received, the message rate is the rate of this counter",
+		"exchange")
+	websocketReconnects = metrics.NewCounter("gct_websocket_reconnects_total",
+		"Websocket reconnection attempts made by the connection monitor",
+		"exchange")
+	websocketConnected = metrics.NewGauge("gct_websocket_connected",
+		"Whether the exchange websocket is connected",
+		"exchange")
+	websocketRequests = metrics.NewCounter("gct_websocket_requests_total",
+		"Websocket requests awaiting responses by result of success, timeout, cancelled or error",
+		"exchange", "result")
+	websocketRequestRetries = metrics.NewCounter("gct_websocket_request_retries_total",
+		"Websocket requests resent after timing out",
+		"exchange")
+	websocketRequestDuration = metrics.NewHistogram("gct_websocket_request_duration_seconds",
+		"Time from sending a websocket request to receiving its last response, including retries",
+		metrics.DefaultLatencyBuckets, "exchange")
+	websocketRequestsInFlight = metrics.NewGauge("gct_websocket_requests_in_flight",
+		"Websocket requests awaiting responses",
+		"exchange")
+)
+
+func init() {
+	err := metrics.Register(websocketMessages,
+		websocketReconnects,
+		websocketConnected,
+		websocketRequests,
+		websocketRequestRetries,
+		websocketRequestDuration,
+		websocketRequestsInFlight)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "Failed to register websocket metrics: %v", err)
+	}
+}
@@ -1,14 +1,25 @@
 package stream
 
 import (
+	"context"
 	"errors"
 	"sync"
+	"time"
+)
+
+var (
+	// ErrRequestTimeout is returned when a request's responses are not
+	// received before its timeout, after any retries
+	ErrRequestTimeout = errors.New("timeout waiting for response")
+
+	errSignatureCollision = errors.New("signature collision")
+	errMatchNotSet        = errors.New("request matcher not set")
 )
 
 // NewMatch returns a new matcher
 func NewMatch() *Match {
 	return &Match{
-		m: make(map[interface{}]chan []byte),
+		m: make(map[interface{}]*matcher),
 	}
 }
 
@@ -17,7 +28,7 @@ func NewMatch() *Match {
 // connections. Stream systems fan in all incoming payloads to one routine for
 // processing.
 type Match struct {
-	m map[interface{}]chan []byte
+	m map[interface{}]*matcher
 	sync.Mutex
 }
 
@@ -27,55 +38,104 @@ func (m *Match) Incoming(signature interface{}) bool {
 }
 
 // IncomingWithData matches with requests and takes in the returned payload, to
-// be processed outside of a stream processing routine
+// be processed outside of a stream processing routine. A request expecting
+// several responses stays matched until its last response is received.
 func (m *Match) IncomingWithData(signature interface{}, data []byte) bool {
 	m.Lock()
 	defer m.Unlock()
-	ch, ok := m.m[signature]
-	if ok {
-		select {
-		case ch <- data:
-		default:
-			// this shouldn't occur but if it does continue to process as normal
-			return false
-		}
-		return true
+	r, ok := m.m[signature]
+	if !ok {
+		return false
 	}
-	return false
+	r.responses = append(r.responses, data)
+	if r.isComplete(data) {
+		delete(m.m, signature)
+		close(r.done)
+	}
+	return true
 }
 
-// Sets the signature response channel for incoming data
-func (m *Match) set(signature interface{}) (matcher, error) {
-	var ch chan []byte
+// Pending returns the number of requests awaiting responses
+func (m *Match) Pending() int {
 	m.Lock()
-	_, ok := m.m[signature]
-	if ok {
-		m.Unlock()
-		return matcher{}, errors.New("signature collision")
-	}
-	// This is buffered so we don't need to wait for receiver.
-	ch = make(chan []byte, 1)
-	m.m[signature] = ch
-	m.Unlock()
+	defer m.Unlock()
+	return len(m.m)
+}
 
-	return matcher{
-		C:   ch,
-		sig: signature,
-		m:   m,
-	}, nil
+// set tracks a request signature which expects the responses defined by cfg
+func (m *Match) set(signature interface{}, cfg *RequestConfig) (*matcher, error) {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.m[signature]; ok {
+		return nil, errSignatureCollision
+	}
+	r := &matcher{
+		sig:      signature,
+		m:        m,
+		expected: cfg.Responses,
+		final:    cfg.Final,
+		done:     make(chan struct{}),
+	}
+	if r.expected <= 0 {
+		r.expected = 1
+	}
+	m.m[signature] = r
+	return r, nil
 }
 
-// matcher defines a payload matching return mechanism
+// matcher tracks the responses received for a request signature
 type matcher struct {
-	C   chan []byte
-	sig interface{}
-	m   *Match
+	sig       interface{}
+	m         *Match
+	expected  int
+	final     func([]byte) bool
+	responses [][]byte
+	done      chan struct{}
+}
+
+// isComplete returns if the latest response completes the request, must be
+// called with the Match lock held
+func (r *matcher) isComplete(data []byte) bool {
+	if r.final != nil {
+		return r.final(data)
+	}
+	return len(r.responses) >= r.expected
 }
 
-// Cleanup closes underlying channel and deletes signature from map
-func (m *matcher) Cleanup() {
-	m.m.Lock()
-	close(m.C)
-	delete(m.m.m, m.sig)
-	m.m.Unlock()
+// wait blocks until the request is complete, the context is done or the
+// timeout elapses
+func (r *matcher) wait(ctx context.Context, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return ErrRequestTimeout
+	}
+}
+
+// reset discards partial responses ahead of a retry, returning false if the
+// request completed in the meantime
+func (r *matcher) reset() bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	select {
+	case <-r.done:
+		return false
+	default:
+	}
+	r.responses = nil
+	return true
+}
+
+// Cleanup stops tracking the signature so late responses are not matched
+func (r *matcher) Cleanup() {
+	r.m.Lock()
+	if current, ok := r.m.m[r.sig]; ok && current == r {
+		delete(r.m.m, r.sig)
+	}
+	r.m.Unlock()
 }
@@ -1,8 +1,15 @@
 package stream
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 )
 
 func TestMatch(t *testing.T) {
@@ -17,14 +24,14 @@ func TestMatch(t *testing.T) {
 		t.Fatal("should not be able to match")
 	}
 
-	m, err := nm.set("hello")
+	m, err := nm.set("hello", &RequestConfig{})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = nm.set("hello")
-	if err == nil {
-		t.Fatal("error cannot be nil as this collision cannot occur")
+	_, err = nm.set("hello", &RequestConfig{})
+	if !errors.Is(err, errSignatureCollision) {
+		t.Fatalf("expected %v, received %v", errSignatureCollision, err)
 	}
 
 	if m.sig != "hello" {
@@ -38,13 +45,191 @@ func TestMatch(t *testing.T) {
 
 	// put in secondary payload with conflicting signature
 	if nm.Incoming("hello") {
-		fmt.Println("should not have been able to match")
+		t.Fatal("should not have been able to match a completed request")
 	}
 
-	data := <-m.C
-	if data != nil {
-		t.Fatal("wow")
+	err = m.wait(context.Background(), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.responses) != 1 || m.responses[0] != nil {
+		t.Fatalf("unexpected responses %v", m.responses)
 	}
 
 	m.Cleanup()
+	if nm.Pending() != 0 {
+		t.Fatal("signature should no longer be tracked")
+	}
+}
+
+func TestMatchMultipleResponses(t *testing.T) {
+	t.Parallel()
+	nm := NewMatch()
+	m, err := nm.set(1, &RequestConfig{Responses: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Cleanup()
+	for i := 0; i < 3; i++ {
+		if !nm.IncomingWithData(1, []byte{byte(i)}) {
+			t.Fatalf("response %d should have matched", i)
+		}
+	}
+	if nm.IncomingWithData(1, []byte{3}) {
+		t.Fatal("should not have matched beyond the expected responses")
+	}
+	err = m.wait(context.Background(), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.responses) != 3 || m.responses[2][0] != 2 {
+		t.Fatalf("unexpected responses %v", m.responses)
+	}
+
+	final, err := nm.set(2, &RequestConfig{
+		Responses: 1,
+		Final:     func(b []byte) bool { return string(b) == "end" },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer final.Cleanup()
+	nm.IncomingWithData(2, []byte("page"))
+	nm.IncomingWithData(2, []byte("page"))
+	nm.IncomingWithData(2, []byte("end"))
+	err = final.wait(context.Background(), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(final.responses) != 3 {
+		t.Fatalf("expected 3 responses, received %d", len(final.responses))
+	}
+}
+
+func TestMatchWait(t *testing.T) {
+	t.Parallel()
+	nm := NewMatch()
+	m, err := nm.set("timeout", &RequestConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Cleanup()
+	err = m.wait(context.Background(), time.Millisecond)
+	if !errors.Is(err, ErrRequestTimeout) {
+		t.Fatalf("expected %v, received %v", ErrRequestTimeout, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = m.wait(ctx, time.Second)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, received %v", context.Canceled, err)
+	}
+
+	nm.IncomingWithData("timeout", []byte("late"))
+	if m.reset() {
+		t.Fatal("a completed request should not be reset")
+	}
+}
+
+// newMatchTestConnection dials a mock server replaying the recorded frames
+// and matches inbound messages by their id field as an exchange's websocket
+// handler would
+func newMatchTestConnection(t *testing.T, frames []mock.WebsocketFrame) (*WebsocketConnection, func()) {
+	t.Helper()
+	server := mock.NewWebsocketServerFromRecording(&mock.WebsocketRecording{
+		Exchange: "test",
+		Frames:   frames,
+	})
+	conn := &WebsocketConnection{
+		ExchangeName:      "test",
+		URL:               server.URL,
+		ResponseMaxLimit:  time.Second,
+		Match:             NewMatch(),
+		Wg:                new(sync.WaitGroup),
+		ShutdownC:         make(chan struct{}),
+		Traffic:           make(chan struct{}, 100),
+		readMessageErrors: make(chan error, 1),
+	}
+	err := conn.Dial(&websocket.Dialer{}, nil)
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			resp := conn.ReadMessage()
+			if resp.Raw == nil {
+				return
+			}
+			var msg struct {
+				ID int64 `json:"id"`
+			}
+			if json.Unmarshal(resp.Raw, &msg) == nil {
+				conn.Match.IncomingWithData(msg.ID, resp.Raw)
+			}
+		}
+	}()
+	return conn, func() {
+		conn.Shutdown()
+		server.Close()
+	}
+}
+
+func TestSendMessageReturnResponses(t *testing.T) {
+	t.Parallel()
+	conn, shutdown := newMatchTestConnection(t, []mock.WebsocketFrame{
+		{Direction: mock.WebsocketOutbound, Data: json.RawMessage(`{"method":"cancel"}`)},
+		{Direction: mock.WebsocketInbound, Data: json.RawMessage(`{"id":1,"order":"a"}`)},
+		{Direction: mock.WebsocketInbound, Data: json.RawMessage(`{"id":1,"order":"b"}`)},
+	})
+	defer shutdown()
+
+	resp, err := conn.SendMessageReturnResponses(context.Background(), int64(1),
+		map[string]interface{}{"id": 1, "method": "cancel"},
+		RequestConfig{Responses: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 || string(resp[1]) != `{"id":1,"order":"b"}` {
+		t.Fatalf("unexpected responses %s", resp)
+	}
+	if conn.Match.Pending() != 0 {
+		t.Fatal("request should no longer be tracked")
+	}
+}
+
+func TestSendMessageReturnResponsesRetry(t *testing.T) {
+	t.Parallel()
+	// the first request goes unanswered and the retry is answered
+	conn, shutdown := newMatchTestConnection(t, []mock.WebsocketFrame{
+		{Direction: mock.WebsocketOutbound, Data: json.RawMessage(`{"method":"order"}`)},
+		{Direction: mock.WebsocketOutbound, Data: json.RawMessage(`{"method":"order"}`)},
+		{Direction: mock.WebsocketInbound, Data: json.RawMessage(`{"id":2,"status":"ok"}`)},
+	})
+	defer shutdown()
+	request := map[string]interface{}{"id": 2, "method": "order"}
+
+	resp, err := conn.SendMessageReturnResponses(context.Background(), int64(2), request,
+		RequestConfig{Timeout: 50 * time.Millisecond, Retries: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 || string(resp[0]) != `{"id":2,"status":"ok"}` {
+		t.Fatalf("unexpected responses %s", resp)
+	}
+
+	// the recording is exhausted so no further requests are answered
+	_, err = conn.SendMessageReturnResponses(context.Background(), int64(3), request,
+		RequestConfig{Timeout: 50 * time.Millisecond})
+	if !errors.Is(err, ErrRequestTimeout) {
+		t.Fatalf("expected %v, received %v", ErrRequestTimeout, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = conn.SendMessageReturnResponses(ctx, int64(4), request, RequestConfig{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, received %v", context.Canceled, err)
+	}
 }
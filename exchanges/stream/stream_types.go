@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -18,6 +19,7 @@ type Connection interface {
 	SetupPingHandler(PingHandler)
 	GenerateMessageID(highPrecision bool) int64
 	SendMessageReturnResponse(signature interface{}, request interface{}) ([]byte, error)
+	SendMessageReturnResponses(ctx context.Context, signature interface{}, request interface{}, cfg RequestConfig) ([][]byte, error)
 	SendRawMessage(messageType int, message []byte) error
 	SetURL(string)
 	SetProxy(string)
@@ -29,6 +31,15 @@ type Connection interface {
 type Response struct {
 	Type int
 	Raw  []byte
+	// Received is when the message was read from the connection
+	Received time.Time
+}
+
+// Delay is sent to the data handler to report the time between the exchange
+// timestamp of a message and its receipt from the connection
+type Delay struct {
+	ExchangeTime time.Time
+	Received     time.Time
 }
 
 // ChannelSubscription container for streaming subscriptions
@@ -48,6 +59,24 @@ type ConnectionSetup struct {
 	Authenticated        bool
 }
 
+// RequestConfig defines how a request sent with SendMessageReturnResponses
+// waits for its responses
+type RequestConfig struct {
+	// Timeout is how long each attempt waits for its responses, defaulting
+	// to the connection's ResponseMaxLimit
+	Timeout time.Duration
+	// Retries is the number of times the request is resent after a timeout.
+	// Only requests which are safe to repeat, or which the exchange
+	// deduplicates by client ID, should be retried
+	Retries int
+	// Responses is the number of responses matched to the signature before
+	// the request completes, defaulting to one
+	Responses int
+	// Final when set reports whether a response is the last of the request
+	// and takes precedence over Responses
+	Final func([]byte) bool
+}
+
 // PingHandler container for ping handler settings
 type PingHandler struct {
 	Websocket         bool
@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -73,6 +75,11 @@ func (w *Websocket) Setup(s *WebsocketSetup) error {
 
 	w.GenerateSubs = s.GenerateSubscriptions
 
+	err := w.setupConnectionPool(s)
+	if err != nil {
+		return err
+	}
+
 	w.enabled = s.Enabled
 	if s.DefaultURL == "" {
 		return errors.New("default url is empty")
@@ -81,7 +88,7 @@ func (w *Websocket) Setup(s *WebsocketSetup) error {
 	if s.RunningURL == "" {
 		return errors.New("running URL cannot be nil")
 	}
-	err := w.SetWebsocketURL(s.RunningURL, false, false)
+	err = w.SetWebsocketURL(s.RunningURL, false, false)
 	if err != nil {
 		return err
 	}
@@ -108,13 +115,72 @@ func (w *Websocket) Setup(s *WebsocketSetup) error {
 	w.Wg = new(sync.WaitGroup)
 	w.SetCanUseAuthenticatedEndpoints(s.AuthenticatedWebsocketAPISupport)
 
-	return w.Orderbook.Setup(s.OrderbookBufferLimit,
+	err = w.Orderbook.Setup(s.OrderbookBufferLimit,
 		s.BufferEnabled,
 		s.SortBuffer,
 		s.SortBufferByUpdateIDs,
 		s.UpdateEntriesByID,
 		w.exchangeName,
 		w.DataHandler)
+	if err != nil {
+		return err
+	}
+	w.Orderbook.SetVerifier(s.OrderbookVerifier, s.OrderbookResync)
+	return nil
+}
+
+// newConnection returns a connection using the websocket's settings which
+// reports read errors to errs
+func (w *Websocket) newConnection(c ConnectionSetup, errs chan error) *WebsocketConnection {
+	connectionURL := w.GetWebsocketURL()
+	if c.URL != "" {
+		connectionURL = c.URL
+	}
+	return &WebsocketConnection{
+		ExchangeName:      w.exchangeName,
+		URL:               connectionURL,
+		ProxyURL:          w.GetProxyAddress(),
+		Verbose:           w.verbose,
+		ResponseMaxLimit:  c.ResponseMaxLimit,
+		Traffic:           w.TrafficAlert,
+		readMessageErrors: errs,
+		ShutdownC:         w.ShutdownC,
+		Wg:                w.Wg,
+		Match:             w.Match,
+		RateLimit:         c.RateLimit,
+		Recorder:          w.recorder,
+		Capture:           w.capture,
+	}
+}
+
+// SetRecorder sets a recorder which captures the inbound and outbound frames
+// of the websocket connections for mock testing, connections which have
+// already been set up are updated
+func (w *Websocket) SetRecorder(r *mock.WebsocketRecorder) {
+	w.connectionMutex.Lock()
+	defer w.connectionMutex.Unlock()
+	w.recorder = r
+	if c, ok := w.Conn.(*WebsocketConnection); ok {
+		c.Recorder = r
+	}
+	if c, ok := w.AuthConn.(*WebsocketConnection); ok {
+		c.Recorder = r
+	}
+}
+
+// SetCapture sets a capture which writes the inbound frames of the websocket
+// connections to file, connections which have already been set up are
+// updated
+func (w *Websocket) SetCapture(c *Capture) {
+	w.connectionMutex.Lock()
+	defer w.connectionMutex.Unlock()
+	w.capture = c
+	if conn, ok := w.Conn.(*WebsocketConnection); ok {
+		conn.Capture = c
+	}
+	if conn, ok := w.AuthConn.(*WebsocketConnection); ok {
+		conn.Capture = c
+	}
 }
 
 // SetupNewConnection sets up an auth or unauth streaming connection
@@ -138,29 +204,12 @@ func (w *Websocket) SetupNewConnection(c ConnectionSetup) error {
 		return errors.New("setting up new connection error: read message errors is nil, please call setup first")
 	}
 
-	connectionURL := w.GetWebsocketURL()
-	if c.URL != "" {
-		connectionURL = c.URL
-	}
-
-	newConn := &WebsocketConnection{
-		ExchangeName:      w.exchangeName,
-		URL:               connectionURL,
-		ProxyURL:          w.GetProxyAddress(),
-		Verbose:           w.verbose,
-		ResponseMaxLimit:  c.ResponseMaxLimit,
-		Traffic:           w.TrafficAlert,
-		readMessageErrors: w.ReadMessageErrors,
-		ShutdownC:         w.ShutdownC,
-		Wg:                w.Wg,
-		Match:             w.Match,
-		RateLimit:         c.RateLimit,
-	}
-
+	newConn := w.newConnection(c, w.ReadMessageErrors)
 	if c.Authenticated {
 		w.AuthConn = newConn
 	} else {
 		w.Conn = newConn
+		w.connectionSetup = c
 	}
 
 	return nil
@@ -205,8 +254,14 @@ func (w *Websocket) Connect() error {
 		w.connectionMonitor()
 	}
 
-	// Resubscribe after re-connection
-	if len(w.subscriptions) != 0 {
+	// Resubscribe after re-connection, pooled connections other than the
+	// standard connection resubscribe on their own
+	if w.IsConnectionPooled() {
+		err = w.resubscribePrimary()
+		if err != nil {
+			return fmt.Errorf("%v Error subscribing %s", w.exchangeName, err)
+		}
+	} else if len(w.subscriptions) != 0 {
 		err = w.Subscriber(w.subscriptions)
 		if err != nil {
 			return fmt.Errorf("%v Error subscribing %s", w.exchangeName, err)
@@ -334,6 +389,7 @@ func (w *Websocket) connectionMonitor() {
 				}
 			case <-timer.C:
 				if !w.IsConnecting() && !w.IsConnected() {
+					websocketReconnects.Inc(w.exchangeName)
 					err := w.Connect()
 					if err != nil {
 						log.Error(log.WebsocketMgr, err)
@@ -387,10 +443,15 @@ func (w *Websocket) Shutdown() error {
 		}
 	}
 
-	// flush any subscriptions from last connection if needed
+	// flush any subscriptions and pooled connections from last connection if
+	// needed
 	w.subscriptionMutex.Lock()
 	w.subscriptions = nil
+	err := w.shutdownPool()
 	w.subscriptionMutex.Unlock()
+	if err != nil {
+		return err
+	}
 
 	close(w.ShutdownC)
 	w.Wg.Wait()
@@ -541,6 +602,11 @@ func (w *Websocket) setConnectedStatus(b bool) {
 	w.connectionMutex.Lock()
 	w.connected = b
 	w.connectionMutex.Unlock()
+	var connected float64
+	if b {
+		connected = 1
+	}
+	websocketConnected.Set(connected, w.exchangeName)
 }
 
 // IsConnected returns status of connection
@@ -685,6 +751,13 @@ func (w *Websocket) SetWebsocketURL(url string, auth, reconnect bool) error {
 		if w.Conn != nil {
 			w.Conn.SetURL(url)
 		}
+		w.subscriptionMutex.Lock()
+		for i := range w.shards {
+			if !w.shards[i].primary {
+				w.shards[i].conn.SetURL(url)
+			}
+		}
+		w.subscriptionMutex.Unlock()
 	}
 
 	if w.IsConnected() && reconnect {
@@ -734,6 +807,13 @@ func (w *Websocket) SetProxyAddress(proxyAddr string) error {
 	if w.AuthConn != nil {
 		w.AuthConn.SetProxy(proxyAddr)
 	}
+	w.subscriptionMutex.Lock()
+	for i := range w.shards {
+		if !w.shards[i].primary {
+			w.shards[i].conn.SetProxy(proxyAddr)
+		}
+	}
+	w.subscriptionMutex.Unlock()
 
 	w.proxyAddr = proxyAddr
 	if w.IsInit() && w.IsEnabled() {
@@ -753,6 +833,34 @@ func (w *Websocket) GetProxyAddress() string {
 	return w.proxyAddr
 }
 
+// RequestContext returns a context for requests made on behalf of the
+// websocket, such as logins, subscriptions and orders, which is cancelled when
+// the websocket shuts down so pending requests do not wait out their timeouts.
+// The cancel function must be called once the request completes
+func (w *Websocket) RequestContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	shutdown := w.ShutdownC
+	go func() {
+		select {
+		case <-shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// ReportDelay sends the delay between the exchange timestamp of a message
+// and its receipt to the data handler. Nothing is reported unless both are
+// known, so exchanges which do not timestamp their messages and messages not
+// read from a connection are not measured
+func (w *Websocket) ReportDelay(exchangeTime, received time.Time) {
+	if exchangeTime.IsZero() || received.IsZero() {
+		return
+	}
+	w.DataHandler <- Delay{ExchangeTime: exchangeTime, Received: received}
+}
+
 // GetName returns exchange name
 func (w *Websocket) GetName() string {
 	return w.exchangeName
@@ -806,6 +914,9 @@ channels:
 			w.exchangeName,
 			channels[x])
 	}
+	if w.IsConnectionPooled() {
+		return w.unsubscribeFromPool(channels)
+	}
 	return w.Unsubscriber(channels)
 }
 
@@ -835,6 +946,9 @@ func (w *Websocket) SubscribeToChannels(channels []ChannelSubscription) error {
 			}
 		}
 	}
+	if w.IsConnectionPooled() {
+		return w.subscribeToPool(channels)
+	}
 	return w.Subscriber(channels)
 }
 
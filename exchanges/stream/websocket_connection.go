@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"context"
 	"crypto/rand"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -15,34 +18,74 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 // SendMessageReturnResponse will send a WS message to the connection and wait
 // for response
 func (w *WebsocketConnection) SendMessageReturnResponse(signature, request interface{}) ([]byte, error) {
-	m, err := w.Match.set(signature)
+	resp, err := w.SendMessageReturnResponses(context.Background(), signature, request, RequestConfig{})
 	if err != nil {
 		return nil, err
 	}
-	defer m.Cleanup()
+	return resp[0], nil
+}
 
-	err = w.SendJSONMessage(request)
+// SendMessageReturnResponses sends a WS message to the connection and waits
+// for the responses matched to its signature, resending it on timeout when
+// retries are configured. Partial responses are discarded before a retry
+func (w *WebsocketConnection) SendMessageReturnResponses(ctx context.Context, signature, request interface{}, cfg RequestConfig) ([][]byte, error) {
+	if w.Match == nil {
+		return nil, errMatchNotSet
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = w.ResponseMaxLimit
+	}
+	m, err := w.Match.set(signature, &cfg)
 	if err != nil {
 		return nil, err
 	}
+	defer m.Cleanup()
 
-	timer := time.NewTimer(w.ResponseMaxLimit)
-
-	select {
-	case payload := <-m.C:
-		return payload, nil
-	case <-timer.C:
-		timer.Stop()
-		return nil, fmt.Errorf("%s websocket connection: timeout waiting for response with signature: %v",
+	websocketRequestsInFlight.Add(1, w.ExchangeName)
+	defer websocketRequestsInFlight.Add(-1, w.ExchangeName)
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if !m.reset() {
+				// completed as the timeout elapsed
+				break
+			}
+			websocketRequestRetries.Inc(w.ExchangeName)
+		}
+		err = w.SendJSONMessage(request)
+		if err != nil {
+			websocketRequests.Inc(w.ExchangeName, "error")
+			return nil, err
+		}
+		err = m.wait(ctx, timeout)
+		if err == nil {
+			break
+		}
+		if errors.Is(err, ErrRequestTimeout) && attempt < cfg.Retries {
+			continue
+		}
+		result := "cancelled"
+		if errors.Is(err, ErrRequestTimeout) {
+			result = "timeout"
+		}
+		websocketRequests.Inc(w.ExchangeName, result)
+		return nil, fmt.Errorf("%s websocket connection: signature: %v attempts: %d: %w",
 			w.ExchangeName,
-			signature)
+			signature,
+			attempt+1,
+			err)
 	}
+	websocketRequests.Inc(w.ExchangeName, "success")
+	websocketRequestDuration.Observe(time.Since(start).Seconds(), w.ExchangeName)
+	return m.responses, nil
 }
 
 // Dial sets proxy urls and then connects to the websocket
@@ -113,6 +156,13 @@ func (w *WebsocketConnection) SendJSONMessage(data interface{}) error {
 				w.ExchangeName)
 		}
 	}
+	if w.Recorder != nil {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		w.Recorder.Record(mock.WebsocketOutbound, payload)
+	}
 	return w.Connection.WriteJSON(data)
 }
 
@@ -143,6 +193,10 @@ func (w *WebsocketConnection) SendRawMessage(messageType int, message []byte) er
 		return fmt.Errorf("%v websocket connection: cannot send message to a disconnected websocket",
 			w.ExchangeName)
 	}
+	if w.Recorder != nil &&
+		(messageType == websocket.TextMessage || messageType == websocket.BinaryMessage) {
+		w.Recorder.Record(mock.WebsocketOutbound, message)
+	}
 	return w.Connection.WriteMessage(messageType, message)
 }
 
@@ -203,6 +257,7 @@ func (w *WebsocketConnection) IsConnected() bool {
 // ReadMessage reads messages, can handle text, gzip and binary
 func (w *WebsocketConnection) ReadMessage() Response {
 	mType, resp, err := w.Connection.ReadMessage()
+	received := time.Now()
 	if err != nil {
 		if isDisconnectionError(err) {
 			w.setConnectedStatus(false)
@@ -210,6 +265,7 @@ func (w *WebsocketConnection) ReadMessage() Response {
 		}
 		return Response{}
 	}
+	websocketMessages.Inc(w.ExchangeName)
 
 	select {
 	case w.Traffic <- struct{}{}:
@@ -236,7 +292,18 @@ func (w *WebsocketConnection) ReadMessage() Response {
 			w.ExchangeName,
 			string(standardMessage))
 	}
-	return Response{Raw: standardMessage, Type: mType}
+	if w.Recorder != nil && len(standardMessage) > 0 {
+		w.Recorder.Record(mock.WebsocketInbound, standardMessage)
+	}
+	if w.Capture != nil && len(standardMessage) > 0 {
+		if err := w.Capture.Write(received, standardMessage); err != nil {
+			log.Errorf(log.WebsocketMgr,
+				"%v websocket connection: capture error: %v",
+				w.ExchangeName,
+				err)
+		}
+	}
+	return Response{Raw: standardMessage, Type: mType, Received: received}
 }
 
 // parseBinaryResponse parses a websocket binary response into a usable byte array
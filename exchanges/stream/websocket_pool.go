@@ -0,0 +1,288 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errPoolDialerUnset       = errors.New("connection pool dialer is not set")
+	errPoolSubscriberUnset   = errors.New("connection pool subscriber is not set")
+	errPoolUnsubscriberUnset = errors.New("connection pool unsubscriber is not set")
+	errPoolFullPayload       = errors.New("connection pool cannot be used with full payload subscriptions")
+	errPoolConnectionUnset   = errors.New("connection pool primary connection is not set")
+)
+
+// shard is a connection in the pool along with the subscriptions it carries
+type shard struct {
+	conn          Connection
+	subscriptions []ChannelSubscription
+	// primary is the websocket's standard connection which is dialled and
+	// reconnected by the exchange's connector, every other shard is dialled
+	// and reconnected by the pool
+	primary bool
+	errs    chan error
+}
+
+// IsConnectionPooled returns whether subscriptions are spread across a pool
+// of connections
+func (w *Websocket) IsConnectionPooled() bool {
+	return w.maxSubscriptionsPerConnection > 0
+}
+
+// GetConnectionCount returns the number of connections in the pool carrying
+// subscriptions
+func (w *Websocket) GetConnectionCount() int {
+	w.subscriptionMutex.Lock()
+	defer w.subscriptionMutex.Unlock()
+	return len(w.shards)
+}
+
+// setupConnectionPool validates and sets the connection pool configuration
+func (w *Websocket) setupConnectionPool(s *WebsocketSetup) error {
+	if s.MaxSubscriptionsPerConnection <= 0 {
+		return nil
+	}
+	if w.features.FullPayloadSubscribe {
+		return errPoolFullPayload
+	}
+	if s.ConnectionDialer == nil {
+		return errPoolDialerUnset
+	}
+	if s.ConnectionSubscriber == nil {
+		return errPoolSubscriberUnset
+	}
+	if w.features.Unsubscribe && s.ConnectionUnsubscriber == nil {
+		return errPoolUnsubscriberUnset
+	}
+	w.maxSubscriptionsPerConnection = s.MaxSubscriptionsPerConnection
+	w.connectionDialer = s.ConnectionDialer
+	w.connectionSubscriber = s.ConnectionSubscriber
+	w.connectionUnsubscriber = s.ConnectionUnsubscriber
+	return nil
+}
+
+// subscribeToPool fills existing connections up to the per connection
+// maximum before dialling new ones. subscriptionMutex must be held
+func (w *Websocket) subscribeToPool(channels []ChannelSubscription) error {
+	if len(w.shards) == 0 {
+		if w.Conn == nil {
+			return errPoolConnectionUnset
+		}
+		w.shards = append(w.shards, &shard{conn: w.Conn, primary: true})
+	}
+	remaining := channels
+	for i := 0; len(remaining) > 0; i++ {
+		if i == len(w.shards) {
+			s, err := w.newShard()
+			if err != nil {
+				return err
+			}
+			w.shards = append(w.shards, s)
+		}
+		s := w.shards[i]
+		spare := w.maxSubscriptionsPerConnection - len(s.subscriptions)
+		if spare <= 0 {
+			continue
+		}
+		if spare > len(remaining) {
+			spare = len(remaining)
+		}
+		batch := remaining[:spare:spare]
+		err := w.connectionSubscriber(s.conn, batch)
+		if err != nil {
+			return fmt.Errorf("%s websocket: connection %d subscription error: %w",
+				w.exchangeName,
+				i,
+				err)
+		}
+		s.subscriptions = append(s.subscriptions, batch...)
+		w.AddSuccessfulSubscriptions(batch...)
+		remaining = remaining[spare:]
+	}
+	return nil
+}
+
+// unsubscribeFromPool removes channels from the connections carrying them,
+// connections left without subscriptions are closed. subscriptionMutex must
+// be held
+func (w *Websocket) unsubscribeFromPool(channels []ChannelSubscription) error {
+	for i := 0; i < len(w.shards); i++ {
+		s := w.shards[i]
+		var batch []ChannelSubscription
+		for x := range channels {
+			for y := range s.subscriptions {
+				if channels[x].Equal(&s.subscriptions[y]) {
+					batch = append(batch, channels[x])
+					break
+				}
+			}
+		}
+		if len(batch) == 0 {
+			continue
+		}
+		err := w.connectionUnsubscriber(s.conn, batch)
+		if err != nil {
+			return fmt.Errorf("%s websocket: connection %d unsubscription error: %w",
+				w.exchangeName,
+				i,
+				err)
+		}
+		s.subscriptions = removeSubscriptions(s.subscriptions, batch)
+		w.RemoveSuccessfulUnsubscriptions(batch...)
+		if len(s.subscriptions) == 0 && !s.primary {
+			err = s.conn.Shutdown()
+			if err != nil {
+				log.Errorf(log.WebsocketMgr,
+					"%s websocket: unable to close unused connection: %v\n",
+					w.exchangeName,
+					err)
+			}
+			w.shards = append(w.shards[:i], w.shards[i+1:]...)
+			i--
+		}
+	}
+	return nil
+}
+
+// resubscribePrimary resubscribes the primary connection's channels after
+// the exchange's connector has reconnected it
+func (w *Websocket) resubscribePrimary() error {
+	w.subscriptionMutex.Lock()
+	defer w.subscriptionMutex.Unlock()
+	for i := range w.shards {
+		if w.shards[i].primary && len(w.shards[i].subscriptions) != 0 {
+			return w.connectionSubscriber(w.shards[i].conn, w.shards[i].subscriptions)
+		}
+	}
+	return nil
+}
+
+// shutdownPool closes every connection dialled by the pool and clears the
+// pool. subscriptionMutex must be held
+func (w *Websocket) shutdownPool() error {
+	for i := range w.shards {
+		if w.shards[i].primary {
+			continue
+		}
+		err := w.shards[i].conn.Shutdown()
+		if err != nil {
+			return err
+		}
+	}
+	w.shards = nil
+	return nil
+}
+
+// newShard dials a new pool connection and starts monitoring it for
+// disconnections
+func (w *Websocket) newShard() (*shard, error) {
+	s := &shard{errs: make(chan error, 1)}
+	s.conn = w.newConnection(w.connectionSetup, s.errs)
+	err := w.connectionDialer(s.conn)
+	if err != nil {
+		return nil, fmt.Errorf("%s websocket: unable to dial pool connection: %w",
+			w.exchangeName,
+			err)
+	}
+	w.Wg.Add(1)
+	go w.monitorShard(s, w.ShutdownC)
+	return s, nil
+}
+
+// monitorShard reconnects and resubscribes a pool connection on its own
+// when it is disconnected, other connections are unaffected
+func (w *Websocket) monitorShard(s *shard, shutdown chan struct{}) {
+	defer w.Wg.Done()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case err := <-s.errs:
+			if !isDisconnectionError(err) {
+				select {
+				case w.DataHandler <- err:
+				case <-shutdown:
+					return
+				}
+				continue
+			}
+			log.Warnf(log.WebsocketMgr,
+				"%v websocket pool connection has been disconnected. Reason: %v\n",
+				w.exchangeName,
+				err)
+			if !w.reconnectShard(s, shutdown) {
+				return
+			}
+		}
+	}
+}
+
+// reconnectShard redials a pool connection and resubscribes its channels,
+// retrying until it succeeds or the websocket is shut down
+func (w *Websocket) reconnectShard(s *shard, shutdown chan struct{}) bool {
+	timer := time.NewTimer(connectionMonitorDelay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-shutdown:
+			return false
+		case <-timer.C:
+		}
+		timer.Reset(connectionMonitorDelay)
+
+		err := w.connectionDialer(s.conn)
+		if err != nil {
+			log.Errorf(log.WebsocketMgr,
+				"%v websocket: pool connection reconnect error: %v\n",
+				w.exchangeName,
+				err)
+			continue
+		}
+		w.subscriptionMutex.Lock()
+		subs := append(s.subscriptions[:0:0], s.subscriptions...)
+		w.subscriptionMutex.Unlock()
+		if len(subs) == 0 {
+			return true
+		}
+		err = w.connectionSubscriber(s.conn, subs)
+		if err == nil {
+			if w.verbose {
+				log.Debugf(log.WebsocketMgr,
+					"%v websocket: pool connection reconnected with %d subscriptions\n",
+					w.exchangeName,
+					len(subs))
+			}
+			return true
+		}
+		log.Errorf(log.WebsocketMgr,
+			"%v websocket: pool connection resubscription error: %v\n",
+			w.exchangeName,
+			err)
+		err = s.conn.Shutdown()
+		if err != nil {
+			log.Errorf(log.WebsocketMgr,
+				"%v websocket: pool connection shutdown error: %v\n",
+				w.exchangeName,
+				err)
+		}
+	}
+}
+
+// removeSubscriptions returns subs without the supplied channels
+func removeSubscriptions(subs, remove []ChannelSubscription) []ChannelSubscription {
+	out := subs[:0]
+subs:
+	for x := range subs {
+		for y := range remove {
+			if subs[x].Equal(&remove[y]) {
+				continue subs
+			}
+		}
+		out = append(out, subs[x])
+	}
+	return out
+}
@@ -0,0 +1,218 @@
+package stream
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+// poolTester records the subscriptions sent over each pooled connection
+type poolTester struct {
+	m      sync.Mutex
+	dials  map[Connection]int
+	subs   map[Connection][]ChannelSubscription
+	unsubs map[Connection][]ChannelSubscription
+}
+
+func newPoolTester() *poolTester {
+	return &poolTester{
+		dials:  make(map[Connection]int),
+		subs:   make(map[Connection][]ChannelSubscription),
+		unsubs: make(map[Connection][]ChannelSubscription),
+	}
+}
+
+func (p *poolTester) dial(c Connection) error {
+	p.m.Lock()
+	p.dials[c]++
+	p.m.Unlock()
+	return nil
+}
+
+func (p *poolTester) subscribe(c Connection, subs []ChannelSubscription) error {
+	p.m.Lock()
+	p.subs[c] = append(p.subs[c], subs...)
+	p.m.Unlock()
+	return nil
+}
+
+func (p *poolTester) unsubscribe(c Connection, subs []ChannelSubscription) error {
+	p.m.Lock()
+	p.unsubs[c] = append(p.unsubs[c], subs...)
+	p.m.Unlock()
+	return nil
+}
+
+func newPoolSetup(p *poolTester) *WebsocketSetup {
+	s := *defaultSetup
+	s.Features = &protocol.Features{Subscribe: true, Unsubscribe: true}
+	s.MaxSubscriptionsPerConnection = 2
+	s.ConnectionDialer = p.dial
+	s.ConnectionSubscriber = p.subscribe
+	s.ConnectionUnsubscriber = p.unsubscribe
+	return &s
+}
+
+func TestSetupConnectionPool(t *testing.T) {
+	p := newPoolTester()
+	s := newPoolSetup(p)
+	s.ConnectionDialer = nil
+	err := New().Setup(s)
+	if !errors.Is(err, errPoolDialerUnset) {
+		t.Errorf("expected %v, received %v", errPoolDialerUnset, err)
+	}
+	s = newPoolSetup(p)
+	s.ConnectionSubscriber = nil
+	err = New().Setup(s)
+	if !errors.Is(err, errPoolSubscriberUnset) {
+		t.Errorf("expected %v, received %v", errPoolSubscriberUnset, err)
+	}
+	s = newPoolSetup(p)
+	s.ConnectionUnsubscriber = nil
+	err = New().Setup(s)
+	if !errors.Is(err, errPoolUnsubscriberUnset) {
+		t.Errorf("expected %v, received %v", errPoolUnsubscriberUnset, err)
+	}
+	s = newPoolSetup(p)
+	s.Features = &protocol.Features{FullPayloadSubscribe: true}
+	err = New().Setup(s)
+	if !errors.Is(err, errPoolFullPayload) {
+		t.Errorf("expected %v, received %v", errPoolFullPayload, err)
+	}
+
+	w := New()
+	err = w.Setup(newPoolSetup(p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !w.IsConnectionPooled() {
+		t.Error("expected connection pool to be enabled")
+	}
+	err = w.SubscribeToChannels([]ChannelSubscription{{Channel: "one"}})
+	if !errors.Is(err, errPoolConnectionUnset) {
+		t.Errorf("expected %v, received %v", errPoolConnectionUnset, err)
+	}
+}
+
+func TestConnectionPoolSubscriptions(t *testing.T) {
+	p := newPoolTester()
+	w := New()
+	err := w.Setup(newPoolSetup(p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.SetupNewConnection(ConnectionSetup{ResponseMaxLimit: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subs := []ChannelSubscription{
+		{Channel: "one"},
+		{Channel: "two"},
+		{Channel: "three"},
+		{Channel: "four"},
+		{Channel: "five"},
+	}
+	err = w.SubscribeToChannels(subs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := w.GetConnectionCount(); c != 3 {
+		t.Fatalf("expected 3 connections, received %v", c)
+	}
+	if len(w.GetSubscriptions()) != 5 {
+		t.Errorf("expected 5 subscriptions, received %v", len(w.GetSubscriptions()))
+	}
+	if len(p.subs[w.Conn]) != 2 || p.dials[w.Conn] != 0 {
+		t.Errorf("expected the standard connection to carry 2 subscriptions without being dialled by the pool")
+	}
+	third := w.shards[2].conn
+	if len(p.subs[third]) != 1 || p.dials[third] != 1 {
+		t.Errorf("expected the third connection to be dialled once and carry 1 subscription")
+	}
+
+	// spare capacity is used before dialling again
+	err = w.SubscribeToChannels([]ChannelSubscription{{Channel: "six"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := w.GetConnectionCount(); c != 3 {
+		t.Fatalf("expected 3 connections, received %v", c)
+	}
+	if len(p.subs[third]) != 2 {
+		t.Errorf("expected the third connection to carry 2 subscriptions, received %v", len(p.subs[third]))
+	}
+
+	// emptied pooled connections are closed
+	err = w.UnsubscribeChannels([]ChannelSubscription{{Channel: "five"}, {Channel: "six"}, {Channel: "one"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := w.GetConnectionCount(); c != 2 {
+		t.Fatalf("expected 2 connections, received %v", c)
+	}
+	if len(p.unsubs[w.Conn]) != 1 || len(p.unsubs[third]) != 2 {
+		t.Error("expected unsubscriptions to be sent over the connections carrying them")
+	}
+	if len(w.GetSubscriptions()) != 3 {
+		t.Errorf("expected 3 subscriptions, received %v", len(w.GetSubscriptions()))
+	}
+
+	w.subscriptionMutex.Lock()
+	err = w.shutdownPool()
+	w.subscriptionMutex.Unlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := w.GetConnectionCount(); c != 0 {
+		t.Errorf("expected no connections, received %v", c)
+	}
+}
+
+func TestConnectionPoolReconnect(t *testing.T) {
+	p := newPoolTester()
+	w := New()
+	err := w.Setup(newPoolSetup(p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.SetupNewConnection(ConnectionSetup{ResponseMaxLimit: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.SubscribeToChannels([]ChannelSubscription{
+		{Channel: "one"},
+		{Channel: "two"},
+		{Channel: "three"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pooled := w.shards[1]
+
+	pooled.errs <- &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}
+	deadline := time.Now().Add(connectionMonitorDelay * 3)
+	for {
+		p.m.Lock()
+		dials, subs := p.dials[pooled.conn], len(p.subs[pooled.conn])
+		p.m.Unlock()
+		if dials == 2 && subs == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("pooled connection did not reconnect, dials: %v subscriptions: %v", dials, subs)
+		}
+		time.Sleep(time.Millisecond * 50)
+	}
+	// the standard connection is left untouched
+	if len(p.subs[w.Conn]) != 2 {
+		t.Errorf("expected the standard connection to keep 2 subscriptions, received %v", len(p.subs[w.Conn]))
+	}
+
+	close(w.ShutdownC)
+	w.Wg.Wait()
+}
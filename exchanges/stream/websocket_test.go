@@ -17,6 +17,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 )
 
@@ -1175,6 +1176,47 @@ func TestEnable(t *testing.T) {
 	fmt.Print()
 }
 
+func TestReportDelay(t *testing.T) {
+	t.Parallel()
+	w := Websocket{DataHandler: make(chan interface{}, 1)}
+	now := time.Now()
+	w.ReportDelay(time.Time{}, now)
+	w.ReportDelay(now, time.Time{})
+	w.ReportDelay(now.Add(-time.Second), now)
+	if len(w.DataHandler) != 1 {
+		t.Fatalf("expected a single delay, received %d", len(w.DataHandler))
+	}
+	d, ok := (<-w.DataHandler).(Delay)
+	if !ok || d.Received.Sub(d.ExchangeTime) != time.Second {
+		t.Errorf("expected a delay of 1s, received %+v", d)
+	}
+}
+
+func TestRequestContext(t *testing.T) {
+	t.Parallel()
+	w := Websocket{ShutdownC: make(chan struct{})}
+	ctx, cancel := w.RequestContext()
+	defer cancel()
+	select {
+	case <-ctx.Done():
+		t.Fatal("context should not be cancelled before shutdown")
+	default:
+	}
+	close(w.ShutdownC)
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context should be cancelled on shutdown")
+	}
+
+	w.ShutdownC = make(chan struct{})
+	ctx, cancel = w.RequestContext()
+	cancel()
+	if ctx.Err() == nil {
+		t.Error("context should be cancelled by its cancel function")
+	}
+}
+
 func TestSetupNewConnection(t *testing.T) {
 	var nonsenseWebsock *Websocket
 	err := nonsenseWebsock.SetupNewConnection(ConnectionSetup{URL: "urlstring"})
@@ -1253,3 +1295,77 @@ func TestWebsocketConnectionShutdown(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestWebsocketRecordReplay(t *testing.T) {
+	session := &mock.WebsocketRecording{
+		Exchange: "test",
+		Frames: []mock.WebsocketFrame{
+			{Direction: mock.WebsocketInbound, Data: json.RawMessage(`{"event":"systemStatus","status":"online"}`)},
+			{Direction: mock.WebsocketOutbound, Data: json.RawMessage(`{"event":"subscribe","reqid":1,"pair":["XBT/USD"]}`)},
+			{Direction: mock.WebsocketInbound, Data: json.RawMessage(`{"event":"subscriptionStatus","reqid":1,"status":"subscribed"}`)},
+			{Direction: mock.WebsocketInbound, Text: "heartbeat"},
+		},
+	}
+	server := mock.NewWebsocketServerFromRecording(session)
+	defer server.Close()
+
+	web := Websocket{
+		Wg:                new(sync.WaitGroup),
+		ShutdownC:         make(chan struct{}),
+		TrafficAlert:      make(chan struct{}),
+		ReadMessageErrors: make(chan error, 1),
+		exchangeName:      "test",
+	}
+	err := web.SetupNewConnection(ConnectionSetup{URL: server.URL, ResponseMaxLimit: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := mock.NewWebsocketRecorder("test")
+	web.SetRecorder(recorder)
+
+	err = web.Conn.Dial(&websocket.Dialer{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer web.Conn.Shutdown()
+
+	resp := web.Conn.ReadMessage()
+	if string(resp.Raw) != `{"event":"systemStatus","status":"online"}` {
+		t.Fatalf("unexpected message %s", resp.Raw)
+	}
+	// messages which do not match the recording are discarded by the server
+	err = web.Conn.SendRawMessage(websocket.TextMessage, []byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = web.Conn.SendJSONMessage(map[string]interface{}{
+		"event": "subscribe",
+		"reqid": 1337,
+		"pair":  []string{"XBT/USD"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp = web.Conn.ReadMessage()
+	if !strings.Contains(string(resp.Raw), "subscriptionStatus") {
+		t.Fatalf("unexpected message %s", resp.Raw)
+	}
+	resp = web.Conn.ReadMessage()
+	if string(resp.Raw) != "heartbeat" {
+		t.Fatalf("unexpected message %s", resp.Raw)
+	}
+
+	rec := recorder.Recording()
+	if len(rec.Frames) != 5 {
+		t.Fatalf("expected 5 recorded frames, received %v", len(rec.Frames))
+	}
+	if rec.Frames[1].Direction != mock.WebsocketOutbound || rec.Frames[1].Text != "ping" {
+		t.Errorf("unexpected frame %+v", rec.Frames[1])
+	}
+	if !mock.MatchWebsocketPayload(session.Frames[1].Payload(), rec.Frames[2].Payload()) {
+		t.Errorf("recorded request %s does not match %s", rec.Frames[2].Payload(), session.Frames[1].Payload())
+	}
+	if errs := server.Errors(); len(errs) != 0 {
+		t.Errorf("unexpected replay errors %v", errs)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
 )
@@ -78,6 +79,22 @@ type Websocket struct {
 	ReadMessageErrors chan error
 	features          *protocol.Features
 
+	// recorder captures the frames of connections set up after it is set
+	recorder *mock.WebsocketRecorder
+	// capture writes inbound frames to file for incident analysis
+	capture *Capture
+
+	// connection pool settings, subscriptions are spread across pooled
+	// connections when maxSubscriptionsPerConnection is set
+	maxSubscriptionsPerConnection int
+	connectionDialer              func(Connection) error
+	connectionSubscriber          func(Connection, []ChannelSubscription) error
+	connectionUnsubscriber        func(Connection, []ChannelSubscription) error
+	connectionSetup               ConnectionSetup
+	// shards holds the pooled connections and the subscriptions carried by
+	// each, guarded by subscriptionMutex
+	shards []*shard
+
 	// Standard stream connection
 	Conn Connection
 	// Authenticated stream connection
@@ -105,6 +122,27 @@ type WebsocketSetup struct {
 	SortBuffer            bool
 	SortBufferByUpdateIDs bool
 	UpdateEntriesByID     bool
+	// OrderbookVerifier checks the integrity of buffered orderbooks as updates
+	// are applied, books failing verification are resynced with
+	// OrderbookResync, typically the exchange's REST UpdateOrderbook
+	OrderbookVerifier buffer.Verifier
+	OrderbookResync   buffer.ResyncFunc
+
+	// MaxSubscriptionsPerConnection enables a pool of connections for
+	// exchanges which cap subscriptions per connection. Subscriptions fill
+	// the standard connection first and further connections are dialled as
+	// needed, each reconnecting and resubscribing on its own
+	MaxSubscriptionsPerConnection int
+	// ConnectionDialer dials a pooled connection and starts its read routine.
+	// It is called while subscriptions are locked so must not call back into
+	// the websocket's subscription methods
+	ConnectionDialer func(Connection) error
+	// ConnectionSubscriber and ConnectionUnsubscriber send subscription
+	// changes over a specific pooled connection. The pool tracks successful
+	// subscriptions so they must not call AddSuccessfulSubscriptions or
+	// RemoveSuccessfulUnsubscriptions
+	ConnectionSubscriber   func(Connection, []ChannelSubscription) error
+	ConnectionUnsubscriber func(Connection, []ChannelSubscription) error
 }
 
 // WebsocketConnection contains all the data needed to send a message to a WS
@@ -129,4 +167,10 @@ type WebsocketConnection struct {
 	ResponseMaxLimit  time.Duration
 	Traffic           chan struct{}
 	readMessageErrors chan error
+
+	// Recorder captures inbound and outbound frames for mock testing when
+	// set
+	Recorder *mock.WebsocketRecorder
+	// Capture writes inbound frames and their receipt times to file when set
+	Capture *Capture
 }
@@ -55,6 +55,18 @@ type Price struct {
 	AskPeriod             float64
 	AskSize               float64
 	FlashReturnRateAmount float64
+
+	// Option field variables, implied volatilities are percentages
+	MarkPrice       float64
+	MarkIV          float64
+	BidIV           float64
+	AskIV           float64
+	UnderlyingPrice float64
+	Delta           float64
+	Gamma           float64
+	Vega            float64
+	Theta           float64
+	Rho             float64
 }
 
 // Ticker struct holds the ticker information for a currency pair and type
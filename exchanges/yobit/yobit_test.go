@@ -65,6 +65,21 @@ func TestGetInfo(t *testing.T) {
 	}
 }
 
+func TestUpdateExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := y.updateExecutionLimits()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := y.GetOrderExecutionLimits(asset.Spot, currency.NewPair(currency.LTC, currency.BTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.PriceTick == 0 || l.MinAmount == 0 {
+		t.Errorf("expected price tick and minimum amount to be loaded, received %+v", l)
+	}
+}
+
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
 	_, err := y.GetTicker("btc_usd")
@@ -171,7 +171,37 @@ func (y *Yobit) UpdateTradablePairs(forceUpdate bool) error {
 	if err != nil {
 		return err
 	}
-	return y.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	err = y.UpdatePairs(p, asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	return y.updateExecutionLimits()
+}
+
+// updateExecutionLimits loads the pair execution limits from the pair info,
+// the decimal places apply to the price
+func (y *Yobit) updateExecutionLimits() error {
+	info, err := y.GetInfo()
+	if err != nil {
+		return err
+	}
+	limits := make([]order.Limits, 0, len(info.Pairs))
+	for symbol, pair := range info.Pairs {
+		var p currency.Pair
+		p, err = currency.NewPairFromString(strings.ToUpper(symbol))
+		if err != nil {
+			return err
+		}
+		limits = append(limits, order.Limits{
+			Pair:      p,
+			Asset:     asset.Spot,
+			MinPrice:  pair.MinPrice,
+			MaxPrice:  pair.MaxPrice,
+			PriceTick: math.Pow10(-pair.DecimalPlaces),
+			MinAmount: pair.MinAmount,
+		})
+	}
+	return y.LoadExecutionLimits(limits)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -96,6 +96,39 @@ type GoCryptoTraderClient interface {
 	FindMissingSavedCandleIntervals(ctx context.Context, in *FindMissingCandlePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(ctx context.Context, in *FindMissingTradePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
 	SetExchangeTradeProcessing(ctx context.Context, in *SetExchangeTradeProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ExportSavedData(ctx context.Context, in *ExportSavedDataRequest, opts ...grpc.CallOption) (GoCryptoTrader_ExportSavedDataClient, error)
+	GetBalanceSnapshots(ctx context.Context, in *GetBalanceSnapshotsRequest, opts ...grpc.CallOption) (*GetBalanceSnapshotsResponse, error)
+	ImportFills(ctx context.Context, in *ImportFillsRequest, opts ...grpc.CallOption) (*ImportFillsResponse, error)
+	GetFills(ctx context.Context, in *GetFillsRequest, opts ...grpc.CallOption) (*GetFillsResponse, error)
+	GetPNL(ctx context.Context, in *GetPNLRequest, opts ...grpc.CallOption) (*GetPNLResponse, error)
+	GetRateLimitBudget(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetRateLimitBudgetResponse, error)
+	GetLatencyStats(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetLatencyStatsResponse, error)
+	GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*GetPositionsResponse, error)
+	ClosePosition(ctx context.Context, in *ClosePositionRequest, opts ...grpc.CallOption) (*ClosePositionResponse, error)
+	GetLatestFundingRate(ctx context.Context, in *GetLatestFundingRateRequest, opts ...grpc.CallOption) (*GetLatestFundingRateResponse, error)
+	GetFundingRateHistory(ctx context.Context, in *GetFundingRateHistoryRequest, opts ...grpc.CallOption) (*GetFundingRateHistoryResponse, error)
+	GetLeverage(ctx context.Context, in *GetLeverageRequest, opts ...grpc.CallOption) (*GetLeverageResponse, error)
+	SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	SetMarginType(ctx context.Context, in *SetMarginTypeRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetLoans(ctx context.Context, in *GetLoansRequest, opts ...grpc.CallOption) (*GetLoansResponse, error)
+	Borrow(ctx context.Context, in *BorrowRequest, opts ...grpc.CallOption) (*LendingTransactionResponse, error)
+	Repay(ctx context.Context, in *RepayRequest, opts ...grpc.CallOption) (*LendingTransactionResponse, error)
+	OfferFunds(ctx context.Context, in *OfferFundsRequest, opts ...grpc.CallOption) (*LendingTransactionResponse, error)
+	CancelFundingOffer(ctx context.Context, in *CancelFundingOfferRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetDerivativePrice(ctx context.Context, in *GetDerivativePriceRequest, opts ...grpc.CallOption) (*DerivativePriceResponse, error)
+	GetDerivativePriceStream(ctx context.Context, in *GetDerivativePriceStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetDerivativePriceStreamClient, error)
+	GetExchangeDerivativePriceStream(ctx context.Context, in *GetExchangeDerivativePriceStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeDerivativePriceStreamClient, error)
+	GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*OptionChainResponse, error)
+	GetContracts(ctx context.Context, in *GetContractsRequest, opts ...grpc.CallOption) (*GetContractsResponse, error)
+	GetSubAccounts(ctx context.Context, in *GetSubAccountsRequest, opts ...grpc.CallOption) (*GetSubAccountsResponse, error)
+	TransferFunds(ctx context.Context, in *TransferFundsRequest, opts ...grpc.CallOption) (*TransferFundsResponse, error)
+	TransferEventByID(ctx context.Context, in *TransferEventByIDRequest, opts ...grpc.CallOption) (*TransferEventsResponse, error)
+	TransferEventsByExchange(ctx context.Context, in *TransferEventsByExchangeRequest, opts ...grpc.CallOption) (*TransferEventsResponse, error)
+	TransferEventsByDate(ctx context.Context, in *TransferEventsByDateRequest, opts ...grpc.CallOption) (*TransferEventsResponse, error)
+	GetLiquidations(ctx context.Context, in *GetLiquidationsRequest, opts ...grpc.CallOption) (*LiquidationsResponse, error)
+	GetSavedLiquidations(ctx context.Context, in *GetLiquidationsRequest, opts ...grpc.CallOption) (*LiquidationsResponse, error)
+	GetLiquidationStream(ctx context.Context, in *GetLiquidationStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetLiquidationStreamClient, error)
+	SetExchangeLiquidationProcessing(ctx context.Context, in *SetExchangeLiquidationProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
 type goCryptoTraderClient struct {
@@ -955,247 +988,669 @@ func (c *goCryptoTraderClient) SetExchangeTradeProcessing(ctx context.Context, i
 	return out, nil
 }
 
-// GoCryptoTraderServer is the server API for GoCryptoTrader service.
-// All implementations must embed UnimplementedGoCryptoTraderServer
-// for forward compatibility
-type GoCryptoTraderServer interface {
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
-	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
-	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
-	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
-	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error)
-	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
-	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
-	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
-	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
-	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
-	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
-	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
-	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
-	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
-	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
-	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
-	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
-	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
-	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
-	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
-	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
-	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
-	CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error)
-	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
-	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
-	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
-	RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error)
-	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
-	WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error)
-	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error)
-	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
-	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
-	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
-	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
-	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
-	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
-	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
-	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
-	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
-	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
-	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
-	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
-	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error)
-	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error)
-	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error)
-	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
-	SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error)
-	SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error)
-	UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error)
-	GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error)
-	WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error)
-	WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error)
-	WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error)
-	WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error)
-	WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error)
-	GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
-	GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTrader_GetHistoricTradesServer) error
-	GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
-	ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error)
-	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
-	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
-	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
-	mustEmbedUnimplementedGoCryptoTraderServer()
+func (c *goCryptoTraderClient) ExportSavedData(ctx context.Context, in *ExportSavedDataRequest, opts ...grpc.CallOption) (GoCryptoTrader_ExportSavedDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[6], "/gctrpc.GoCryptoTrader/ExportSavedData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderExportSavedDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// UnimplementedGoCryptoTraderServer must be embedded to have forward compatible implementations.
-type UnimplementedGoCryptoTraderServer struct {
+type GoCryptoTrader_ExportSavedDataClient interface {
+	Recv() (*ExportSavedDataResponse, error)
+	grpc.ClientStream
 }
 
-func (UnimplementedGoCryptoTraderServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+type goCryptoTraderExportSavedDataClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+
+func (x *goCryptoTraderExportSavedDataClient) Recv() (*ExportSavedDataResponse, error) {
+	m := new(ExportSavedDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServer) EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+
+func (c *goCryptoTraderClient) GetBalanceSnapshots(ctx context.Context, in *GetBalanceSnapshotsRequest, opts ...grpc.CallOption) (*GetBalanceSnapshotsResponse, error) {
+	out := new(GetBalanceSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetBalanceSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+
+func (c *goCryptoTraderClient) ImportFills(ctx context.Context, in *ImportFillsRequest, opts ...grpc.CallOption) (*ImportFillsResponse, error) {
+	out := new(ImportFillsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ImportFills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+
+func (c *goCryptoTraderClient) GetFills(ctx context.Context, in *GetFillsRequest, opts ...grpc.CallOption) (*GetFillsResponse, error) {
+	out := new(GetFillsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+
+func (c *goCryptoTraderClient) GetPNL(ctx context.Context, in *GetPNLRequest, opts ...grpc.CallOption) (*GetPNLResponse, error) {
+	out := new(GetPNLResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPNL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+
+func (c *goCryptoTraderClient) GetRateLimitBudget(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetRateLimitBudgetResponse, error) {
+	out := new(GetRateLimitBudgetResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRateLimitBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+
+func (c *goCryptoTraderClient) GetLatencyStats(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetLatencyStatsResponse, error) {
+	out := new(GetLatencyStatsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLatencyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+
+func (c *goCryptoTraderClient) GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*GetPositionsResponse, error) {
+	out := new(GetPositionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+
+func (c *goCryptoTraderClient) ClosePosition(ctx context.Context, in *ClosePositionRequest, opts ...grpc.CallOption) (*ClosePositionResponse, error) {
+	out := new(ClosePositionResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ClosePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+
+func (c *goCryptoTraderClient) GetLatestFundingRate(ctx context.Context, in *GetLatestFundingRateRequest, opts ...grpc.CallOption) (*GetLatestFundingRateResponse, error) {
+	out := new(GetLatestFundingRateResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLatestFundingRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+
+func (c *goCryptoTraderClient) GetFundingRateHistory(ctx context.Context, in *GetFundingRateHistoryRequest, opts ...grpc.CallOption) (*GetFundingRateHistoryResponse, error) {
+	out := new(GetFundingRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFundingRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+
+func (c *goCryptoTraderClient) GetLeverage(ctx context.Context, in *GetLeverageRequest, opts ...grpc.CallOption) (*GetLeverageResponse, error) {
+	out := new(GetLeverageResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+
+func (c *goCryptoTraderClient) SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+
+func (c *goCryptoTraderClient) SetMarginType(ctx context.Context, in *SetMarginTypeRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetMarginType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+
+func (c *goCryptoTraderClient) GetLoans(ctx context.Context, in *GetLoansRequest, opts ...grpc.CallOption) (*GetLoansResponse, error) {
+	out := new(GetLoansResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLoans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+
+func (c *goCryptoTraderClient) Borrow(ctx context.Context, in *BorrowRequest, opts ...grpc.CallOption) (*LendingTransactionResponse, error) {
+	out := new(LendingTransactionResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/Borrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInfo not implemented")
+
+func (c *goCryptoTraderClient) Repay(ctx context.Context, in *RepayRequest, opts ...grpc.CallOption) (*LendingTransactionResponse, error) {
+	out := new(LendingTransactionResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/Repay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+
+func (c *goCryptoTraderClient) OfferFunds(ctx context.Context, in *OfferFundsRequest, opts ...grpc.CallOption) (*LendingTransactionResponse, error) {
+	out := new(LendingTransactionResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/OfferFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+
+func (c *goCryptoTraderClient) CancelFundingOffer(ctx context.Context, in *CancelFundingOfferRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelFundingOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+
+func (c *goCryptoTraderClient) GetDerivativePrice(ctx context.Context, in *GetDerivativePriceRequest, opts ...grpc.CallOption) (*DerivativePriceResponse, error) {
+	out := new(DerivativePriceResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDerivativePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+
+func (c *goCryptoTraderClient) GetDerivativePriceStream(ctx context.Context, in *GetDerivativePriceStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetDerivativePriceStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[7], "/gctrpc.GoCryptoTrader/GetDerivativePriceStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetDerivativePriceStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+
+type GoCryptoTrader_GetDerivativePriceStreamClient interface {
+	Recv() (*DerivativePriceResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+
+type goCryptoTraderGetDerivativePriceStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+
+func (x *goCryptoTraderGetDerivativePriceStreamClient) Recv() (*DerivativePriceResponse, error) {
+	m := new(DerivativePriceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+
+func (c *goCryptoTraderClient) GetExchangeDerivativePriceStream(ctx context.Context, in *GetExchangeDerivativePriceStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeDerivativePriceStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[8], "/gctrpc.GoCryptoTrader/GetExchangeDerivativePriceStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeDerivativePriceStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+
+type GoCryptoTrader_GetExchangeDerivativePriceStreamClient interface {
+	Recv() (*DerivativePriceResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+
+type goCryptoTraderGetExchangeDerivativePriceStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+
+func (x *goCryptoTraderGetExchangeDerivativePriceStreamClient) Recv() (*DerivativePriceResponse, error) {
+	m := new(DerivativePriceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+
+func (c *goCryptoTraderClient) GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*OptionChainResponse, error) {
+	out := new(OptionChainResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOptionChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+
+func (c *goCryptoTraderClient) GetContracts(ctx context.Context, in *GetContractsRequest, opts ...grpc.CallOption) (*GetContractsResponse, error) {
+	out := new(GetContractsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+
+func (c *goCryptoTraderClient) GetSubAccounts(ctx context.Context, in *GetSubAccountsRequest, opts ...grpc.CallOption) (*GetSubAccountsResponse, error) {
+	out := new(GetSubAccountsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSubAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelBatchOrders not implemented")
+
+func (c *goCryptoTraderClient) TransferFunds(ctx context.Context, in *TransferFundsRequest, opts ...grpc.CallOption) (*TransferFundsResponse, error) {
+	out := new(TransferFundsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/TransferFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+
+func (c *goCryptoTraderClient) TransferEventByID(ctx context.Context, in *TransferEventByIDRequest, opts ...grpc.CallOption) (*TransferEventsResponse, error) {
+	out := new(TransferEventsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/TransferEventByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+
+func (c *goCryptoTraderClient) TransferEventsByExchange(ctx context.Context, in *TransferEventsByExchangeRequest, opts ...grpc.CallOption) (*TransferEventsResponse, error) {
+	out := new(TransferEventsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/TransferEventsByExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+
+func (c *goCryptoTraderClient) TransferEventsByDate(ctx context.Context, in *TransferEventsByDateRequest, opts ...grpc.CallOption) (*TransferEventsResponse, error) {
+	out := new(TransferEventsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/TransferEventsByDate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+
+func (c *goCryptoTraderClient) GetLiquidations(ctx context.Context, in *GetLiquidationsRequest, opts ...grpc.CallOption) (*LiquidationsResponse, error) {
+	out := new(LiquidationsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLiquidations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+
+func (c *goCryptoTraderClient) GetSavedLiquidations(ctx context.Context, in *GetLiquidationsRequest, opts ...grpc.CallOption) (*LiquidationsResponse, error) {
+	out := new(LiquidationsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSavedLiquidations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+
+func (c *goCryptoTraderClient) GetLiquidationStream(ctx context.Context, in *GetLiquidationStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetLiquidationStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[9], "/gctrpc.GoCryptoTrader/GetLiquidationStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetLiquidationStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+
+type GoCryptoTrader_GetLiquidationStreamClient interface {
+	Recv() (*LiquidationsResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+
+type goCryptoTraderGetLiquidationStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventByID not implemented")
+
+func (x *goCryptoTraderGetLiquidationStreamClient) Recv() (*LiquidationsResponse, error) {
+	m := new(LiquidationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByExchange not implemented")
+
+func (c *goCryptoTraderClient) SetExchangeLiquidationProcessing(ctx context.Context, in *SetExchangeLiquidationProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetExchangeLiquidationProcessing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
+
+// GoCryptoTraderServer is the server API for GoCryptoTrader service.
+// All implementations must embed UnimplementedGoCryptoTraderServer
+// for forward compatibility
+type GoCryptoTraderServer interface {
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
+	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
+	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
+	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error)
+	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
+	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
+	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
+	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
+	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
+	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
+	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
+	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
+	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
+	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
+	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
+	CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error)
+	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
+	RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error)
+	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
+	WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error)
+	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error)
+	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
+	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
+	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
+	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
+	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
+	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
+	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
+	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
+	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
+	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
+	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
+	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
+	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error)
+	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error)
+	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error)
+	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+	SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error)
+	SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error)
+	UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error)
+	GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error)
+	WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error)
+	WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error)
+	WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error)
+	WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error)
+	WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error)
+	GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
+	GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTrader_GetHistoricTradesServer) error
+	GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
+	ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error)
+	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
+	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
+	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
+	ExportSavedData(*ExportSavedDataRequest, GoCryptoTrader_ExportSavedDataServer) error
+	GetBalanceSnapshots(context.Context, *GetBalanceSnapshotsRequest) (*GetBalanceSnapshotsResponse, error)
+	ImportFills(context.Context, *ImportFillsRequest) (*ImportFillsResponse, error)
+	GetFills(context.Context, *GetFillsRequest) (*GetFillsResponse, error)
+	GetPNL(context.Context, *GetPNLRequest) (*GetPNLResponse, error)
+	GetRateLimitBudget(context.Context, *GenericExchangeNameRequest) (*GetRateLimitBudgetResponse, error)
+	GetLatencyStats(context.Context, *GenericExchangeNameRequest) (*GetLatencyStatsResponse, error)
+	GetPositions(context.Context, *GetPositionsRequest) (*GetPositionsResponse, error)
+	ClosePosition(context.Context, *ClosePositionRequest) (*ClosePositionResponse, error)
+	GetLatestFundingRate(context.Context, *GetLatestFundingRateRequest) (*GetLatestFundingRateResponse, error)
+	GetFundingRateHistory(context.Context, *GetFundingRateHistoryRequest) (*GetFundingRateHistoryResponse, error)
+	GetLeverage(context.Context, *GetLeverageRequest) (*GetLeverageResponse, error)
+	SetLeverage(context.Context, *SetLeverageRequest) (*GenericResponse, error)
+	SetMarginType(context.Context, *SetMarginTypeRequest) (*GenericResponse, error)
+	GetLoans(context.Context, *GetLoansRequest) (*GetLoansResponse, error)
+	Borrow(context.Context, *BorrowRequest) (*LendingTransactionResponse, error)
+	Repay(context.Context, *RepayRequest) (*LendingTransactionResponse, error)
+	OfferFunds(context.Context, *OfferFundsRequest) (*LendingTransactionResponse, error)
+	CancelFundingOffer(context.Context, *CancelFundingOfferRequest) (*GenericResponse, error)
+	GetDerivativePrice(context.Context, *GetDerivativePriceRequest) (*DerivativePriceResponse, error)
+	GetDerivativePriceStream(*GetDerivativePriceStreamRequest, GoCryptoTrader_GetDerivativePriceStreamServer) error
+	GetExchangeDerivativePriceStream(*GetExchangeDerivativePriceStreamRequest, GoCryptoTrader_GetExchangeDerivativePriceStreamServer) error
+	GetOptionChain(context.Context, *GetOptionChainRequest) (*OptionChainResponse, error)
+	GetContracts(context.Context, *GetContractsRequest) (*GetContractsResponse, error)
+	GetSubAccounts(context.Context, *GetSubAccountsRequest) (*GetSubAccountsResponse, error)
+	TransferFunds(context.Context, *TransferFundsRequest) (*TransferFundsResponse, error)
+	TransferEventByID(context.Context, *TransferEventByIDRequest) (*TransferEventsResponse, error)
+	TransferEventsByExchange(context.Context, *TransferEventsByExchangeRequest) (*TransferEventsResponse, error)
+	TransferEventsByDate(context.Context, *TransferEventsByDateRequest) (*TransferEventsResponse, error)
+	GetLiquidations(context.Context, *GetLiquidationsRequest) (*LiquidationsResponse, error)
+	GetSavedLiquidations(context.Context, *GetLiquidationsRequest) (*LiquidationsResponse, error)
+	GetLiquidationStream(*GetLiquidationStreamRequest, GoCryptoTrader_GetLiquidationStreamServer) error
+	SetExchangeLiquidationProcessing(context.Context, *SetExchangeLiquidationProcessingRequest) (*GenericResponse, error)
+	mustEmbedUnimplementedGoCryptoTraderServer()
 }
-func (UnimplementedGoCryptoTraderServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+
+// UnimplementedGoCryptoTraderServer must be embedded to have forward compatible implementations.
+type UnimplementedGoCryptoTraderServer struct {
 }
-func (UnimplementedGoCryptoTraderServer) SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+
+func (UnimplementedGoCryptoTraderServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+func (UnimplementedGoCryptoTraderServer) GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
 }
-func (UnimplementedGoCryptoTraderServer) SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangePair not implemented")
+func (UnimplementedGoCryptoTraderServer) EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
 }
-func (UnimplementedGoCryptoTraderServer) GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+func (UnimplementedGoCryptoTraderServer) DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+func (UnimplementedGoCryptoTraderServer) GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
 }
-func (UnimplementedGoCryptoTraderServer) GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error {
+func (UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBatchOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventByID not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangePair not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
 }
 func (UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error {
@@ -1276,1461 +1731,2166 @@ func (UnimplementedGoCryptoTraderServer) ConvertTradesToCandles(context.Context,
 func (UnimplementedGoCryptoTraderServer) FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedCandleIntervals not implemented")
 }
-func (UnimplementedGoCryptoTraderServer) FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedTradeIntervals not implemented")
+func (UnimplementedGoCryptoTraderServer) FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedTradeIntervals not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ExportSavedData(*ExportSavedDataRequest, GoCryptoTrader_ExportSavedDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSavedData not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetBalanceSnapshots(context.Context, *GetBalanceSnapshotsRequest) (*GetBalanceSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceSnapshots not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ImportFills(context.Context, *ImportFillsRequest) (*ImportFillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFills not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFills(context.Context, *GetFillsRequest) (*GetFillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFills not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPNL(context.Context, *GetPNLRequest) (*GetPNLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPNL not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetRateLimitBudget(context.Context, *GenericExchangeNameRequest) (*GetRateLimitBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimitBudget not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLatencyStats(context.Context, *GenericExchangeNameRequest) (*GetLatencyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatencyStats not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPositions(context.Context, *GetPositionsRequest) (*GetPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ClosePosition(context.Context, *ClosePositionRequest) (*ClosePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLatestFundingRate(context.Context, *GetLatestFundingRateRequest) (*GetLatestFundingRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestFundingRate not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFundingRateHistory(context.Context, *GetFundingRateHistoryRequest) (*GetFundingRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRateHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLeverage(context.Context, *GetLeverageRequest) (*GetLeverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetLeverage(context.Context, *SetLeverageRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetMarginType(context.Context, *SetMarginTypeRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginType not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLoans(context.Context, *GetLoansRequest) (*GetLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoans not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) Borrow(context.Context, *BorrowRequest) (*LendingTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Borrow not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) Repay(context.Context, *RepayRequest) (*LendingTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repay not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) OfferFunds(context.Context, *OfferFundsRequest) (*LendingTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelFundingOffer(context.Context, *CancelFundingOfferRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFundingOffer not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDerivativePrice(context.Context, *GetDerivativePriceRequest) (*DerivativePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDerivativePrice not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDerivativePriceStream(*GetDerivativePriceStreamRequest, GoCryptoTrader_GetDerivativePriceStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDerivativePriceStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeDerivativePriceStream(*GetExchangeDerivativePriceStreamRequest, GoCryptoTrader_GetExchangeDerivativePriceStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeDerivativePriceStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOptionChain(context.Context, *GetOptionChainRequest) (*OptionChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOptionChain not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetContracts(context.Context, *GetContractsRequest) (*GetContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContracts not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetSubAccounts(context.Context, *GetSubAccountsRequest) (*GetSubAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubAccounts not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) TransferFunds(context.Context, *TransferFundsRequest) (*TransferFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) TransferEventByID(context.Context, *TransferEventByIDRequest) (*TransferEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEventByID not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) TransferEventsByExchange(context.Context, *TransferEventsByExchangeRequest) (*TransferEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEventsByExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) TransferEventsByDate(context.Context, *TransferEventsByDateRequest) (*TransferEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEventsByDate not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLiquidations(context.Context, *GetLiquidationsRequest) (*LiquidationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidations not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetSavedLiquidations(context.Context, *GetLiquidationsRequest) (*LiquidationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedLiquidations not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLiquidationStream(*GetLiquidationStreamRequest, GoCryptoTrader_GetLiquidationStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLiquidationStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetExchangeLiquidationProcessing(context.Context, *SetExchangeLiquidationProcessingRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeLiquidationProcessing not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) mustEmbedUnimplementedGoCryptoTraderServer() {}
+
+// UnsafeGoCryptoTraderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoCryptoTraderServer will
+// result in compilation errors.
+type UnsafeGoCryptoTraderServer interface {
+	mustEmbedUnimplementedGoCryptoTraderServer()
+}
+
+func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
+	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+}
+
+func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubsystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRPCEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunicationRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeOTPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_UpdateAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).UpdateAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/UpdateAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).UpdateAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAccountInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+}
+
+type GoCryptoTrader_GetAccountInfoStreamServer interface {
+	Send(*GetAccountInfoResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetAccountInfoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
+
+func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) mustEmbedUnimplementedGoCryptoTraderServer() {}
 
-// UnsafeGoCryptoTraderServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GoCryptoTraderServer will
-// result in compilation errors.
-type UnsafeGoCryptoTraderServer interface {
-	mustEmbedUnimplementedGoCryptoTraderServer()
+func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
-	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhaleBombRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubsystemsRequest)
+func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_CancelBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBatchOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelBatchOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, req.(*CancelBatchOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRPCEndpointsRequest)
+func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunicationRelayersRequest)
+func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangesRequest)
+func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFiatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeOTPsRequest)
+func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCryptoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_WithdrawalEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventByID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, req.(*WithdrawalEventByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickerRequest)
+func _GoCryptoTrader_WithdrawalEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByExchangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByExchange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, req.(*WithdrawalEventsByExchangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickersRequest)
+func _GoCryptoTrader_WithdrawalEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByDate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, req.(*WithdrawalEventsByDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookRequest)
+func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbooksRequest)
+func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangePairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_UpdateAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTrader_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).UpdateAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/UpdateAccountInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).UpdateAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetAccountInfoRequest)
+func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
 }
 
-type GoCryptoTrader_GetAccountInfoStreamServer interface {
-	Send(*GetAccountInfoResponse) error
+type GoCryptoTrader_GetOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
 	grpc.ServerStream
 }
 
-type goCryptoTraderGetAccountInfoStreamServer struct {
+type goCryptoTraderGetOrderbookStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
+func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
 }
 
-func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
 }
 
-func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioSummaryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+type goCryptoTraderGetExchangeOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
 }
 
-func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPortfolioAddressRequest)
+type GoCryptoTrader_GetExchangeTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePortfolioAddressRequest)
+func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptExecuteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexProvidersRequest)
+func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexRatesRequest)
+func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptReadScriptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitOrderRequest)
+func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateOrderRequest)
+func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhaleBombRequest)
+func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptListAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptAutoLoadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelBatchOrdersRequest)
+func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelBatchOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, req.(*CancelBatchOrdersRequest))
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelAllOrdersRequest)
+func _GoCryptoTrader_SetExchangeAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeAssetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).SetExchangeAsset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeAsset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+		return srv.(GoCryptoTraderServer).SetExchangeAsset(ctx, req.(*SetExchangeAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _GoCryptoTrader_SetAllExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeAllPairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+		return srv.(GoCryptoTraderServer).SetAllExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetAllExchangePairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(GoCryptoTraderServer).SetAllExchangePairs(ctx, req.(*SetExchangeAllPairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEventRequest)
+func _GoCryptoTrader_UpdateExchangeSupportedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExchangeSupportedPairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).UpdateExchangeSupportedPairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/UpdateExchangeSupportedPairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+		return srv.(GoCryptoTraderServer).UpdateExchangeSupportedPairs(ctx, req.(*UpdateExchangeSupportedPairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEventRequest)
+func _GoCryptoTrader_GetExchangeAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeAssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangeAssets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeAssets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+		return srv.(GoCryptoTraderServer).GetExchangeAssets(ctx, req.(*GetExchangeAssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressesRequest)
+func _GoCryptoTrader_WebsocketGetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketGetInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketGetInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
+		return srv.(GoCryptoTraderServer).WebsocketGetInfo(ctx, req.(*WebsocketGetInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressRequest)
+func _GoCryptoTrader_WebsocketSetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetEnabledRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketSetEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetEnabled",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+		return srv.(GoCryptoTraderServer).WebsocketSetEnabled(ctx, req.(*WebsocketSetEnabledRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawFiatRequest)
+func _GoCryptoTrader_WebsocketGetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketGetSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketGetSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetSubscriptions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
+		return srv.(GoCryptoTraderServer).WebsocketGetSubscriptions(ctx, req.(*WebsocketGetSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCryptoRequest)
+func _GoCryptoTrader_WebsocketSetProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetProxyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketSetProxy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetProxy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
+		return srv.(GoCryptoTraderServer).WebsocketSetProxy(ctx, req.(*WebsocketSetProxyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventByIDRequest)
+func _GoCryptoTrader_WebsocketSetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetURLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketSetURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventByID",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetURL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, req.(*WithdrawalEventByIDRequest))
+		return srv.(GoCryptoTraderServer).WebsocketSetURL(ctx, req.(*WebsocketSetURLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventsByExchangeRequest)
+func _GoCryptoTrader_GetRecentTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedTradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRecentTrades",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, req.(*WithdrawalEventsByExchangeRequest))
+		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, req.(*GetSavedTradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventsByDateRequest)
+func _GoCryptoTrader_GetHistoricTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSavedTradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetHistoricTrades(m, &goCryptoTraderGetHistoricTradesServer{stream})
+}
+
+type GoCryptoTrader_GetHistoricTradesServer interface {
+	Send(*SavedTradesResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetHistoricTradesServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetHistoricTradesServer) Send(m *SavedTradesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetSavedTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedTradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, in)
+		return srv.(GoCryptoTraderServer).GetSavedTrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByDate",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSavedTrades",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, req.(*WithdrawalEventsByDateRequest))
+		return srv.(GoCryptoTraderServer).GetSavedTrades(ctx, req.(*GetSavedTradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoggerDetailsRequest)
+func _GoCryptoTrader_ConvertTradesToCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertTradesToCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).ConvertTradesToCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/ConvertTradesToCandles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).ConvertTradesToCandles(ctx, req.(*ConvertTradesToCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLoggerDetailsRequest)
+func _GoCryptoTrader_FindMissingSavedCandleIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMissingCandlePeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).FindMissingSavedCandleIntervals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/FindMissingSavedCandleIntervals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).FindMissingSavedCandleIntervals(ctx, req.(*FindMissingCandlePeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangePairsRequest)
+func _GoCryptoTrader_FindMissingSavedTradeIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMissingTradePeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).FindMissingSavedTradeIntervals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/FindMissingSavedTradeIntervals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+		return srv.(GoCryptoTraderServer).FindMissingSavedTradeIntervals(ctx, req.(*FindMissingTradePeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangePairRequest)
+func _GoCryptoTrader_SetExchangeTradeProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeTradeProcessingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).SetExchangeTradeProcessing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeTradeProcessing",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
+		return srv.(GoCryptoTraderServer).SetExchangeTradeProcessing(ctx, req.(*SetExchangeTradeProcessingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetOrderbookStreamRequest)
+func _GoCryptoTrader_ExportSavedData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSavedDataRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
+	return srv.(GoCryptoTraderServer).ExportSavedData(m, &goCryptoTraderExportSavedDataServer{stream})
 }
 
-type GoCryptoTrader_GetOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
+type GoCryptoTrader_ExportSavedDataServer interface {
+	Send(*ExportSavedDataResponse) error
 	grpc.ServerStream
 }
 
-type goCryptoTraderGetOrderbookStreamServer struct {
+type goCryptoTraderExportSavedDataServer struct {
 	grpc.ServerStream
 }
 
-func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
+func (x *goCryptoTraderExportSavedDataServer) Send(m *ExportSavedDataResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoCryptoTrader_GetBalanceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetBalanceSnapshots(ctx, in)
 	}
-	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetBalanceSnapshots",
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetBalanceSnapshots(ctx, req.(*GetBalanceSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type goCryptoTraderGetExchangeTickerStreamServer struct {
-	grpc.ServerStream
+func _GoCryptoTrader_ImportFills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).ImportFills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/ImportFills",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).ImportFills(ctx, req.(*ImportFillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GoCryptoTrader_GetFills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetFills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFills",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetFills(ctx, req.(*GetFillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuditEventRequest)
+func _GoCryptoTrader_GetPNL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPNLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetPNL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPNL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
+		return srv.(GoCryptoTraderServer).GetPNL(ctx, req.(*GetPNLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptExecuteRequest)
+func _GoCryptoTrader_GetRateLimitBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
+		return srv.(GoCryptoTraderServer).GetRateLimitBudget(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRateLimitBudget",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
+		return srv.(GoCryptoTraderServer).GetRateLimitBudget(ctx, req.(*GenericExchangeNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptUploadRequest)
+func _GoCryptoTrader_GetLatencyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLatencyStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLatencyStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
+		return srv.(GoCryptoTraderServer).GetLatencyStats(ctx, req.(*GenericExchangeNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptReadScriptRequest)
+func _GoCryptoTrader_GetPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPositionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
+		return srv.(GoCryptoTraderServer).GetPositions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPositions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
+		return srv.(GoCryptoTraderServer).GetPositions(ctx, req.(*GetPositionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStatusRequest)
+func _GoCryptoTrader_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePositionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
+		return srv.(GoCryptoTraderServer).ClosePosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
+		FullMethod: "/gctrpc.GoCryptoTrader/ClosePosition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
+		return srv.(GoCryptoTraderServer).ClosePosition(ctx, req.(*ClosePositionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptQueryRequest)
+func _GoCryptoTrader_GetLatestFundingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestFundingRateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLatestFundingRate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLatestFundingRate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
+		return srv.(GoCryptoTraderServer).GetLatestFundingRate(ctx, req.(*GetLatestFundingRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopRequest)
+func _GoCryptoTrader_GetFundingRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingRateHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFundingRateHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFundingRateHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
+		return srv.(GoCryptoTraderServer).GetFundingRateHistory(ctx, req.(*GetFundingRateHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopAllRequest)
+func _GoCryptoTrader_GetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeverageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLeverage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLeverage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
+		return srv.(GoCryptoTraderServer).GetLeverage(ctx, req.(*GetLeverageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptListAllRequest)
+func _GoCryptoTrader_SetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLeverageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLeverage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLeverage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
+		return srv.(GoCryptoTraderServer).SetLeverage(ctx, req.(*SetLeverageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptAutoLoadRequest)
+func _GoCryptoTrader_SetMarginType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMarginTypeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
+		return srv.(GoCryptoTraderServer).SetMarginType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetMarginType",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
+		return srv.(GoCryptoTraderServer).SetMarginType(ctx, req.(*SetMarginTypeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHistoricCandlesRequest)
+func _GoCryptoTrader_GetLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoansRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLoans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLoans",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
+		return srv.(GoCryptoTraderServer).GetLoans(ctx, req.(*GetLoansRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetExchangeAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeAssetRequest)
+func _GoCryptoTrader_Borrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BorrowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetExchangeAsset(ctx, in)
+		return srv.(GoCryptoTraderServer).Borrow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeAsset",
+		FullMethod: "/gctrpc.GoCryptoTrader/Borrow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetExchangeAsset(ctx, req.(*SetExchangeAssetRequest))
+		return srv.(GoCryptoTraderServer).Borrow(ctx, req.(*BorrowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetAllExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeAllPairsRequest)
+func _GoCryptoTrader_Repay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetAllExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).Repay(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetAllExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/Repay",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetAllExchangePairs(ctx, req.(*SetExchangeAllPairsRequest))
+		return srv.(GoCryptoTraderServer).Repay(ctx, req.(*RepayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_UpdateExchangeSupportedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateExchangeSupportedPairsRequest)
+func _GoCryptoTrader_OfferFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfferFundsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).UpdateExchangeSupportedPairs(ctx, in)
+		return srv.(GoCryptoTraderServer).OfferFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/UpdateExchangeSupportedPairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/OfferFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).UpdateExchangeSupportedPairs(ctx, req.(*UpdateExchangeSupportedPairsRequest))
+		return srv.(GoCryptoTraderServer).OfferFunds(ctx, req.(*OfferFundsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeAssetsRequest)
+func _GoCryptoTrader_CancelFundingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFundingOfferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeAssets(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelFundingOffer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeAssets",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelFundingOffer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeAssets(ctx, req.(*GetExchangeAssetsRequest))
+		return srv.(GoCryptoTraderServer).CancelFundingOffer(ctx, req.(*CancelFundingOfferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketGetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketGetInfoRequest)
+func _GoCryptoTrader_GetDerivativePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDerivativePriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketGetInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDerivativePrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDerivativePrice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketGetInfo(ctx, req.(*WebsocketGetInfoRequest))
+		return srv.(GoCryptoTraderServer).GetDerivativePrice(ctx, req.(*GetDerivativePriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketSetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetEnabledRequest)
+func _GoCryptoTrader_GetDerivativePriceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDerivativePriceStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetDerivativePriceStream(m, &goCryptoTraderGetDerivativePriceStreamServer{stream})
+}
+
+type GoCryptoTrader_GetDerivativePriceStreamServer interface {
+	Send(*DerivativePriceResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetDerivativePriceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetDerivativePriceStreamServer) Send(m *DerivativePriceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeDerivativePriceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeDerivativePriceStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeDerivativePriceStream(m, &goCryptoTraderGetExchangeDerivativePriceStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeDerivativePriceStreamServer interface {
+	Send(*DerivativePriceResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeDerivativePriceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeDerivativePriceStreamServer) Send(m *DerivativePriceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetOptionChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOptionChainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketSetEnabled(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOptionChain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetEnabled",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOptionChain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketSetEnabled(ctx, req.(*WebsocketSetEnabledRequest))
+		return srv.(GoCryptoTraderServer).GetOptionChain(ctx, req.(*GetOptionChainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketGetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketGetSubscriptionsRequest)
+func _GoCryptoTrader_GetContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketGetSubscriptions(ctx, in)
+		return srv.(GoCryptoTraderServer).GetContracts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetSubscriptions",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetContracts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketGetSubscriptions(ctx, req.(*WebsocketGetSubscriptionsRequest))
+		return srv.(GoCryptoTraderServer).GetContracts(ctx, req.(*GetContractsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketSetProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetProxyRequest)
+func _GoCryptoTrader_GetSubAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketSetProxy(ctx, in)
+		return srv.(GoCryptoTraderServer).GetSubAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetProxy",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSubAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketSetProxy(ctx, req.(*WebsocketSetProxyRequest))
+		return srv.(GoCryptoTraderServer).GetSubAccounts(ctx, req.(*GetSubAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketSetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetURLRequest)
+func _GoCryptoTrader_TransferFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferFundsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketSetURL(ctx, in)
+		return srv.(GoCryptoTraderServer).TransferFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetURL",
+		FullMethod: "/gctrpc.GoCryptoTrader/TransferFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketSetURL(ctx, req.(*WebsocketSetURLRequest))
+		return srv.(GoCryptoTraderServer).TransferFunds(ctx, req.(*TransferFundsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetRecentTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSavedTradesRequest)
+func _GoCryptoTrader_TransferEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferEventByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, in)
+		return srv.(GoCryptoTraderServer).TransferEventByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetRecentTrades",
+		FullMethod: "/gctrpc.GoCryptoTrader/TransferEventByID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, req.(*GetSavedTradesRequest))
+		return srv.(GoCryptoTraderServer).TransferEventByID(ctx, req.(*TransferEventByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetHistoricTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetSavedTradesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServer).GetHistoricTrades(m, &goCryptoTraderGetHistoricTradesServer{stream})
-}
-
-type GoCryptoTrader_GetHistoricTradesServer interface {
-	Send(*SavedTradesResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetHistoricTradesServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetHistoricTradesServer) Send(m *SavedTradesResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetSavedTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSavedTradesRequest)
+func _GoCryptoTrader_TransferEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferEventsByExchangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetSavedTrades(ctx, in)
+		return srv.(GoCryptoTraderServer).TransferEventsByExchange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetSavedTrades",
+		FullMethod: "/gctrpc.GoCryptoTrader/TransferEventsByExchange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetSavedTrades(ctx, req.(*GetSavedTradesRequest))
+		return srv.(GoCryptoTraderServer).TransferEventsByExchange(ctx, req.(*TransferEventsByExchangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_ConvertTradesToCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConvertTradesToCandlesRequest)
+func _GoCryptoTrader_TransferEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferEventsByDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).ConvertTradesToCandles(ctx, in)
+		return srv.(GoCryptoTraderServer).TransferEventsByDate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/ConvertTradesToCandles",
+		FullMethod: "/gctrpc.GoCryptoTrader/TransferEventsByDate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).ConvertTradesToCandles(ctx, req.(*ConvertTradesToCandlesRequest))
+		return srv.(GoCryptoTraderServer).TransferEventsByDate(ctx, req.(*TransferEventsByDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_FindMissingSavedCandleIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindMissingCandlePeriodsRequest)
+func _GoCryptoTrader_GetLiquidations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).FindMissingSavedCandleIntervals(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLiquidations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/FindMissingSavedCandleIntervals",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLiquidations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).FindMissingSavedCandleIntervals(ctx, req.(*FindMissingCandlePeriodsRequest))
+		return srv.(GoCryptoTraderServer).GetLiquidations(ctx, req.(*GetLiquidationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_FindMissingSavedTradeIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindMissingTradePeriodsRequest)
+func _GoCryptoTrader_GetSavedLiquidations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).FindMissingSavedTradeIntervals(ctx, in)
+		return srv.(GoCryptoTraderServer).GetSavedLiquidations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/FindMissingSavedTradeIntervals",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSavedLiquidations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).FindMissingSavedTradeIntervals(ctx, req.(*FindMissingTradePeriodsRequest))
+		return srv.(GoCryptoTraderServer).GetSavedLiquidations(ctx, req.(*GetLiquidationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetExchangeTradeProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeTradeProcessingRequest)
+func _GoCryptoTrader_GetLiquidationStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLiquidationStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetLiquidationStream(m, &goCryptoTraderGetLiquidationStreamServer{stream})
+}
+
+type GoCryptoTrader_GetLiquidationStreamServer interface {
+	Send(*LiquidationsResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetLiquidationStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetLiquidationStreamServer) Send(m *LiquidationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_SetExchangeLiquidationProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeLiquidationProcessingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetExchangeTradeProcessing(ctx, in)
+		return srv.(GoCryptoTraderServer).SetExchangeLiquidationProcessing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeTradeProcessing",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeLiquidationProcessing",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetExchangeTradeProcessing(ctx, req.(*SetExchangeTradeProcessingRequest))
+		return srv.(GoCryptoTraderServer).SetExchangeLiquidationProcessing(ctx, req.(*SetExchangeLiquidationProcessingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3031,6 +4191,122 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetExchangeTradeProcessing",
 			Handler:    _GoCryptoTrader_SetExchangeTradeProcessing_Handler,
 		},
+		{
+			MethodName: "GetBalanceSnapshots",
+			Handler:    _GoCryptoTrader_GetBalanceSnapshots_Handler,
+		},
+		{
+			MethodName: "ImportFills",
+			Handler:    _GoCryptoTrader_ImportFills_Handler,
+		},
+		{
+			MethodName: "GetFills",
+			Handler:    _GoCryptoTrader_GetFills_Handler,
+		},
+		{
+			MethodName: "GetPNL",
+			Handler:    _GoCryptoTrader_GetPNL_Handler,
+		},
+		{
+			MethodName: "GetRateLimitBudget",
+			Handler:    _GoCryptoTrader_GetRateLimitBudget_Handler,
+		},
+		{
+			MethodName: "GetLatencyStats",
+			Handler:    _GoCryptoTrader_GetLatencyStats_Handler,
+		},
+		{
+			MethodName: "GetPositions",
+			Handler:    _GoCryptoTrader_GetPositions_Handler,
+		},
+		{
+			MethodName: "ClosePosition",
+			Handler:    _GoCryptoTrader_ClosePosition_Handler,
+		},
+		{
+			MethodName: "GetLatestFundingRate",
+			Handler:    _GoCryptoTrader_GetLatestFundingRate_Handler,
+		},
+		{
+			MethodName: "GetFundingRateHistory",
+			Handler:    _GoCryptoTrader_GetFundingRateHistory_Handler,
+		},
+		{
+			MethodName: "GetLeverage",
+			Handler:    _GoCryptoTrader_GetLeverage_Handler,
+		},
+		{
+			MethodName: "SetLeverage",
+			Handler:    _GoCryptoTrader_SetLeverage_Handler,
+		},
+		{
+			MethodName: "SetMarginType",
+			Handler:    _GoCryptoTrader_SetMarginType_Handler,
+		},
+		{
+			MethodName: "GetLoans",
+			Handler:    _GoCryptoTrader_GetLoans_Handler,
+		},
+		{
+			MethodName: "Borrow",
+			Handler:    _GoCryptoTrader_Borrow_Handler,
+		},
+		{
+			MethodName: "Repay",
+			Handler:    _GoCryptoTrader_Repay_Handler,
+		},
+		{
+			MethodName: "OfferFunds",
+			Handler:    _GoCryptoTrader_OfferFunds_Handler,
+		},
+		{
+			MethodName: "CancelFundingOffer",
+			Handler:    _GoCryptoTrader_CancelFundingOffer_Handler,
+		},
+		{
+			MethodName: "GetDerivativePrice",
+			Handler:    _GoCryptoTrader_GetDerivativePrice_Handler,
+		},
+		{
+			MethodName: "GetOptionChain",
+			Handler:    _GoCryptoTrader_GetOptionChain_Handler,
+		},
+		{
+			MethodName: "GetContracts",
+			Handler:    _GoCryptoTrader_GetContracts_Handler,
+		},
+		{
+			MethodName: "GetSubAccounts",
+			Handler:    _GoCryptoTrader_GetSubAccounts_Handler,
+		},
+		{
+			MethodName: "TransferFunds",
+			Handler:    _GoCryptoTrader_TransferFunds_Handler,
+		},
+		{
+			MethodName: "TransferEventByID",
+			Handler:    _GoCryptoTrader_TransferEventByID_Handler,
+		},
+		{
+			MethodName: "TransferEventsByExchange",
+			Handler:    _GoCryptoTrader_TransferEventsByExchange_Handler,
+		},
+		{
+			MethodName: "TransferEventsByDate",
+			Handler:    _GoCryptoTrader_TransferEventsByDate_Handler,
+		},
+		{
+			MethodName: "GetLiquidations",
+			Handler:    _GoCryptoTrader_GetLiquidations_Handler,
+		},
+		{
+			MethodName: "GetSavedLiquidations",
+			Handler:    _GoCryptoTrader_GetSavedLiquidations_Handler,
+		},
+		{
+			MethodName: "SetExchangeLiquidationProcessing",
+			Handler:    _GoCryptoTrader_SetExchangeLiquidationProcessing_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3063,6 +4339,26 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportSavedData",
+			Handler:       _GoCryptoTrader_ExportSavedData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetDerivativePriceStream",
+			Handler:       _GoCryptoTrader_GetDerivativePriceStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetExchangeDerivativePriceStream",
+			Handler:       _GoCryptoTrader_GetExchangeDerivativePriceStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLiquidationStream",
+			Handler:       _GoCryptoTrader_GetLiquidationStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta/indicators"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -28,6 +29,11 @@ var exchangeModule = map[string]objects.Object{
 	"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
 	"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
 	"ohlcv":          &objects.UserFunction{Name: "ohlcv", Value: exchangeOHLCV},
+	"positions":      &objects.UserFunction{Name: "positions", Value: ExchangePositions},
+	"closeposition":  &objects.UserFunction{Name: "closeposition", Value: ExchangeClosePosition},
+	"leverage":       &objects.UserFunction{Name: "leverage", Value: ExchangeLeverage},
+	"setleverage":    &objects.UserFunction{Name: "setleverage", Value: ExchangeSetLeverage},
+	"setmargintype":  &objects.UserFunction{Name: "setmargintype", Value: ExchangeSetMarginType},
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
@@ -475,6 +481,260 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
+// ExchangePositions returns the open positions for an exchange's derivatives
+// asset type
+func ExchangePositions(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	assetString, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetString)
+	}
+	assetType, err := asset.New(assetString)
+	if err != nil {
+		return nil, err
+	}
+	rtnValue, err := wrappers.GetWrapper().Positions(exchangeName, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	var positions objects.Array
+	for x := range rtnValue {
+		temp := make(map[string]objects.Object, 11)
+		temp["pair"] = &objects.String{Value: rtnValue[x].Pair.String()}
+		temp["asset"] = &objects.String{Value: rtnValue[x].Asset.String()}
+		temp["side"] = &objects.String{Value: rtnValue[x].Side.String()}
+		temp["size"] = &objects.Float{Value: rtnValue[x].Size}
+		temp["entryprice"] = &objects.Float{Value: rtnValue[x].EntryPrice}
+		temp["markprice"] = &objects.Float{Value: rtnValue[x].MarkPrice}
+		temp["liquidationprice"] = &objects.Float{Value: rtnValue[x].LiquidationPrice}
+		temp["leverage"] = &objects.Float{Value: rtnValue[x].Leverage}
+		temp["unrealisedpnl"] = &objects.Float{Value: rtnValue[x].UnrealisedPNL}
+		temp["margintype"] = &objects.String{Value: string(rtnValue[x].MarginType)}
+		temp["updated"] = &objects.Time{Value: rtnValue[x].UpdatedAt}
+		positions.Value = append(positions.Value, &objects.Map{Value: temp})
+	}
+
+	data := make(map[string]objects.Object, 2)
+	data["exchange"] = &objects.String{Value: exchangeName}
+	data["positions"] = &positions
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
+// ExchangeClosePosition closes all or part of an open derivatives position, a
+// zero amount closes the whole position and a zero price closes at market
+func ExchangeClosePosition(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	assetString, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetString)
+	}
+	assetType, err := asset.New(assetString)
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := objects.ToFloat64(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, amount)
+	}
+	price, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, price)
+	}
+	clientOrderID, ok := objects.ToString(args[6])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, clientOrderID)
+	}
+
+	rtn, err := wrappers.GetWrapper().ClosePosition(&position.Close{
+		Exchange:      exchangeName,
+		Pair:          pair,
+		Asset:         assetType,
+		Amount:        amount,
+		Price:         price,
+		ClientOrderID: clientOrderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 2)
+	data["orderid"] = &objects.String{Value: rtn.OrderID}
+	if rtn.IsOrderPlaced {
+		data["isorderplaced"] = objects.TrueValue
+	} else {
+		data["isorderplaced"] = objects.FalseValue
+	}
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
+// ExchangeLeverage returns the leverage and margin type an exchange applies
+// to orders in a derivatives contract
+func ExchangeLeverage(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	assetString, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetString)
+	}
+	assetType, err := asset.New(assetString)
+	if err != nil {
+		return nil, err
+	}
+
+	rtn, err := wrappers.GetWrapper().Leverage(exchangeName, pair, assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 5)
+	data["exchange"] = &objects.String{Value: rtn.Exchange}
+	data["pair"] = &objects.String{Value: rtn.Pair.String()}
+	data["asset"] = &objects.String{Value: rtn.Asset.String()}
+	data["leverage"] = &objects.Float{Value: rtn.Leverage}
+	data["margintype"] = &objects.String{Value: string(rtn.MarginType)}
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
+// ExchangeSetLeverage sets the leverage an exchange applies to orders in a
+// derivatives contract
+func ExchangeSetLeverage(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	assetString, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetString)
+	}
+	assetType, err := asset.New(assetString)
+	if err != nil {
+		return nil, err
+	}
+	leverage, ok := objects.ToFloat64(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, leverage)
+	}
+
+	err = wrappers.GetWrapper().SetLeverage(exchangeName, pair, assetType, leverage)
+	if err != nil {
+		return nil, err
+	}
+	return objects.TrueValue, nil
+}
+
+// ExchangeSetMarginType sets whether a derivatives contract uses isolated or
+// cross margin
+func ExchangeSetMarginType(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	assetString, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetString)
+	}
+	assetType, err := asset.New(assetString)
+	if err != nil {
+		return nil, err
+	}
+	marginType, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, marginType)
+	}
+
+	err = wrappers.GetWrapper().SetMarginType(exchangeName, pair, assetType, position.StringToMarginType(marginType))
+	if err != nil {
+		return nil, err
+	}
+	return objects.TrueValue, nil
+}
+
 // ExchangeDepositAddress returns deposit address (if supported by exchange)
 func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 2 {
@@ -228,6 +228,109 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	}
 }
 
+func TestExchangePositions(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangePositions()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	futures := &objects.String{Value: asset.Futures.String()}
+	_, err = ExchangePositions(exch, futures)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangePositions(exchError, futures)
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeClosePosition(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeClosePosition()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	futures := &objects.String{Value: asset.Futures.String()}
+	amount := &objects.Float{Value: 0}
+	price := &objects.Float{Value: 0}
+	_, err = ExchangeClosePosition(exch, currencyPair, delimiter, futures, amount, price, orderID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeClosePosition(exchError, currencyPair, delimiter, futures, amount, price, orderID)
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeLeverage()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	futures := &objects.String{Value: asset.Futures.String()}
+	_, err = ExchangeLeverage(exch, currencyPair, delimiter, futures)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeLeverage(exchError, currencyPair, delimiter, futures)
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeSetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetLeverage()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	futures := &objects.String{Value: asset.Futures.String()}
+	leverage := &objects.Float{Value: 5}
+	_, err = ExchangeSetLeverage(exch, currencyPair, delimiter, futures, leverage)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeSetLeverage(exchError, currencyPair, delimiter, futures, leverage)
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeSetMarginType(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetMarginType()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+
+	futures := &objects.String{Value: asset.Futures.String()}
+	_, err = ExchangeSetMarginType(exch, currencyPair, delimiter, futures, &objects.String{Value: "cross"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeSetMarginType(exch, currencyPair, delimiter, futures, &objects.String{Value: "leveraged"})
+	if err == nil {
+		t.Error("expected an error for an unknown margin type")
+	}
+
+	_, err = ExchangeSetMarginType(exchError, currencyPair, delimiter, futures, &objects.String{Value: "cross"})
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Error(err)
+	}
+}
+
 func TestAllModuleNames(t *testing.T) {
 	t.Parallel()
 	x := AllModuleNames()
@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -43,6 +44,11 @@ type Exchange interface {
 	WithdrawalFiatFunds(bankAccountID string, request *withdraw.Request) (out string, err error)
 	WithdrawalCryptoFunds(request *withdraw.Request) (out string, err error)
 	OHLCV(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error)
+	Positions(exch string, item asset.Item) ([]position.Position, error)
+	ClosePosition(c *position.Close) (*order.SubmitResponse, error)
+	Leverage(exch string, pair currency.Pair, item asset.Item) (*position.Leverage, error)
+	SetLeverage(exch string, pair currency.Pair, item asset.Item, leverage float64) error
+	SetMarginType(exch string, pair currency.Pair, item asset.Item, m position.MarginType) error
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -231,3 +232,56 @@ func (e Exchange) OHLCV(exch string, pair currency.Pair, item asset.Item, start,
 
 	return ret, nil
 }
+
+// Positions returns the open positions for an exchange's derivatives asset
+// type
+func (e Exchange) Positions(exch string, item asset.Item) ([]position.Position, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetDerivativePositions(item)
+}
+
+// ClosePosition closes all or part of an open derivatives position
+func (e Exchange) ClosePosition(c *position.Close) (*order.SubmitResponse, error) {
+	ex, err := e.GetExchange(c.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	r, err := ex.CloseDerivativePosition(c)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Leverage returns the leverage and margin type an exchange applies to orders
+// in a derivatives contract
+func (e Exchange) Leverage(exch string, pair currency.Pair, item asset.Item) (*position.Leverage, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetLeverage(pair, item)
+}
+
+// SetLeverage sets the leverage an exchange applies to orders in a
+// derivatives contract
+func (e Exchange) SetLeverage(exch string, pair currency.Pair, item asset.Item, leverage float64) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetLeverage(pair, item, leverage)
+}
+
+// SetMarginType sets whether a derivatives contract uses isolated or cross
+// margin
+func (e Exchange) SetMarginType(exch string, pair currency.Pair, item asset.Item, m position.MarginType) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetMarginType(pair, item, m)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -278,3 +279,70 @@ func (w Wrapper) OHLCV(exch string, p currency.Pair, a asset.Item, start, end ti
 		Candles:  candles,
 	}, nil
 }
+
+// Positions validator for test execution/scripts
+func (w Wrapper) Positions(exch string, a asset.Item) ([]position.Position, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []position.Position{
+		{
+			Exchange:         exch,
+			Pair:             currency.NewPair(currency.BTC, currency.USD),
+			Asset:            a,
+			Side:             order.Long,
+			Size:             validatorVol,
+			EntryPrice:       validatorOpen,
+			MarkPrice:        validatorClose,
+			LiquidationPrice: validatorOpen / 2,
+			Leverage:         2,
+			UnrealisedPNL:    (validatorClose - validatorOpen) * validatorVol,
+			MarginType:       position.Isolated,
+			UpdatedAt:        time.Now(),
+		},
+	}, nil
+}
+
+// ClosePosition validator for test execution/scripts
+func (w Wrapper) ClosePosition(c *position.Close) (*order.SubmitResponse, error) {
+	if c == nil {
+		return nil, errTestFailed
+	}
+	if c.Exchange == exchError.String() {
+		return nil, errTestFailed
+	}
+	return &order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       c.Exchange,
+	}, nil
+}
+
+// Leverage validator for test execution/scripts
+func (w Wrapper) Leverage(exch string, pair currency.Pair, item asset.Item) (*position.Leverage, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return &position.Leverage{
+		Exchange:   exch,
+		Pair:       pair,
+		Asset:      item,
+		Leverage:   2,
+		MarginType: position.Isolated,
+	}, nil
+}
+
+// SetLeverage validator for test execution/scripts
+func (w Wrapper) SetLeverage(exch string, _ currency.Pair, _ asset.Item, leverage float64) error {
+	if exch == exchError.String() {
+		return errTestFailed
+	}
+	return position.ValidateLeverage(leverage)
+}
+
+// SetMarginType validator for test execution/scripts
+func (w Wrapper) SetMarginType(exch string, _ currency.Pair, _ asset.Item, m position.MarginType) error {
+	if exch == exchError.String() {
+		return errTestFailed
+	}
+	return m.Validate()
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -233,6 +234,40 @@ func TestWrapper_WithdrawalFiatFunds(t *testing.T) {
 	}
 }
 
+func TestWrapper_Positions(t *testing.T) {
+	t.Parallel()
+	p, err := testWrapper.Positions("test", asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p) != 1 || p[0].Asset != asset.Futures {
+		t.Fatalf("unexpected positions %+v", p)
+	}
+	_, err = testWrapper.Positions(exchError.String(), asset.Futures)
+	if err == nil {
+		t.Fatal("expected Positions to return error with invalid name")
+	}
+}
+
+func TestWrapper_ClosePosition(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.ClosePosition(nil)
+	if err == nil {
+		t.Fatal("expected ClosePosition to return error with nil request")
+	}
+	resp, err := testWrapper.ClosePosition(&position.Close{Exchange: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.IsOrderPlaced {
+		t.Fatal("expected order to be placed")
+	}
+	_, err = testWrapper.ClosePosition(&position.Close{Exchange: exchError.String()})
+	if err == nil {
+		t.Fatal("expected ClosePosition to return error with invalid name")
+	}
+}
+
 func TestWrapper_OHLCV(t *testing.T) {
 	c, err := currency.NewPairDelimiter(pairs, delimiter)
 	if err != nil {
@@ -247,3 +282,50 @@ func TestWrapper_OHLCV(t *testing.T) {
 		t.Fatal("expected OHLCV to return error with invalid name")
 	}
 }
+
+func TestWrapper_Leverage(t *testing.T) {
+	t.Parallel()
+	l, err := testWrapper.Leverage("test", currency.NewPair(currency.BTC, currency.USD), asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Leverage != 2 || l.MarginType != position.Isolated {
+		t.Fatalf("unexpected leverage %+v", l)
+	}
+	_, err = testWrapper.Leverage(exchError.String(), currency.NewPair(currency.BTC, currency.USD), asset.Futures)
+	if err == nil {
+		t.Fatal("expected Leverage to return error with invalid name")
+	}
+}
+
+func TestWrapper_SetLeverage(t *testing.T) {
+	t.Parallel()
+	err := testWrapper.SetLeverage("test", currency.NewPair(currency.BTC, currency.USD), asset.Futures, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testWrapper.SetLeverage("test", currency.NewPair(currency.BTC, currency.USD), asset.Futures, 0)
+	if err == nil {
+		t.Fatal("expected SetLeverage to return error with zero leverage")
+	}
+	err = testWrapper.SetLeverage(exchError.String(), currency.NewPair(currency.BTC, currency.USD), asset.Futures, 5)
+	if err == nil {
+		t.Fatal("expected SetLeverage to return error with invalid name")
+	}
+}
+
+func TestWrapper_SetMarginType(t *testing.T) {
+	t.Parallel()
+	err := testWrapper.SetMarginType("test", currency.NewPair(currency.BTC, currency.USD), asset.Futures, position.Cross)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testWrapper.SetMarginType("test", currency.NewPair(currency.BTC, currency.USD), asset.Futures, position.UnknownMarginType)
+	if err == nil {
+		t.Fatal("expected SetMarginType to return error with unknown margin type")
+	}
+	err = testWrapper.SetMarginType(exchError.String(), currency.NewPair(currency.BTC, currency.USD), asset.Futures, position.Cross)
+	if err == nil {
+		t.Fatal("expected SetMarginType to return error with invalid name")
+	}
+}
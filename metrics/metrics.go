@@ -0,0 +1,369 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Enable starts recording observations, recording is off by default so
+// instrumented packages cost nothing when metrics are not served
+func Enable() {
+	atomic.StoreInt32(&enabled, 1)
+}
+
+// Disable stops recording observations, existing values are kept
+func Disable() {
+	atomic.StoreInt32(&enabled, 0)
+}
+
+// IsEnabled returns whether observations are being recorded
+func IsEnabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// Register adds collectors to the default registry
+func Register(c ...Collector) error {
+	return defaultRegistry.Register(c...)
+}
+
+// Unregister removes collectors from the default registry
+func Unregister(c ...Collector) {
+	defaultRegistry.Unregister(c...)
+}
+
+// Handler returns a http handler serving the default registry
+func Handler() http.Handler {
+	return defaultRegistry.Handler()
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]Collector)}
+}
+
+// Register adds collectors to the registry, collector names must be unique
+func (r *Registry) Register(c ...Collector) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range c {
+		if c[i] == nil {
+			return errCollectorNil
+		}
+		name := c[i].Name()
+		if name == "" {
+			return errCollectorNameUnset
+		}
+		if _, ok := r.collectors[name]; ok {
+			return fmt.Errorf("%w: %s", errDuplicateCollector, name)
+		}
+	}
+	for i := range c {
+		r.collectors[c[i].Name()] = c[i]
+	}
+	return nil
+}
+
+// Unregister removes collectors from the registry
+func (r *Registry) Unregister(c ...Collector) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range c {
+		if c[i] == nil {
+			continue
+		}
+		if existing, ok := r.collectors[c[i].Name()]; ok && existing == c[i] {
+			delete(r.collectors, c[i].Name())
+		}
+	}
+}
+
+// Gather returns every collector in the Prometheus text exposition format,
+// ordered by metric name
+func (r *Registry) Gather() []byte {
+	r.m.RLock()
+	collectors := make([]Collector, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		collectors = append(collectors, c)
+	}
+	r.m.RUnlock()
+
+	sort.Slice(collectors, func(i, j int) bool {
+		return collectors[i].Name() < collectors[j].Name()
+	})
+	var b []byte
+	for i := range collectors {
+		collectors[i].write(&b)
+	}
+	return b
+}
+
+// Handler returns a http handler serving the registry to Prometheus scrapes
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(r.Gather())
+	})
+}
+
+// NewCounter returns a counter partitioned by the supplied label names
+func NewCounter(name, help string, labels ...string) *Counter {
+	return &Counter{
+		desc:   desc{name: name, help: help, labels: labels},
+		series: make(map[string]*series),
+	}
+}
+
+// Inc increments the counter for the label values by one
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add increments the counter for the label values, negative values and
+// label values not matching the label names are ignored
+func (c *Counter) Add(v float64, labelValues ...string) {
+	if v < 0 || !IsEnabled() || len(labelValues) != len(c.labels) {
+		return
+	}
+	c.m.Lock()
+	getSeries(c.series, labelValues).value += v
+	c.m.Unlock()
+}
+
+// Name returns the metric name
+func (c *Counter) Name() string {
+	return c.name
+}
+
+func (c *Counter) write(b *[]byte) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	writeSeries(b, &c.desc, counterType, c.series)
+}
+
+// NewGauge returns a gauge partitioned by the supplied label names
+func NewGauge(name, help string, labels ...string) *Gauge {
+	return &Gauge{
+		desc:   desc{name: name, help: help, labels: labels},
+		series: make(map[string]*series),
+	}
+}
+
+// Set sets the gauge for the label values, label values not matching the
+// label names are ignored
+func (g *Gauge) Set(v float64, labelValues ...string) {
+	if !IsEnabled() || len(labelValues) != len(g.labels) {
+		return
+	}
+	g.m.Lock()
+	getSeries(g.series, labelValues).value = v
+	g.m.Unlock()
+}
+
+// Add adds to the gauge for the label values, v may be negative
+func (g *Gauge) Add(v float64, labelValues ...string) {
+	if !IsEnabled() || len(labelValues) != len(g.labels) {
+		return
+	}
+	g.m.Lock()
+	getSeries(g.series, labelValues).value += v
+	g.m.Unlock()
+}
+
+// Name returns the metric name
+func (g *Gauge) Name() string {
+	return g.name
+}
+
+func (g *Gauge) write(b *[]byte) {
+	g.m.Lock()
+	defer g.m.Unlock()
+	writeSeries(b, &g.desc, gaugeType, g.series)
+}
+
+// NewGaugeFunc returns a gauge whose samples are read from fn on each scrape
+func NewGaugeFunc(name, help string, labels []string, fn func() []Sample) *GaugeFunc {
+	return &GaugeFunc{
+		desc: desc{name: name, help: help, labels: labels},
+		fn:   fn,
+	}
+}
+
+// Name returns the metric name
+func (g *GaugeFunc) Name() string {
+	return g.name
+}
+
+func (g *GaugeFunc) write(b *[]byte) {
+	samples := g.fn()
+	s := make(map[string]*series, len(samples))
+	for i := range samples {
+		if len(samples[i].LabelValues) != len(g.labels) {
+			continue
+		}
+		getSeries(s, samples[i].LabelValues).value = samples[i].Value
+	}
+	writeSeries(b, &g.desc, gaugeType, s)
+}
+
+// NewHistogram returns a histogram with the supplied bucket upper bounds
+// partitioned by the supplied label names
+func NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	sorted := make([]float64, 0, len(buckets))
+	for i := range buckets {
+		if !math.IsInf(buckets[i], 1) {
+			sorted = append(sorted, buckets[i])
+		}
+	}
+	sort.Float64s(sorted)
+	return &Histogram{
+		desc:    desc{name: name, help: help, labels: labels},
+		buckets: sorted,
+		series:  make(map[string]*histogramSeries),
+	}
+}
+
+// Observe records a value for the label values, label values not matching
+// the label names are ignored
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	if !IsEnabled() || len(labelValues) != len(h.labels) {
+		return
+	}
+	h.m.Lock()
+	defer h.m.Unlock()
+	key := seriesKey(labelValues)
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{
+			labelValues: append([]string(nil), labelValues...),
+			counts:      make([]uint64, len(h.buckets)),
+		}
+		h.series[key] = s
+	}
+	for i := range h.buckets {
+		if v <= h.buckets[i] {
+			s.counts[i]++
+		}
+	}
+	s.sum += v
+	s.count++
+}
+
+// Name returns the metric name
+func (h *Histogram) Name() string {
+	return h.name
+}
+
+func (h *Histogram) write(b *[]byte) {
+	h.m.Lock()
+	defer h.m.Unlock()
+	writeHeader(b, &h.desc, histogramType)
+	keys := make([]string, 0, len(h.series))
+	for k := range h.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	labels := append(append([]string(nil), h.labels...), "le")
+	for _, k := range keys {
+		s := h.series[k]
+		values := append(append([]string(nil), s.labelValues...), "")
+		for i := range h.buckets {
+			values[len(values)-1] = formatFloat(h.buckets[i])
+			writeSample(b, h.name+"_bucket", labels, values, float64(s.counts[i]))
+		}
+		values[len(values)-1] = "+Inf"
+		writeSample(b, h.name+"_bucket", labels, values, float64(s.count))
+		writeSample(b, h.name+"_sum", h.labels, s.labelValues, s.sum)
+		writeSample(b, h.name+"_count", h.labels, s.labelValues, float64(s.count))
+	}
+}
+
+// seriesKey joins label values with a byte which cannot appear in valid
+// UTF-8 label values
+func seriesKey(labelValues []string) string {
+	return strings.Join(labelValues, "\xff")
+}
+
+func getSeries(m map[string]*series, labelValues []string) *series {
+	key := seriesKey(labelValues)
+	s, ok := m[key]
+	if !ok {
+		s = &series{labelValues: append([]string(nil), labelValues...)}
+		m[key] = s
+	}
+	return s
+}
+
+func writeSeries(b *[]byte, d *desc, metricType string, m map[string]*series) {
+	writeHeader(b, d, metricType)
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		writeSample(b, d.name, d.labels, m[k].labelValues, m[k].value)
+	}
+}
+
+func writeHeader(b *[]byte, d *desc, metricType string) {
+	if d.help != "" {
+		*b = append(*b, "# HELP "...)
+		*b = append(*b, d.name...)
+		*b = append(*b, ' ')
+		*b = append(*b, escape(d.help, false)...)
+		*b = append(*b, '\n')
+	}
+	*b = append(*b, "# TYPE "...)
+	*b = append(*b, d.name...)
+	*b = append(*b, ' ')
+	*b = append(*b, metricType...)
+	*b = append(*b, '\n')
+}
+
+func writeSample(b *[]byte, name string, labels, values []string, v float64) {
+	*b = append(*b, name...)
+	if len(labels) > 0 {
+		*b = append(*b, '{')
+		for i := range labels {
+			if i > 0 {
+				*b = append(*b, ',')
+			}
+			*b = append(*b, labels[i]...)
+			*b = append(*b, `="`...)
+			*b = append(*b, escape(values[i], true)...)
+			*b = append(*b, '"')
+		}
+		*b = append(*b, '}')
+	}
+	*b = append(*b, ' ')
+	*b = append(*b, formatFloat(v)...)
+	*b = append(*b, '\n')
+}
+
+// escape escapes backslashes and line feeds, and double quotes in label
+// values, as required by the text exposition format
+func escape(s string, quotes bool) string {
+	r := []string{`\`, `\\`, "\n", `\n`}
+	if quotes {
+		r = append(r, `"`, `\"`)
+	}
+	return strings.NewReplacer(r...).Replace(s)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
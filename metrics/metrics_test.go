@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	Enable()
+	os.Exit(m.Run())
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+	r := NewRegistry()
+	c := NewCounter("test_total", "")
+	if err := r.Register(nil); !errors.Is(err, errCollectorNil) {
+		t.Fatalf("expected %v, received %v", errCollectorNil, err)
+	}
+	if err := r.Register(NewCounter("", "")); !errors.Is(err, errCollectorNameUnset) {
+		t.Fatalf("expected %v, received %v", errCollectorNameUnset, err)
+	}
+	if err := r.Register(c); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(NewGauge("test_total", "")); !errors.Is(err, errDuplicateCollector) {
+		t.Fatalf("expected %v, received %v", errDuplicateCollector, err)
+	}
+	// only the registered collector is removed by name
+	r.Unregister(NewGauge("test_total", ""))
+	if err := r.Register(c); !errors.Is(err, errDuplicateCollector) {
+		t.Fatalf("expected %v, received %v", errDuplicateCollector, err)
+	}
+	r.Unregister(c)
+	if err := r.Register(c); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGather(t *testing.T) {
+	t.Parallel()
+	r := NewRegistry()
+	c := NewCounter("test_requests_total", "Requests\nsent", "exchange")
+	g := NewGauge("test_connected", "", "exchange")
+	f := NewGaugeFunc("test_queue", "Queue depth", nil, func() []Sample {
+		return []Sample{{Value: 5}, {LabelValues: []string{"ignored"}, Value: 1}}
+	})
+	h := NewHistogram("test_duration_seconds", "", []float64{1, .5}, "exchange")
+	if err := r.Register(c, g, f, h); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Inc("Bit\"stamp")
+	c.Add(2, "Bit\"stamp")
+	c.Add(-1, "Bit\"stamp")
+	c.Inc("too", "many")
+	g.Set(1, "Binance")
+	g.Add(-2, "Binance")
+	h.Observe(.25, "Binance")
+	h.Observe(.75, "Binance")
+	h.Observe(2, "Binance")
+
+	expected := `# TYPE test_connected gauge
+test_connected{exchange="Binance"} -1
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{exchange="Binance",le="0.5"} 1
+test_duration_seconds_bucket{exchange="Binance",le="1"} 2
+test_duration_seconds_bucket{exchange="Binance",le="+Inf"} 3
+test_duration_seconds_sum{exchange="Binance"} 3
+test_duration_seconds_count{exchange="Binance"} 3
+# HELP test_queue Queue depth
+# TYPE test_queue gauge
+test_queue 5
+# HELP test_requests_total Requests\nsent
+# TYPE test_requests_total counter
+test_requests_total{exchange="Bit\"stamp"} 3
+`
+	if received := string(r.Gather()); received != expected {
+		t.Errorf("expected:\n%s\nreceived:\n%s", expected, received)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	c := NewCounter("test_total", "")
+	Disable()
+	c.Inc()
+	Enable()
+	if len(c.series) != 0 {
+		t.Error("observations should not be recorded while disabled")
+	}
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	r := NewRegistry()
+	c := NewCounter("test_total", "")
+	if err := r.Register(c); err != nil {
+		t.Fatal(err)
+	}
+	c.Inc()
+	resp := httptest.NewRecorder()
+	r.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected %v, received %v", http.StatusOK, resp.Code)
+	}
+	if ct := resp.Header().Get("Content-Type"); ct != ContentType {
+		t.Errorf("expected %v, received %v", ContentType, ct)
+	}
+	if !strings.Contains(resp.Body.String(), "test_total 1\n") {
+		t.Errorf("unexpected body %s", resp.Body.String())
+	}
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"errors"
+	"sync"
+)
+
+const (
+	counterType   = "counter"
+	gaugeType     = "gauge"
+	histogramType = "histogram"
+
+	// ContentType is the Prometheus text exposition format content type
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+var (
+	// DefaultLatencyBuckets are histogram upper bounds in seconds suited to
+	// exchange request round trips
+	DefaultLatencyBuckets = []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	defaultRegistry = NewRegistry()
+	enabled         int32
+
+	errCollectorNil       = errors.New("collector is nil")
+	errCollectorNameUnset = errors.New("collector name unset")
+	errDuplicateCollector = errors.New("collector already registered")
+)
+
+// Collector is a metric family which can be written in the Prometheus text
+// exposition format
+type Collector interface {
+	Name() string
+	write(b *[]byte)
+}
+
+// Registry holds the collectors served on a metrics endpoint
+type Registry struct {
+	m          sync.RWMutex
+	collectors map[string]Collector
+}
+
+// Sample is a single labelled value reported by a GaugeFunc
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+// desc describes a metric family
+type desc struct {
+	name   string
+	help   string
+	labels []string
+}
+
+// Counter is a monotonically increasing value partitioned by labels
+type Counter struct {
+	desc
+	m      sync.Mutex
+	series map[string]*series
+}
+
+// Gauge is a value which can go up and down partitioned by labels
+type Gauge struct {
+	desc
+	m      sync.Mutex
+	series map[string]*series
+}
+
+// GaugeFunc is a gauge whose samples are read from a function when the
+// metrics are scraped, used for state already held elsewhere such as queue
+// lengths
+type GaugeFunc struct {
+	desc
+	fn func() []Sample
+}
+
+// Histogram counts observations into cumulative buckets partitioned by
+// labels
+type Histogram struct {
+	desc
+	buckets []float64
+	m       sync.Mutex
+	series  map[string]*histogramSeries
+}
+
+type series struct {
+	labelValues []string
+	value       float64
+}
+
+type histogramSeries struct {
+	labelValues []string
+	counts      []uint64
+	sum         float64
+	count       uint64
+}
@@ -0,0 +1,152 @@
+package pnl
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ParseMethod returns a supported Method from a string
+func ParseMethod(m string) (Method, error) {
+	m = strings.ToLower(m)
+	for i := range SupportedMethods {
+		if string(SupportedMethods[i]) == m {
+			return SupportedMethods[i], nil
+		}
+	}
+	return "", fmt.Errorf("%w %s", errUnsupportedMethod, m)
+}
+
+// Calculate matches fills against open lots using the supplied method and
+// returns the realised profit and loss of every closed lot along with the
+// unrealised profit and loss of the remaining position valued at markPrice.
+// Sells without an open long position open a short position and flag the
+// result as ShortOpened. Opening fees are added to the cost basis of the lot
+// and closing fees are deducted from the realised profit, base currency fees
+// adjust the lot amount instead. If markPrice is zero the unrealised profit
+// and loss is not calculated
+func Calculate(method Method, fills []Fill, markPrice float64) (*Result, error) {
+	return CalculateSince(method, fills, markPrice, time.Time{})
+}
+
+// CalculateSince is Calculate where fills before since only seed the open
+// lots, so a date range which starts with sells is matched against the buys
+// preceding it. Only disposals and fees at or after since are reported
+func CalculateSince(method Method, fills []Fill, markPrice float64, since time.Time) (*Result, error) {
+	if _, err := ParseMethod(string(method)); err != nil {
+		return nil, err
+	}
+	sorted := make([]Fill, len(fills))
+	copy(sorted, fills)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	r := &Result{Method: method, MarkPrice: markPrice}
+	var lots []lot
+	// direction of the open position, 1 for long and -1 for short
+	var direction float64
+	for i := range sorted {
+		f := &sorted[i]
+		if f.Amount <= 0 {
+			return nil, errInvalidAmount
+		}
+		if f.Price <= 0 {
+			return nil, errInvalidPrice
+		}
+		var side float64
+		switch f.Side {
+		case order.Buy, order.Bid:
+			side = 1
+		case order.Sell, order.Ask:
+			side = -1
+		default:
+			return nil, fmt.Errorf("%w, received %s", errInvalidSide, f.Side)
+		}
+		// base fees are taken from the amount bought or added to the amount
+		// sold, the quote value of the fill is spread over the new amount
+		amount, price := f.Amount, f.Price
+		if f.BaseFee != 0 {
+			amount = f.Amount - f.BaseFee*side
+			if amount <= 0 {
+				return nil, errInvalidFee
+			}
+			price = f.Price * f.Amount / amount
+		}
+		reported := !f.Timestamp.Before(since)
+		if reported {
+			r.Fees += f.Fee + f.BaseFee*f.Price
+		}
+		feePerUnit := f.Fee / amount
+
+		remaining := amount
+		for remaining > amountTolerance && len(lots) > 0 && direction != side {
+			idx := 0
+			if method == LIFO {
+				idx = len(lots) - 1
+			}
+			l := &lots[idx]
+			matched := math.Min(remaining, l.amount)
+			fee := feePerUnit * matched
+			if reported {
+				r.Disposals = append(r.Disposals, Disposal{
+					Short:      direction < 0,
+					Amount:     matched,
+					OpenPrice:  l.price,
+					ClosePrice: price,
+					Fee:        fee,
+					PNL:        (price-l.price)*matched*direction - fee,
+					OpenTime:   l.timestamp,
+					CloseTime:  f.Timestamp,
+				})
+			}
+			l.amount -= matched
+			remaining -= matched
+			if l.amount <= amountTolerance {
+				lots = append(lots[:idx], lots[idx+1:]...)
+			}
+		}
+		if remaining <= amountTolerance {
+			continue
+		}
+
+		// fees raise the cost of a long and lower the proceeds of a short
+		direction = side
+		if side < 0 {
+			r.ShortOpened = true
+		}
+		opened := lot{
+			amount:    remaining,
+			price:     price + feePerUnit*side,
+			timestamp: f.Timestamp,
+		}
+		if method == AverageCost && len(lots) == 1 {
+			total := lots[0].amount + opened.amount
+			lots[0].price = (lots[0].price*lots[0].amount + opened.price*opened.amount) / total
+			lots[0].amount = total
+			continue
+		}
+		lots = append(lots, opened)
+	}
+
+	for i := range r.Disposals {
+		r.RealisedPNL += r.Disposals[i].PNL
+	}
+	var cost float64
+	for i := range lots {
+		r.OpenAmount += lots[i].amount
+		cost += lots[i].amount * lots[i].price
+		if markPrice > 0 {
+			r.UnrealisedPNL += (markPrice - lots[i].price) * lots[i].amount * direction
+		}
+	}
+	if r.OpenAmount > 0 {
+		r.AverageOpenPrice = cost / r.OpenAmount
+		r.OpenAmount *= direction
+	}
+	return r, nil
+}
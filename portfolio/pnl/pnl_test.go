@@ -0,0 +1,201 @@
+package pnl
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testFills() []Fill {
+	return []Fill{
+		{Side: order.Buy, Price: 100, Amount: 1, Timestamp: testStart},
+		{Side: order.Buy, Price: 200, Amount: 1, Timestamp: testStart.Add(time.Hour)},
+		{Side: order.Sell, Price: 300, Amount: 1, Timestamp: testStart.Add(time.Hour * 2)},
+	}
+}
+
+func equal(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestParseMethod(t *testing.T) {
+	t.Parallel()
+	m, err := ParseMethod("FIFO")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != FIFO {
+		t.Errorf("expected %v, received %v", FIFO, m)
+	}
+	_, err = ParseMethod("hifo")
+	if !errors.Is(err, errUnsupportedMethod) {
+		t.Errorf("expected %v, received %v", errUnsupportedMethod, err)
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		method     Method
+		realised   float64
+		unrealised float64
+		openPrice  float64
+	}{
+		{method: FIFO, realised: 200, unrealised: 200, openPrice: 200},
+		{method: LIFO, realised: 100, unrealised: 300, openPrice: 100},
+		{method: AverageCost, realised: 150, unrealised: 250, openPrice: 150},
+	}
+	for i := range testCases {
+		r, err := Calculate(testCases[i].method, testFills(), 400)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equal(r.RealisedPNL, testCases[i].realised) {
+			t.Errorf("%v: expected realised %v, received %v", testCases[i].method, testCases[i].realised, r.RealisedPNL)
+		}
+		if !equal(r.UnrealisedPNL, testCases[i].unrealised) {
+			t.Errorf("%v: expected unrealised %v, received %v", testCases[i].method, testCases[i].unrealised, r.UnrealisedPNL)
+		}
+		if !equal(r.AverageOpenPrice, testCases[i].openPrice) {
+			t.Errorf("%v: expected open price %v, received %v", testCases[i].method, testCases[i].openPrice, r.AverageOpenPrice)
+		}
+		if !equal(r.OpenAmount, 1) {
+			t.Errorf("%v: expected open amount 1, received %v", testCases[i].method, r.OpenAmount)
+		}
+		if len(r.Disposals) != 1 {
+			t.Errorf("%v: expected 1 disposal, received %v", testCases[i].method, len(r.Disposals))
+		}
+	}
+}
+
+func TestCalculateFeesAndShorts(t *testing.T) {
+	t.Parallel()
+	fills := []Fill{
+		// out of order fills are sorted by timestamp
+		{Side: order.Buy, Price: 80, Amount: 3, Fee: 3, Timestamp: testStart.Add(time.Hour)},
+		{Side: order.Sell, Price: 100, Amount: 2, Fee: 2, Timestamp: testStart},
+	}
+	r, err := Calculate(FIFO, fills, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// short opened at 99 after fees and closed at 81 after fees
+	if !equal(r.RealisedPNL, 36) {
+		t.Errorf("expected realised 36, received %v", r.RealisedPNL)
+	}
+	if !equal(r.Fees, 5) {
+		t.Errorf("expected fees 5, received %v", r.Fees)
+	}
+	if !r.Disposals[0].Short {
+		t.Error("expected short disposal")
+	}
+	// remaining buy flips the position long
+	if !equal(r.OpenAmount, 1) || !equal(r.AverageOpenPrice, 81) {
+		t.Errorf("expected 1 open at 81, received %v at %v", r.OpenAmount, r.AverageOpenPrice)
+	}
+	if r.UnrealisedPNL != 0 {
+		t.Errorf("expected no unrealised pnl without a mark price, received %v", r.UnrealisedPNL)
+	}
+
+	r, err = Calculate(AverageCost, fills[1:], 90)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal(r.OpenAmount, -2) || !equal(r.UnrealisedPNL, 18) {
+		t.Errorf("expected short of -2 with 18 unrealised, received %v %v", r.OpenAmount, r.UnrealisedPNL)
+	}
+}
+
+func TestCalculateBaseFees(t *testing.T) {
+	t.Parallel()
+	fills := []Fill{
+		{Side: order.Buy, Price: 100, Amount: 1, BaseFee: 0.01, Timestamp: testStart},
+		{Side: order.Sell, Price: 200, Amount: 0.99, Timestamp: testStart.Add(time.Hour)},
+	}
+	r, err := Calculate(FIFO, fills, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the base fee leaves 0.99 bought for 100 which is sold for 198
+	if !equal(r.RealisedPNL, 98) || !equal(r.OpenAmount, 0) || r.ShortOpened {
+		t.Errorf("expected realised 98 with no open amount, received %v %v %v",
+			r.RealisedPNL, r.OpenAmount, r.ShortOpened)
+	}
+	if !equal(r.Fees, 1) {
+		t.Errorf("expected fees 1, received %v", r.Fees)
+	}
+
+	// a base fee on a sell gives up more than the amount sold
+	fills[1] = Fill{Side: order.Sell, Price: 200, Amount: 0.98, BaseFee: 0.01, Timestamp: testStart.Add(time.Hour)}
+	r, err = Calculate(FIFO, fills, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal(r.RealisedPNL, 96) || !equal(r.OpenAmount, 0) {
+		t.Errorf("expected realised 96 with no open amount, received %v %v", r.RealisedPNL, r.OpenAmount)
+	}
+
+	_, err = Calculate(FIFO, []Fill{{Side: order.Buy, Price: 1, Amount: 1, BaseFee: 1}}, 0)
+	if !errors.Is(err, errInvalidFee) {
+		t.Errorf("expected %v, received %v", errInvalidFee, err)
+	}
+}
+
+func TestCalculateSince(t *testing.T) {
+	t.Parallel()
+	since := testStart.Add(time.Hour * 2)
+	r, err := CalculateSince(FIFO, testFills(), 400, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the sell is matched against the oldest buy preceding the range
+	if r.ShortOpened || len(r.Disposals) != 1 || !equal(r.RealisedPNL, 200) {
+		t.Errorf("expected the seeded buy to be closed, received %+v", r)
+	}
+	if !equal(r.OpenAmount, 1) || !equal(r.AverageOpenPrice, 200) {
+		t.Errorf("expected 1 open at 200, received %v at %v", r.OpenAmount, r.AverageOpenPrice)
+	}
+
+	// without the preceding buys the sell opens a short which is flagged
+	r, err = Calculate(FIFO, testFills()[2:], 400)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.ShortOpened || !equal(r.OpenAmount, -1) {
+		t.Errorf("expected a flagged short, received %+v", r)
+	}
+
+	// disposals closed before since are not reported
+	r, err = CalculateSince(FIFO, testFills(), 400, since.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Disposals) != 0 || r.RealisedPNL != 0 || !equal(r.OpenAmount, 1) {
+		t.Errorf("expected no reported disposals, received %+v", r)
+	}
+}
+
+func TestCalculateErrors(t *testing.T) {
+	t.Parallel()
+	_, err := Calculate("hifo", nil, 0)
+	if !errors.Is(err, errUnsupportedMethod) {
+		t.Errorf("expected %v, received %v", errUnsupportedMethod, err)
+	}
+	_, err = Calculate(FIFO, []Fill{{Side: order.Buy, Price: 1}}, 0)
+	if !errors.Is(err, errInvalidAmount) {
+		t.Errorf("expected %v, received %v", errInvalidAmount, err)
+	}
+	_, err = Calculate(FIFO, []Fill{{Side: order.Buy, Amount: 1}}, 0)
+	if !errors.Is(err, errInvalidPrice) {
+		t.Errorf("expected %v, received %v", errInvalidPrice, err)
+	}
+	_, err = Calculate(FIFO, []Fill{{Side: order.AnySide, Price: 1, Amount: 1}}, 0)
+	if !errors.Is(err, errInvalidSide) {
+		t.Errorf("expected %v, received %v", errInvalidSide, err)
+	}
+}
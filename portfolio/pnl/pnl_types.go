@@ -0,0 +1,85 @@
+package pnl
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Method defines how closing fills are matched against open lots
+type Method string
+
+const (
+	// FIFO closes the oldest open lot first
+	FIFO Method = "fifo"
+	// LIFO closes the most recently opened lot first
+	LIFO Method = "lifo"
+	// AverageCost pools all open lots at their weighted average price
+	AverageCost Method = "average"
+
+	// amountTolerance absorbs float rounding when lots are fully closed
+	amountTolerance = 1e-12
+)
+
+var (
+	// SupportedMethods lists all lot matching methods
+	SupportedMethods = []Method{FIFO, LIFO, AverageCost}
+
+	errUnsupportedMethod = errors.New("unsupported pnl method")
+	errInvalidSide       = errors.New("fill side must be buy or sell")
+	errInvalidAmount     = errors.New("fill amount must be greater than zero")
+	errInvalidPrice      = errors.New("fill price must be greater than zero")
+	errInvalidFee        = errors.New("fill base fee must be less than the fill amount")
+)
+
+// Fill is a single execution used for lot accounting. Fee is charged in the
+// quote currency of the pair while BaseFee is charged in the base currency,
+// reducing the amount a buy receives or increasing the amount a sell gives up
+type Fill struct {
+	Side      order.Side
+	Price     float64
+	Amount    float64
+	Fee       float64
+	BaseFee   float64
+	Timestamp time.Time
+}
+
+// Disposal is the realised result of closing part or all of an open lot, one
+// disposal is produced per matched lot which is the level of detail required
+// for tax reporting
+type Disposal struct {
+	Short      bool
+	Amount     float64
+	OpenPrice  float64
+	ClosePrice float64
+	Fee        float64
+	PNL        float64
+	OpenTime   time.Time
+	CloseTime  time.Time
+}
+
+// Result holds the realised and unrealised profit and loss for a series of
+// fills of a single pair, all values are in the quote currency
+type Result struct {
+	Method           Method
+	RealisedPNL      float64
+	UnrealisedPNL    float64
+	Fees             float64
+	OpenAmount       float64
+	AverageOpenPrice float64
+	MarkPrice        float64
+	// ShortOpened is set when a sell found no open long lot and opened a
+	// short position. For spot pairs this means buys preceding the supplied
+	// fills are missing and the result is incomplete
+	ShortOpened bool
+	Disposals   []Disposal
+}
+
+// lot is an open position opened by a single fill, the price includes the
+// opening fee
+type lot struct {
+	amount    float64
+	price     float64
+	timestamp time.Time
+}
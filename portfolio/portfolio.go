@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -30,6 +32,10 @@ var Portfolio Base
 // Verbose allows for debug output when sending an http request
 var Verbose bool
 
+// loansMtx guards the loans stored in a portfolio base, a mutex field would
+// be copied by Seed
+var loansMtx sync.RWMutex
+
 // GetEthereumBalance single or multiple address information as
 // EtherchainBalanceResponse
 func GetEthereumBalance(address string) (EthplorerResponse, error) {
@@ -451,6 +457,34 @@ func (p *Base) Seed(port Base) {
 	p.Addresses = port.Addresses
 }
 
+// UpdateExchangeLoans replaces the loans tracked for an exchange
+func (p *Base) UpdateExchangeLoans(exchangeName string, loans []lending.Loan) {
+	loansMtx.Lock()
+	defer loansMtx.Unlock()
+	target := 0
+	for i := range p.Loans {
+		if p.Loans[i].Exchange != exchangeName {
+			p.Loans[target] = p.Loans[i]
+			target++
+		}
+	}
+	p.Loans = append(p.Loans[:target], loans...)
+}
+
+// GetExchangeLoans returns the loans tracked for an exchange, or the loans
+// tracked across all exchanges when the exchange name is empty
+func (p *Base) GetExchangeLoans(exchangeName string) []lending.Loan {
+	loansMtx.RLock()
+	defer loansMtx.RUnlock()
+	var loans []lending.Loan
+	for i := range p.Loans {
+		if exchangeName == "" || p.Loans[i].Exchange == exchangeName {
+			loans = append(loans, p.Loans[i])
+		}
+	}
+	return loans
+}
+
 // StartPortfolioWatcher observes the portfolio object
 func StartPortfolioWatcher() {
 	addrCount := len(Portfolio.Addresses)
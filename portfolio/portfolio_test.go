@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 )
 
 const (
@@ -550,6 +551,34 @@ func TestGetPortfolio(t *testing.T) {
 	}
 }
 
+func TestExchangeLoans(t *testing.T) {
+	var b Base
+	b.UpdateExchangeLoans("Bitfinex", []lending.Loan{
+		{Exchange: "Bitfinex", ID: "1", Currency: currency.USD, Side: lending.Lent, Amount: 100},
+		{Exchange: "Bitfinex", ID: "2", Currency: currency.BTC, Side: lending.Borrowed, Amount: 1},
+	})
+	b.UpdateExchangeLoans("Binance", []lending.Loan{
+		{Exchange: "Binance", Currency: currency.USDT, Side: lending.Borrowed, Amount: 50},
+	})
+	if loans := b.GetExchangeLoans(""); len(loans) != 3 {
+		t.Fatalf("expected 3 loans, received %d", len(loans))
+	}
+	b.UpdateExchangeLoans("Bitfinex", []lending.Loan{
+		{Exchange: "Bitfinex", ID: "2", Currency: currency.BTC, Side: lending.Borrowed, Amount: 0.5},
+	})
+	loans := b.GetExchangeLoans("Bitfinex")
+	if len(loans) != 1 || loans[0].Amount != 0.5 {
+		t.Fatalf("unexpected Bitfinex loans %+v", loans)
+	}
+	if loans = b.GetExchangeLoans("Binance"); len(loans) != 1 {
+		t.Fatalf("expected 1 Binance loan, received %d", len(loans))
+	}
+	b.UpdateExchangeLoans("Binance", nil)
+	if loans = b.GetExchangeLoans("Binance"); len(loans) != 0 {
+		t.Fatalf("expected no Binance loans, received %d", len(loans))
+	}
+}
+
 func seedPortFolioForTest(t *testing.T) {
 	t.Helper()
 	if portfolioSeeded {
@@ -4,11 +4,15 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 )
 
 // Base holds the portfolio base addresses
 type Base struct {
 	Addresses []Address `json:"addresses"`
+	// Loans holds the outstanding borrowed and lent funds tracked across
+	// exchanges, it is guarded by loansMtx
+	Loans []lending.Loan `json:"-"`
 }
 
 // Address sub type holding address information for portfolio
@@ -0,0 +1,79 @@
+package transfer
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/cache"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	// ErrRequestCannotBeNil message to return when a request is nil
+	ErrRequestCannotBeNil = errors.New("request cannot be nil")
+	// ErrExchangeNameUnset message to return when an exchange name is unset
+	ErrExchangeNameUnset = errors.New("exchange name unset")
+	// ErrAmountMustBeGreaterThanZero message to return when requested amount is less than 0
+	ErrAmountMustBeGreaterThanZero = errors.New("amount must be greater than 0")
+	// ErrNoCurrencySet message to return when no currency is set
+	ErrNoCurrencySet = errors.New("currency not set")
+	// ErrInvalidAsset message to return when a wallet asset cannot hold funds
+	ErrInvalidAsset = errors.New("asset is not a valid wallet")
+	// ErrSameSourceAndDestination message to return when funds would not move
+	ErrSameSourceAndDestination = errors.New("source and destination cannot be the same")
+	// ErrUnsupportedTransfer message to return when an exchange cannot move
+	// funds between the requested accounts or wallets
+	ErrUnsupportedTransfer = errors.New("transfer route not supported")
+	// CacheSize cache size to use for transfer request history
+	CacheSize uint64 = 25
+	// Cache LRU cache for recent requests
+	Cache = cache.New(CacheSize)
+	// DryRunID uuid to use for dryruns
+	DryRunID, _ = uuid.FromString("a1f52f4f-6a7a-4e86-9f5e-3cbb1d2f0c31")
+
+	wallets = asset.Items{
+		asset.Spot,
+		asset.Margin,
+		asset.MarginFunding,
+		asset.Futures,
+		asset.PerpetualSwap,
+		asset.CoinMarginedFutures,
+		asset.USDTMarginedFutures,
+	}
+)
+
+// Request holds the details of a transfer between the wallets of an account
+// or between the master account and its sub-accounts. An empty account
+// refers to the master account
+type Request struct {
+	Exchange    string        `json:"exchange"`
+	Currency    currency.Code `json:"currency"`
+	Amount      float64       `json:"amount"`
+	Description string        `json:"description"`
+
+	FromAccount string     `json:"from_account"`
+	ToAccount   string     `json:"to_account"`
+	FromAsset   asset.Item `json:"from_asset"`
+	ToAsset     asset.Item `json:"to_asset"`
+}
+
+// Response holds complete details for Response
+type Response struct {
+	ID uuid.UUID `json:"id"`
+
+	Exchange       ExchangeResponse `json:"exchange"`
+	RequestDetails Request          `json:"request_details"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ExchangeResponse holds information returned from an exchange
+type ExchangeResponse struct {
+	Name   string `json:"name"`
+	UUID   uuid.UUID
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
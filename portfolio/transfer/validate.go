@@ -0,0 +1,46 @@
+package transfer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Validate checks the request meets the requirements to submit
+func (r *Request) Validate() error {
+	if r == nil {
+		return ErrRequestCannotBeNil
+	}
+	if r.Exchange == "" {
+		return ErrExchangeNameUnset
+	}
+	if r.Amount <= 0 {
+		return ErrAmountMustBeGreaterThanZero
+	}
+	if (r.Currency == currency.Code{}) {
+		return ErrNoCurrencySet
+	}
+	if !IsWallet(r.FromAsset) {
+		return fmt.Errorf("%w %s", ErrInvalidAsset, r.FromAsset)
+	}
+	if !IsWallet(r.ToAsset) {
+		return fmt.Errorf("%w %s", ErrInvalidAsset, r.ToAsset)
+	}
+	if r.FromAsset == r.ToAsset &&
+		strings.EqualFold(r.FromAccount, r.ToAccount) {
+		return ErrSameSourceAndDestination
+	}
+	return nil
+}
+
+// IsWallet returns whether funds can be held and transferred in an asset
+func IsWallet(a asset.Item) bool {
+	return wallets.Contains(a)
+}
+
+// IsSubAccountTransfer returns whether funds move between different accounts
+func (r *Request) IsSubAccountTransfer() bool {
+	return !strings.EqualFold(r.FromAccount, r.ToAccount)
+}
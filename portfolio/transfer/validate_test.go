@@ -0,0 +1,62 @@
+package transfer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func validRequest() *Request {
+	return &Request{
+		Exchange:  "Binance",
+		Currency:  currency.BTC,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Margin,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var r *Request
+	if err := r.Validate(); !errors.Is(err, ErrRequestCannotBeNil) {
+		t.Errorf("received %v, expected %v", err, ErrRequestCannotBeNil)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *Request)
+		err    error
+	}{
+		{"Valid", func(r *Request) {}, nil},
+		{"SubAccount", func(r *Request) { r.ToAsset = asset.Spot; r.ToAccount = "sub" }, nil},
+		{"NoExchange", func(r *Request) { r.Exchange = "" }, ErrExchangeNameUnset},
+		{"NoAmount", func(r *Request) { r.Amount = 0 }, ErrAmountMustBeGreaterThanZero},
+		{"NoCurrency", func(r *Request) { r.Currency = currency.Code{} }, ErrNoCurrencySet},
+		{"BadFromAsset", func(r *Request) { r.FromAsset = asset.Index }, ErrInvalidAsset},
+		{"BadToAsset", func(r *Request) { r.ToAsset = "" }, ErrInvalidAsset},
+		{"Same", func(r *Request) { r.ToAsset = asset.Spot }, ErrSameSourceAndDestination},
+	}
+	for x := range tests {
+		test := tests[x]
+		t.Run(test.name, func(t *testing.T) {
+			r := validRequest()
+			test.modify(r)
+			if err := r.Validate(); !errors.Is(err, test.err) {
+				t.Errorf("received %v, expected %v", err, test.err)
+			}
+		})
+	}
+}
+
+func TestIsSubAccountTransfer(t *testing.T) {
+	r := validRequest()
+	if r.IsSubAccountTransfer() {
+		t.Error("expected wallet transfer")
+	}
+	r.ToAccount = "sub"
+	if !r.IsSubAccountTransfer() {
+		t.Error("expected sub-account transfer")
+	}
+}